@@ -61,7 +61,7 @@ func (a *App) Run() error {
 		return fmt.Errorf("unable to init store: %w", err)
 	}
 
-	cache, err := abicodec.NewABICache(store, a.config.CacheStateName)
+	cache, err := abicodec.NewABICache(store, a.config.CacheStateName, abicodec.WithLegacyMigration())
 	if err != nil {
 		return fmt.Errorf("unable to init ABI cache: %w", err)
 	}
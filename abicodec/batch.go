@@ -0,0 +1,204 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package abicodec
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/streamingfast/derr"
+	pbabicodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/abicodec/v1"
+	"github.com/zhongshuwen/zswchain-go"
+	"google.golang.org/grpc/codes"
+)
+
+// maxBatchSize is the maximum number of payloads a single batch request can hold, all groups included
+const maxBatchSize = 10000
+
+// maxResolvedABIs bounds the lookups remembered by an `abiResolver`, long lived streams
+// start over once reached.
+const maxResolvedABIs = 10000
+
+// ABINotFoundError is returned when an account has no ABI at the requested block.
+type ABINotFoundError struct {
+	Account  string
+	BlockNum uint32
+}
+
+func (e *ABINotFoundError) Error() string {
+	return fmt.Sprintf("no ABI found for account: %s at block %d", e.Account, e.BlockNum)
+}
+
+type abiLookup struct {
+	account  string
+	blockNum uint32
+}
+
+// abiResolver remembers the ABIs found in the cache for the lifetime of a batch request or
+// a stream, so payloads of the same account and block resolve their ABI once. Misses are
+// not remembered, the ABI can be synced in the cache later on in a stream.
+type abiResolver struct {
+	cache Cache
+	items map[abiLookup]*ABICacheItem
+}
+
+func newABIResolver(cache Cache) *abiResolver {
+	return &abiResolver{
+		cache: cache,
+		items: map[abiLookup]*ABICacheItem{},
+	}
+}
+
+func (r *abiResolver) resolve(account string, blockNum uint32) (*ABICacheItem, error) {
+	key := abiLookup{account, blockNum}
+
+	if item, found := r.items[key]; found {
+		return item, nil
+	}
+
+	item := r.cache.ABIAtBlockNum(account, blockNum)
+	if item == nil {
+		return nil, &ABINotFoundError{Account: account, BlockNum: blockNum}
+	}
+
+	if len(r.items) >= maxResolvedABIs {
+		r.items = map[abiLookup]*ABICacheItem{}
+	}
+	r.items[key] = item
+
+	return item, nil
+}
+
+func (r *abiResolver) decodeAction(account string, action string, payload []byte, blockNum uint32) *pbabicodec.DecodeResult {
+	abiItem, err := r.resolve(account, blockNum)
+	if err != nil {
+		return newDecodeErrorResult(err)
+	}
+
+	out, err := abiItem.ABI.DecodeAction(payload, zsw.ActionName(action))
+	if err != nil {
+		return newDecodeErrorResult(err)
+	}
+
+	return &pbabicodec.DecodeResult{AbiBlockNum: abiItem.BlockNum, JsonPayload: string(out)}
+}
+
+func (r *abiResolver) decodeTable(account string, table string, payload []byte, blockNum uint32) *pbabicodec.DecodeResult {
+	abiItem, err := r.resolve(account, blockNum)
+	if err != nil {
+		return newDecodeErrorResult(err)
+	}
+
+	out, err := abiItem.ABI.DecodeTableRow(zsw.TableName(table), payload)
+	if err != nil {
+		return newDecodeErrorResult(err)
+	}
+
+	return &pbabicodec.DecodeResult{AbiBlockNum: abiItem.BlockNum, JsonPayload: string(out)}
+}
+
+func newDecodeErrorResult(err error) *pbabicodec.DecodeResult {
+	code := pbabicodec.DecodeErrorCode_DECODEERRORCODE_INVALID_PAYLOAD
+	if _, ok := err.(*ABINotFoundError); ok {
+		code = pbabicodec.DecodeErrorCode_DECODEERRORCODE_ABI_NOT_FOUND
+	}
+
+	return &pbabicodec.DecodeResult{ErrorCode: code, ErrorMessage: err.Error()}
+}
+
+// DecodeActionsBatch decodes every action of every group, results are returned in the order
+// of the request, groups flattened.
+func (d *Decoder) DecodeActionsBatch(ctx context.Context, req *pbabicodec.DecodeActionsBatchRequest) (*pbabicodec.DecodeBatchResponse, error) {
+	count := 0
+	for _, group := range req.Groups {
+		count += len(group.Actions)
+	}
+
+	if count > maxBatchSize {
+		return nil, derr.Statusf(codes.InvalidArgument, "batch holds %d actions, at most %d are accepted", count, maxBatchSize)
+	}
+
+	resolver := newABIResolver(d.cache)
+	resp := &pbabicodec.DecodeBatchResponse{Results: make([]*pbabicodec.DecodeResult, 0, count)}
+	for _, group := range req.Groups {
+		for _, action := range group.Actions {
+			resp.Results = append(resp.Results, resolver.decodeAction(group.Account, action.Action, action.Payload, group.AtBlockNum))
+		}
+	}
+
+	return resp, nil
+}
+
+// DecodeTablesBatch decodes every table row of every group, results are returned in the order
+// of the request, groups flattened.
+func (d *Decoder) DecodeTablesBatch(ctx context.Context, req *pbabicodec.DecodeTablesBatchRequest) (*pbabicodec.DecodeBatchResponse, error) {
+	count := 0
+	for _, group := range req.Groups {
+		count += len(group.Rows)
+	}
+
+	if count > maxBatchSize {
+		return nil, derr.Statusf(codes.InvalidArgument, "batch holds %d table rows, at most %d are accepted", count, maxBatchSize)
+	}
+
+	resolver := newABIResolver(d.cache)
+	resp := &pbabicodec.DecodeBatchResponse{Results: make([]*pbabicodec.DecodeResult, 0, count)}
+	for _, group := range req.Groups {
+		for _, row := range group.Rows {
+			resp.Results = append(resp.Results, resolver.decodeTable(group.Account, row.Table, row.Payload, group.AtBlockNum))
+		}
+	}
+
+	return resp, nil
+}
+
+// DecodeActionsStream sends back one result per received action, in the order they were received.
+func (d *Decoder) DecodeActionsStream(stream pbabicodec.Decoder_DecodeActionsStreamServer) error {
+	resolver := newABIResolver(d.cache)
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(resolver.decodeAction(req.Account, req.Action, req.Payload, req.AtBlockNum)); err != nil {
+			return err
+		}
+	}
+}
+
+// DecodeTablesStream sends back one result per received table row, in the order they were received.
+func (d *Decoder) DecodeTablesStream(stream pbabicodec.Decoder_DecodeTablesStreamServer) error {
+	resolver := newABIResolver(d.cache)
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(resolver.decodeTable(req.Account, req.Table, req.Payload, req.AtBlockNum)); err != nil {
+			return err
+		}
+	}
+}
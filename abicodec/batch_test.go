@@ -0,0 +1,175 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package abicodec
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	pbabicodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/abicodec/v1"
+	"github.com/zhongshuwen/zswchain-go"
+	"google.golang.org/grpc"
+)
+
+const testTransferHex = "7015345262aaba4a90558c8663aaba4a853300000000000004454f53000000006d7b2274797065223a22627579222c226d61726b6574223a22454f53222c227175616e74697479223a22312e33313839222c227072696365223a22302e3130343334393137222c22636f6465223a22656f7364747374746f6b656e222c2273796d626f6c223a22454f534454227d"
+const testAccountRowHex = "2ef204000000000004454f5300000000"
+
+func TestDecoder_DecodeActionsBatch(t *testing.T) {
+	decoder, cache := newBatchTestDecoder(t)
+	transfer, _ := hex.DecodeString(testTransferHex)
+
+	resp, err := decoder.DecodeActionsBatch(context.Background(), &pbabicodec.DecodeActionsBatchRequest{
+		Groups: []*pbabicodec.DecodeActionsGroup{
+			{Account: "zswhq.token", AtBlockNum: 101, Actions: []*pbabicodec.ActionPayload{
+				{Action: "transfer", Payload: transfer},
+				{Action: "transfer", Payload: []byte{0x01}},
+			}},
+			{Account: "zswhq.token", AtBlockNum: 99, Actions: []*pbabicodec.ActionPayload{
+				{Action: "transfer", Payload: transfer},
+			}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 3)
+
+	assert.Equal(t, pbabicodec.DecodeErrorCode_DECODEERRORCODE_NONE, resp.Results[0].ErrorCode)
+	assert.Equal(t, uint32(100), resp.Results[0].AbiBlockNum)
+	assert.Equal(t, "dexeosmmaker", gjson.Get(resp.Results[0].JsonPayload, "from").Str)
+
+	assert.Equal(t, pbabicodec.DecodeErrorCode_DECODEERRORCODE_INVALID_PAYLOAD, resp.Results[1].ErrorCode)
+	assert.NotEmpty(t, resp.Results[1].ErrorMessage)
+
+	assert.Equal(t, pbabicodec.DecodeErrorCode_DECODEERRORCODE_ABI_NOT_FOUND, resp.Results[2].ErrorCode)
+	assert.Equal(t, "no ABI found for account: zswhq.token at block 99", resp.Results[2].ErrorMessage)
+
+	assert.Equal(t, 2, cache.lookups, "ABI should be resolved once per account and block")
+}
+
+func TestDecoder_DecodeTablesBatch(t *testing.T) {
+	decoder, _ := newBatchTestDecoder(t)
+	row, _ := hex.DecodeString(testAccountRowHex)
+
+	resp, err := decoder.DecodeTablesBatch(context.Background(), &pbabicodec.DecodeTablesBatchRequest{
+		Groups: []*pbabicodec.DecodeTablesGroup{
+			{Account: "zswhq.token", AtBlockNum: 100, Rows: []*pbabicodec.TablePayload{{Table: "accounts", Payload: row}}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 1)
+
+	assert.Equal(t, "32.4142 EOS", gjson.Get(resp.Results[0].JsonPayload, "balance").Str)
+}
+
+func TestDecoder_DecodeActionsBatch_TooLarge(t *testing.T) {
+	decoder, _ := newBatchTestDecoder(t)
+
+	_, err := decoder.DecodeActionsBatch(context.Background(), &pbabicodec.DecodeActionsBatchRequest{
+		Groups: []*pbabicodec.DecodeActionsGroup{{Account: "zswhq.token", Actions: make([]*pbabicodec.ActionPayload, maxBatchSize+1)}},
+	})
+	assert.Error(t, err)
+}
+
+func TestDecoder_DecodeActionsStream(t *testing.T) {
+	decoder, cache := newBatchTestDecoder(t)
+	transfer, _ := hex.DecodeString(testTransferHex)
+
+	stream := &testActionsStream{requests: []*pbabicodec.DecodeActionRequest{
+		{Account: "zswhq.token", Action: "transfer", AtBlockNum: 150, Payload: transfer},
+		{Account: "zswhq.token", Action: "transfer", AtBlockNum: 150, Payload: transfer},
+		{Account: "unknown", Action: "transfer", AtBlockNum: 150, Payload: transfer},
+	}}
+
+	require.NoError(t, decoder.DecodeActionsStream(stream))
+	require.Len(t, stream.results, 3)
+
+	assert.Equal(t, "dexeoswallet", gjson.Get(stream.results[0].JsonPayload, "to").Str)
+	assert.Equal(t, stream.results[0], stream.results[1])
+	assert.Equal(t, pbabicodec.DecodeErrorCode_DECODEERRORCODE_ABI_NOT_FOUND, stream.results[2].ErrorCode)
+	assert.Equal(t, 2, cache.lookups)
+}
+
+func TestDecoder_DecodeActionsStream_ABISyncedLater(t *testing.T) {
+	decoder, cache := newBatchTestDecoder(t)
+	transfer, _ := hex.DecodeString(testTransferHex)
+
+	stream := &testActionsStream{
+		requests: []*pbabicodec.DecodeActionRequest{
+			{Account: "other.token", Action: "transfer", AtBlockNum: 150, Payload: transfer},
+			{Account: "other.token", Action: "transfer", AtBlockNum: 150, Payload: transfer},
+		},
+		onSend: func() {
+			cache.SetABIAtBlockNum("other.token", 120, "", cache.ABIAtBlockNum("zswhq.token", 100).ABI)
+		},
+	}
+
+	require.NoError(t, decoder.DecodeActionsStream(stream))
+	require.Len(t, stream.results, 2)
+
+	assert.Equal(t, pbabicodec.DecodeErrorCode_DECODEERRORCODE_ABI_NOT_FOUND, stream.results[0].ErrorCode)
+	assert.Equal(t, pbabicodec.DecodeErrorCode_DECODEERRORCODE_NONE, stream.results[1].ErrorCode)
+	assert.Equal(t, uint32(120), stream.results[1].AbiBlockNum)
+}
+
+type countingCache struct {
+	*DefaultCache
+	lookups int
+}
+
+func (c *countingCache) ABIAtBlockNum(account string, blockNum uint32) *ABICacheItem {
+	c.lookups++
+	return c.DefaultCache.ABIAtBlockNum(account, blockNum)
+}
+
+func newBatchTestDecoder(t *testing.T) (*Decoder, *countingCache) {
+	var abi *zsw.ABI
+	require.NoError(t, json.Unmarshal([]byte(ABI_TRANSFER), &abi))
+
+	cache := &countingCache{DefaultCache: &DefaultCache{Abis: map[string][]*ABICacheItem{}}}
+	cache.SetABIAtBlockNum("zswhq.token", 100, "", abi)
+
+	return NewDecoder(cache), cache
+}
+
+type testActionsStream struct {
+	grpc.ServerStream
+
+	requests []*pbabicodec.DecodeActionRequest
+	results  []*pbabicodec.DecodeResult
+	onSend   func()
+}
+
+func (s *testActionsStream) Recv() (*pbabicodec.DecodeActionRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *testActionsStream) Send(result *pbabicodec.DecodeResult) error {
+	s.results = append(s.results, result)
+	if s.onSend != nil {
+		s.onSend()
+	}
+	return nil
+}
@@ -33,7 +33,8 @@ import (
 
 type Cache interface {
 	ABIAtBlockNum(account string, blockNum uint32) *ABICacheItem
-	SetABIAtBlockNum(account string, blockNum uint32, abi *zsw.ABI)
+	ListABIVersions(account string) []*ABIVersion
+	SetABIAtBlockNum(account string, blockNum uint32, trxID string, abi *zsw.ABI)
 	RemoveABIAtBlockNum(account string, blockNum uint32)
 	SaveState() error
 	SetCursor(cursor string)
@@ -42,21 +43,81 @@ type Cache interface {
 }
 
 type DefaultCache struct {
-	Abis      map[string][]*ABICacheItem // from account to the ABIs in range
+	Abis      map[string][]*ABICacheItem // from account to the ABIs in range, holds only the accounts loaded so far
 	Cursor    string                     `json:"cursor"`
-	lock      sync.Mutex
+	lock      sync.Mutex                 // guards the cache state, never held while reading from or writing to the store
+	saveLock  sync.Mutex                 // serializes `SaveState` calls
 	store     dstore.Store
 	cacheName string
 	dirty     bool
+
+	index         *cacheIndex     // accounts and cursor as last saved in the store
+	dirtyAccounts map[string]bool // accounts changed since last save
+	pending       map[string][]abiMutation
+	loads         map[string]*segmentLoad // segments being read from the store
+}
+
+// segmentLoad is an in-flight read of the segment of an account, `err` being set once `done`
+// is closed.
+type segmentLoad struct {
+	done chan struct{}
+	err  error
 }
 
-func NewABICache(store dstore.Store, cacheName string) (*DefaultCache, error) {
+// abiMutation is a change to the ABIs of an account, kept pending while the account's segment
+// cannot be loaded.
+type abiMutation func(items []*ABICacheItem) []*ABICacheItem
+
+// CacheOption configures how `NewABICache` opens a cache.
+type CacheOption func(o *cacheOptions)
+
+type cacheOptions struct {
+	migrateLegacy bool
+}
+
+// WithLegacyMigration writes the versioned format of a legacy gob cache as soon as it is opened.
+// Only the process syncing the cache should use it, other users reading the store untouched.
+func WithLegacyMigration() CacheOption {
+	return func(o *cacheOptions) {
+		o.migrateLegacy = true
+	}
+}
+
+// NewABICache loads the cache index named `cacheName` from `store`, the ABIs of each account
+// being loaded when the account is first accessed. A legacy gob cache file named `cacheName`
+// is loaded when no index exists yet, and written in the versioned format by the next
+// `SaveState` call, right away with `WithLegacyMigration`.
+//
+// Once the index exists the legacy file is ignored, so every instance sharing the store must be
+// upgraded together, the legacy file still written by older instances being never read again.
+func NewABICache(store dstore.Store, cacheName string, opts ...CacheOption) (*DefaultCache, error) {
+	options := &cacheOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
 	zlog.Info("loading cache", zap.String("cache_name", cacheName))
 	start := time.Now()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
 	defer cancel()
 
+	index, err := readIndex(ctx, store, cacheName)
+	if err != nil {
+		return nil, fmt.Errorf("reading cache index: %w", err)
+	}
+
+	if index != nil {
+		zlog.Info("cache index loaded", zap.String("cache_name", cacheName), zap.Int("account_count", len(index.Accounts)), zap.Duration("in", time.Since(start)))
+		return &DefaultCache{
+			Abis:      make(map[string][]*ABICacheItem),
+			Cursor:    index.Cursor,
+			store:     store,
+			cacheName: cacheName,
+			index:     index,
+		}, nil
+	}
+
 	exist, err := store.FileExists(ctx, cacheName)
 	if err != nil {
 		return nil, fmt.Errorf("validating existance of cache file %s: %s", cacheName, err)
@@ -68,6 +129,7 @@ func NewABICache(store dstore.Store, cacheName string) (*DefaultCache, error) {
 			store:     store,
 			cacheName: cacheName,
 			Abis:      make(map[string][]*ABICacheItem),
+			index:     newCacheIndex(),
 		}, nil
 
 	}
@@ -88,25 +150,139 @@ func NewABICache(store dstore.Store, cacheName string) (*DefaultCache, error) {
 
 	cache.store = store
 	cache.cacheName = cacheName
+	cache.index = newCacheIndex()
+	if cache.Abis == nil {
+		cache.Abis = make(map[string][]*ABICacheItem)
+	}
+
+	zlog.Info("legacy cache loaded", zap.String("cache_name", cacheName), zap.Int("account_count", len(cache.Abis)), zap.Duration("in", time.Since(start)))
+	for account := range cache.Abis {
+		cache.markDirty(account)
+	}
+
+	if !options.migrateLegacy {
+		return cache, nil
+	}
+
+	// On failure, the migration is retried by the next `SaveState` call
+	if err := cache.SaveState(); err != nil {
+		zlog.Warn("unable to migrate legacy cache", zap.String("cache_name", cacheName), zap.Error(err))
+	} else {
+		zlog.Info("legacy cache migrated, legacy cache file is not used anymore", zap.String("cache_name", cacheName))
+	}
 
-	zlog.Info("cache loaded", zap.String("cache_name", cacheName), zap.Duration("in", time.Since(start)))
 	return cache, nil
+}
+
+// loadAccount reads the segment of an account persisted in the store but not loaded yet and
+// installs it along with the changes kept pending for it. The segment is read without holding
+// the lock, concurrent calls for the same account waiting for a single read. It must be called
+// without the lock held.
+func (c *DefaultCache) loadAccount(account string) error {
+	c.lock.Lock()
+	if !c.needsLoad(account) {
+		c.lock.Unlock()
+		return nil
+	}
+
+	if load, inFlight := c.loads[account]; inFlight {
+		c.lock.Unlock()
+		<-load.done
+		return load.err
+	}
+
+	load := &segmentLoad{done: make(chan struct{})}
+	if c.loads == nil {
+		c.loads = make(map[string]*segmentLoad)
+	}
+	c.loads[account] = load
+	c.lock.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	items, err := readSegment(ctx, c.store, c.cacheName, account)
+	cancel()
 
+	c.lock.Lock()
+	delete(c.loads, account)
+	if err != nil {
+		load.err = fmt.Errorf("loading ABIs of account %s: %w", account, err)
+	} else {
+		for _, mutate := range c.pending[account] {
+			items = mutate(items)
+		}
+		delete(c.pending, account)
+
+		c.Abis[account] = items
+	}
+	close(load.done)
+	c.lock.Unlock()
+
+	return load.err
+}
+
+func (c *DefaultCache) loadAccounts(accounts []string) error {
+	for _, account := range accounts {
+		if err := c.loadAccount(account); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// needsLoad tells if the account has a segment in the store that is not loaded yet. It must be
+// called with the lock held.
+func (c *DefaultCache) needsLoad(account string) bool {
+	if _, loaded := c.Abis[account]; loaded {
+		return false
+	}
+
+	return c.index != nil && c.index.Accounts[account] != nil
+}
+
+// mutateAccount applies `mutate` to the ABIs of the account, which the caller loads beforehand,
+// the change being kept pending when the account could not be loaded. It must be called with
+// the lock held.
+func (c *DefaultCache) mutateAccount(account string, mutate abiMutation) {
+	c.markDirty(account)
+
+	if c.needsLoad(account) {
+		zlog.Warn("account ABIs not loaded, keeping change pending", zap.String("account", account))
+		if c.pending == nil {
+			c.pending = make(map[string][]abiMutation)
+		}
+
+		c.pending[account] = append(c.pending[account], mutate)
+		return
+	}
+
+	c.Abis[account] = mutate(c.Abis[account])
+}
+
+func (c *DefaultCache) markDirty(account string) {
+	if c.dirtyAccounts == nil {
+		c.dirtyAccounts = make(map[string]bool)
+	}
+
+	c.dirtyAccounts[account] = true
+	c.dirty = true
 }
 
 type ABICacheItem struct {
 	ABI      *zsw.ABI
 	BlockNum uint32
+	TrxID    string // ID of the `setabi` transaction, empty for ABIs cached before it was tracked until the cache is synced again from the blocks
 }
 
-func (c *DefaultCache) SetABIAtBlockNum(account string, blockNum uint32, abi *zsw.ABI) {
+func (c *DefaultCache) SetABIAtBlockNum(account string, blockNum uint32, trxID string, abi *zsw.ABI) {
+	if err := c.loadAccount(account); err != nil {
+		zlog.Warn("unable to load account ABIs", zap.String("account", account), zap.Error(err))
+	}
+
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	c.dirty = true
-
-	if accountItems, ok := c.Abis[account]; ok {
-
+	c.mutateAccount(account, func(accountItems []*ABICacheItem) []*ABICacheItem {
 		replace := false
 		var newItemIndex int
 		for i := len(accountItems) - 1; i >= 0; i-- {
@@ -125,77 +301,156 @@ func (c *DefaultCache) SetABIAtBlockNum(account string, blockNum uint32, abi *zs
 		newItem := &ABICacheItem{
 			BlockNum: blockNum,
 			ABI:      abi,
+			TrxID:    trxID,
 		}
 		if replace {
 			accountItems[newItemIndex] = newItem
-		} else {
-			accountItems = append(accountItems[:newItemIndex], append([]*ABICacheItem{newItem}, accountItems[newItemIndex:]...)...)
+			return accountItems
 		}
-		c.Abis[account] = accountItems
-		return
-	}
 
-	//this is the first abi for the account
-	c.Abis[account] = []*ABICacheItem{
-		{ABI: abi, BlockNum: blockNum},
-	}
-
-	return
+		return append(accountItems[:newItemIndex], append([]*ABICacheItem{newItem}, accountItems[newItemIndex:]...)...)
+	})
 }
 
 func (c *DefaultCache) RemoveABIAtBlockNum(account string, blockNum uint32) {
+	if err := c.loadAccount(account); err != nil {
+		zlog.Warn("unable to load account ABIs", zap.String("account", account), zap.Error(err))
+	}
+
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if accountItems, ok := c.Abis[account]; ok {
+	if _, loaded := c.Abis[account]; !loaded && (c.index == nil || c.index.Accounts[account] == nil) {
+		return
+	}
 
+	c.mutateAccount(account, func(accountItems []*ABICacheItem) []*ABICacheItem {
 		for i := len(accountItems) - 1; i >= 0; i-- {
 			item := accountItems[i]
 			if item.BlockNum == blockNum {
-				accountItems = append(accountItems[:i], accountItems[i+1:]...)
-				c.Abis[account] = accountItems
-				break
+				return append(accountItems[:i], accountItems[i+1:]...)
 			}
 		}
-		return
-	}
-	return
+
+		return accountItems
+	})
 }
 
+// SaveState writes the segments of the accounts changed since the last save, then the index
+// holding the current cursor. The changes are captured under the lock and written without it,
+// so lookups are not blocked by the store.
 func (c *DefaultCache) SaveState() error {
+	c.saveLock.Lock()
+	defer c.saveLock.Unlock()
+
+	// Accounts having changes kept pending are loaded first so their changes can be saved
+	if err := c.loadAccounts(c.unloadedDirtyAccounts()); err != nil {
+		return fmt.Errorf("saving cache: %w", err)
+	}
+
 	c.lock.Lock()
-	defer c.lock.Unlock()
 	zlog.Info("saving cache", zap.String("cache_name", c.cacheName), zap.Bool("dirty", c.dirty))
 
-	if !c.dirty {
+	if !c.dirty && c.index != nil && c.index.Cursor == c.Cursor {
+		c.lock.Unlock()
 		zlog.Info("not a dirty cache, no need to be save", zap.String("cache_name", c.cacheName), zap.Bool("dirty", c.dirty))
 		return nil
 	}
 
-	start := time.Now()
+	segments := make(map[string][]*ABICacheItem, len(c.dirtyAccounts))
+	for account := range c.dirtyAccounts {
+		if c.needsLoad(account) {
+			c.lock.Unlock()
+			return fmt.Errorf("saving cache: ABIs of account %s are not loaded", account)
+		}
 
-	var b bytes.Buffer
-	encoder := gob.NewEncoder(&b)
-	err := encoder.Encode(&c)
-	if err != nil {
-		return err
+		segments[account] = append([]*ABICacheItem(nil), c.Abis[account]...)
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
-	defer cancel()
-
-	err = c.store.WriteObject(ctx, c.cacheName, bytes.NewReader(b.Bytes()))
-	if err != nil {
-		return fmt.Errorf("saving cache: %s", err)
+	index := newCacheIndex()
+	if c.index != nil {
+		for account, indexAccount := range c.index.Accounts {
+			index.Accounts[account] = indexAccount
+		}
 	}
+	index.Cursor = c.Cursor
 
+	c.dirtyAccounts = nil
 	c.dirty = false
+	c.lock.Unlock()
 
-	zlog.Info("cache save", zap.String("cache_name", c.cacheName), zap.Duration("in", time.Since(start)))
+	start := time.Now()
+	if err := c.writeState(index, segments); err != nil {
+		c.lock.Lock()
+		for account := range segments {
+			c.markDirty(account)
+		}
+		c.dirty = true
+		c.lock.Unlock()
+
+		return fmt.Errorf("saving cache: %w", err)
+	}
+
+	c.lock.Lock()
+	c.index = index
+	c.lock.Unlock()
+
+	zlog.Info("cache save", zap.String("cache_name", c.cacheName), zap.Int("saved_account_count", len(segments)), zap.Duration("in", time.Since(start)))
 	return nil
 }
 
+// unloadedDirtyAccounts lists the changed accounts whose segment is not loaded yet.
+func (c *DefaultCache) unloadedDirtyAccounts() (accounts []string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for account := range c.dirtyAccounts {
+		if c.needsLoad(account) {
+			accounts = append(accounts, account)
+		}
+	}
+
+	return accounts
+}
+
+// writeState writes `segments` then `index`, updating it with the written accounts.
+func (c *DefaultCache) writeState(index *cacheIndex, segments map[string][]*ABICacheItem) error {
+	for account, items := range segments {
+		if err := c.writeWithTimeout(func(ctx context.Context) error {
+			return writeSegment(ctx, c.store, c.cacheName, account, items)
+		}); err != nil {
+			return err
+		}
+
+		indexAccount := &cacheIndexAccount{ABICount: len(items)}
+		if len(items) > 0 {
+			indexAccount.LastBlockNum = items[len(items)-1].BlockNum
+		}
+
+		index.Accounts[account] = indexAccount
+	}
+
+	return c.writeWithTimeout(func(ctx context.Context) error {
+		return writeJSONObject(ctx, c.store, indexFilename(c.cacheName), index)
+	})
+}
+
+func (c *DefaultCache) writeWithTimeout(write func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	return write(ctx)
+}
+
 func (c *DefaultCache) ABIAtBlockNum(account string, blockNum uint32) *ABICacheItem {
+	if err := c.loadAccount(account); err != nil {
+		zlog.Error("unable to load account ABIs", zap.String("account", account), zap.Error(err))
+		return nil
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
 	if abis, ok := c.Abis[account]; ok {
 
 		for i := len(abis) - 1; i >= 0; i-- {
@@ -208,11 +463,31 @@ func (c *DefaultCache) ABIAtBlockNum(account string, blockNum uint32) *ABICacheI
 	return nil //todo: should we return a "not found error"
 }
 
+// ListABIVersions returns every ABI version of the account, oldest first, each one applying
+// until the block preceding the next version.
+func (c *DefaultCache) ListABIVersions(account string) []*ABIVersion {
+	if err := c.loadAccount(account); err != nil {
+		zlog.Error("unable to load account ABIs", zap.String("account", account), zap.Error(err))
+		return nil
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return abiVersions(c.Abis[account])
+}
+
 func (c *DefaultCache) SetCursor(cursor string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
 	c.Cursor = cursor
 }
 
 func (c *DefaultCache) GetCursor() string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
 	return c.Cursor
 }
 
@@ -221,20 +496,23 @@ func (c *DefaultCache) Load(workerID string) (string, error) {
 }
 
 func (c *DefaultCache) Export(baseURL, filename string) error {
-	c.lock.Lock()
-	defer c.lock.Unlock()
-
 	zlog.Debug("exporting ABIs",
 		zap.String("base_url", baseURL),
 		zap.String("filename", filename),
 	)
 
+	if err := c.loadAccounts(c.indexedAccounts()); err != nil {
+		return fmt.Errorf("error exporting cache: %w", err)
+	}
+
 	store, err := dstore.NewStore(baseURL, "", "zstd", true)
 	if err != nil {
 		return fmt.Errorf("error creating export store: %w", err)
 	}
 
+	c.lock.Lock()
 	data, err := json.Marshal(c)
+	c.lock.Unlock()
 	if err != nil {
 		return fmt.Errorf("error marshalling default cache: %w", err)
 	}
@@ -250,6 +528,20 @@ func (c *DefaultCache) Export(baseURL, filename string) error {
 	return nil
 }
 
+// indexedAccounts lists the accounts persisted in the store.
+func (c *DefaultCache) indexedAccounts() (accounts []string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.index != nil {
+		for account := range c.index.Accounts {
+			accounts = append(accounts, account)
+		}
+	}
+
+	return accounts
+}
+
 func getStoreInfo(storeUrl string) (baseURL, filename string, err error) {
 	u, err := url.Parse(storeUrl)
 	if err != nil {
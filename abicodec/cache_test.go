@@ -151,7 +151,7 @@ func TestABICache_SetABIAtBlockNum(t *testing.T) {
 			require.NoError(t, err)
 
 			cache.Abis = c.items
-			cache.SetABIAtBlockNum(c.account, c.blockNum, NewTestABI(c.version))
+			cache.SetABIAtBlockNum(c.account, c.blockNum, "", NewTestABI(c.version))
 			assert.Equal(t, c.expectedVersion, cache.Abis[c.account][c.expectedABIAtIndex].ABI.Version)
 			assert.Equal(t, c.expectedCacheSize, len(cache.Abis[c.account]))
 		})
@@ -321,11 +321,13 @@ func TestDefaultCache_Save_Load(t *testing.T) {
 	store, err := dstore.NewSimpleStore("file:///tmp")
 	require.NoError(t, err)
 
-	exist, err := store.FileExists(ctx, cacheName)
-	require.NoError(t, err)
-	if exist {
-		err := store.DeleteObject(ctx, cacheName)
+	for _, filename := range []string{cacheName, indexFilename(cacheName)} {
+		exist, err := store.FileExists(ctx, filename)
 		require.NoError(t, err)
+		if exist {
+			err := store.DeleteObject(ctx, filename)
+			require.NoError(t, err)
+		}
 	}
 
 	require.NoError(t, err)
@@ -341,7 +343,7 @@ func TestDefaultCache_Save_Load(t *testing.T) {
 
 	spew.Dump(abi)
 
-	cache.SetABIAtBlockNum("account.1", 2, abi)
+	cache.SetABIAtBlockNum("account.1", 2, "", abi)
 	err = cache.Save("cursor.1", "not.used.1")
 	require.NoError(t, err)
 
@@ -352,13 +354,13 @@ func TestDefaultCache_Save_Load(t *testing.T) {
 	require.NoError(t, err)
 
 	require.Equal(t, "cursor.1", loadedCache.GetCursor())
-	require.Equal(t, 1, len(loadedCache.Abis))
+	require.Equal(t, 0, len(loadedCache.Abis), "ABIs are loaded when their account is first accessed")
 
-	accountABIS := loadedCache.Abis["account.1"]
+	accountABIS := loadedCache.ListABIVersions("account.1")
 	require.Equal(t, 1, len(accountABIS))
 
 	a := accountABIS[0]
-	require.Equal(t, uint32(2), a.BlockNum)
+	require.Equal(t, uint32(2), a.StartBlockNum)
 	require.Equal(t, "zswhq::abi/1.0", a.ABI.Version)
 
 }
@@ -370,11 +372,13 @@ func TestDefaultCache_Large_Save_Load(t *testing.T) {
 	store, err := dstore.NewSimpleStore("file:///tmp")
 	require.NoError(t, err)
 
-	exist, err := store.FileExists(ctx, cacheName)
-	require.NoError(t, err)
-	if exist {
-		err := store.DeleteObject(ctx, cacheName)
+	for _, filename := range []string{cacheName, indexFilename(cacheName)} {
+		exist, err := store.FileExists(ctx, filename)
 		require.NoError(t, err)
+		if exist {
+			err := store.DeleteObject(ctx, filename)
+			require.NoError(t, err)
+		}
 	}
 
 	require.NoError(t, err)
@@ -389,7 +393,7 @@ func TestDefaultCache_Large_Save_Load(t *testing.T) {
 	require.NoError(t, err)
 
 	for i := 1; i < 10000; i++ {
-		cache.SetABIAtBlockNum("account.1", uint32(i), abi)
+		cache.SetABIAtBlockNum("account.1", uint32(i), "", abi)
 	}
 
 	err = cache.Save("cursor.1", "not.used.1")
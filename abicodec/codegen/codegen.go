@@ -0,0 +1,297 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package codegen generates Go, TypeScript and JSON Schema type definitions for the
+// actions and tables of an ABI.
+package codegen
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/zhongshuwen/zswchain-go"
+)
+
+type Language string
+
+const (
+	LanguageGo         Language = "go"
+	LanguageTypeScript Language = "ts"
+	LanguageJSONSchema Language = "jsonschema"
+)
+
+// Languages lists the supported languages, in the order files are generated.
+var Languages = []Language{LanguageGo, LanguageTypeScript, LanguageJSONSchema}
+
+// FileExtension is the extension, dot included, of the files holding code generated in `lang`.
+func (l Language) FileExtension() string {
+	switch l {
+	case LanguageGo:
+		return ".go"
+	case LanguageTypeScript:
+		return ".ts"
+	case LanguageJSONSchema:
+		return ".schema.json"
+	}
+
+	return ""
+}
+
+// Generate emits the type definitions of `abi` in `lang`, `goPackage` is the package name used
+// for Go code and is ignored by the other languages.
+func Generate(lang Language, abi *zsw.ABI, goPackage string) ([]byte, error) {
+	switch lang {
+	case LanguageGo:
+		return GenerateGo(abi, goPackage)
+	case LanguageTypeScript:
+		return GenerateTypeScript(abi)
+	case LanguageJSONSchema:
+		return GenerateJSONSchema(abi)
+	}
+
+	return nil, fmt.Errorf("unknown language %q, valid languages are go, ts and jsonschema", lang)
+}
+
+// builtinTypes are the types natively known by the ABI serializer, they can be used without
+// being declared in the ABI.
+var builtinTypes = map[string]bool{
+	"bool": true, "int8": true, "uint8": true, "int16": true, "uint16": true, "int32": true, "uint32": true,
+	"int64": true, "uint64": true, "int128": true, "uint128": true, "varint32": true, "varuint32": true,
+	"float32": true, "float64": true, "float128": true,
+	"time_point": true, "time_point_sec": true, "block_timestamp_type": true,
+	"name": true, "bytes": true, "string": true,
+	"checksum160": true, "checksum256": true, "checksum512": true,
+	"public_key": true, "signature": true,
+	"symbol": true, "symbol_code": true, "asset": true, "extended_asset": true,
+}
+
+// abiIndex resolves the names declared by an ABI and remembers which actions and tables use
+// each struct.
+type abiIndex struct {
+	abi      *zsw.ABI
+	aliases  map[string]string
+	structs  map[string]bool
+	variants map[string]bool
+
+	actionsByType map[string][]string
+	tablesByType  map[string][]string
+}
+
+func newABIIndex(abi *zsw.ABI) (*abiIndex, error) {
+	if abi == nil {
+		return nil, fmt.Errorf("no ABI to generate code for")
+	}
+
+	index := &abiIndex{
+		abi:           abi,
+		aliases:       map[string]string{},
+		structs:       map[string]bool{},
+		variants:      map[string]bool{},
+		actionsByType: map[string][]string{},
+		tablesByType:  map[string][]string{},
+	}
+
+	for _, alias := range abi.Types {
+		index.aliases[alias.NewTypeName] = alias.Type
+	}
+
+	for _, s := range abi.Structs {
+		index.structs[s.Name] = true
+	}
+
+	for _, variant := range abi.Variants {
+		index.variants[variant.Name] = true
+	}
+
+	for _, action := range abi.Actions {
+		index.actionsByType[action.Type] = append(index.actionsByType[action.Type], string(action.Name))
+	}
+
+	for _, table := range abi.Tables {
+		index.tablesByType[table.Type] = append(index.tablesByType[table.Type], string(table.Name))
+	}
+
+	if err := index.validate(); err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+// validate ensures every type referenced by the ABI is either declared or builtin, so
+// generators can emit references without checking them.
+func (i *abiIndex) validate() error {
+	check := func(context string, typeName string) error {
+		name := baseTypeName(typeName)
+		if !builtinTypes[name] && !i.isDeclared(name) {
+			return fmt.Errorf("%s: unknown type %q", context, name)
+		}
+
+		return nil
+	}
+
+	for _, alias := range i.abi.Types {
+		if err := check(fmt.Sprintf("type %q", alias.NewTypeName), alias.Type); err != nil {
+			return err
+		}
+	}
+
+	for _, s := range i.abi.Structs {
+		if s.Base != "" && !i.structs[i.resolveAlias(s.Base)] {
+			return fmt.Errorf("struct %q: base %q is not a struct", s.Name, s.Base)
+		}
+
+		for _, field := range s.Fields {
+			if err := check(fmt.Sprintf("struct %q field %q", s.Name, field.Name), field.Type); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, variant := range i.abi.Variants {
+		for _, typeName := range variant.Types {
+			if err := check(fmt.Sprintf("variant %q", variant.Name), typeName); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, action := range i.abi.Actions {
+		if err := check(fmt.Sprintf("action %q", action.Name), action.Type); err != nil {
+			return err
+		}
+	}
+
+	for _, table := range i.abi.Tables {
+		if err := check(fmt.Sprintf("table %q", table.Name), table.Type); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *abiIndex) isDeclared(name string) bool {
+	_, isAlias := i.aliases[name]
+	return isAlias || i.structs[name] || i.variants[name]
+}
+
+// resolveAlias follows aliases until reaching a non alias type name.
+func (i *abiIndex) resolveAlias(name string) string {
+	for depth := 0; depth < 32; depth++ {
+		target, found := i.aliases[name]
+		if !found {
+			return name
+		}
+
+		name = target
+	}
+
+	return name
+}
+
+// usage describes, in plain words, the actions and tables a struct is used by, empty when
+// it is used by none.
+func (i *abiIndex) usage(structName string) string {
+	var parts []string
+	if actions := i.actionsByType[structName]; len(actions) > 0 {
+		parts = append(parts, fmt.Sprintf("data of action%s %s", plural(actions), quoteAll(actions)))
+	}
+
+	if tables := i.tablesByType[structName]; len(tables) > 0 {
+		parts = append(parts, fmt.Sprintf("row of table%s %s", plural(tables), quoteAll(tables)))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+type typeModifier int
+
+const (
+	modifierNone typeModifier = iota
+	modifierArray
+	modifierOptional
+	modifierBinaryExtension
+)
+
+// splitType strips the outermost modifier of an ABI type, `[]` for arrays, `?` for optional
+// values and `$` for binary extensions.
+func splitType(typeName string) (inner string, modifier typeModifier) {
+	switch {
+	case strings.HasSuffix(typeName, "$"):
+		return strings.TrimSuffix(typeName, "$"), modifierBinaryExtension
+	case strings.HasSuffix(typeName, "?"):
+		return strings.TrimSuffix(typeName, "?"), modifierOptional
+	case strings.HasSuffix(typeName, "[]"):
+		return strings.TrimSuffix(typeName, "[]"), modifierArray
+	}
+
+	return typeName, modifierNone
+}
+
+// baseTypeName is the type name stripped of all its modifiers.
+func baseTypeName(typeName string) string {
+	for {
+		inner, modifier := splitType(typeName)
+		if modifier == modifierNone {
+			return inner
+		}
+
+		typeName = inner
+	}
+}
+
+// pascalCase turns an ABI name like `currency_stats` or `eosio.token` into `CurrencyStats`
+// and `EosioToken`.
+func pascalCase(name string) string {
+	var out strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+
+		out.WriteRune(r)
+	}
+
+	if out.Len() == 0 || unicode.IsDigit(rune(out.String()[0])) {
+		return "T" + out.String()
+	}
+
+	return out.String()
+}
+
+func plural(names []string) string {
+	if len(names) > 1 {
+		return "s"
+	}
+
+	return ""
+}
+
+func quoteAll(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = "`" + name + "`"
+	}
+
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,157 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codegen
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"github.com/zhongshuwen/zswchain-go"
+)
+
+const testABI = `{
+	"version": "eosio::abi/1.2",
+	"types": [{"new_type_name": "account_name", "type": "name"}],
+	"structs": [
+		{"name": "base_transfer", "base": "", "fields": [{"name": "from", "type": "account_name"}, {"name": "to", "type": "name"}]},
+		{"name": "transfer", "base": "base_transfer", "fields": [
+			{"name": "quantity", "type": "asset"},
+			{"name": "memo", "type": "string?"},
+			{"name": "tags", "type": "string[]"},
+			{"name": "extra", "type": "uint64$"}
+		]},
+		{"name": "account", "base": "", "fields": [{"name": "balance", "type": "asset"}, {"name": "payload", "type": "payload"}, {"name": "last_transfer", "type": "transfer?"}]}
+	],
+	"variants": [{"name": "payload", "types": ["uint64", "transfer"]}],
+	"actions": [{"name": "transfer", "type": "transfer"}],
+	"tables": [{"name": "accounts", "type": "account", "index_type": "i64"}]
+}`
+
+func newTestABI(t *testing.T) *zsw.ABI {
+	abi := &zsw.ABI{}
+	require.NoError(t, json.Unmarshal([]byte(testABI), abi))
+	return abi
+}
+
+func TestGenerateGo(t *testing.T) {
+	out, err := GenerateGo(newTestABI(t), "token")
+	require.NoError(t, err)
+
+	code := string(out)
+	typeCheckGo(t, "token", out)
+
+	assert.Contains(t, code, "package token")
+	assert.Contains(t, code, "type AccountName = zsw.Name")
+	assert.Contains(t, code, "// Transfer is the `transfer` struct, data of action `transfer`.")
+	assert.Contains(t, code, "// Account is the `account` struct, row of table `accounts`.")
+	assert.Regexp(t, "type Transfer struct {\n\tBaseTransfer\n", code)
+	assert.Regexp(t, "Memo +string +`json:\"memo,omitempty\" eos:\"optional\"`", code)
+	assert.Regexp(t, "Tags +\\[\\]string +`json:\"tags\"`", code)
+	assert.Regexp(t, "Extra +uint64 +`json:\"extra,omitempty\" eos:\"binary_extension\"`", code)
+	assert.Regexp(t, "Payload +Payload +`json:\"payload\"`", code)
+	assert.Regexp(t, "LastTransfer +\\*Transfer +`json:\"last_transfer,omitempty\" eos:\"optional\"`", code)
+	assert.Contains(t, code, `{Name: "transfer", Type: (*Transfer)(nil)},`)
+	assert.Contains(t, code, "return v.BaseVariant.UnmarshalBinaryVariant(decoder, PayloadVariant)")
+	assert.NotContains(t, code, "zswchain-go/ecc")
+
+	_, err = GenerateGo(newTestABI(t), "")
+	assert.Error(t, err)
+}
+
+func TestGenerateGo_DuplicateIdentifiers(t *testing.T) {
+	abi := newTestABI(t)
+	abi.Structs = append(abi.Structs, zsw.StructDef{Name: "payload_variant", Fields: []zsw.FieldDef{{Name: "value", Type: "uint64"}}})
+
+	_, err := GenerateGo(abi, "token")
+	assert.EqualError(t, err, `struct "payload_variant" and variant "payload" definition both generate Go identifier "PayloadVariant"`)
+
+	abi = newTestABI(t)
+	abi.Structs[0].Fields = append(abi.Structs[0].Fields, zsw.FieldDef{Name: "to.", Type: "name"})
+
+	_, err = GenerateGo(abi, "token")
+	assert.EqualError(t, err, `struct "base_transfer" field "to" and struct "base_transfer" field "to." both generate Go identifier "To"`)
+}
+
+// typeCheckGo parses and type-checks generated Go code, zswchain-go being imported from source.
+func typeCheckGo(t *testing.T, packageName string, code []byte) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, packageName+".go", code, 0)
+	require.NoError(t, err, string(code))
+
+	config := &types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	_, err = config.Check(packageName, fset, []*ast.File{file}, nil)
+	require.NoError(t, err, string(code))
+}
+
+func TestGenerateTypeScript(t *testing.T) {
+	out, err := GenerateTypeScript(newTestABI(t))
+	require.NoError(t, err)
+
+	code := string(out)
+	assert.Contains(t, code, "export type AccountName = string\n")
+	assert.Contains(t, code, "export interface Transfer extends BaseTransfer {\n  quantity: string\n  memo?: string | null\n  tags: string[]\n  extra?: number | string\n}")
+	assert.Contains(t, code, `export type Payload = ["uint64", number | string] | ["transfer", Transfer]`)
+	assert.Contains(t, code, "export interface Actions {\n  transfer: Transfer\n}")
+	assert.Contains(t, code, "export interface Tables {\n  accounts: Account\n}")
+}
+
+func TestGenerateJSONSchema(t *testing.T) {
+	out, err := GenerateJSONSchema(newTestABI(t))
+	require.NoError(t, err)
+	require.True(t, gjson.ValidBytes(out))
+
+	schema := gjson.ParseBytes(out)
+	assert.Equal(t, jsonSchemaDraft, schema.Get("$schema").Str)
+	assert.Equal(t, "#/definitions/transfer", schema.Get("definitions.action\\.transfer.$ref").Str)
+	assert.Equal(t, "#/definitions/account", schema.Get("definitions.table\\.accounts.$ref").Str)
+	assert.Equal(t, "#/definitions/name", schema.Get("definitions.account_name.$ref").Str)
+	assert.Equal(t, "string", schema.Get("definitions.name.type").Str)
+
+	transfer := schema.Get("definitions.transfer.allOf")
+	assert.Equal(t, "#/definitions/base_transfer", transfer.Get("0.$ref").Str)
+	assert.Equal(t, []interface{}{"quantity", "tags"}, transfer.Get("1.required").Value())
+	assert.Equal(t, "null", transfer.Get("1.properties.memo.oneOf.1.type").Str)
+	assert.Equal(t, "#/definitions/string", transfer.Get("1.properties.tags.items.$ref").Str)
+
+	payload := schema.Get("definitions.payload.oneOf")
+	assert.Equal(t, "uint64", payload.Get("0.items.0.const").Str)
+	assert.Equal(t, "#/definitions/transfer", payload.Get("1.items.1.$ref").Str)
+}
+
+func TestGenerate_UnknownType(t *testing.T) {
+	abi := &zsw.ABI{Structs: []zsw.StructDef{{Name: "transfer", Fields: []zsw.FieldDef{{Name: "to", Type: "unknown[]"}}}}}
+
+	for _, lang := range Languages {
+		_, err := Generate(lang, abi, "token")
+		assert.EqualError(t, err, `struct "transfer" field "to": unknown type "unknown"`, string(lang))
+	}
+
+	_, err := Generate("java", newTestABI(t), "")
+	assert.Error(t, err)
+}
+
+func TestPascalCase(t *testing.T) {
+	assert.Equal(t, "CurrencyStats", pascalCase("currency_stats"))
+	assert.Equal(t, "EosioToken", pascalCase("eosio.token"))
+	assert.Equal(t, "T2fa", pascalCase("2fa"))
+}
@@ -0,0 +1,283 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+
+	"github.com/zhongshuwen/zswchain-go"
+)
+
+var goBuiltinTypes = map[string]string{
+	"bool":                 "bool",
+	"int8":                 "int8",
+	"uint8":                "uint8",
+	"int16":                "int16",
+	"uint16":               "uint16",
+	"int32":                "int32",
+	"uint32":               "uint32",
+	"int64":                "int64",
+	"uint64":               "uint64",
+	"int128":               "zsw.Int128",
+	"uint128":              "zsw.Uint128",
+	"varint32":             "zsw.Varint32",
+	"varuint32":            "zsw.Varuint32",
+	"float32":              "float32",
+	"float64":              "float64",
+	"float128":             "zsw.Float128",
+	"time_point":           "zsw.TimePoint",
+	"time_point_sec":       "zsw.TimePointSec",
+	"block_timestamp_type": "zsw.BlockTimestamp",
+	"name":                 "zsw.Name",
+	"bytes":                "zsw.HexBytes",
+	"string":               "string",
+	"checksum160":          "zsw.Checksum160",
+	"checksum256":          "zsw.Checksum256",
+	"checksum512":          "zsw.Checksum512",
+	"public_key":           "ecc.PublicKey",
+	"signature":            "ecc.Signature",
+	"symbol":               "zsw.Symbol",
+	"symbol_code":          "zsw.SymbolCode",
+	"asset":                "zsw.Asset",
+	"extended_asset":       "zsw.ExtendedAsset",
+}
+
+var goBuiltinStructs = map[string]bool{
+	"int128": true, "uint128": true, "float128": true, "block_timestamp_type": true,
+	"public_key": true, "signature": true, "symbol": true, "asset": true, "extended_asset": true,
+}
+
+type goGenerator struct {
+	*abiIndex
+
+	body    bytes.Buffer
+	imports map[string]bool
+}
+
+// GenerateGo emits, in package `packageName`, one Go type per struct, alias and variant of
+// `abi`. Structs are tagged so they encode and decode with zswchain-go, optional structs
+// become pointers and variants embed `zsw.BaseVariant`.
+func GenerateGo(abi *zsw.ABI, packageName string) ([]byte, error) {
+	index, err := newABIIndex(abi)
+	if err != nil {
+		return nil, err
+	}
+
+	if packageName == "" {
+		return nil, fmt.Errorf("a Go package name is required")
+	}
+
+	g := &goGenerator{abiIndex: index, imports: map[string]bool{}}
+	if err := g.checkIdentifiers(); err != nil {
+		return nil, err
+	}
+
+	for _, alias := range abi.Types {
+		g.writeAlias(alias)
+	}
+
+	for _, s := range abi.Structs {
+		g.writeStruct(s)
+	}
+
+	for _, variant := range abi.Variants {
+		g.writeVariant(variant)
+	}
+
+	out := &bytes.Buffer{}
+	fmt.Fprintln(out, "// Code generated by dfuseeos tools abi codegen. DO NOT EDIT.")
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "package %s\n\n", packageName)
+
+	if len(g.imports) > 0 {
+		fmt.Fprintln(out, "import (")
+		if g.imports["zsw"] {
+			fmt.Fprintln(out, `	"github.com/zhongshuwen/zswchain-go"`)
+		}
+		if g.imports["ecc"] {
+			fmt.Fprintln(out, `	"github.com/zhongshuwen/zswchain-go/ecc"`)
+		}
+		fmt.Fprintln(out, ")")
+		fmt.Fprintln(out)
+	}
+
+	out.Write(g.body.Bytes())
+
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated Go code: %w", err)
+	}
+
+	return formatted, nil
+}
+
+// checkIdentifiers ensures distinct ABI names do not generate the same Go identifier, like
+// struct `x_variant` and the `XVariant` definition of variant `x`, or fields `to_account` and
+// `to.account` of the same struct.
+func (g *goGenerator) checkIdentifiers() error {
+	declared := map[string]string{}
+	for _, alias := range g.abi.Types {
+		if err := declareGoIdentifier(declared, pascalCase(alias.NewTypeName), fmt.Sprintf("type %q", alias.NewTypeName)); err != nil {
+			return err
+		}
+	}
+
+	for _, s := range g.abi.Structs {
+		if err := declareGoIdentifier(declared, pascalCase(s.Name), fmt.Sprintf("struct %q", s.Name)); err != nil {
+			return err
+		}
+
+		fields := map[string]string{}
+		if s.Base != "" {
+			fields[pascalCase(s.Base)] = fmt.Sprintf("struct %q base %q", s.Name, s.Base)
+		}
+
+		for _, field := range s.Fields {
+			if err := declareGoIdentifier(fields, pascalCase(field.Name), fmt.Sprintf("struct %q field %q", s.Name, field.Name)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, variant := range g.abi.Variants {
+		if err := declareGoIdentifier(declared, pascalCase(variant.Name), fmt.Sprintf("variant %q", variant.Name)); err != nil {
+			return err
+		}
+
+		if err := declareGoIdentifier(declared, pascalCase(variant.Name)+"Variant", fmt.Sprintf("variant %q definition", variant.Name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func declareGoIdentifier(declared map[string]string, identifier string, origin string) error {
+	if previous, found := declared[identifier]; found {
+		return fmt.Errorf("%s and %s both generate Go identifier %q", previous, origin, identifier)
+	}
+
+	declared[identifier] = origin
+	return nil
+}
+
+func (g *goGenerator) typeExpr(typeName string) string {
+	inner, modifier := splitType(typeName)
+	switch modifier {
+	case modifierArray:
+		return "[]" + g.typeExpr(inner)
+	case modifierOptional:
+		if g.isStruct(inner) {
+			return "*" + g.typeExpr(inner)
+		}
+
+		return g.typeExpr(inner)
+	case modifierBinaryExtension:
+		return g.typeExpr(inner)
+	}
+
+	if g.isDeclared(typeName) {
+		return pascalCase(typeName)
+	}
+
+	goType := goBuiltinTypes[typeName]
+	switch {
+	case strings.HasPrefix(goType, "zsw."):
+		g.imports["zsw"] = true
+	case strings.HasPrefix(goType, "ecc."):
+		g.imports["ecc"] = true
+	}
+
+	return goType
+}
+
+// isStruct tells if `typeName` maps to a Go struct. Optional structs are emitted as pointers
+// while other optional values are emitted as-is, zswchain-go being unable to encode pointers
+// to non struct types, an absent value then being its zero value.
+func (g *goGenerator) isStruct(typeName string) bool {
+	resolved := g.resolveAlias(typeName)
+	if _, modifier := splitType(resolved); modifier != modifierNone {
+		return false
+	}
+
+	if g.structs[resolved] || g.variants[resolved] {
+		return true
+	}
+
+	return goBuiltinStructs[resolved]
+}
+
+func (g *goGenerator) writeAlias(alias zsw.ABIType) {
+	fmt.Fprintf(&g.body, "// %s is the `%s` type alias of `%s`.\n", pascalCase(alias.NewTypeName), alias.NewTypeName, alias.Type)
+	fmt.Fprintf(&g.body, "type %s = %s\n\n", pascalCase(alias.NewTypeName), g.typeExpr(alias.Type))
+}
+
+func (g *goGenerator) writeStruct(s zsw.StructDef) {
+	name := pascalCase(s.Name)
+	if usage := g.usage(s.Name); usage != "" {
+		fmt.Fprintf(&g.body, "// %s is the `%s` struct, %s.\n", name, s.Name, usage)
+	} else {
+		fmt.Fprintf(&g.body, "// %s is the `%s` struct.\n", name, s.Name)
+	}
+
+	fmt.Fprintf(&g.body, "type %s struct {\n", name)
+	if s.Base != "" {
+		fmt.Fprintf(&g.body, "\t%s\n", pascalCase(s.Base))
+	}
+
+	for _, field := range s.Fields {
+		fmt.Fprintf(&g.body, "\t%s %s %s\n", pascalCase(field.Name), g.typeExpr(field.Type), goFieldTag(field))
+	}
+
+	fmt.Fprint(&g.body, "}\n\n")
+}
+
+// goFieldTag is the struct tag of a field, a binary extension takes precedence over an
+// optional marker as zswchain-go accepts a single `eos` tag per field.
+func goFieldTag(field zsw.FieldDef) string {
+	_, modifier := splitType(field.Type)
+	switch modifier {
+	case modifierBinaryExtension:
+		return fmt.Sprintf("`json:\"%s,omitempty\" eos:\"binary_extension\"`", field.Name)
+	case modifierOptional:
+		return fmt.Sprintf("`json:\"%s,omitempty\" eos:\"optional\"`", field.Name)
+	}
+
+	return fmt.Sprintf("`json:\"%s\"`", field.Name)
+}
+
+func (g *goGenerator) writeVariant(variant zsw.VariantDef) {
+	g.imports["zsw"] = true
+
+	name := pascalCase(variant.Name)
+	definition := name + "Variant"
+
+	fmt.Fprintf(&g.body, "// %s lists, in binary order, the types of the `%s` variant.\n", definition, variant.Name)
+	fmt.Fprintf(&g.body, "var %s = zsw.NewVariantDefinition([]zsw.VariantType{\n", definition)
+	for _, typeName := range variant.Types {
+		fmt.Fprintf(&g.body, "\t{Name: %q, Type: (*%s)(nil)},\n", typeName, g.typeExpr(typeName))
+	}
+	fmt.Fprint(&g.body, "})\n\n")
+
+	fmt.Fprintf(&g.body, "// %s is the `%s` variant, its JSON form is a `[type_name, value]` pair.\n", name, variant.Name)
+	fmt.Fprintf(&g.body, "type %s struct {\n\tzsw.BaseVariant\n}\n\n", name)
+
+	fmt.Fprintf(&g.body, "func (v *%s) MarshalJSON() ([]byte, error) {\n\treturn v.BaseVariant.MarshalJSON(%s)\n}\n\n", name, definition)
+	fmt.Fprintf(&g.body, "func (v *%s) UnmarshalJSON(data []byte) error {\n\treturn v.BaseVariant.UnmarshalJSON(data, %s)\n}\n\n", name, definition)
+	fmt.Fprintf(&g.body, "func (v *%s) UnmarshalBinary(decoder *zsw.Decoder) error {\n\treturn v.BaseVariant.UnmarshalBinaryVariant(decoder, %s)\n}\n\n", name, definition)
+}
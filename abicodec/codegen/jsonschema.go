@@ -0,0 +1,208 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/zhongshuwen/zswchain-go"
+)
+
+const jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+type jsonSchema = map[string]interface{}
+
+func integerSchema(min, max int64) jsonSchema {
+	return jsonSchema{"type": "integer", "minimum": min, "maximum": max}
+}
+
+func hexSchema(length int) jsonSchema {
+	if length == 0 {
+		return jsonSchema{"type": "string", "pattern": "^([0-9a-fA-F]{2})*$"}
+	}
+
+	return jsonSchema{"type": "string", "pattern": fmt.Sprintf("^[0-9a-fA-F]{%d}$", length)}
+}
+
+var jsonSchemaBuiltinTypes = map[string]func() jsonSchema{
+	"bool":      func() jsonSchema { return jsonSchema{"type": "boolean"} },
+	"int8":      func() jsonSchema { return integerSchema(math.MinInt8, math.MaxInt8) },
+	"uint8":     func() jsonSchema { return integerSchema(0, math.MaxUint8) },
+	"int16":     func() jsonSchema { return integerSchema(math.MinInt16, math.MaxInt16) },
+	"uint16":    func() jsonSchema { return integerSchema(0, math.MaxUint16) },
+	"int32":     func() jsonSchema { return integerSchema(math.MinInt32, math.MaxInt32) },
+	"uint32":    func() jsonSchema { return integerSchema(0, math.MaxUint32) },
+	"varint32":  func() jsonSchema { return integerSchema(math.MinInt32, math.MaxInt32) },
+	"varuint32": func() jsonSchema { return integerSchema(0, math.MaxUint32) },
+	"int64": func() jsonSchema {
+		return jsonSchema{"oneOf": []interface{}{jsonSchema{"type": "integer"}, jsonSchema{"type": "string", "pattern": "^-?[0-9]+$"}}}
+	},
+	"uint64": func() jsonSchema {
+		return jsonSchema{"oneOf": []interface{}{jsonSchema{"type": "integer", "minimum": 0}, jsonSchema{"type": "string", "pattern": "^[0-9]+$"}}}
+	},
+	"int128":               func() jsonSchema { return jsonSchema{"type": "string"} },
+	"uint128":              func() jsonSchema { return jsonSchema{"type": "string"} },
+	"float32":              func() jsonSchema { return jsonSchema{"type": "number"} },
+	"float64":              func() jsonSchema { return jsonSchema{"type": "number"} },
+	"float128":             func() jsonSchema { return jsonSchema{"type": "string"} },
+	"time_point":           func() jsonSchema { return jsonSchema{"type": "string"} },
+	"time_point_sec":       func() jsonSchema { return jsonSchema{"type": "string"} },
+	"block_timestamp_type": func() jsonSchema { return jsonSchema{"type": "string"} },
+	"name":                 func() jsonSchema { return jsonSchema{"type": "string", "pattern": "^[.1-5a-z]{0,12}[.1-5a-j]?$"} },
+	"bytes":                func() jsonSchema { return hexSchema(0) },
+	"string":               func() jsonSchema { return jsonSchema{"type": "string"} },
+	"checksum160":          func() jsonSchema { return hexSchema(40) },
+	"checksum256":          func() jsonSchema { return hexSchema(64) },
+	"checksum512":          func() jsonSchema { return hexSchema(128) },
+	"public_key":           func() jsonSchema { return jsonSchema{"type": "string"} },
+	"signature":            func() jsonSchema { return jsonSchema{"type": "string"} },
+	"symbol":               func() jsonSchema { return jsonSchema{"type": "string", "pattern": "^[0-9]+,[A-Z]{1,7}$"} },
+	"symbol_code":          func() jsonSchema { return jsonSchema{"type": "string", "pattern": "^[A-Z]{1,7}$"} },
+	"asset":                func() jsonSchema { return jsonSchema{"type": "string", "pattern": "^-?[0-9]+(\\.[0-9]+)? [A-Z]{1,7}$"} },
+	"extended_asset": func() jsonSchema {
+		return jsonSchema{
+			"type": "object",
+			"properties": jsonSchema{
+				"quantity": jsonSchema{"$ref": "#/definitions/asset"},
+				"contract": jsonSchema{"$ref": "#/definitions/name"},
+			},
+			"required": []string{"quantity", "contract"},
+		}
+	},
+}
+
+type jsonSchemaGenerator struct {
+	*abiIndex
+
+	definitions jsonSchema
+}
+
+// GenerateJSONSchema emits a draft-07 JSON Schema document holding, under `definitions`, one
+// schema per struct, alias and variant of `abi` as well as `action.<name>` and `table.<name>`
+// entries validating the JSON form of each action data and table row.
+func GenerateJSONSchema(abi *zsw.ABI) ([]byte, error) {
+	index, err := newABIIndex(abi)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &jsonSchemaGenerator{abiIndex: index, definitions: jsonSchema{}}
+
+	for _, alias := range abi.Types {
+		g.definitions[alias.NewTypeName] = g.typeSchema(alias.Type)
+	}
+
+	for _, s := range abi.Structs {
+		g.definitions[s.Name] = g.structSchema(s)
+	}
+
+	for _, variant := range abi.Variants {
+		g.definitions[variant.Name] = g.variantSchema(variant)
+	}
+
+	for _, action := range abi.Actions {
+		g.definitions["action."+string(action.Name)] = g.typeSchema(action.Type)
+	}
+
+	for _, table := range abi.Tables {
+		g.definitions["table."+string(table.Name)] = g.typeSchema(table.Type)
+	}
+
+	out, err := json.MarshalIndent(jsonSchema{
+		"$schema":     jsonSchemaDraft,
+		"definitions": g.definitions,
+	}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshalling JSON schema: %w", err)
+	}
+
+	return append(out, '\n'), nil
+}
+
+// typeSchema is the schema of a type reference, declared and builtin types are referenced
+// by their definition, builtin definitions being added as they are used.
+func (g *jsonSchemaGenerator) typeSchema(typeName string) jsonSchema {
+	inner, modifier := splitType(typeName)
+	switch modifier {
+	case modifierArray:
+		return jsonSchema{"type": "array", "items": g.typeSchema(inner)}
+	case modifierOptional:
+		return jsonSchema{"oneOf": []interface{}{g.typeSchema(inner), jsonSchema{"type": "null"}}}
+	case modifierBinaryExtension:
+		return g.typeSchema(inner)
+	}
+
+	if !g.isDeclared(typeName) {
+		g.addBuiltin(typeName)
+	}
+
+	return jsonSchema{"$ref": "#/definitions/" + typeName}
+}
+
+func (g *jsonSchemaGenerator) addBuiltin(typeName string) {
+	if _, found := g.definitions[typeName]; found {
+		return
+	}
+
+	g.definitions[typeName] = jsonSchemaBuiltinTypes[typeName]()
+	if typeName == "extended_asset" {
+		g.addBuiltin("asset")
+		g.addBuiltin("name")
+	}
+}
+
+func (g *jsonSchemaGenerator) structSchema(s zsw.StructDef) jsonSchema {
+	properties := jsonSchema{}
+	required := []string{}
+	for _, field := range s.Fields {
+		properties[field.Name] = g.typeSchema(field.Type)
+
+		// Optional fields and binary extensions can both be left out of the JSON form
+		if _, modifier := splitType(field.Type); modifier == modifierNone || modifier == modifierArray {
+			required = append(required, field.Name)
+		}
+	}
+
+	schema := jsonSchema{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	if usage := g.usage(s.Name); usage != "" {
+		schema["description"] = fmt.Sprintf("The `%s` struct, %s.", s.Name, usage)
+	}
+
+	if s.Base == "" {
+		return schema
+	}
+
+	return jsonSchema{"allOf": []interface{}{g.typeSchema(s.Base), schema}}
+}
+
+func (g *jsonSchemaGenerator) variantSchema(variant zsw.VariantDef) jsonSchema {
+	members := make([]interface{}, len(variant.Types))
+	for i, typeName := range variant.Types {
+		members[i] = jsonSchema{
+			"type":     "array",
+			"items":    []interface{}{jsonSchema{"const": typeName}, g.typeSchema(typeName)},
+			"minItems": 2,
+			"maxItems": 2,
+		}
+	}
+
+	return jsonSchema{"oneOf": members}
+}
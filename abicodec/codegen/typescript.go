@@ -0,0 +1,181 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/zhongshuwen/zswchain-go"
+)
+
+// tsBuiltinTypes maps builtin types to their TypeScript form, 64 bits and larger integers
+// are emitted as strings by nodeos when they don't fit a JavaScript number.
+var tsBuiltinTypes = map[string]string{
+	"bool":                 "boolean",
+	"int8":                 "number",
+	"uint8":                "number",
+	"int16":                "number",
+	"uint16":               "number",
+	"int32":                "number",
+	"uint32":               "number",
+	"int64":                "number | string",
+	"uint64":               "number | string",
+	"int128":               "string",
+	"uint128":              "string",
+	"varint32":             "number",
+	"varuint32":            "number",
+	"float32":              "number",
+	"float64":              "number",
+	"float128":             "string",
+	"time_point":           "string",
+	"time_point_sec":       "string",
+	"block_timestamp_type": "string",
+	"name":                 "string",
+	"bytes":                "string",
+	"string":               "string",
+	"checksum160":          "string",
+	"checksum256":          "string",
+	"checksum512":          "string",
+	"public_key":           "string",
+	"signature":            "string",
+	"symbol":               "string",
+	"symbol_code":          "string",
+	"asset":                "string",
+	"extended_asset":       "{ quantity: string; contract: string }",
+}
+
+var tsIdentifierRegex = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+type tsGenerator struct {
+	*abiIndex
+
+	body bytes.Buffer
+}
+
+// GenerateTypeScript emits one TypeScript type per struct, alias and variant of `abi`, plus
+// the `Actions` and `Tables` interfaces mapping each action and table name to its type.
+func GenerateTypeScript(abi *zsw.ABI) ([]byte, error) {
+	index, err := newABIIndex(abi)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &tsGenerator{abiIndex: index}
+	fmt.Fprint(&g.body, "// Code generated by dfuseeos tools abi codegen. DO NOT EDIT.\n\n")
+
+	for _, alias := range abi.Types {
+		fmt.Fprintf(&g.body, "/** The `%s` type alias of `%s`. */\n", alias.NewTypeName, alias.Type)
+		fmt.Fprintf(&g.body, "export type %s = %s\n\n", pascalCase(alias.NewTypeName), g.typeExpr(alias.Type))
+	}
+
+	for _, s := range abi.Structs {
+		g.writeStruct(s)
+	}
+
+	for _, variant := range abi.Variants {
+		g.writeVariant(variant)
+	}
+
+	fmt.Fprint(&g.body, "/** Maps each action name to the type of its data. */\n")
+	fmt.Fprint(&g.body, "export interface Actions {\n")
+	for _, action := range abi.Actions {
+		fmt.Fprintf(&g.body, "  %s: %s\n", tsPropertyName(string(action.Name)), g.typeExpr(action.Type))
+	}
+	fmt.Fprint(&g.body, "}\n\n")
+
+	fmt.Fprint(&g.body, "/** Maps each table name to the type of its rows. */\n")
+	fmt.Fprint(&g.body, "export interface Tables {\n")
+	for _, table := range abi.Tables {
+		fmt.Fprintf(&g.body, "  %s: %s\n", tsPropertyName(string(table.Name)), g.typeExpr(table.Type))
+	}
+	fmt.Fprint(&g.body, "}\n")
+
+	return g.body.Bytes(), nil
+}
+
+func (g *tsGenerator) typeExpr(typeName string) string {
+	inner, modifier := splitType(typeName)
+	switch modifier {
+	case modifierArray:
+		element := g.typeExpr(inner)
+		if strings.Contains(element, "|") {
+			element = "(" + element + ")"
+		}
+
+		return element + "[]"
+	case modifierOptional:
+		return g.typeExpr(inner) + " | null"
+	case modifierBinaryExtension:
+		return g.typeExpr(inner)
+	}
+
+	if g.isDeclared(typeName) {
+		return pascalCase(typeName)
+	}
+
+	return tsBuiltinTypes[typeName]
+}
+
+func (g *tsGenerator) writeStruct(s zsw.StructDef) {
+	if usage := g.usage(s.Name); usage != "" {
+		fmt.Fprintf(&g.body, "/** The `%s` struct, %s. */\n", s.Name, usage)
+	} else {
+		fmt.Fprintf(&g.body, "/** The `%s` struct. */\n", s.Name)
+	}
+
+	fmt.Fprintf(&g.body, "export interface %s", pascalCase(s.Name))
+	if s.Base != "" {
+		fmt.Fprintf(&g.body, " extends %s", pascalCase(s.Base))
+	}
+	fmt.Fprint(&g.body, " {\n")
+
+	for _, field := range s.Fields {
+		// Optional fields and binary extensions can both be left out of the JSON form
+		property := tsPropertyName(field.Name)
+		if _, modifier := splitType(field.Type); modifier != modifierNone && modifier != modifierArray {
+			property += "?"
+		}
+
+		fmt.Fprintf(&g.body, "  %s: %s\n", property, g.typeExpr(field.Type))
+	}
+
+	fmt.Fprint(&g.body, "}\n\n")
+}
+
+func (g *tsGenerator) writeVariant(variant zsw.VariantDef) {
+	members := make([]string, len(variant.Types))
+	for i, typeName := range variant.Types {
+		members[i] = fmt.Sprintf("[%q, %s]", typeName, g.typeExpr(typeName))
+	}
+
+	union := strings.Join(members, " | ")
+	if union == "" {
+		union = "never"
+	}
+
+	fmt.Fprintf(&g.body, "/** The `%s` variant, a `[type_name, value]` pair. */\n", variant.Name)
+	fmt.Fprintf(&g.body, "export type %s = %s\n\n", pascalCase(variant.Name), union)
+}
+
+func tsPropertyName(name string) string {
+	if tsIdentifierRegex.MatchString(name) {
+		return name
+	}
+
+	return fmt.Sprintf("%q", name)
+}
@@ -96,7 +96,7 @@ func (d *Decoder) DecodeTable(cxt context.Context, req *pbabicodec.DecodeTableRe
 
 func (d *Decoder) DecodeAction(ctx context.Context, req *pbabicodec.DecodeActionRequest) (*pbabicodec.Response, error) {
 
-	out, abiBlockNum, err := d.decodeTable(req.Account, req.Action, req.Payload, req.AtBlockNum)
+	out, abiBlockNum, err := d.decodeAction(req.Account, req.Action, req.Payload, req.AtBlockNum)
 
 	if err != nil {
 		return nil, err
@@ -124,3 +124,91 @@ func (d *Decoder) GetAbi(ctx context.Context, req *pbabicodec.GetAbiRequest) (*p
 
 	return resp, nil
 }
+
+func (d *Decoder) ListAbiVersions(ctx context.Context, req *pbabicodec.ListAbiVersionsRequest) (*pbabicodec.ListAbiVersionsResponse, error) {
+	versions := d.cache.ListABIVersions(req.Account)
+	if len(versions) == 0 {
+		return nil, derr.Statusf(codes.NotFound, "no ABI found for account: %s", req.Account)
+	}
+
+	resp := &pbabicodec.ListAbiVersionsResponse{}
+	for _, version := range versions {
+		abiVersion := &pbabicodec.AbiVersion{
+			StartBlockNum: version.StartBlockNum,
+			EndBlockNum:   version.EndBlockNum,
+			TrxId:         version.TrxID,
+		}
+
+		if req.WithAbi {
+			data, err := json.Marshal(version.ABI)
+			if err != nil {
+				return nil, derr.Status(codes.Internal, err.Error())
+			}
+			abiVersion.JsonAbi = string(data)
+		}
+
+		resp.Versions = append(resp.Versions, abiVersion)
+	}
+
+	return resp, nil
+}
+
+func (d *Decoder) DiffAbis(ctx context.Context, req *pbabicodec.DiffAbisRequest) (*pbabicodec.DiffAbisResponse, error) {
+	if req.FromBlockNum > req.ToBlockNum {
+		return nil, derr.Statusf(codes.InvalidArgument, "from block %d is higher than to block %d", req.FromBlockNum, req.ToBlockNum)
+	}
+
+	fromItem := d.cache.ABIAtBlockNum(req.Account, req.FromBlockNum)
+	if fromItem == nil {
+		return nil, derr.Statusf(codes.NotFound, "no ABI found for account: %s at block %d", req.Account, req.FromBlockNum)
+	}
+
+	toItem := d.cache.ABIAtBlockNum(req.Account, req.ToBlockNum)
+	if toItem == nil {
+		return nil, derr.Statusf(codes.NotFound, "no ABI found for account: %s at block %d", req.Account, req.ToBlockNum)
+	}
+
+	diff := DiffABIs(fromItem.ABI, toItem.ABI)
+
+	resp := &pbabicodec.DiffAbisResponse{
+		FromAbiBlockNum: fromItem.BlockNum,
+		ToAbiBlockNum:   toItem.BlockNum,
+		AddedActions:    diff.AddedActions,
+		RemovedActions:  diff.RemovedActions,
+		ChangedActions:  typeChangesToProto(diff.ChangedActions),
+		AddedTables:     diff.AddedTables,
+		RemovedTables:   diff.RemovedTables,
+		ChangedTables:   typeChangesToProto(diff.ChangedTables),
+		AddedStructs:    diff.AddedStructs,
+		RemovedStructs:  diff.RemovedStructs,
+	}
+
+	for _, change := range diff.ChangedStructs {
+		resp.ChangedStructs = append(resp.ChangedStructs, &pbabicodec.StructChange{
+			Name:          change.Name,
+			FromBase:      change.FromBase,
+			ToBase:        change.ToBase,
+			AddedFields:   fieldDefsToProto(change.AddedFields),
+			RemovedFields: fieldDefsToProto(change.RemovedFields),
+			ChangedFields: typeChangesToProto(change.ChangedFields),
+		})
+	}
+
+	return resp, nil
+}
+
+func typeChangesToProto(changes []*TypeChange) (out []*pbabicodec.TypeChange) {
+	for _, change := range changes {
+		out = append(out, &pbabicodec.TypeChange{Name: change.Name, FromType: change.FromType, ToType: change.ToType})
+	}
+
+	return
+}
+
+func fieldDefsToProto(fields []zsw.FieldDef) (out []*pbabicodec.FieldDef) {
+	for _, field := range fields {
+		out = append(out, &pbabicodec.FieldDef{Name: field.Name, Type: field.Type})
+	}
+
+	return
+}
@@ -0,0 +1,213 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package abicodec
+
+import (
+	"sort"
+
+	"github.com/zhongshuwen/zswchain-go"
+)
+
+// ABIVersion is an ABI that was set on an account along with the range of blocks it applied to.
+type ABIVersion struct {
+	ABI           *zsw.ABI
+	StartBlockNum uint32
+	// EndBlockNum is the last block where the version applied, 0 when it's the account's current ABI
+	EndBlockNum uint32
+	TrxID       string
+}
+
+func abiVersions(items []*ABICacheItem) []*ABIVersion {
+	if len(items) == 0 {
+		return nil
+	}
+
+	out := make([]*ABIVersion, len(items))
+	for i, item := range items {
+		out[i] = &ABIVersion{
+			ABI:           item.ABI,
+			StartBlockNum: item.BlockNum,
+			TrxID:         item.TrxID,
+		}
+
+		if i > 0 {
+			out[i-1].EndBlockNum = item.BlockNum - 1
+		}
+	}
+
+	return out
+}
+
+// ABIDiff is the structural difference between two ABIs, names are sorted alphabetically.
+type ABIDiff struct {
+	AddedActions   []string
+	RemovedActions []string
+	ChangedActions []*TypeChange
+
+	AddedTables   []string
+	RemovedTables []string
+	ChangedTables []*TypeChange
+
+	AddedStructs   []string
+	RemovedStructs []string
+	ChangedStructs []*StructChange
+}
+
+// TypeChange is an action, a table or a struct field whose type changed.
+type TypeChange struct {
+	Name     string
+	FromType string
+	ToType   string
+}
+
+type StructChange struct {
+	Name          string
+	FromBase      string
+	ToBase        string
+	AddedFields   []zsw.FieldDef
+	RemovedFields []zsw.FieldDef
+	ChangedFields []*TypeChange
+}
+
+// DiffABIs compares the actions, tables and structs of two ABIs. A nil ABI is treated as
+// an empty one.
+func DiffABIs(from, to *zsw.ABI) *ABIDiff {
+	if from == nil {
+		from = &zsw.ABI{}
+	}
+
+	if to == nil {
+		to = &zsw.ABI{}
+	}
+
+	diff := &ABIDiff{}
+	diff.AddedActions, diff.RemovedActions, diff.ChangedActions = diffNamedTypes(actionTypes(from), actionTypes(to))
+	diff.AddedTables, diff.RemovedTables, diff.ChangedTables = diffNamedTypes(tableTypes(from), tableTypes(to))
+
+	fromStructs, fromStructNames := structsByName(from)
+	toStructs, toStructNames := structsByName(to)
+
+	for _, name := range toStructNames {
+		if _, found := fromStructs[name]; !found {
+			diff.AddedStructs = append(diff.AddedStructs, name)
+		}
+	}
+
+	for _, name := range fromStructNames {
+		toStruct, found := toStructs[name]
+		if !found {
+			diff.RemovedStructs = append(diff.RemovedStructs, name)
+			continue
+		}
+
+		if change := diffStructs(fromStructs[name], toStruct); change != nil {
+			diff.ChangedStructs = append(diff.ChangedStructs, change)
+		}
+	}
+
+	return diff
+}
+
+func diffStructs(from, to *zsw.StructDef) *StructChange {
+	change := &StructChange{Name: from.Name}
+	if from.Base != to.Base {
+		change.FromBase = from.Base
+		change.ToBase = to.Base
+	}
+
+	fromFields := map[string]string{}
+	for _, field := range from.Fields {
+		fromFields[field.Name] = field.Type
+	}
+
+	toFields := map[string]string{}
+	for _, field := range to.Fields {
+		toFields[field.Name] = field.Type
+		if fromType, found := fromFields[field.Name]; !found {
+			change.AddedFields = append(change.AddedFields, field)
+		} else if fromType != field.Type {
+			change.ChangedFields = append(change.ChangedFields, &TypeChange{Name: field.Name, FromType: fromType, ToType: field.Type})
+		}
+	}
+
+	for _, field := range from.Fields {
+		if _, found := toFields[field.Name]; !found {
+			change.RemovedFields = append(change.RemovedFields, field)
+		}
+	}
+
+	if from.Base == to.Base && len(change.AddedFields) == 0 && len(change.RemovedFields) == 0 && len(change.ChangedFields) == 0 {
+		return nil
+	}
+
+	return change
+}
+
+func diffNamedTypes(from, to map[string]string) (added, removed []string, changed []*TypeChange) {
+	for _, name := range sortedNames(to) {
+		if _, found := from[name]; !found {
+			added = append(added, name)
+		}
+	}
+
+	for _, name := range sortedNames(from) {
+		toType, found := to[name]
+		if !found {
+			removed = append(removed, name)
+		} else if from[name] != toType {
+			changed = append(changed, &TypeChange{Name: name, FromType: from[name], ToType: toType})
+		}
+	}
+
+	return
+}
+
+func actionTypes(abi *zsw.ABI) map[string]string {
+	out := make(map[string]string, len(abi.Actions))
+	for _, action := range abi.Actions {
+		out[string(action.Name)] = action.Type
+	}
+
+	return out
+}
+
+func tableTypes(abi *zsw.ABI) map[string]string {
+	out := make(map[string]string, len(abi.Tables))
+	for _, table := range abi.Tables {
+		out[string(table.Name)] = table.Type
+	}
+
+	return out
+}
+
+func structsByName(abi *zsw.ABI) (out map[string]*zsw.StructDef, names []string) {
+	out = make(map[string]*zsw.StructDef, len(abi.Structs))
+	for i := range abi.Structs {
+		out[abi.Structs[i].Name] = &abi.Structs[i]
+		names = append(names, abi.Structs[i].Name)
+	}
+
+	sort.Strings(names)
+	return
+}
+
+func sortedNames(types map[string]string) (out []string) {
+	for name := range types {
+		out = append(out, name)
+	}
+
+	sort.Strings(out)
+	return out
+}
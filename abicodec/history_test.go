@@ -0,0 +1,110 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package abicodec
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pbabicodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/abicodec/v1"
+	"github.com/zhongshuwen/zswchain-go"
+)
+
+func TestDefaultCache_ListABIVersions(t *testing.T) {
+	cache := &DefaultCache{Abis: map[string][]*ABICacheItem{
+		"account.1": {
+			{BlockNum: 100, ABI: NewTestABI("version.1"), TrxID: "trx.1"},
+			{BlockNum: 200, ABI: NewTestABI("version.2")},
+			{BlockNum: 300, ABI: NewTestABI("version.3"), TrxID: "trx.3"},
+		},
+	}}
+
+	assert.Equal(t, []*ABIVersion{
+		{ABI: NewTestABI("version.1"), StartBlockNum: 100, EndBlockNum: 199, TrxID: "trx.1"},
+		{ABI: NewTestABI("version.2"), StartBlockNum: 200, EndBlockNum: 299},
+		{ABI: NewTestABI("version.3"), StartBlockNum: 300, EndBlockNum: 0, TrxID: "trx.3"},
+	}, cache.ListABIVersions("account.1"))
+
+	assert.Nil(t, cache.ListABIVersions("account.2"))
+}
+
+func TestDiffABIs(t *testing.T) {
+	from := &zsw.ABI{
+		Structs: []zsw.StructDef{
+			{Name: "transfer", Fields: []zsw.FieldDef{{Name: "from", Type: "name"}, {Name: "to", Type: "name"}, {Name: "memo", Type: "string"}}},
+			{Name: "account", Fields: []zsw.FieldDef{{Name: "balance", Type: "asset"}}},
+			{Name: "close", Fields: []zsw.FieldDef{{Name: "owner", Type: "name"}}},
+		},
+		Actions: []zsw.ActionDef{{Name: "transfer", Type: "transfer"}, {Name: "close", Type: "close"}},
+		Tables:  []zsw.TableDef{{Name: "accounts", Type: "account"}},
+	}
+
+	to := &zsw.ABI{
+		Structs: []zsw.StructDef{
+			{Name: "transfer", Base: "base", Fields: []zsw.FieldDef{{Name: "from", Type: "name"}, {Name: "to", Type: "account_name"}, {Name: "quantity", Type: "asset"}}},
+			{Name: "account", Fields: []zsw.FieldDef{{Name: "balance", Type: "asset"}}},
+			{Name: "account_v2", Fields: []zsw.FieldDef{{Name: "balance", Type: "asset"}}},
+			{Name: "base", Fields: []zsw.FieldDef{}},
+		},
+		Actions: []zsw.ActionDef{{Name: "transfer", Type: "transfer"}, {Name: "open", Type: "open"}},
+		Tables:  []zsw.TableDef{{Name: "accounts", Type: "account_v2"}, {Name: "stat", Type: "currency_stats"}},
+	}
+
+	assert.Equal(t, &ABIDiff{
+		AddedActions:   []string{"open"},
+		RemovedActions: []string{"close"},
+		AddedTables:    []string{"stat"},
+		ChangedTables:  []*TypeChange{{Name: "accounts", FromType: "account", ToType: "account_v2"}},
+		AddedStructs:   []string{"account_v2", "base"},
+		RemovedStructs: []string{"close"},
+		ChangedStructs: []*StructChange{
+			{
+				Name:          "transfer",
+				ToBase:        "base",
+				AddedFields:   []zsw.FieldDef{{Name: "quantity", Type: "asset"}},
+				RemovedFields: []zsw.FieldDef{{Name: "memo", Type: "string"}},
+				ChangedFields: []*TypeChange{{Name: "to", FromType: "name", ToType: "account_name"}},
+			},
+		},
+	}, DiffABIs(from, to))
+
+	assert.Equal(t, &ABIDiff{}, DiffABIs(from, from))
+	assert.Equal(t, &ABIDiff{AddedActions: []string{"open", "transfer"}, AddedTables: []string{"accounts", "stat"}, AddedStructs: []string{"account", "account_v2", "base", "transfer"}}, DiffABIs(nil, to))
+}
+
+func TestDecoder_DiffAbis(t *testing.T) {
+	cache := &DefaultCache{Abis: map[string][]*ABICacheItem{
+		"account.1": {
+			{BlockNum: 100, ABI: &zsw.ABI{Actions: []zsw.ActionDef{{Name: "transfer", Type: "transfer"}}}},
+			{BlockNum: 200, ABI: &zsw.ABI{Actions: []zsw.ActionDef{{Name: "transfer", Type: "transfer_v2"}}}},
+		},
+	}}
+	decoder := NewDecoder(cache)
+
+	resp, err := decoder.DiffAbis(context.Background(), &pbabicodec.DiffAbisRequest{Account: "account.1", FromBlockNum: 150, ToBlockNum: 250})
+	require.NoError(t, err)
+
+	assert.Equal(t, uint32(100), resp.FromAbiBlockNum)
+	assert.Equal(t, uint32(200), resp.ToAbiBlockNum)
+	assert.Equal(t, []*pbabicodec.TypeChange{{Name: "transfer", FromType: "transfer", ToType: "transfer_v2"}}, resp.ChangedActions)
+
+	_, err = decoder.DiffAbis(context.Background(), &pbabicodec.DiffAbisRequest{Account: "account.1", FromBlockNum: 50, ToBlockNum: 250})
+	assert.Error(t, err)
+
+	_, err = decoder.DiffAbis(context.Background(), &pbabicodec.DiffAbisRequest{Account: "account.1", FromBlockNum: 250, ToBlockNum: 150})
+	assert.Error(t, err)
+}
@@ -0,0 +1,162 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package abicodec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/streamingfast/dstore"
+	"github.com/zhongshuwen/zswchain-go"
+)
+
+// cacheFormatVersion is the version of the cache storage format written by this code, version
+// 1 being the legacy single gob file named after the cache.
+//
+// Version 2 stores, next to the legacy file name, an index (`<cacheName>.index.json`) holding
+// the cursor and the list of cached accounts, and one segment per account
+// (`<cacheName>.segments/<account>.json`) holding its ABIs. Segments are only read when their
+// account is first accessed and only the segments of changed accounts are written on save.
+const cacheFormatVersion = 2
+
+type cacheIndex struct {
+	Version  uint32                        `json:"version"`
+	Cursor   string                        `json:"cursor"`
+	Accounts map[string]*cacheIndexAccount `json:"accounts"`
+}
+
+type cacheIndexAccount struct {
+	ABICount     int    `json:"abi_count"`
+	LastBlockNum uint32 `json:"last_block_num"`
+}
+
+func newCacheIndex() *cacheIndex {
+	return &cacheIndex{Version: cacheFormatVersion, Accounts: map[string]*cacheIndexAccount{}}
+}
+
+type cacheSegment struct {
+	Version uint32              `json:"version"`
+	Account string              `json:"account"`
+	ABIs    []*cacheSegmentItem `json:"abis"`
+}
+
+type cacheSegmentItem struct {
+	BlockNum uint32   `json:"block_num"`
+	TrxID    string   `json:"trx_id,omitempty"`
+	ABI      *zsw.ABI `json:"abi"`
+}
+
+func indexFilename(cacheName string) string {
+	return cacheName + ".index.json"
+}
+
+func segmentFilename(cacheName string, account string) string {
+	return cacheName + ".segments/" + account + ".json"
+}
+
+func readIndex(ctx context.Context, store dstore.Store, cacheName string) (*cacheIndex, error) {
+	index := &cacheIndex{}
+	found, err := readJSONObject(ctx, store, indexFilename(cacheName), index)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	if index.Version > cacheFormatVersion {
+		return nil, fmt.Errorf("cache index %s has format version %d, only versions up to %d are supported", indexFilename(cacheName), index.Version, cacheFormatVersion)
+	}
+
+	if index.Accounts == nil {
+		index.Accounts = map[string]*cacheIndexAccount{}
+	}
+
+	return index, nil
+}
+
+func readSegment(ctx context.Context, store dstore.Store, cacheName string, account string) ([]*ABICacheItem, error) {
+	filename := segmentFilename(cacheName, account)
+
+	segment := &cacheSegment{}
+	found, err := readJSONObject(ctx, store, filename, segment)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, fmt.Errorf("cache segment %s listed in index does not exist", filename)
+	}
+
+	if segment.Version > cacheFormatVersion {
+		return nil, fmt.Errorf("cache segment %s has format version %d, only versions up to %d are supported", filename, segment.Version, cacheFormatVersion)
+	}
+
+	items := make([]*ABICacheItem, len(segment.ABIs))
+	for i, item := range segment.ABIs {
+		items[i] = &ABICacheItem{ABI: item.ABI, BlockNum: item.BlockNum, TrxID: item.TrxID}
+	}
+
+	return items, nil
+}
+
+func writeSegment(ctx context.Context, store dstore.Store, cacheName string, account string, items []*ABICacheItem) error {
+	segment := &cacheSegment{
+		Version: cacheFormatVersion,
+		Account: account,
+		ABIs:    make([]*cacheSegmentItem, len(items)),
+	}
+
+	for i, item := range items {
+		segment.ABIs[i] = &cacheSegmentItem{BlockNum: item.BlockNum, TrxID: item.TrxID, ABI: item.ABI}
+	}
+
+	return writeJSONObject(ctx, store, segmentFilename(cacheName, account), segment)
+}
+
+func readJSONObject(ctx context.Context, store dstore.Store, filename string, v interface{}) (found bool, err error) {
+	exists, err := store.FileExists(ctx, filename)
+	if err != nil {
+		return false, fmt.Errorf("validating existence of %s: %w", filename, err)
+	}
+
+	if !exists {
+		return false, nil
+	}
+
+	r, err := store.OpenObject(ctx, filename)
+	if err != nil {
+		return false, fmt.Errorf("opening %s: %w", filename, err)
+	}
+	defer r.Close()
+
+	if err := json.NewDecoder(r).Decode(v); err != nil {
+		return false, fmt.Errorf("decoding %s: %w", filename, err)
+	}
+
+	return true, nil
+}
+
+func writeJSONObject(ctx context.Context, store dstore.Store, filename string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encoding %s: %w", filename, err)
+	}
+
+	if err := store.WriteObject(ctx, filename, bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("writing %s: %w", filename, err)
+	}
+
+	return nil
+}
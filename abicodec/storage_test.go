@@ -0,0 +1,251 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package abicodec
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/streamingfast/dstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCacheName = "abicodec_cache.bin"
+
+func newTestStore(t *testing.T) dstore.Store {
+	dir, err := ioutil.TempDir("", "abicodec-cache")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	store, err := dstore.NewSimpleStore("file://" + dir)
+	require.NoError(t, err)
+
+	return store
+}
+
+func TestDefaultCache_IncrementalSave(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+
+	cache, err := NewABICache(store, testCacheName)
+	require.NoError(t, err)
+
+	cache.SetABIAtBlockNum("account.1", 10, "trx.1", NewTestABI("version.1"))
+	cache.SetABIAtBlockNum("account.2", 20, "trx.2", NewTestABI("version.2"))
+	cache.SetCursor("cursor.1")
+	require.NoError(t, cache.SaveState())
+
+	// A corrupted segment is neither read nor rewritten when its account is left untouched
+	require.NoError(t, store.WriteObject(ctx, segmentFilename(testCacheName, "account.1"), strings.NewReader("corrupted")))
+
+	cache, err = NewABICache(store, testCacheName)
+	require.NoError(t, err)
+	assert.Equal(t, "cursor.1", cache.GetCursor())
+
+	cache.SetABIAtBlockNum("account.2", 30, "trx.3", NewTestABI("version.3"))
+	cache.SetCursor("cursor.2")
+	require.NoError(t, cache.SaveState())
+
+	cache, err = NewABICache(store, testCacheName)
+	require.NoError(t, err)
+	assert.Equal(t, "cursor.2", cache.GetCursor())
+	assert.Equal(t, map[string]*cacheIndexAccount{
+		"account.1": {ABICount: 1, LastBlockNum: 10},
+		"account.2": {ABICount: 2, LastBlockNum: 30},
+	}, cache.index.Accounts)
+
+	assert.Equal(t, []*ABIVersion{
+		{ABI: NewTestABI("version.2"), StartBlockNum: 20, EndBlockNum: 29, TrxID: "trx.2"},
+		{ABI: NewTestABI("version.3"), StartBlockNum: 30, TrxID: "trx.3"},
+	}, cache.ListABIVersions("account.2"))
+	assert.Nil(t, cache.ABIAtBlockNum("account.1", 10))
+	assert.Len(t, cache.Abis, 1)
+}
+
+func TestDefaultCache_SaveState_CursorOnly(t *testing.T) {
+	store := newTestStore(t)
+
+	cache, err := NewABICache(store, testCacheName)
+	require.NoError(t, err)
+
+	cache.SetCursor("cursor.1")
+	require.NoError(t, cache.SaveState())
+
+	cache, err = NewABICache(store, testCacheName)
+	require.NoError(t, err)
+	assert.Equal(t, "cursor.1", cache.GetCursor())
+}
+
+func TestDefaultCache_PendingChanges(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+
+	cache, err := NewABICache(store, testCacheName)
+	require.NoError(t, err)
+
+	cache.SetABIAtBlockNum("account.1", 10, "", NewTestABI("version.1"))
+	require.NoError(t, cache.SaveState())
+
+	segment := segmentFilename(testCacheName, "account.1")
+	require.NoError(t, store.DeleteObject(ctx, segment))
+
+	cache, err = NewABICache(store, testCacheName)
+	require.NoError(t, err)
+
+	cache.SetABIAtBlockNum("account.1", 20, "", NewTestABI("version.2"))
+	assert.Error(t, cache.SaveState(), "changes to an account that cannot be loaded are not saved")
+
+	require.NoError(t, writeSegment(ctx, store, testCacheName, "account.1", []*ABICacheItem{{BlockNum: 10, ABI: NewTestABI("version.1")}}))
+
+	assert.Equal(t, "version.1", cache.ABIAtBlockNum("account.1", 15).ABI.Version)
+	assert.Equal(t, "version.2", cache.ABIAtBlockNum("account.1", 20).ABI.Version)
+	require.NoError(t, cache.SaveState())
+}
+
+// blockingStore blocks the reads of `filename` until `release` is closed.
+type blockingStore struct {
+	dstore.Store
+
+	filename string
+	reads    int32
+	release  chan struct{}
+}
+
+func (s *blockingStore) OpenObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	if name == s.filename {
+		atomic.AddInt32(&s.reads, 1)
+		<-s.release
+	}
+
+	return s.Store.OpenObject(ctx, name)
+}
+
+func TestDefaultCache_LoadsSegmentsOutsideLock(t *testing.T) {
+	store := newTestStore(t)
+
+	cache, err := NewABICache(store, testCacheName)
+	require.NoError(t, err)
+
+	cache.SetABIAtBlockNum("account.1", 10, "", NewTestABI("version.1"))
+	cache.SetABIAtBlockNum("account.2", 20, "", NewTestABI("version.2"))
+	require.NoError(t, cache.SaveState())
+
+	blocking := &blockingStore{Store: store, filename: segmentFilename(testCacheName, "account.1"), release: make(chan struct{})}
+	cache, err = NewABICache(blocking, testCacheName)
+	require.NoError(t, err)
+	require.Equal(t, "version.2", cache.ABIAtBlockNum("account.2", 20).ABI.Version)
+
+	var wg sync.WaitGroup
+	results := make([]*ABICacheItem, 3)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = cache.ABIAtBlockNum("account.1", 10)
+		}(i)
+	}
+
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&blocking.reads) == 1 }, time.Second, time.Millisecond)
+
+	// Lookups and saves of other accounts go on while the segment of `account.1` is read
+	assert.Equal(t, "version.2", cache.ABIAtBlockNum("account.2", 25).ABI.Version)
+	cache.SetABIAtBlockNum("account.2", 30, "", NewTestABI("version.3"))
+	require.NoError(t, cache.SaveState())
+
+	close(blocking.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&blocking.reads), "concurrent lookups should share a single segment read")
+	for _, result := range results {
+		require.NotNil(t, result)
+		assert.Equal(t, "version.1", result.ABI.Version)
+	}
+}
+
+func writeLegacyCache(t *testing.T, store dstore.Store) {
+	legacy := &DefaultCache{
+		Abis: map[string][]*ABICacheItem{
+			"account.1": {{BlockNum: 10, ABI: NewTestABI("version.1")}, {BlockNum: 20, ABI: NewTestABI("version.2")}},
+		},
+		Cursor: "cursor.1",
+	}
+
+	var buffer bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buffer).Encode(&legacy))
+	require.NoError(t, store.WriteObject(context.Background(), testCacheName, &buffer))
+}
+
+func TestNewABICache_MigratesLegacyCache(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	writeLegacyCache(t, store)
+
+	cache, err := NewABICache(store, testCacheName, WithLegacyMigration())
+	require.NoError(t, err)
+	assert.Equal(t, "cursor.1", cache.GetCursor())
+	assert.Equal(t, "version.2", cache.ABIAtBlockNum("account.1", 25).ABI.Version)
+
+	exists, err := store.FileExists(ctx, indexFilename(testCacheName))
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	cache, err = NewABICache(store, testCacheName)
+	require.NoError(t, err)
+	assert.Len(t, cache.Abis, 0)
+	assert.Equal(t, "cursor.1", cache.GetCursor())
+	assert.Equal(t, "version.1", cache.ABIAtBlockNum("account.1", 15).ABI.Version)
+}
+
+func TestNewABICache_ReadsLegacyCacheWithoutMigrating(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	writeLegacyCache(t, store)
+
+	cache, err := NewABICache(store, testCacheName)
+	require.NoError(t, err)
+	assert.Equal(t, "cursor.1", cache.GetCursor())
+	assert.Equal(t, "version.1", cache.ABIAtBlockNum("account.1", 15).ABI.Version)
+
+	exists, err := store.FileExists(ctx, indexFilename(testCacheName))
+	require.NoError(t, err)
+	assert.False(t, exists, "the store should be left untouched")
+
+	// Every legacy account is written by the first save, not only the changed ones
+	cache.SetABIAtBlockNum("account.2", 30, "", NewTestABI("version.3"))
+	require.NoError(t, cache.SaveState())
+
+	cache, err = NewABICache(store, testCacheName)
+	require.NoError(t, err)
+	assert.Equal(t, "version.2", cache.ABIAtBlockNum("account.1", 25).ABI.Version)
+	assert.Equal(t, "version.3", cache.ABIAtBlockNum("account.2", 30).ABI.Version)
+}
+
+func TestNewABICache_UnsupportedVersion(t *testing.T) {
+	store := newTestStore(t)
+	require.NoError(t, store.WriteObject(context.Background(), indexFilename(testCacheName), strings.NewReader(`{"version":3}`)))
+
+	_, err := NewABICache(store, testCacheName)
+	assert.Error(t, err)
+}
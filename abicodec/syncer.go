@@ -181,7 +181,7 @@ func (s *ABISyncer) handleABIAction(blockRef bstream.BlockRef, trxID string, act
 	}
 
 	zlog.Debug("setting new abi", zap.String("account", account), zap.Stringer("transaction_id", blockRef), zap.Stringer("block", blockRef))
-	s.cache.SetABIAtBlockNum(account, uint32(blockRef.Num()), abi)
+	s.cache.SetABIAtBlockNum(account, uint32(blockRef.Num()), trxID, abi)
 
 	return nil
 }
@@ -0,0 +1,152 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apikey
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/streamingfast/dauth/authenticator"
+	"github.com/streamingfast/dmetering"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeys_SignatureRoundTrip(t *testing.T) {
+	keys := []*Key{
+		{ID: "team-a", KeySHA256: HashKey("key-a"), Quotas: Quotas{Documents: 10}},
+		{ID: "team-b", KeySHA256: HashKey("key-b"), Disabled: true},
+	}
+
+	cnt, err := encodeKeys(keys, []byte("secret"))
+	require.NoError(t, err)
+
+	decoded, err := decodeKeys(cnt, []byte("secret"))
+	require.NoError(t, err)
+	assert.Equal(t, keys, decoded)
+
+	_, err = decodeKeys(cnt, []byte("other"))
+	assert.EqualError(t, err, "keys file signature does not match")
+}
+
+func TestKeys_DuplicatedID(t *testing.T) {
+	cnt, err := encodeKeys([]*Key{
+		{ID: "team-a", KeySHA256: HashKey("key-a")},
+		{ID: "team-a", KeySHA256: HashKey("key-b")},
+	}, []byte("secret"))
+	require.NoError(t, err)
+
+	_, err = decodeKeys(cnt, []byte("secret"))
+	assert.EqualError(t, err, `key "team-a": defined more than once`)
+}
+
+func TestTracker_Quotas(t *testing.T) {
+	now := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	tracker := NewTracker(time.Hour)
+	tracker.now = func() time.Time { return now }
+
+	key := &Key{ID: "team-a", Quotas: Quotas{Documents: 10, RESTCalls: 2}}
+
+	require.NoError(t, tracker.CheckQuotas(key))
+
+	tracker.AddRESTCalls(key.ID, 2)
+	err := tracker.CheckQuotas(key)
+	require.IsType(t, &QuotaExceededError{}, err)
+	assert.Equal(t, QuotaRESTCalls, err.(*QuotaExceededError).Quota)
+
+	now = now.Add(time.Hour)
+	require.NoError(t, tracker.CheckQuotas(key))
+
+	tracker.AddDocuments(key.ID, 10)
+	err = tracker.CheckQuotas(key)
+	require.IsType(t, &QuotaExceededError{}, err)
+	assert.Equal(t, QuotaDocuments, err.(*QuotaExceededError).Quota)
+}
+
+func TestTracker_AcquireStream(t *testing.T) {
+	tracker := NewTracker(time.Hour)
+	key := &Key{ID: "team-a", Quotas: Quotas{ConcurrentStreams: 1}}
+
+	release, err := tracker.AcquireStream(key, func(error) {})
+	require.NoError(t, err)
+
+	_, err = tracker.AcquireStream(key, func(error) {})
+	assert.EqualError(t, err, `api key "team-a" reached its quota of 1 concurrent streams`)
+
+	release()
+	release()
+
+	release, err = tracker.AcquireStream(key, func(error) {})
+	require.NoError(t, err)
+	release()
+}
+
+func TestTracker_DocumentsQuotaClosesStreams(t *testing.T) {
+	tracker := NewTracker(time.Hour)
+	key := &Key{ID: "team-a", Quotas: Quotas{Documents: 10}}
+
+	var exceeded []error
+	release, err := tracker.AcquireStream(key, func(err error) { exceeded = append(exceeded, err) })
+	require.NoError(t, err)
+	defer release()
+
+	tracker.AddDocuments(key.ID, 9)
+	assert.Len(t, exceeded, 0)
+
+	tracker.AddDocuments(key.ID, 1)
+	tracker.AddDocuments(key.ID, 1)
+	require.Len(t, exceeded, 1, "streams are notified once")
+	assert.EqualError(t, exceeded[0], `api key "team-a" exhausted its quota of 10 documents for the current period`)
+
+	_, err = tracker.AcquireStream(key, func(error) {})
+	assert.EqualError(t, err, `api key "team-a" exhausted its quota of 10 documents for the current period`)
+}
+
+func TestAuthenticator_Check(t *testing.T) {
+	dir, err := ioutil.TempDir("", "apikey")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "keys.json")
+	require.NoError(t, WriteKeys(path, []*Key{
+		{ID: "team-a", KeySHA256: HashKey("key-a"), Quotas: Quotas{RESTCalls: 1}},
+		{ID: "team-b", KeySHA256: HashKey("key-b"), Disabled: true},
+	}, []byte("secret")))
+
+	tracker := NewTracker(time.Hour)
+	auth, err := newAuthenticator(path, []byte("secret"), tracker)
+	require.NoError(t, err)
+
+	_, err = auth.Check(context.Background(), "unknown", "127.0.0.1")
+	assert.EqualError(t, err, "unknown api key")
+
+	_, err = auth.Check(context.Background(), "key-b", "127.0.0.1")
+	assert.EqualError(t, err, `api key "team-b" is disabled`)
+
+	ctx, err := auth.Check(context.Background(), "key-a", "127.0.0.1")
+	require.NoError(t, err)
+
+	creds := authenticator.GetCredentials(ctx)
+	assert.Equal(t, "team-a", creds.GetUserID())
+
+	newMeteringPlugin(tracker).EmitWithContext(dmetering.Event{Kind: "REST API", RequestsCount: 1}, ctx)
+
+	_, err = auth.Check(context.Background(), "key-a", "127.0.0.1")
+	assert.EqualError(t, err, `api key "team-a" exhausted its quota of 1 rest_calls for the current period`)
+}
@@ -0,0 +1,203 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/streamingfast/dauth/authenticator"
+	"github.com/streamingfast/dmetrics"
+	"go.uber.org/zap"
+)
+
+func init() {
+	// apikey://./keys.json?secret=signing-secret&period=24h
+	authenticator.Register("apikey", func(dsn string) (authenticator.Authenticator, error) {
+		config, err := parseDSN(dsn)
+		if err != nil {
+			return nil, err
+		}
+
+		if config.secret == "" {
+			return nil, errors.New("apikey authenticator: missing 'secret' query parameter")
+		}
+
+		return newAuthenticator(config.path, []byte(config.secret), trackerFor(config.path, config.period))
+	})
+}
+
+type dsnConfig struct {
+	path   string
+	secret string
+	period time.Duration
+}
+
+func parseDSN(dsn string) (*dsnConfig, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &dsnConfig{
+		path:   u.Host + u.Path,
+		secret: u.Query().Get("secret"),
+		period: 24 * time.Hour,
+	}
+
+	if config.path == "" {
+		return nil, fmt.Errorf("invalid apikey dsn %q: missing keys file path", dsn)
+	}
+
+	if period := u.Query().Get("period"); period != "" {
+		if config.period, err = time.ParseDuration(period); err != nil || config.period <= 0 {
+			return nil, fmt.Errorf("invalid apikey dsn %q: invalid period %q", dsn, period)
+		}
+	}
+
+	dmetrics.Register(Metricset)
+	return config, nil
+}
+
+// authenticatorPlugin accepts the keys listed in a signed keys file, the file being
+// reloaded when it changes. A file that cannot be loaded anymore leaves the previously
+// loaded keys in place.
+type authenticatorPlugin struct {
+	path    string
+	secret  []byte
+	tracker *Tracker
+
+	lock    sync.RWMutex
+	keys    map[string]*Key // by key hash
+	modTime time.Time
+}
+
+func newAuthenticator(path string, secret []byte, tracker *Tracker) (*authenticatorPlugin, error) {
+	a := &authenticatorPlugin{
+		path:    path,
+		secret:  secret,
+		tracker: tracker,
+	}
+
+	if err := a.reload(); err != nil {
+		return nil, fmt.Errorf("apikey authenticator: %w", err)
+	}
+
+	return a, nil
+}
+
+func (a *authenticatorPlugin) reload() error {
+	stat, err := os.Stat(a.path)
+	if err != nil {
+		return fmt.Errorf("stat keys file: %w", err)
+	}
+
+	a.lock.RLock()
+	unchanged := a.keys != nil && stat.ModTime().Equal(a.modTime)
+	a.lock.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	keys, err := LoadKeys(a.path, a.secret)
+	if err != nil {
+		return err
+	}
+
+	byHash := make(map[string]*Key, len(keys))
+	for _, key := range keys {
+		byHash[key.KeySHA256] = key
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.keys = byHash
+	a.modTime = stat.ModTime()
+
+	zlog.Info("loaded api keys", zap.String("path", a.path), zap.Int("key_count", len(keys)))
+	return nil
+}
+
+func (a *authenticatorPlugin) GetAuthTokenRequirement() authenticator.AuthTokenRequirement {
+	return authenticator.AuthTokenRequired
+}
+
+func (a *authenticatorPlugin) Check(ctx context.Context, token, ipAddress string) (context.Context, error) {
+	if err := a.reload(); err != nil {
+		zlog.Warn("unable to reload api keys, keeping current ones", zap.String("path", a.path), zap.Error(err))
+	}
+
+	a.lock.RLock()
+	key := a.keys[HashKey(token)]
+	a.lock.RUnlock()
+
+	if key == nil {
+		return ctx, errors.New("unknown api key")
+	}
+
+	if key.Disabled {
+		RejectedCount.Inc(key.ID, "disabled")
+		return ctx, fmt.Errorf("api key %q is disabled", key.ID)
+	}
+
+	if err := a.tracker.CheckQuotas(key); err != nil {
+		return ctx, err
+	}
+
+	return authenticator.WithCredentials(ctx, &Credentials{
+		key:       key,
+		tracker:   a.tracker,
+		ipAddress: ipAddress,
+	}), nil
+}
+
+// Credentials of a request authenticated with an API key. Besides the standard credentials,
+// they expose `CheckQuota` and `AcquireStream` so the services can enforce the quotas of
+// the key on long lived connections.
+type Credentials struct {
+	key       *Key
+	tracker   *Tracker
+	ipAddress string
+}
+
+func (c *Credentials) GetUserID() string {
+	return c.key.ID
+}
+
+func (c *Credentials) GetLogFields() []zap.Field {
+	return []zap.Field{
+		zap.String("key_id", c.key.ID),
+		zap.String("ip", c.ipAddress),
+	}
+}
+
+// CheckQuota returns a `*QuotaExceededError` when the key exhausted its documents or REST
+// calls for the current period.
+func (c *Credentials) CheckQuota() error {
+	return c.tracker.CheckQuotas(c.key)
+}
+
+// AcquireStream reserves a concurrent stream of the key, `release` must be called when
+// the stream ends. The stream must be closed when `onQuotaExceeded` is called, the key
+// having exhausted its documents quota.
+func (c *Credentials) AcquireStream(onQuotaExceeded func(err error)) (release func(), err error) {
+	return c.tracker.AcquireStream(c.key, onQuotaExceeded)
+}
@@ -0,0 +1,169 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apikey
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Quotas are the limits of a key, a zero value means unlimited. Documents and REST
+// calls are counted per period, concurrent streams at any point in time.
+type Quotas struct {
+	Documents         uint64 `json:"documents,omitempty"`
+	RESTCalls         uint64 `json:"rest_calls,omitempty"`
+	ConcurrentStreams uint64 `json:"concurrent_streams,omitempty"`
+}
+
+// Key is an API key entry, only the SHA-256 of the key itself is kept in the file
+type Key struct {
+	ID        string `json:"id"`
+	KeySHA256 string `json:"key_sha256"`
+	Quotas    Quotas `json:"quotas"`
+	Disabled  bool   `json:"disabled,omitempty"`
+}
+
+// keysFile is the on-disk format, `signature` is the hex encoded HMAC-SHA256 of the
+// compacted JSON of `keys` computed with the secret shared with the authenticator.
+type keysFile struct {
+	Keys      json.RawMessage `json:"keys"`
+	Signature string          `json:"signature"`
+}
+
+// LoadKeys reads the keys file at `path`, refusing it if its signature does not match
+func LoadKeys(path string, secret []byte) ([]*Key, error) {
+	cnt, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read keys file: %w", err)
+	}
+
+	return decodeKeys(cnt, secret)
+}
+
+func decodeKeys(cnt []byte, secret []byte) ([]*Key, error) {
+	var file keysFile
+	if err := json.Unmarshal(cnt, &file); err != nil {
+		return nil, fmt.Errorf("invalid keys file: %w", err)
+	}
+
+	signature, err := hex.DecodeString(file.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keys file signature: %w", err)
+	}
+
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, file.Keys); err != nil {
+		return nil, fmt.Errorf("invalid keys: %w", err)
+	}
+
+	if !hmac.Equal(signature, sign(compacted.Bytes(), secret)) {
+		return nil, errors.New("keys file signature does not match")
+	}
+
+	var keys []*Key
+	if err := json.Unmarshal(file.Keys, &keys); err != nil {
+		return nil, fmt.Errorf("invalid keys: %w", err)
+	}
+
+	seen := map[string]bool{}
+	for _, key := range keys {
+		if key.ID == "" || key.KeySHA256 == "" {
+			return nil, fmt.Errorf("key %q: id and key_sha256 are required", key.ID)
+		}
+
+		if seen[key.ID] {
+			return nil, fmt.Errorf("key %q: defined more than once", key.ID)
+		}
+		seen[key.ID] = true
+	}
+
+	return keys, nil
+}
+
+// WriteKeys signs and writes `keys` to `path`, replacing the file atomically
+func WriteKeys(path string, keys []*Key, secret []byte) error {
+	cnt, err := encodeKeys(keys, secret)
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("create temporary keys file: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(cnt); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("write temporary keys file: %w", err)
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		return fmt.Errorf("close temporary keys file: %w", err)
+	}
+
+	if err := os.Chmod(tmpFile.Name(), 0600); err != nil {
+		return fmt.Errorf("chmod keys file: %w", err)
+	}
+
+	return os.Rename(tmpFile.Name(), path)
+}
+
+func encodeKeys(keys []*Key, secret []byte) ([]byte, error) {
+	if keys == nil {
+		keys = []*Key{}
+	}
+
+	rawKeys, err := json.Marshal(keys)
+	if err != nil {
+		return nil, fmt.Errorf("marshal keys: %w", err)
+	}
+
+	return json.MarshalIndent(&keysFile{
+		Keys:      rawKeys,
+		Signature: hex.EncodeToString(sign(rawKeys, secret)),
+	}, "", "  ")
+}
+
+func sign(payload []byte, secret []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// NewKey generates a random API key, returning it along with its hash as found in keys files
+func NewKey() (key string, keySHA256 string, err error) {
+	secret := make([]byte, 24)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", fmt.Errorf("generate key: %w", err)
+	}
+
+	key = "zsk_" + hex.EncodeToString(secret)
+	return key, HashKey(key), nil
+}
+
+func HashKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,82 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apikey
+
+import (
+	"context"
+
+	"github.com/streamingfast/dauth/authenticator"
+	"github.com/streamingfast/dmetering"
+	"go.uber.org/atomic"
+)
+
+func init() {
+	// apikey://./keys.json?period=24h, the same DSN as the authenticator can be used
+	dmetering.Register("apikey", func(dsn string) (dmetering.Metering, error) {
+		config, err := parseDSN(dsn)
+		if err != nil {
+			return nil, err
+		}
+
+		return newMeteringPlugin(trackerFor(config.path, config.period)), nil
+	})
+}
+
+// streamingKinds are the metering event kinds whose responses are streamed documents,
+// the requests of all other kinds being REST (or GraphQL query) calls.
+var streamingKinds = map[string]bool{
+	"Websocket Message":    true,
+	"GraphQL Subscription": true,
+}
+
+// meteringPlugin feeds the usage of the keys to the tracker shared with the authenticator
+type meteringPlugin struct {
+	tracker *Tracker
+	total   atomic.Uint64
+}
+
+func newMeteringPlugin(tracker *Tracker) *meteringPlugin {
+	return &meteringPlugin{tracker: tracker}
+}
+
+func (p *meteringPlugin) EmitWithContext(ev dmetering.Event, ctx context.Context) {
+	p.EmitWithCredentials(ev, authenticator.GetCredentials(ctx))
+}
+
+func (p *meteringPlugin) EmitWithCredentials(ev dmetering.Event, creds authenticator.Credentials) {
+	p.total.Inc()
+
+	if creds == nil || creds.GetUserID() == "" {
+		return
+	}
+
+	if streamingKinds[ev.Kind] {
+		if ev.ResponsesCount > 0 {
+			p.tracker.AddDocuments(creds.GetUserID(), uint64(ev.ResponsesCount))
+		}
+		return
+	}
+
+	if ev.RequestsCount > 0 {
+		p.tracker.AddRESTCalls(creds.GetUserID(), uint64(ev.RequestsCount))
+	}
+}
+
+func (p *meteringPlugin) GetStatusCounters() (total, errors uint64) {
+	return p.total.Load(), 0
+}
+
+func (p *meteringPlugin) WaitToFlush() {
+}
@@ -0,0 +1,26 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apikey
+
+import (
+	"github.com/streamingfast/dmetrics"
+)
+
+var Metricset = dmetrics.NewSet()
+
+var DocumentsCount = Metricset.NewCounterVec("apikey_documents_count", []string{"key_id"}, "Number of documents streamed per API key")
+var RESTCallsCount = Metricset.NewCounterVec("apikey_rest_calls_count", []string{"key_id"}, "Number of REST and GraphQL query calls per API key")
+var ActiveStreams = Metricset.NewGaugeVec("apikey_active_streams", []string{"key_id"}, "Number of streams currently open per API key")
+var RejectedCount = Metricset.NewCounterVec("apikey_rejected_count", []string{"key_id", "reason"}, "Number of requests rejected per API key and reason")
@@ -0,0 +1,217 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apikey
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	QuotaDocuments         = "documents"
+	QuotaRESTCalls         = "rest_calls"
+	QuotaConcurrentStreams = "concurrent_streams"
+)
+
+type QuotaExceededError struct {
+	KeyID string
+	Quota string
+	Limit uint64
+}
+
+func (e *QuotaExceededError) Error() string {
+	if e.Quota == QuotaConcurrentStreams {
+		return fmt.Sprintf("api key %q reached its quota of %d concurrent streams", e.KeyID, e.Limit)
+	}
+
+	return fmt.Sprintf("api key %q exhausted its quota of %d %s for the current period", e.KeyID, e.Limit, e.Quota)
+}
+
+// Tracker accounts the usage of each key, in memory, documents and REST calls being
+// reset at the start of each period.
+//
+// The usage is not shared between processes, each instance of a service enforcing the
+// quotas of a key on its own, so a key served by N instances can consume up to N times
+// its quotas.
+type Tracker struct {
+	period time.Duration
+	now    func() time.Time
+
+	lock   sync.Mutex
+	usages map[string]*usage
+}
+
+type usage struct {
+	periodStart time.Time
+	documents   uint64
+	restCalls   uint64
+
+	// documentsLimit is the documents quota of the key the last time it was checked,
+	// documents being added by key ID only.
+	documentsLimit uint64
+	streams        map[*trackedStream]bool
+}
+
+type trackedStream struct {
+	onQuotaExceeded func(err error)
+	notified        bool
+}
+
+func NewTracker(period time.Duration) *Tracker {
+	return &Tracker{
+		period: period,
+		now:    time.Now,
+		usages: map[string]*usage{},
+	}
+}
+
+// usage must be called with the lock held
+func (t *Tracker) usage(keyID string) *usage {
+	periodStart := t.now().Truncate(t.period)
+
+	u, found := t.usages[keyID]
+	if !found {
+		u = &usage{periodStart: periodStart, streams: map[*trackedStream]bool{}}
+		t.usages[keyID] = u
+	}
+
+	if u.periodStart.Before(periodStart) {
+		u.periodStart = periodStart
+		u.documents = 0
+		u.restCalls = 0
+	}
+
+	return u
+}
+
+// AddDocuments accounts documents sent to the key. Once its documents quota is
+// exhausted, the `onQuotaExceeded` callback of each of its streams is called so they
+// get closed.
+func (t *Tracker) AddDocuments(keyID string, count uint64) {
+	DocumentsCount.AddUint64(count, keyID)
+
+	t.lock.Lock()
+	u := t.usage(keyID)
+	u.documents += count
+
+	var exceeded []*trackedStream
+	limit := u.documentsLimit
+	if limit != 0 && u.documents >= limit {
+		for stream := range u.streams {
+			if !stream.notified {
+				stream.notified = true
+				exceeded = append(exceeded, stream)
+			}
+		}
+	}
+	t.lock.Unlock()
+
+	if len(exceeded) == 0 {
+		return
+	}
+
+	err := t.rejected(keyID, QuotaDocuments, limit)
+	for _, stream := range exceeded {
+		stream.onQuotaExceeded(err)
+	}
+}
+
+func (t *Tracker) AddRESTCalls(keyID string, count uint64) {
+	RESTCallsCount.AddUint64(count, keyID)
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.usage(keyID).restCalls += count
+}
+
+// CheckQuotas returns a `*QuotaExceededError` when the key has no documents or REST
+// calls left for the current period.
+func (t *Tracker) CheckQuotas(key *Key) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	u := t.usage(key.ID)
+	u.documentsLimit = key.Quotas.Documents
+	if key.Quotas.Documents != 0 && u.documents >= key.Quotas.Documents {
+		return t.rejected(key.ID, QuotaDocuments, key.Quotas.Documents)
+	}
+
+	if key.Quotas.RESTCalls != 0 && u.restCalls >= key.Quotas.RESTCalls {
+		return t.rejected(key.ID, QuotaRESTCalls, key.Quotas.RESTCalls)
+	}
+
+	return nil
+}
+
+// AcquireStream reserves one of the concurrent streams of the key, the returned
+// function must be called once the stream is over. It can safely be called more than once.
+//
+// The `onQuotaExceeded` callback is called, at most once, when the key exhausts its
+// documents quota while the stream is running, the stream must then be closed. It is
+// called from the metering of documents and must not block.
+func (t *Tracker) AcquireStream(key *Key, onQuotaExceeded func(err error)) (release func(), err error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	u := t.usage(key.ID)
+	u.documentsLimit = key.Quotas.Documents
+	if key.Quotas.Documents != 0 && u.documents >= key.Quotas.Documents {
+		return nil, t.rejected(key.ID, QuotaDocuments, key.Quotas.Documents)
+	}
+
+	if key.Quotas.ConcurrentStreams != 0 && uint64(len(u.streams)) >= key.Quotas.ConcurrentStreams {
+		return nil, t.rejected(key.ID, QuotaConcurrentStreams, key.Quotas.ConcurrentStreams)
+	}
+
+	stream := &trackedStream{onQuotaExceeded: onQuotaExceeded}
+	u.streams[stream] = true
+	ActiveStreams.Inc(key.ID)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			t.lock.Lock()
+			defer t.lock.Unlock()
+
+			delete(t.usage(key.ID).streams, stream)
+			ActiveStreams.Dec(key.ID)
+		})
+	}, nil
+}
+
+func (t *Tracker) rejected(keyID string, quota string, limit uint64) error {
+	RejectedCount.Inc(keyID, quota)
+	return &QuotaExceededError{KeyID: keyID, Quota: quota, Limit: limit}
+}
+
+var trackersLock sync.Mutex
+var trackers = map[string]*Tracker{}
+
+// trackerFor returns the tracker of the keys file at `path`, shared by the authenticator
+// and the metering plugins so quotas are enforced from the usage the metering reports.
+func trackerFor(path string, period time.Duration) *Tracker {
+	trackersLock.Lock()
+	defer trackersLock.Unlock()
+
+	tracker, found := trackers[path]
+	if !found {
+		tracker = NewTracker(period)
+		trackers[path] = tracker
+	}
+
+	return tracker
+}
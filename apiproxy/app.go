@@ -17,8 +17,10 @@ package apiproxy
 import (
 	"fmt"
 
-	"github.com/streamingfast/shutter"
+	"github.com/streamingfast/dauth/authenticator"
 	"github.com/streamingfast/dlauncher/launcher"
+	"github.com/streamingfast/dmetering"
+	"github.com/streamingfast/shutter"
 )
 
 type Config struct {
@@ -30,6 +32,11 @@ type Config struct {
 	NodeosHTTPAddr   string
 	RootHTTPAddr     string
 	AutocertCacheDir string
+
+	// AuthPlugin, when set, authenticates the API routes at the proxy level. The nodeos
+	// routes, that have no backing service doing it, are also metered with MeteringPlugin.
+	AuthPlugin     string
+	MeteringPlugin string
 }
 
 type App struct {
@@ -52,6 +59,21 @@ func (a *App) Run() error {
 
 	p := newProxy(a.config)
 
+	if a.config.AuthPlugin != "" {
+		auth, err := authenticator.New(a.config.AuthPlugin)
+		if err != nil {
+			return fmt.Errorf("unable to initialize dauth: %w", err)
+		}
+
+		meter, err := dmetering.New(a.config.MeteringPlugin)
+		if err != nil {
+			return fmt.Errorf("metering setup: %w", err)
+		}
+
+		p.authenticator = auth
+		p.meter = meter
+	}
+
 	a.OnTerminating(p.Shutdown)
 
 	go func() {
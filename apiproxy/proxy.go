@@ -1,11 +1,17 @@
 package apiproxy
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
+	"strings"
 
+	"github.com/streamingfast/dauth/authenticator"
+	dauthMiddleware "github.com/streamingfast/dauth/authenticator/middleware"
+	"github.com/streamingfast/derr"
+	"github.com/streamingfast/dmetering"
 	"github.com/streamingfast/shutter"
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
@@ -22,6 +28,9 @@ type proxy struct {
 	eoswsProxy    *httputil.ReverseProxy
 	nodeosProxy   *httputil.ReverseProxy
 	rootProxy     *httputil.ReverseProxy
+
+	authenticator authenticator.Authenticator
+	meter         dmetering.Metering
 }
 
 func newProxy(config *Config) *proxy {
@@ -54,14 +63,14 @@ func (p *proxy) Launch() error {
 	// "/dfuse.zswhq.v1.GraphQL" dgraphqlProxy
 	// "/grpc.reflection.v1alpha.ServerReflection" dgraphqlProxy
 
-	router.PathPrefix("/graphql").Handler(p.dgraphqlProxy)
+	router.PathPrefix("/graphql").Handler(p.authenticatedOrWebSocket(p.dgraphqlProxy))
 	router.PathPrefix("/graphiql").Handler(p.dgraphqlProxy)
-	router.PathPrefix("/v1/chain/push_transaction").Handler(p.eoswsProxy)
-	router.PathPrefix("/v1/chain/send_transaction").Handler(p.eoswsProxy)
-	router.PathPrefix("/v1/chain").Handler(p.nodeosProxy)
-	router.PathPrefix("/v1/stream").Handler(p.eoswsProxy)
-	router.PathPrefix("/v1").Handler(p.eoswsProxy)
-	router.PathPrefix("/v0").Handler(p.eoswsProxy)
+	router.PathPrefix("/v1/chain/push_transaction").Handler(p.authenticated(p.eoswsProxy))
+	router.PathPrefix("/v1/chain/send_transaction").Handler(p.authenticated(p.eoswsProxy))
+	router.PathPrefix("/v1/chain").Handler(p.authenticated(p.metered(p.nodeosProxy)))
+	router.PathPrefix("/v1/stream").Handler(p.authenticatedOrWebSocket(p.eoswsProxy))
+	router.PathPrefix("/v1").Handler(p.authenticated(p.eoswsProxy))
+	router.PathPrefix("/v0").Handler(p.authenticated(p.eoswsProxy))
 	router.PathPrefix("/").Handler(p.rootProxy)
 
 	errorLogger, err := zap.NewStdLogAt(zlog, zap.ErrorLevel)
@@ -101,6 +110,64 @@ func (p *proxy) Launch() error {
 	return p.httpServer.ListenAndServe()
 }
 
+// authenticated checks the credentials of the requests before proxying them.
+func (p *proxy) authenticated(next http.Handler) http.Handler {
+	if p.authenticator == nil {
+		return next
+	}
+
+	return dauthMiddleware.NewAuthMiddleware(p.authenticator, authErrorHandler).Handler(next)
+}
+
+// authenticatedOrWebSocket is like `authenticated` except that WebSocket upgrades are left
+// to the backing service, which authenticates them with the token received in their first
+// message. It must only be used for services doing so, eosws streams and dgraphql.
+func (p *proxy) authenticatedOrWebSocket(next http.Handler) http.Handler {
+	if p.authenticator == nil {
+		return next
+	}
+
+	authHandler := p.authenticated(next)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isWebSocketUpgrade(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		authHandler.ServeHTTP(w, r)
+	})
+}
+
+// isWebSocketUpgrade tells if `r` asks to upgrade to the WebSocket protocol, the `Connection`
+// header being a list of tokens, like `keep-alive, Upgrade` sent by browsers.
+func isWebSocketUpgrade(r *http.Request) bool {
+	if !strings.EqualFold(strings.TrimSpace(r.Header.Get("Upgrade")), "websocket") {
+		return false
+	}
+
+	for _, value := range r.Header.Values("Connection") {
+		for _, token := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(token), "upgrade") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (p *proxy) metered(next http.Handler) http.Handler {
+	if p.meter == nil {
+		return next
+	}
+
+	return dmetering.NewMeteringMiddleware(next, p.meter, "apiproxy", "REST API", true, true)
+}
+
+func authErrorHandler(w http.ResponseWriter, ctx context.Context, err error) {
+	derr.WriteError(ctx, w, "unable to authorize request", err)
+}
+
 func (p *proxy) cleanUp(err error) {
 	if p.httpServer != nil {
 		p.httpServer.Close()
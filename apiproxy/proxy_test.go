@@ -0,0 +1,34 @@
+package apiproxy
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsWebSocketUpgrade(t *testing.T) {
+	tests := []struct {
+		name       string
+		connection string
+		upgrade    string
+		expected   bool
+	}{
+		{"websocket", "Upgrade", "websocket", true},
+		{"browser websocket", "keep-alive, Upgrade", "WebSocket", true},
+		{"no upgrade header", "upgrade", "", false},
+		{"other protocol", "upgrade", "h2c", false},
+		{"no upgrade connection token", "keep-alive", "websocket", false},
+		{"plain request", "", "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/v1/stream", nil)
+			r.Header.Set("Connection", test.connection)
+			r.Header.Set("Upgrade", test.upgrade)
+
+			assert.Equal(t, test.expected, isWebSocketUpgrade(r))
+		})
+	}
+}
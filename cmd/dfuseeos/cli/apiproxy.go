@@ -26,11 +26,18 @@ func init() {
 			cmd.Flags().String("apiproxy-dgraphql-http-addr", DgraphqlHTTPServingAddr, "Target address of the dgraphql API endpoint")
 			cmd.Flags().String("apiproxy-nodeos-http-addr", NodeosAPIAddr, "Address of a queriable nodeos instance")
 			cmd.Flags().String("apiproxy-root-http-addr", EosqHTTPServingAddr, "What to serve at the root of the proxy (defaults to eosq)")
+			cmd.Flags().Bool("apiproxy-authenticate", false, "Authenticate API requests with the common auth plugin at the proxy level, metering the nodeos routes with the common metering plugin")
 			return nil
 		},
 		FactoryFunc: func(runtime *launcher.Runtime) (launcher.App, error) {
 			autocertDomains := strings.Split(viper.GetString("apiproxy-autocert-domains"), ",")
 			dfuseDataDir := runtime.AbsDataDir
+
+			var authPlugin string
+			if viper.GetBool("apiproxy-authenticate") {
+				authPlugin = viper.GetString("common-auth-plugin")
+			}
+
 			return apiproxy.New(&apiproxy.Config{
 				HTTPListenAddr:   viper.GetString("apiproxy-http-listen-addr"),
 				HTTPSListenAddr:  viper.GetString("apiproxy-https-listen-addr"),
@@ -40,6 +47,8 @@ func init() {
 				DgraphqlHTTPAddr: viper.GetString("apiproxy-dgraphql-http-addr"),
 				NodeosHTTPAddr:   viper.GetString("apiproxy-nodeos-http-addr"),
 				RootHTTPAddr:     viper.GetString("apiproxy-root-http-addr"),
+				AuthPlugin:       authPlugin,
+				MeteringPlugin:   viper.GetString("common-metering-plugin"),
 			}), nil
 		},
 	})
@@ -14,6 +14,8 @@ func init() {
 		cmd.Flags().String("common-backup-store-url", PitreosURL, "[COMMON] Store URL (with prefix) where to read or write backups.")
 		cmd.Flags().String("common-blocks-store-url", MergedBlocksStoreURL, "[COMMON] Store URL (with prefix) where to read/write. Used by: relayer, statedb, trxdb-loader, blockmeta, search-indexer, search-live, search-forkresolver, eosws, accounthist")
 		cmd.Flags().String("common-oneblock-store-url", OneBlockStoreURL, "[COMMON] Store URL (with prefix) to read/write one-block files. Used by: mindreader, merger")
+		cmd.Flags().Bool("common-blocks-compact-encoding", false, "[COMMON] Write one-block and merged blocks files using the compact encoding, where transaction traces are stored deduplicated and without the JSON data of actions other than `zswhq` ones. Blocks in both encodings are always readable. Used by: mindreader, merger")
+		cmd.Flags().String("common-blocks-abicodec-addr", ABICodecServingAddr, "[COMMON] gRPC endpoint of the abicodec service, used to rehydrate the action JSON data of blocks read in the compact encoding, reading compact blocks fails when empty or unreachable. Used by: all components reading blocks")
 		cmd.Flags().String("common-blockstream-addr", RelayerServingAddr, "[COMMON] gRPC endpoint to get real-time blocks. Used by: statedb, trxdb-loader, blockmeta, search-indexer, search-live, eosws, accounthist. (relayer uses its own --relayer-blockstream-addr)")
 
 		// Network config
@@ -23,8 +25,8 @@ func init() {
 		cmd.Flags().String("common-network-id", NetworkID, "[COMMON] Short network identifier, for billing purposes (usually maps namespaces on deployments). Used by: dgraphql")
 
 		// Authentication, metering and rate limiter plugins
-		cmd.Flags().String("common-auth-plugin", "null://", "[COMMON] Auth plugin URI, see dfuse-io/dauth repository")
-		cmd.Flags().String("common-metering-plugin", "null://", "[COMMON] Metering plugin URI, see dfuse-io/dmetering repository")
+		cmd.Flags().String("common-auth-plugin", "null://", "[COMMON] Auth plugin URI, see dfuse-io/dauth repository. Use 'apikey://<keys-file>?secret=<secret>&period=24h' for the built-in API keys with quotas, tracked in memory by each process so every instance enforces them on its own")
+		cmd.Flags().String("common-metering-plugin", "null://", "[COMMON] Metering plugin URI, see dfuse-io/dmetering repository. Use the same URI as --common-auth-plugin to enforce the quotas of the built-in API keys")
 		cmd.Flags().String("common-ratelimiter-plugin", "null://", "[COMMON] Rate Limiter plugin URI, see dfuse-io/dauth repository")
 
 		// Database connection strings
@@ -50,7 +52,7 @@ func init() {
 		cmd.Flags().String("search-common-dfuse-events-action-name", "", "[COMMON] The dfuse Events action name to intercept, format is <contract>:<action>, the `<contract>` should have dfuse Event Hooks ABI set on it for the feature to work properly, see https://github.com/dfuse-io/dfuseiohooks/releases/tag/1.0.0 for ABI")
 		cmd.Flags().Bool("search-common-dfuse-events-unrestricted", false, "[COMMON] Flag to disable all restrictions of dfuse Events specialize indexing, for example for a private deployment")
 		cmd.Flags().String("search-common-indices-store-url", IndicesStoreURL, "[COMMON] Indices path to read or write index shards Used by: search-indexer, search-archiver.")
-		cmd.Flags().String("search-common-indexed-terms", eosSearch.DefaultIndexedTerms, "[COMMON] Comma separated list of terms available for indexing. These include: receiver, account, action, auth, scheduled, status, notif, input, event, ram.consumed, ram.released, db.table, db.key, data.[freeform]. Ex: 'data.from', 'data.to', they are those fields dynamically specified by smart contracts as part of their action invocations.")
+		cmd.Flags().String("search-common-indexed-terms", eosSearch.DefaultIndexedTerms, "[COMMON] Comma separated list of terms available for indexing. These include: receiver, account, action, auth, scheduled, status, notif, input, event, ram.consumed, ram.released, db.table, db.key, data.[freeform], return.[freeform]. Ex: 'data.from', 'data.to', they are those fields dynamically specified by smart contracts as part of their action invocations, 'return.[field]' fields are read from the action's decoded return value.")
 
 		return nil
 	}
@@ -48,6 +48,7 @@ const (
 	AccountHistGRPCServingAddr  string = ":13034"
 	FirehoseGRPCServingAddr     string = ":13035"
 	TokenmetaGRPCServingAddr    string = ":14001"
+	SearchHookHTTPServingAddr   string = ":14002"
 	DashboardHTTPListenAddr     string = ":8081"
 	APIProxyHTTPListenAddr      string = ":8080"
 	MindreaderNodeosAPIAddr     string = ":9888"
@@ -23,6 +23,7 @@ import (
 	_ "github.com/streamingfast/dauth/authenticator/null"   // auth null plugin
 	_ "github.com/streamingfast/dauth/authenticator/secret" // auth secret/hard-coded plugin
 	_ "github.com/streamingfast/dauth/ratelimiter/null"     // ratelimiter plugin
+	_ "github.com/zhongshuwen/histnew/apikey"               // auth and metering api keys plugins
 
 	"github.com/spf13/cobra"
 	"github.com/streamingfast/derr"
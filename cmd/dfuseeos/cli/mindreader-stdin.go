@@ -38,11 +38,28 @@ func init() {
 			mergeArchiveStoreURL := mustReplaceDataDir(dfuseDataDir, viper.GetString("common-blocks-store-url"))
 
 			maxConsoleLengthInBytes := viper.GetInt("mindreader-max-console-length-in-bytes")
+			parsingWorkers := viper.GetInt("mindreader-parsing-workers")
+
+			var blockValidator *codec.BlockValidator
+			if spec := viper.GetString("mindreader-block-validation"); spec != "" {
+				validator, err := codec.ParseBlockValidator(spec)
+				if err != nil {
+					return nil, fmt.Errorf("invalid --mindreader-block-validation: %w", err)
+				}
+				blockValidator = validator
+			}
+
 			consoleReaderFactory := func(reader io.Reader) (mindreader.ConsolerReader, error) {
 				var options []codec.ConsoleReaderOption
 				if maxConsoleLengthInBytes > 0 {
 					options = append(options, codec.LimitConsoleLength(maxConsoleLengthInBytes))
 				}
+				if parsingWorkers > 1 {
+					options = append(options, codec.ParallelParsing(parsingWorkers))
+				}
+				if blockValidator != nil {
+					options = append(options, codec.ValidateBlocks(blockValidator))
+				}
 
 				return codec.NewConsoleReader(reader, options...)
 			}
@@ -76,6 +76,8 @@ func init() {
 			cmd.Flags().Bool("mindreader-fail-on-non-contiguous-block", false, "Enables the Continuity Checker that stops (or refuses to start) the superviser if a block was missed. It has a significant performance cost on reprocessing large segments of blocks")
 			cmd.Flags().Duration("mindreader-wait-upload-complete-on-shutdown", 30*time.Second, "When the mindreader is shutting down, it will wait up to that amount of time for the archiver to finish uploading the blocks before leaving anyway")
 			cmd.Flags().Int("mindreader-max-console-length-in-bytes", 0, "Limits maximal amount of bytes that we allow from contract's console log to make it's way to the our block, 0 means unlimited.")
+			cmd.Flags().Int("mindreader-parsing-workers", 0, "Number of goroutines decoding the heavy deep mind lines (transaction traces, accepted blocks, database operations) ahead of the block assembly, which stays sequential, lower than 2 means sequential parsing")
+			cmd.Flags().String("mindreader-block-validation", "", "Semantic checks run against each block, as a comma separated list of '<check>[=report|reject]' ('all' for every check), 'reject' failing mindreader on a violation while 'report' (the default) only logs it. Checks are 'trace-counts', 'op-action-indexes', 'ram-deltas' and 'creation-tree', empty disables validation")
 
 			return nil
 		},
@@ -103,11 +105,28 @@ func init() {
 			}
 
 			maxConsoleLengthInBytes := viper.GetInt("mindreader-max-console-length-in-bytes")
+			parsingWorkers := viper.GetInt("mindreader-parsing-workers")
+
+			var blockValidator *codec.BlockValidator
+			if spec := viper.GetString("mindreader-block-validation"); spec != "" {
+				validator, err := codec.ParseBlockValidator(spec)
+				if err != nil {
+					return nil, fmt.Errorf("invalid --mindreader-block-validation: %w", err)
+				}
+				blockValidator = validator
+			}
+
 			consoleReaderFactory := func(reader io.Reader) (mindreader.ConsolerReader, error) {
 				var options []codec.ConsoleReaderOption
 				if maxConsoleLengthInBytes > 0 {
 					options = append(options, codec.LimitConsoleLength(maxConsoleLengthInBytes))
 				}
+				if parsingWorkers > 1 {
+					options = append(options, codec.ParallelParsing(parsingWorkers))
+				}
+				if blockValidator != nil {
+					options = append(options, codec.ValidateBlocks(blockValidator))
+				}
 
 				return codec.NewConsoleReader(reader, options...)
 			}
@@ -4,11 +4,12 @@ import (
 	"fmt"
 	"time"
 
-	eosSearch "github.com/zhongshuwen/histnew/search"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/streamingfast/dlauncher/launcher"
-	archiveApp "github.com/streamingfast/search/app/archive"
+	upstreamArchiveApp "github.com/streamingfast/search/app/archive"
+	eosSearch "github.com/zhongshuwen/histnew/search"
+	archiveApp "github.com/zhongshuwen/histnew/search/app/archive"
 )
 
 func init() {
@@ -18,7 +19,7 @@ func init() {
 		Title:       "Search archive",
 		Description: "Serves historical search queries",
 		MetricsID:   "archive",
-		Logger:      launcher.NewLoggingDef("github.com/streamingfast/search/(archive|app/archive).*", nil),
+		Logger:      launcher.NewLoggingDef("github.com/(streamingfast/search/(archive|app/archive)|zhongshuwen/histnew/search/(app/archive|summary)).*", nil),
 		RegisterFlags: func(cmd *cobra.Command) error {
 			// These flags are scoped to search, since they are shared betwween search-router, search-live, search-archive, etc....
 			cmd.Flags().String("search-archive-grpc-listen-addr", ArchiveServingAddr, "Address to listen for incoming gRPC requests")
@@ -38,6 +39,8 @@ func init() {
 			cmd.Flags().Duration("search-archive-shutdown-delay", 0*time.Second, "On shutdown, time to wait before actually leaving, to try and drain connections")
 			cmd.Flags().String("search-archive-warmup-filepath", "", "Optional filename containing queries to warm-up the search")
 			cmd.Flags().String("search-archive-writable-path", "{dfuse-data-dir}/search/archiver", "Writable base path for storing index files")
+			cmd.Flags().Bool("search-archive-enable-term-summaries", false, "Skip index shards whose term summary (produced by search-indexer or 'tools search build-summaries') proves they cannot match the query, summaries must be rebuilt if indexed terms change")
+			cmd.Flags().Duration("search-archive-term-summaries-poll-interval", 1*time.Minute, "How often new term summaries are fetched from --search-common-indices-store-url")
 			return nil
 		},
 		FactoryFunc: func(runtime *launcher.Runtime) (launcher.App, error) {
@@ -51,30 +54,35 @@ func init() {
 			eosSearch.RegisterHandlers(indexedTerms)
 
 			return archiveApp.New(&archiveApp.Config{
-				BlockmetaAddr:           viper.GetString("common-blockmeta-addr"),
-				MemcacheAddr:            viper.GetString("search-archive-memcache-addr"),
-				EnableEmptyResultsCache: viper.GetBool("search-archive-enable-empty-results-cache"),
-				ServiceVersion:          viper.GetString("search-common-mesh-service-version"),
-				TierLevel:               viper.GetUint32("search-archive-tier-level"),
-				GRPCListenAddr:          viper.GetString("search-archive-grpc-listen-addr"),
-				HTTPListenAddr:          viper.GetString("search-archive-http-listen-addr"),
-				PublishInterval:         viper.GetDuration("search-common-mesh-publish-interval"),
-				EnableMovingTail:        viper.GetBool("search-archive-enable-moving-tail"),
-				ShardSize:               viper.GetUint64("search-archive-shard-size"),
-				StartBlock:              viper.GetInt64("search-archive-start-block"),
-				StopBlock:               viper.GetUint64("search-archive-stop-block"),
-				IndexPolling:            viper.GetBool("search-archive-index-polling"),
-				SyncFromStore:           viper.GetBool("search-archive-sync-from-storage"),
-				SyncMaxIndexes:          viper.GetInt("search-archive-sync-max-indexes"),
-				IndicesDLThreads:        viper.GetInt("search-archive-indices-dl-threads"),
-				NumQueryThreads:         viper.GetInt("search-archive-max-query-threads"),
-				ShutdownDelay:           viper.GetDuration("search-archive-shutdown-delay"),
-				WarmupFilepath:          viper.GetString("search-archive-warmup-filepath"),
-				IndexesStoreURL:         mustReplaceDataDir(dfuseDataDir, viper.GetString("search-common-indices-store-url")),
-				IndexesPath:             mustReplaceDataDir(dfuseDataDir, viper.GetString("search-archive-writable-path")),
+				Config: upstreamArchiveApp.Config{
+					BlockmetaAddr:           viper.GetString("common-blockmeta-addr"),
+					MemcacheAddr:            viper.GetString("search-archive-memcache-addr"),
+					EnableEmptyResultsCache: viper.GetBool("search-archive-enable-empty-results-cache"),
+					ServiceVersion:          viper.GetString("search-common-mesh-service-version"),
+					TierLevel:               viper.GetUint32("search-archive-tier-level"),
+					GRPCListenAddr:          viper.GetString("search-archive-grpc-listen-addr"),
+					HTTPListenAddr:          viper.GetString("search-archive-http-listen-addr"),
+					PublishInterval:         viper.GetDuration("search-common-mesh-publish-interval"),
+					EnableMovingTail:        viper.GetBool("search-archive-enable-moving-tail"),
+					ShardSize:               viper.GetUint64("search-archive-shard-size"),
+					StartBlock:              viper.GetInt64("search-archive-start-block"),
+					StopBlock:               viper.GetUint64("search-archive-stop-block"),
+					IndexPolling:            viper.GetBool("search-archive-index-polling"),
+					SyncFromStore:           viper.GetBool("search-archive-sync-from-storage"),
+					SyncMaxIndexes:          viper.GetInt("search-archive-sync-max-indexes"),
+					IndicesDLThreads:        viper.GetInt("search-archive-indices-dl-threads"),
+					NumQueryThreads:         viper.GetInt("search-archive-max-query-threads"),
+					ShutdownDelay:           viper.GetDuration("search-archive-shutdown-delay"),
+					WarmupFilepath:          viper.GetString("search-archive-warmup-filepath"),
+					IndexesStoreURL:         mustReplaceDataDir(dfuseDataDir, viper.GetString("search-common-indices-store-url")),
+					IndexesPath:             mustReplaceDataDir(dfuseDataDir, viper.GetString("search-archive-writable-path")),
+				},
+				QueryFactory:           eosSearch.NewBleveQueryFactory(indexedTerms),
+				EnableTermSummaries:    viper.GetBool("search-archive-enable-term-summaries"),
+				TermSummariesPollEvery: viper.GetDuration("search-archive-term-summaries-poll-interval"),
 			}, &archiveApp.Modules{
 				Dmesh: runtime.SearchDmeshClient,
-			}), nil
+			})
 		},
 	})
 }
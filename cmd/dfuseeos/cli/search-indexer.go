@@ -1,14 +1,18 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/streamingfast/bstream"
-	eosSearch "github.com/zhongshuwen/histnew/search"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/streamingfast/bstream"
 	"github.com/streamingfast/dlauncher/launcher"
+	"github.com/streamingfast/search"
 	indexerApp "github.com/streamingfast/search/app/indexer"
+	eosSearch "github.com/zhongshuwen/histnew/search"
+	"github.com/zhongshuwen/histnew/search/summary"
+	"go.uber.org/zap"
 )
 
 func init() {
@@ -31,6 +35,7 @@ func init() {
 			cmd.Flags().Bool("search-indexer-enable-index-truncation", false, "Enable index truncation, requires a relative --start-block (negative number)")
 			cmd.Flags().Uint64("search-indexer-shard-size", 200, "Number of blocks to store in a given Bleve index")
 			cmd.Flags().String("search-indexer-writable-path", "{dfuse-data-dir}/search/indexer", "Writable base path for storing index files")
+			cmd.Flags().Bool("search-indexer-enable-term-summaries", false, "Write a term summary of each completed shard next to it in --search-common-indices-store-url, used by search-archive to skip shards that cannot match")
 			return nil
 		},
 		FactoryFunc: func(runtime *launcher.Runtime) (launcher.App, error) {
@@ -47,6 +52,24 @@ func init() {
 			eosSearch.RegisterHandlers(mapper.IndexedTerms())
 
 			dfuseDataDir := runtime.AbsDataDir
+
+			var blockMapper search.BlockMapper = mapper
+			if viper.GetBool("search-indexer-enable-term-summaries") {
+				summaryStore, err := summary.NewStore(mustReplaceDataDir(dfuseDataDir, viper.GetString("search-common-indices-store-url")))
+				if err != nil {
+					return nil, fmt.Errorf("unable to create summaries store: %w", err)
+				}
+
+				shardSize := viper.GetUint64("search-indexer-shard-size")
+				blockMapper = summary.NewSummarizingMapper(mapper, shardSize, func(baseBlockNum uint64, shardSummary *summary.Summary) {
+					go func() {
+						if err := summary.Write(context.Background(), summaryStore, shardSize, baseBlockNum, shardSummary); err != nil {
+							userLog.Warn("unable to write shard term summary", zap.Uint64("base_block_num", baseBlockNum), zap.Error(err))
+						}
+					}()
+				})
+			}
+
 			blocksStoreURL := mustReplaceDataDir(dfuseDataDir, viper.GetString("common-blocks-store-url"))
 			blockstreamAddr := viper.GetString("common-blockstream-addr")
 			tracker := runtime.Tracker.Clone()
@@ -70,7 +93,7 @@ func init() {
 				BlocksStoreURL:        blocksStoreURL,
 			}, &indexerApp.Modules{
 				BlockFilter: runtime.BlockFilter.TransformInPlace,
-				BlockMapper: mapper,
+				BlockMapper: blockMapper,
 				Tracker:     tracker,
 			}), nil
 		},
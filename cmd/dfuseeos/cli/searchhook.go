@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/streamingfast/dlauncher/launcher"
+	searchhookApp "github.com/zhongshuwen/histnew/searchhook/app/searchhook"
+)
+
+func init() {
+	launcher.RegisterApp(&launcher.AppDef{
+		ID:          "searchhook",
+		Title:       "Search hooks",
+		Description: "Runs saved search queries as forward streams and POSTs their matches to webhooks",
+		MetricsID:   "searchhook",
+		Logger:      launcher.NewLoggingDef("github.com/zhongshuwen/histnew/searchhook.*", nil),
+		RegisterFlags: func(cmd *cobra.Command) error {
+			cmd.Flags().String("searchhook-http-listen-addr", SearchHookHTTPServingAddr, "Address to listen for incoming REST requests managing saved hooks")
+			cmd.Flags().String("searchhook-hooks-file", "{dfuse-data-dir}/searchhook/hooks.json", "Path to JSON file containing saved hooks definitions along with their cursor")
+			cmd.Flags().Duration("searchhook-delivery-timeout", 10*time.Second, "Timeout of a single webhook delivery attempt")
+			cmd.Flags().Int("searchhook-delivery-max-attempts", 5, "Number of attempts to deliver a match before the hook stream is restarted from its last saved cursor")
+			cmd.Flags().Duration("searchhook-retry-backoff", 1*time.Second, "Initial backoff between webhook delivery attempts and stream reconnections, doubled on each failure")
+			cmd.Flags().Duration("searchhook-max-retry-backoff", 1*time.Minute, "Maximum backoff between webhook delivery attempts and stream reconnections")
+			return nil
+		},
+		FactoryFunc: func(runtime *launcher.Runtime) (launcher.App, error) {
+			dfuseDataDir := runtime.AbsDataDir
+
+			return searchhookApp.New(&searchhookApp.Config{
+				HTTPListenAddr:      viper.GetString("searchhook-http-listen-addr"),
+				AuthPlugin:          viper.GetString("common-auth-plugin"),
+				SearchAddr:          viper.GetString("common-search-addr"),
+				KvdbDSN:             mustReplaceDataDir(dfuseDataDir, viper.GetString("common-trxdb-dsn")),
+				HooksFile:           mustReplaceDataDir(dfuseDataDir, viper.GetString("searchhook-hooks-file")),
+				DeliveryTimeout:     viper.GetDuration("searchhook-delivery-timeout"),
+				DeliveryMaxAttempts: viper.GetInt("searchhook-delivery-max-attempts"),
+				RetryBackoff:        viper.GetDuration("searchhook-retry-backoff"),
+				MaxRetryBackoff:     viper.GetDuration("searchhook-max-retry-backoff"),
+			}), nil
+		},
+	})
+}
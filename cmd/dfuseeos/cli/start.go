@@ -21,6 +21,7 @@ import (
 
 	"github.com/zhongshuwen/histnew/codec"
 	"github.com/zhongshuwen/histnew/filtering"
+	pbabicodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/abicodec/v1"
 	"github.com/streamingfast/dgrpc"
 	"github.com/streamingfast/dstore"
 	pbblockmeta "github.com/streamingfast/pbgo/dfuse/blockmeta/v1"
@@ -28,6 +29,7 @@ import (
 	"github.com/streamingfast/bstream"
 	"github.com/streamingfast/derr"
 	_ "github.com/zhongshuwen/histnew/codec"
+	_ "github.com/zhongshuwen/histnew/trxdb/flat"
 	_ "github.com/zhongshuwen/histnew/trxdb/kv"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -124,6 +126,20 @@ func Start(dataDir string, args []string) (err error) {
 		Tracker:           tracker,
 	}
 
+	if viper.GetBool("common-blocks-compact-encoding") {
+		bstream.GetBlockWriterFactory = bstream.BlockWriterFactoryFunc(codec.CompactBlockWriterFactory)
+	}
+
+	abicodecAddr := viper.GetString("common-blocks-abicodec-addr")
+	if abicodecAddr != "" {
+		conn, err := dgrpc.NewInternalClient(abicodecAddr)
+		if err != nil {
+			userLog.Warn("cannot get grpc connection to abicodec, compact blocks will not be readable", zap.Error(err), zap.String("abicodec_addr", abicodecAddr))
+		} else {
+			codec.GetActionABI = codec.NewABICodecActionABIGetter(pbabicodec.NewDecoderClient(conn))
+		}
+	}
+
 	err = bstream.ValidateRegistry()
 	if err != nil {
 		return fmt.Errorf("protocol specific hooks not configured correctly: %w", err)
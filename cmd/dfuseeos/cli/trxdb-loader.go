@@ -22,12 +22,12 @@ func init() {
 			cmd.Flags().Uint64("trxdb-loader-start-block-num", 0, "[BATCH] Block number where we start processing")
 			cmd.Flags().Uint64("trxdb-loader-stop-block-num", math.MaxUint32, "[BATCH] Block number where we stop processing")
 			cmd.Flags().Uint64("trxdb-loader-num-blocks-before-start", 300, "[BATCH] Number of blocks to fetch before start block")
-			cmd.Flags().String("trxdb-loader-http-listen-addr", KvdbHTTPServingAddr, "Listen address for /healthz endpoint")
+			cmd.Flags().String("trxdb-loader-http-listen-addr", KvdbHTTPServingAddr, "Listen address for /healthz and /v1/purge_report endpoints")
 			cmd.Flags().Int("trxdb-loader-parallel-file-download-count", 2, "Maximum number of files to download in parallel")
 			cmd.Flags().Bool("trxdb-loader-allow-live-on-empty-table", true, "[LIVE] force pipeline creation if live request and table is empty")
 			cmd.Flags().Bool("trxdb-loader-truncation-enabled", false, "Write truncation markers, and enable the automated purge of blocks past the window")
 			cmd.Flags().Uint64("trxdb-loader-truncation-purge-interval", 1000, "Interval of blocks between each purge.")
-			cmd.Flags().Uint64("trxdb-loader-truncation-window", 0, "When truncating, purge blocks older than this amount of blocks.")
+			cmd.Flags().Uint64("trxdb-loader-truncation-window", 0, "When truncating, purge blocks older than this amount of blocks. Tables can override it with the `ttl=<table>:<ttl>,...` option of the trxdb DSN, which requires truncation to be enabled")
 			return nil
 		},
 		FactoryFunc: func(runtime *launcher.Runtime) (launcher.App, error) {
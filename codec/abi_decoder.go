@@ -24,6 +24,7 @@ import (
 	"github.com/zhongshuwen/zswchain-go"
 	"github.com/zhongshuwen/zswchain-go/system"
 	"github.com/lytics/ordpool"
+	"github.com/tidwall/gjson"
 	"go.uber.org/zap"
 )
 
@@ -167,7 +168,9 @@ func (c *ABIDecoder) endBlock(block *pbcodec.Block) error {
 	return nil
 }
 
-func (c *ABIDecoder) processTransaction(trxTrace *pbcodec.TransactionTrace) error {
+// processTransaction queues the decoding of the transaction's actions and of the return
+// values listed in `returnTypes`, which maps an action index to its result type.
+func (c *ABIDecoder) processTransaction(trxTrace *pbcodec.TransactionTrace, returnTypes map[uint32]string) error {
 	zlog.Debug("processing transaction for decoding", zap.String("trx_id", trxTrace.Id))
 
 	// Optimization: The truncation and ABI addition just below could share the same
@@ -184,7 +187,7 @@ func (c *ABIDecoder) processTransaction(trxTrace *pbcodec.TransactionTrace) erro
 		}
 	}
 
-	abiOperations, err := c.extractABIOperations(trxTrace)
+	abiOperations, err := extractABIOperations(trxTrace)
 	if err != nil {
 		return fmt.Errorf("unable to extract abis: %w", err)
 	}
@@ -225,6 +228,13 @@ func (c *ABIDecoder) processTransaction(trxTrace *pbcodec.TransactionTrace) erro
 		decodingJobs = append(decodingJobs, actionDecodingJob{actionTrace.Action, c.activeBlockNum, trxTrace.Id, globalSequence, localCache})
 	}
 
+	for actionIndex, resultType := range returnTypes {
+		actionTrace := trxTrace.ActionTraces[actionIndex]
+		globalSequence := actionTraceGlobalSequence(actionTrace)
+
+		decodingJobs = append(decodingJobs, returnValueDecodingJob{actionTrace, resultType, c.activeBlockNum, trxTrace.Id, globalSequence, localCache})
+	}
+
 	for _, dtrxOp := range trxTrace.DtrxOps {
 		// A deferred transaction push in the blockchain (using CLI and `--delay-sec`) does not have any action trace,
 		// let's use the most recent active ABI global sequence in those cases.
@@ -314,7 +324,7 @@ func (c *ABIDecoder) createLocalABICache(trxID string, operations []abiOperation
 	return abiCache, nil
 }
 
-func (c *ABIDecoder) extractABIOperations(trxTrace *pbcodec.TransactionTrace) (out []abiOperation, err error) {
+func extractABIOperations(trxTrace *pbcodec.TransactionTrace) (out []abiOperation, err error) {
 	for i, actionTrace := range trxTrace.ActionTraces {
 		// If the action trace receipt is `nil`, it means the action failed, in which case, we don't care about those `setabi`
 		if actionTrace.FullName() == "zswhq:zswhq:setabi" && actionTrace.Receipt != nil {
@@ -357,14 +367,17 @@ type decodingJob interface {
 	trxID() string
 }
 
-func (j actionDecodingJob) blockNum() uint64 { return j.actualblockNum }
-func (j dtrxDecodingJob) blockNum() uint64   { return j.actionDecodingJob.actualblockNum }
+func (j actionDecodingJob) blockNum() uint64      { return j.actualblockNum }
+func (j dtrxDecodingJob) blockNum() uint64        { return j.actionDecodingJob.actualblockNum }
+func (j returnValueDecodingJob) blockNum() uint64 { return j.actualblockNum }
 
-func (j actionDecodingJob) trxID() string { return j.actualTrxID }
-func (j dtrxDecodingJob) trxID() string   { return j.actionDecodingJob.actualTrxID }
+func (j actionDecodingJob) trxID() string      { return j.actualTrxID }
+func (j dtrxDecodingJob) trxID() string        { return j.actionDecodingJob.actualTrxID }
+func (j returnValueDecodingJob) trxID() string { return j.actualTrxID }
 
-func (j actionDecodingJob) kind() string { return "action" }
-func (j dtrxDecodingJob) kind() string   { return "dtrx" }
+func (j actionDecodingJob) kind() string      { return "action" }
+func (j dtrxDecodingJob) kind() string        { return "dtrx" }
+func (j returnValueDecodingJob) kind() string { return "return_value" }
 
 type actionDecodingJob struct {
 	action         *pbcodec.Action
@@ -378,6 +391,15 @@ type dtrxDecodingJob struct {
 	actionDecodingJob
 }
 
+type returnValueDecodingJob struct {
+	actionTrace    *pbcodec.ActionTrace
+	resultType     string
+	actualblockNum uint64
+	actualTrxID    string
+	globalSequence uint64
+	localCache     *ABICache
+}
+
 func (d *ABIDecoder) addJobs(jobs []decodingJob) error {
 
 	for _, job := range jobs {
@@ -427,6 +449,8 @@ func (d *ABIDecoder) executeDecodingJob(inJob interface{}) (interface{}, error)
 		return []interface{}{job.kind()}, d.decodeAction(v.action, v.globalSequence, job.trxID(), job.blockNum(), v.localCache)
 	case dtrxDecodingJob:
 		return []interface{}{job.kind()}, d.decodeAction(v.action, v.globalSequence, job.trxID(), job.blockNum(), v.localCache)
+	case returnValueDecodingJob:
+		return []interface{}{job.kind()}, d.decodeReturnValue(v.actionTrace, v.resultType, v.globalSequence, job.trxID(), job.blockNum(), v.localCache)
 	default:
 		return nil, fmt.Errorf("unknown decoding job kind %s", job.kind())
 	}
@@ -511,6 +535,59 @@ func (d *ABIDecoder) decodeAction(action *pbcodec.Action, globalSequence uint64,
 	return nil
 }
 
+func (d *ABIDecoder) decodeReturnValue(actionTrace *pbcodec.ActionTrace, resultType string, globalSequence uint64, trxID string, blockNum uint64, localCache *ABICache) error {
+	if traceEnabled {
+		zlog.Debug("decoding return value", zap.String("receiver", actionTrace.Receiver), zap.String("result_type", resultType), zap.Uint64("global_sequence", globalSequence))
+	}
+
+	// The result type is declared by the ABI of the code that ran, which is the receiver's one
+	abi := d.findABI(actionTrace.Receiver, globalSequence, localCache)
+	if abi == nil {
+		if traceEnabled {
+			zlog.Debug("skipping return value since no ABI found for it", zap.String("receiver", actionTrace.Receiver), zap.Uint64("global_sequence", globalSequence))
+		}
+		return nil
+	}
+
+	jsonData, err := decodeABIType(abi, resultType, actionTrace.ReturnValue)
+	if err != nil {
+		// Like action data, a return value is not guaranteed to fit the ABI, so we cannot error out here
+		zlog.Debug("skipping return value since we were not able to decode it against ABI",
+			zap.Uint64("block_num", blockNum),
+			zap.String("trx_id", trxID),
+			zap.String("receiver", actionTrace.Receiver),
+			zap.String("result_type", resultType),
+			zap.Uint64("global_sequence", globalSequence),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	actionTrace.JsonReturnValue = string(jsonData)
+
+	return nil
+}
+
+const decodeABITypeStructName = "$dfuse_decode_type"
+
+// decodeABIType decodes `data` as an instance of `typeName`, which can be any type known
+// to the ABI, built-in types included. The ABI only decodes structs, so a copy of it is
+// extended with a struct holding a single field of the requested type.
+func decodeABIType(abi *zsw.ABI, typeName string, data []byte) ([]byte, error) {
+	wrapper := *abi
+	wrapper.Structs = append(wrapper.Structs[:len(wrapper.Structs):len(wrapper.Structs)], zsw.StructDef{
+		Name:   decodeABITypeStructName,
+		Fields: []zsw.FieldDef{{Name: "value", Type: typeName}},
+	})
+
+	jsonData, err := wrapper.Decode(zsw.NewDecoder(data), decodeABITypeStructName)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(gjson.GetBytes(jsonData, "value").Raw), nil
+}
+
 func (d *ABIDecoder) findABI(contract string, globalSequence uint64, localCache *ABICache) *zsw.ABI {
 	if localCache != emptyCache {
 		localCache.RLock()
@@ -426,7 +426,7 @@ func TestABIDecoder(t *testing.T) {
 				require.NoError(t, err)
 
 				for _, trxTrace := range block.UnfilteredTransactionTraces {
-					err := decoder.processTransaction(trxTrace)
+					err := decoder.processTransaction(trxTrace, nil)
 					require.NoError(t, err)
 				}
 
@@ -474,6 +474,46 @@ func TestABIDecoder(t *testing.T) {
 	}
 }
 
+func TestABIDecoder_ReturnValue(t *testing.T) {
+	testABI1 := readABI(t, "test.1.abi.json")
+
+	structValue, err := testABI1.EncodeAction(zsw.ActionName("act1"), []byte(`{"from":"test1"}`))
+	require.NoError(t, err)
+
+	nameValue, err := zsw.MarshalBinary(zsw.Name("test2"))
+	require.NoError(t, err)
+
+	withReturnValue := func(actionTrace *pbcodec.ActionTrace, value []byte) *pbcodec.ActionTrace {
+		actionTrace.ReturnValue = value
+		return actionTrace
+	}
+
+	block := testBlock(t, "00000002aa", "00000001aa",
+		trxTrace(t,
+			actionTraceSetABI(t, "test", 0, 1, testABI1),
+			withReturnValue(actionTrace(t, "test:test:act1", 1, 2, testABI1, `{"from":"test1"}`), structValue),
+			withReturnValue(actionTrace(t, "test:test:act1", 2, 3, testABI1, `{"from":"test1"}`), nameValue),
+			withReturnValue(actionTrace(t, "test:test:act1", 3, 4, testABI1, `{"from":"test1"}`), nameValue),
+			withReturnValue(actionTrace(t, "test:test:act1", 4, 5, testABI1, `{"from":"test1"}`), nameValue),
+		),
+	)
+
+	decoder := newABIDecoder()
+	require.NoError(t, decoder.startBlock(block.Num()))
+	require.NoError(t, decoder.processTransaction(block.UnfilteredTransactionTraces[0], map[uint32]string{
+		1: "value",
+		2: "name",
+		3: "unknown",
+	}))
+	require.NoError(t, decoder.endBlock(block))
+
+	actionTraces := block.UnfilteredTransactionTraces[0].ActionTraces
+	assert.JSONEq(t, `{"from":"test1"}`, actionTraces[1].JsonReturnValue)
+	assert.JSONEq(t, `"test2"`, actionTraces[2].JsonReturnValue)
+	assert.Empty(t, actionTraces[3].JsonReturnValue, "type not in ABI")
+	assert.Empty(t, actionTraces[4].JsonReturnValue, "no result type")
+}
+
 func fullMatchRegex(regex *regexp.Regexp, content string) []string {
 	match := regex.FindAllStringSubmatch(content, -1)
 	if match == nil {
@@ -0,0 +1,158 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	pbabicodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/abicodec/v1"
+	"github.com/zhongshuwen/zswchain-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type abicodecABIVersion struct {
+	startBlockNum uint64
+	endBlockNum   uint64 // 0 for the latest version
+	abi           *zsw.ABI
+}
+
+type abicodecAccountABIs struct {
+	versions []*abicodecABIVersion
+}
+
+// ABICodecActionABIGetter is an `ActionABIGetter` resolving ABIs through the abicodec
+// `ListAbiVersions` call. The versions of each account are listed once, then listed again
+// after a `setabi` of the account is seen in an expanded block. The ABI of that `setabi` is
+// used until the listing includes it, abicodec being possibly behind the expanded blocks.
+type ABICodecActionABIGetter struct {
+	client pbabicodec.DecoderClient
+
+	lock     sync.Mutex
+	accounts map[string]*abicodecAccountABIs
+	seen     map[string][]*abicodecABIVersion
+}
+
+func NewABICodecActionABIGetter(client pbabicodec.DecoderClient) *ABICodecActionABIGetter {
+	return &ABICodecActionABIGetter{
+		client:   client,
+		accounts: map[string]*abicodecAccountABIs{},
+		seen:     map[string][]*abicodecABIVersion{},
+	}
+}
+
+func (g *ABICodecActionABIGetter) ABIBefore(account string, blockNum uint64) (*zsw.ABI, error) {
+	if blockNum == 0 {
+		return nil, nil
+	}
+
+	g.lock.Lock()
+	abis := g.accounts[account]
+	g.lock.Unlock()
+
+	if abis == nil {
+		listed, err := listABIVersions(g.client, account)
+		if err != nil {
+			return nil, err
+		}
+
+		g.lock.Lock()
+		abis = g.withSeenVersions(account, listed)
+		g.accounts[account] = abis
+		g.lock.Unlock()
+	}
+
+	return abis.at(blockNum - 1), nil
+}
+
+func (g *ABICodecActionABIGetter) ABISet(account string, blockNum uint64, abi *zsw.ABI) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	delete(g.accounts, account)
+	g.seen[account] = append(g.seen[account], &abicodecABIVersion{startBlockNum: blockNum, abi: abi})
+}
+
+// withSeenVersions appends to `listed` the versions seen in expanded blocks after its latest
+// version, forgetting the seen versions it already holds. The lock must be held.
+func (g *ABICodecActionABIGetter) withSeenVersions(account string, listed *abicodecAccountABIs) *abicodecAccountABIs {
+	var latestStartBlockNum uint64
+	if len(listed.versions) > 0 {
+		latestStartBlockNum = listed.versions[len(listed.versions)-1].startBlockNum
+	}
+
+	var pending []*abicodecABIVersion
+	for _, version := range g.seen[account] {
+		if version.startBlockNum > latestStartBlockNum {
+			pending = append(pending, version)
+		}
+	}
+
+	if len(pending) == 0 {
+		delete(g.seen, account)
+	} else {
+		g.seen[account] = pending
+	}
+
+	listed.versions = append(listed.versions, pending...)
+	return listed
+}
+
+func listABIVersions(client pbabicodec.DecoderClient, account string) (*abicodecAccountABIs, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	abis := &abicodecAccountABIs{}
+	response, err := client.ListAbiVersions(ctx, &pbabicodec.ListAbiVersionsRequest{Account: account, WithAbi: true})
+	if status.Code(err) == codes.NotFound {
+		return abis, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("listing ABI versions of %s: %w", account, err)
+	}
+
+	for _, version := range response.Versions {
+		abi := new(zsw.ABI)
+		if err := json.Unmarshal([]byte(version.JsonAbi), abi); err != nil {
+			return nil, fmt.Errorf("decoding ABI of %s at block %d: %w", account, version.StartBlockNum, err)
+		}
+
+		abis.versions = append(abis.versions, &abicodecABIVersion{
+			startBlockNum: uint64(version.StartBlockNum),
+			endBlockNum:   uint64(version.EndBlockNum),
+			abi:           abi,
+		})
+	}
+
+	return abis, nil
+}
+
+// at returns the ABI in force at the end of block `blockNum`, versions being walked from the
+// latest one as seen versions are appended without closing the previous one.
+func (a *abicodecAccountABIs) at(blockNum uint64) *zsw.ABI {
+	for i := len(a.versions) - 1; i >= 0; i-- {
+		version := a.versions[i]
+		if version.startBlockNum <= blockNum && (version.endBlockNum == 0 || blockNum <= version.endBlockNum) {
+			return version.abi
+		}
+	}
+
+	return nil
+}
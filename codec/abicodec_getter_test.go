@@ -0,0 +1,107 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pbabicodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/abicodec/v1"
+	"github.com/zhongshuwen/zswchain-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testABICodecClient struct {
+	pbabicodec.DecoderClient
+
+	versions map[string][]*pbabicodec.AbiVersion
+	calls    int
+}
+
+func (c *testABICodecClient) ListAbiVersions(ctx context.Context, in *pbabicodec.ListAbiVersionsRequest, opts ...grpc.CallOption) (*pbabicodec.ListAbiVersionsResponse, error) {
+	c.calls++
+
+	versions, found := c.versions[in.Account]
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no ABI found for account: %s", in.Account)
+	}
+
+	return &pbabicodec.ListAbiVersionsResponse{Versions: versions}, nil
+}
+
+func TestABICodecActionABIGetter(t *testing.T) {
+	client := &testABICodecClient{versions: map[string][]*pbabicodec.AbiVersion{
+		"token": {
+			{StartBlockNum: 10, EndBlockNum: 19, JsonAbi: `{"version":"eosio::abi/1.0"}`},
+			{StartBlockNum: 20, JsonAbi: `{"version":"eosio::abi/1.1"}`},
+		},
+	}}
+
+	getter := NewABICodecActionABIGetter(client)
+
+	abi, err := getter.ABIBefore("token", 10)
+	require.NoError(t, err)
+	assert.Nil(t, abi, "the ABI set in block 10 is not in force at its start")
+
+	abi, err = getter.ABIBefore("token", 11)
+	require.NoError(t, err)
+	assert.Equal(t, "eosio::abi/1.0", abi.Version)
+
+	abi, err = getter.ABIBefore("token", 1000)
+	require.NoError(t, err)
+	assert.Equal(t, "eosio::abi/1.1", abi.Version)
+
+	abi, err = getter.ABIBefore("unknown", 15)
+	require.NoError(t, err)
+	assert.Nil(t, abi)
+	assert.Equal(t, 2, client.calls, "versions should be listed once per account")
+}
+
+func TestABICodecActionABIGetter_ABISet(t *testing.T) {
+	client := &testABICodecClient{versions: map[string][]*pbabicodec.AbiVersion{
+		"token": {{StartBlockNum: 10, JsonAbi: `{"version":"eosio::abi/1.0"}`}},
+	}}
+
+	getter := NewABICodecActionABIGetter(client)
+	_, err := getter.ABIBefore("token", 20)
+	require.NoError(t, err)
+
+	// abicodec has not synced the `setabi` yet, the ABI it set is used anyway
+	getter.ABISet("token", 30, &zsw.ABI{Version: "eosio::abi/1.1"})
+
+	abi, err := getter.ABIBefore("token", 30)
+	require.NoError(t, err)
+	assert.Equal(t, "eosio::abi/1.0", abi.Version)
+
+	abi, err = getter.ABIBefore("token", 31)
+	require.NoError(t, err)
+	assert.Equal(t, "eosio::abi/1.1", abi.Version)
+	assert.Equal(t, 2, client.calls, "versions should be listed again after a setabi")
+
+	client.versions["token"] = []*pbabicodec.AbiVersion{
+		{StartBlockNum: 10, EndBlockNum: 29, JsonAbi: `{"version":"eosio::abi/1.0"}`},
+		{StartBlockNum: 30, JsonAbi: `{"version":"eosio::abi/1.1"}`},
+	}
+	getter.ABISet("token", 40, &zsw.ABI{Version: "eosio::abi/1.2"})
+
+	abi, err = getter.ABIBefore("token", 35)
+	require.NoError(t, err)
+	assert.Equal(t, "eosio::abi/1.1", abi.Version)
+	assert.Len(t, getter.seen["token"], 1, "seen versions included in the listing should be forgotten")
+}
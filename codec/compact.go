@@ -0,0 +1,232 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+	"github.com/zhongshuwen/zswchain-go"
+	"go.uber.org/zap"
+)
+
+// ActionABIGetter resolves the ABIs used to rehydrate the JSON data of actions read from
+// compact blocks, which only store the raw data of actions that are not `zswhq` ones.
+type ActionABIGetter interface {
+	// ABIBefore returns the ABI of `account` in force at the start of block `blockNum`, before
+	// any of the block's actions executed, nil when the account has none.
+	ABIBefore(account string, blockNum uint64) (*zsw.ABI, error)
+
+	// ABISet is called, in execution order, for each `setabi` recorded in an expanded block.
+	ABISet(account string, blockNum uint64, abi *zsw.ABI)
+}
+
+// GetActionABI is the `ActionABIGetter` used to expand compact blocks when they are decoded.
+// When nil, decoding a compact block holding an action whose JSON data needs an ABI fails.
+var GetActionABI ActionABIGetter
+
+// compactKeptJSONAccount is the account whose actions keep their JSON data in compact blocks.
+// Its system actions (`setabi`, `setcode`, `newaccount`, ...) are what ABIs are learned from,
+// so they must be readable without an ABI.
+const compactKeptJSONAccount = "zswhq"
+
+// CompactBlock strips from the block's transaction traces every piece of data that can be
+// recomputed from the rest of the block: the action of a notification is stored once for all
+// receivers, the JSON data of an action is dropped when its raw data is present, apart from
+// `zswhq` actions, and the block references carried by each transaction and action trace are
+// removed. Use `ExpandBlock` to restore the block, action JSON data being decoded again from
+// the raw data through an ABI.
+func CompactBlock(block *pbcodec.Block) {
+	for _, trace := range block.UnfilteredTransactionTraces {
+		compactTransactionTrace(block, trace)
+	}
+
+	for _, trace := range block.FilteredTransactionTraces {
+		compactTransactionTrace(block, trace)
+	}
+}
+
+// ExpandBlock is the inverse of `CompactBlock`, it restores in place the data that was
+// stripped from the block's transaction traces.
+//
+// Like the console reader, action JSON data is decoded against the ABI in force at the action's
+// global sequence: ABIs set earlier in the block are used first, then the ABI in force at the
+// start of the block returned by `getABI`. It is left empty when the account has no ABI or when
+// the raw data does not fit the ABI, which is what the console reader produced, but an error is
+// returned when the ABI cannot be retrieved.
+func ExpandBlock(block *pbcodec.Block, getABI ActionABIGetter) error {
+	for _, traces := range [][]*pbcodec.TransactionTrace{block.UnfilteredTransactionTraces, block.FilteredTransactionTraces} {
+		blockABIs := newABICache()
+		for _, trace := range traces {
+			if err := expandTransactionTrace(block, trace, blockABIs, getABI); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func compactTransactionTrace(block *pbcodec.Block, trace *pbcodec.TransactionTrace) {
+	DeduplicateTransactionTrace(trace)
+
+	for _, actionTrace := range trace.ActionTraces {
+		if actionTrace.Action != nil && actionTrace.Action.Account != compactKeptJSONAccount && len(actionTrace.Action.RawData) > 0 {
+			actionTrace.Action.JsonData = ""
+		}
+	}
+
+	// Block references are only stripped when they match the block, so a trace that would
+	// carry different values is preserved as-is.
+	if trace.BlockNum == uint64(block.Number) {
+		trace.BlockNum = 0
+	}
+
+	if trace.ProducerBlockId == block.Id {
+		trace.ProducerBlockId = ""
+	}
+
+	if block.Header != nil && proto.Equal(trace.BlockTime, block.Header.Timestamp) {
+		trace.BlockTime = nil
+	}
+
+	if trace.FailedDtrxTrace != nil {
+		compactTransactionTrace(block, trace.FailedDtrxTrace)
+	}
+}
+
+func expandTransactionTrace(block *pbcodec.Block, trace *pbcodec.TransactionTrace, blockABIs *ABICache, getABI ActionABIGetter) (err error) {
+	if trace.BlockNum == 0 {
+		trace.BlockNum = uint64(block.Number)
+	}
+
+	if trace.ProducerBlockId == "" {
+		trace.ProducerBlockId = block.Id
+	}
+
+	if trace.BlockTime == nil && block.Header != nil {
+		trace.BlockTime = block.Header.Timestamp
+	}
+
+	if err := reduplicateTransactionTrace(trace); err != nil {
+		return err
+	}
+
+	abiOperations, err := extractABIOperations(trace)
+	if err != nil {
+		return fmt.Errorf("unable to extract abis of transaction trace %s: %w", trace.Id, err)
+	}
+
+	// Same as `ABIDecoder.processTransaction`, ABIs of a reverted transaction only apply to itself
+	localABIs := emptyCache
+	if len(abiOperations) > 0 && trace.HasBeenReverted() {
+		localABIs = newABICache()
+	}
+
+	for _, operation := range abiOperations {
+		if localABIs != emptyCache {
+			err = localABIs.addABI(operation.account, operation.globalSequence, operation.abi)
+		} else {
+			err = blockABIs.addABI(operation.account, operation.globalSequence, operation.abi)
+			if getABI != nil {
+				getABI.ABISet(operation.account, trace.BlockNum, operation.abi)
+			}
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to add ABI in action trace at index %d in transaction %s: %w", operation.actionIndex, trace.Id, err)
+		}
+	}
+
+	for _, actionTrace := range trace.ActionTraces {
+		// Notifications share the action of their creator, which is only decoded once
+		action := actionTrace.Action
+		if action == nil || action.JsonData != "" || action.Account == compactKeptJSONAccount {
+			continue
+		}
+
+		globalSequence := actionTraceGlobalSequence(actionTrace)
+		abi := localABIs.findABI(action.Account, globalSequence)
+		if abi == nil {
+			abi = blockABIs.findABI(action.Account, globalSequence)
+		}
+
+		action.JsonData, err = decodeActionJSON(action, trace.BlockNum, abi, getABI)
+		if err != nil {
+			return fmt.Errorf("unable to expand transaction trace %s: %w", trace.Id, err)
+		}
+	}
+
+	if trace.FailedDtrxTrace != nil {
+		return expandTransactionTrace(block, trace.FailedDtrxTrace, blockABIs, getABI)
+	}
+
+	return nil
+}
+
+func reduplicateTransactionTrace(trace *pbcodec.TransactionTrace) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("unable to expand transaction trace %s: %v", trace.Id, r)
+		}
+	}()
+
+	ReduplicateTransactionTrace(trace)
+	return nil
+}
+
+// decodeActionJSON decodes the raw data of `action` against `abi`, the ABI of its account set
+// earlier in the block, or else the one in force at the start of the block.
+func decodeActionJSON(action *pbcodec.Action, blockNum uint64, abi *zsw.ABI, getABI ActionABIGetter) (string, error) {
+	if len(action.RawData) == 0 {
+		return "", nil
+	}
+
+	// Same pre-built decoder as the console reader, see `ABIDecoder.decodeAction`
+	if action.Account == "zswhq.token" && action.Name == "transfer" && len(action.RawData) >= 33 {
+		jsonData, _ := decodeTransfer(action.RawData)
+		return jsonData, nil
+	}
+
+	if abi == nil {
+		if getABI == nil {
+			return "", fmt.Errorf("no ABI getter configured to decode action %s", action.SimpleName())
+		}
+
+		var err error
+		abi, err = getABI.ABIBefore(action.Account, blockNum)
+		if err != nil {
+			return "", fmt.Errorf("unable to get ABI of action %s at block %d: %w", action.SimpleName(), blockNum, err)
+		}
+	}
+
+	if abi == nil {
+		return "", nil
+	}
+
+	actionDef := abi.ActionForName(zsw.ActionName(action.Name))
+	if actionDef == nil {
+		return "", nil
+	}
+
+	jsonData, err := abi.Decode(zsw.NewDecoder(action.RawData), actionDef.Type)
+	if err != nil {
+		zlog.Debug("unable to decode action against ABI", zap.String("action", action.SimpleName()), zap.Uint64("block_num", blockNum), zap.Error(err))
+		return "", nil
+	}
+
+	return string(jsonData), nil
+}
@@ -0,0 +1,415 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zhongshuwen/histnew/codec/zswhq"
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+	"github.com/zhongshuwen/zswchain-go"
+	"github.com/zhongshuwen/zswchain-go/system"
+)
+
+func TestCompactEncoding_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name            string
+		options         []BlockWriterOption
+		expectedVersion int
+	}{
+		{"standard", nil, StandardBlockEncodingVersion},
+		{"compact", []BlockWriterOption{CompactEncoding()}, CompactBlockEncodingVersion},
+	}
+
+	setTestActionABIGetter(t)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			blocks := []*pbcodec.Block{compactTestBlock(10, 3), compactTestBlock(11, 0)}
+
+			content, err := writeTestBlocks(blocks, test.options...)
+			require.NoError(t, err)
+
+			// dbin header is the magic `dbin`, a format version byte, the content type and its version
+			assert.Equal(t, fmt.Sprintf("%02d", test.expectedVersion), string(content[8:10]))
+
+			reader, err := NewBlockReader(bytes.NewReader(content))
+			require.NoError(t, err)
+
+			for _, expected := range blocks {
+				block, err := reader.Read()
+				require.NoError(t, err)
+
+				actual := block.ToNative().(*pbcodec.Block)
+				assert.True(t, proto.Equal(expected, actual), "block #%d differs after round-trip\nexpected: %s\nactual: %s", expected.Number, expected, actual)
+			}
+
+			_, err = reader.Read()
+			assert.Equal(t, io.EOF, err)
+		})
+	}
+}
+
+func TestCompactEncoding_Conversions(t *testing.T) {
+	setTestActionABIGetter(t)
+
+	blocks := []*pbcodec.Block{compactTestBlock(10, 3)}
+	standard, err := writeTestBlocks(blocks)
+	require.NoError(t, err)
+
+	compact, err := writeTestBlocks(blocks, CompactEncoding())
+	require.NoError(t, err)
+
+	// Compact payloads are copied as-is, without being decoded
+	converted, err := convertTestBlocks(compact, CompactEncoding())
+	require.NoError(t, err)
+	assert.Equal(t, compact, converted)
+
+	converted, err = convertTestBlocks(compact)
+	require.NoError(t, err)
+	assert.Equal(t, standard, converted)
+
+	converted, err = convertTestBlocks(standard, CompactEncoding())
+	require.NoError(t, err)
+	assert.Equal(t, compact, converted)
+}
+
+func TestCompactBlock(t *testing.T) {
+	block := compactTestBlock(10, 3)
+	CompactBlock(block)
+
+	trace := block.UnfilteredTransactionTraces[0]
+	assert.Zero(t, trace.BlockNum)
+	assert.Empty(t, trace.ProducerBlockId)
+	assert.Nil(t, trace.BlockTime)
+
+	require.Len(t, trace.ActionTraces, 3)
+	assert.NotNil(t, trace.ActionTraces[0].Action)
+	assert.Empty(t, trace.ActionTraces[0].Action.JsonData)
+	assert.NotEmpty(t, trace.ActionTraces[0].Action.RawData)
+	assert.Nil(t, trace.ActionTraces[1].Action)
+	assert.Nil(t, trace.ActionTraces[2].Action)
+
+	require.NoError(t, ExpandBlock(block, &testActionABIGetter{}))
+	assert.True(t, proto.Equal(compactTestBlock(10, 3), block))
+}
+
+func TestCompactBlock_KeepsSystemActionsJSON(t *testing.T) {
+	block := compactTestBlock(10, 1)
+	action := block.UnfilteredTransactionTraces[0].ActionTraces[0].Action
+	action.Account = "zswhq"
+
+	CompactBlock(block)
+	assert.NotEmpty(t, action.JsonData)
+
+	require.NoError(t, ExpandBlock(block, nil))
+	action.Account = "token"
+	assert.True(t, proto.Equal(compactTestBlock(10, 1), block))
+}
+
+func TestExpandBlock_ABIErrors(t *testing.T) {
+	block := compactTestBlock(10, 1)
+	CompactBlock(block)
+	assert.EqualError(t, ExpandBlock(proto.Clone(block).(*pbcodec.Block), nil), "unable to expand transaction trace trx.10.0: no ABI getter configured to decode action token:transfer")
+
+	err := ExpandBlock(block, &testActionABIGetter{err: fmt.Errorf("unavailable")})
+	assert.EqualError(t, err, "unable to expand transaction trace trx.10.0: unable to get ABI of action token:transfer at block 10: unavailable")
+}
+
+func TestExpandBlock_SetABIInBlock(t *testing.T) {
+	block := compactTestBlock(10, 2)
+
+	// The second transaction sets an ABI renaming the transfer fields, its actions and the
+	// ones of later transactions are decoded against it, earlier ones against the previous ABI
+	renamedABI := strings.NewReplacer(`"from"`, `"sender"`, `"to"`, `"recipient"`).Replace(testTokenABI)
+	abiData, err := zsw.MarshalBinary(mustTestABI(renamedABI))
+	require.NoError(t, err)
+	setABIData, err := zsw.MarshalBinary(&system.SetABI{Account: "token", ABI: abiData})
+	require.NoError(t, err)
+
+	second := block.UnfilteredTransactionTraces[1]
+	second.ActionTraces = append([]*pbcodec.ActionTrace{{
+		Receiver: "zswhq",
+		Action:   &pbcodec.Action{Account: "zswhq", Name: "setabi", JsonData: `{"account":"token"}`, RawData: setABIData},
+		Receipt:  &pbcodec.ActionReceipt{Receiver: "zswhq", Digest: "setabi", GlobalSequence: second.ActionTraces[0].Receipt.GlobalSequence - 1},
+	}}, second.ActionTraces...)
+	for _, actionTrace := range second.ActionTraces[1:] {
+		actionTrace.Action.JsonData = `{"memo":"a memo long enough to weight on the block size","recipient":"bob","sender":"alice"}`
+	}
+	setABITrace := second.ActionTraces[0]
+	setABITrace.TransactionId, setABITrace.BlockNum, setABITrace.BlockTime, setABITrace.ProducerBlockId = second.Id, second.BlockNum, second.BlockTime, second.ProducerBlockId
+
+	expected := proto.Clone(block).(*pbcodec.Block)
+	getABI := &testActionABIGetter{}
+
+	CompactBlock(block)
+	require.NoError(t, ExpandBlock(block, getABI))
+	assert.True(t, proto.Equal(expected, block), "expected: %s\nactual: %s", expected, block)
+	assert.Equal(t, []string{"token@10"}, getABI.set)
+}
+
+func TestExpandBlock_TokenTransferWithoutABI(t *testing.T) {
+	block := compactTestBlock(10, 1)
+	action := block.UnfilteredTransactionTraces[0].ActionTraces[0].Action
+	action.Account = "zswhq.token"
+
+	var err error
+	action.RawData, err = zsw.MarshalBinary(&zswhqTokenTransfer{From: "alice", To: "bob", Quantity: zsw.Asset{Amount: 10000, Symbol: zsw.Symbol{Precision: 4, Symbol: "EOS"}}, Memo: "memo"})
+	require.NoError(t, err)
+
+	CompactBlock(block)
+	require.NoError(t, ExpandBlock(block, nil))
+	assert.JSONEq(t, `{"from":"alice","to":"bob","quantity":"1.0000 EOS","memo":"memo"}`, action.JsonData)
+}
+
+func TestExpandBlock_MissingAction(t *testing.T) {
+	block := compactTestBlock(10, 1)
+	CompactBlock(block)
+	block.UnfilteredTransactionTraces[0].ActionTraces[0].Action = nil
+
+	assert.EqualError(t, ExpandBlock(block, &testActionABIGetter{}), "unable to expand transaction trace trx.10.0: consistency error in deduplicate/reduplicate")
+}
+
+func BenchmarkBlockEncoding(b *testing.B) {
+	blocks := make([]*pbcodec.Block, 100)
+	for i := range blocks {
+		blocks[i] = compactTestBlock(uint32(i+1), 25)
+	}
+
+	benchmarks := []struct {
+		name    string
+		options []BlockWriterOption
+	}{
+		{"standard", nil},
+		{"compact", []BlockWriterOption{CompactEncoding()}},
+	}
+
+	setTestActionABIGetter(b)
+
+	for _, bench := range benchmarks {
+		b.Run(bench.name, func(b *testing.B) {
+			var content []byte
+			var err error
+
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				content, err = writeTestBlocks(blocks, bench.options...)
+				if err != nil {
+					b.Fatal(err)
+				}
+
+				reader, err := NewBlockReader(bytes.NewReader(content))
+				if err != nil {
+					b.Fatal(err)
+				}
+
+				for {
+					if _, err := reader.Read(); err == io.EOF {
+						break
+					} else if err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+
+			b.ReportMetric(float64(len(content))/float64(len(blocks)), "bytes/block")
+		})
+	}
+}
+
+func writeTestBlocks(blocks []*pbcodec.Block, options ...BlockWriterOption) ([]byte, error) {
+	buffer := bytes.NewBuffer(nil)
+	writer, err := NewBlockWriter(buffer, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, block := range blocks {
+		bstreamBlock, err := BlockFromProto(block)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := writer.Write(bstreamBlock); err != nil {
+			return nil, err
+		}
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func convertTestBlocks(content []byte, options ...BlockWriterOption) ([]byte, error) {
+	reader, err := NewBlockReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	writer, err := NewBlockWriter(buffer, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		block, err := reader.Read()
+		if err == io.EOF {
+			return buffer.Bytes(), nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := writer.Write(block); err != nil {
+			return nil, err
+		}
+	}
+}
+
+const testTokenABI = `{
+	"version": "eosio::abi/1.1",
+	"structs": [{"name": "transfer", "base": "", "fields": [
+		{"name": "from", "type": "name"},
+		{"name": "to", "type": "name"},
+		{"name": "memo", "type": "string"}
+	]}],
+	"actions": [{"name": "transfer", "type": "transfer"}]
+}`
+
+func mustTestABI(content string) *zsw.ABI {
+	abi := new(zsw.ABI)
+	if err := json.Unmarshal([]byte(content), abi); err != nil {
+		panic(err)
+	}
+
+	return abi
+}
+
+// testActionABIGetter returns `testTokenABI` for the `token` account and records the ABIs set
+type testActionABIGetter struct {
+	err error
+	set []string
+}
+
+func (g *testActionABIGetter) ABIBefore(account string, blockNum uint64) (*zsw.ABI, error) {
+	if g.err != nil {
+		return nil, g.err
+	}
+
+	if account != "token" {
+		return nil, nil
+	}
+
+	return mustTestABI(testTokenABI), nil
+}
+
+func (g *testActionABIGetter) ABISet(account string, blockNum uint64, abi *zsw.ABI) {
+	g.set = append(g.set, fmt.Sprintf("%s@%d", account, blockNum))
+}
+
+func setTestActionABIGetter(t testing.TB) {
+	previous := GetActionABI
+	GetActionABI = &testActionABIGetter{}
+	t.Cleanup(func() { GetActionABI = previous })
+}
+
+// testTransferRawData is the binary form of the `transfer` action of `testTokenABI` whose JSON
+// form is used by `compactTestBlock`.
+var testTransferRawData = func() []byte {
+	data, err := zsw.MarshalBinary(&struct {
+		From zsw.Name
+		To   zsw.Name
+		Memo string
+	}{"alice", "bob", "a memo long enough to weight on the block size"})
+	if err != nil {
+		panic(err)
+	}
+
+	return data
+}()
+
+// compactTestBlock returns a block where each transaction is a token transfer notifying
+// both parties, the layout that benefits the most from the compact encoding.
+func compactTestBlock(number uint32, transactionCount int) *pbcodec.Block {
+	block := &pbcodec.Block{
+		Id:      fmt.Sprintf("%08x%056x", number, number),
+		Number:  number,
+		Version: 1,
+		Header: &pbcodec.BlockHeader{
+			Previous:  fmt.Sprintf("%08x%056x", number-1, number-1),
+			Producer:  "zswhq",
+			Timestamp: &timestamp.Timestamp{Seconds: 1600000000 + int64(number)},
+		},
+	}
+
+	for i := 0; i < transactionCount; i++ {
+		digest := fmt.Sprintf("%064x", int(number)*1000+i)
+		action := &pbcodec.Action{
+			Account:       "token",
+			Name:          "transfer",
+			Authorization: []*pbcodec.PermissionLevel{{Actor: "alice", Permission: "active"}},
+			JsonData:      `{"from":"alice","memo":"a memo long enough to weight on the block size","to":"bob"}`,
+			RawData:       testTransferRawData,
+		}
+
+		var actionTraces []*pbcodec.ActionTrace
+		for ordinal, receiver := range []string{"zswhq.token", "alice", "bob"} {
+			var creatorOrdinal uint32
+			if ordinal > 0 {
+				creatorOrdinal = 1
+			}
+
+			actionTraces = append(actionTraces, &pbcodec.ActionTrace{
+				Receiver:             receiver,
+				Action:               proto.Clone(action).(*pbcodec.Action),
+				ActionOrdinal:        uint32(ordinal + 1),
+				CreatorActionOrdinal: creatorOrdinal,
+				ExecutionIndex:       uint32(ordinal),
+				Receipt: &pbcodec.ActionReceipt{
+					Receiver:       receiver,
+					Digest:         digest,
+					GlobalSequence: uint64(number)*1000 + uint64(i*3+ordinal),
+				},
+			})
+		}
+
+		block.UnfilteredTransactionTraces = append(block.UnfilteredTransactionTraces, &pbcodec.TransactionTrace{
+			Id:           fmt.Sprintf("trx.%d.%d", number, i),
+			Receipt:      &pbcodec.TransactionReceiptHeader{Status: pbcodec.TransactionStatus_TRANSACTIONSTATUS_EXECUTED},
+			ActionTraces: actionTraces,
+		})
+	}
+
+	zswhq.AttachTransactionTraces(block)
+	for _, trace := range block.UnfilteredTransactionTraces {
+		for _, actionTrace := range trace.ActionTraces {
+			actionTrace.TransactionId = trace.Id
+			actionTrace.BlockNum = trace.BlockNum
+			actionTrace.BlockTime = trace.BlockTime
+			actionTrace.ProducerBlockId = trace.ProducerBlockId
+		}
+	}
+
+	return block
+}
@@ -24,17 +24,18 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/lytics/ordpool"
+	"github.com/tidwall/gjson"
 	"github.com/zhongshuwen/histnew/codec/zswhq"
 	zswhq_v2_0 "github.com/zhongshuwen/histnew/codec/zswhq/v2.0"
 	zswhq_v2_1 "github.com/zhongshuwen/histnew/codec/zswhq/v2.1"
 	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
 	"github.com/zhongshuwen/zswchain-go"
-	"github.com/tidwall/gjson"
 	"go.uber.org/zap"
 )
 
-var supportedVersions = []uint64{12, 13}
-var supportedVersionStrings = []string{"12", "13"}
+var supportedVersions = []uint64{12, 13, 14}
+var supportedVersionStrings = []string{"12", "13", "14"}
 
 type ConsoleReaderOption interface {
 	apply(reader *ConsoleReader)
@@ -59,6 +60,26 @@ func LimitConsoleLength(maxByteCount int) ConsoleReaderOption {
 	})
 }
 
+// ValidateBlocks runs the validator against each block once accepted, see `BlockValidator`
+// for how violations are handled.
+func ValidateBlocks(validator *BlockValidator) ConsoleReaderOption {
+	return consoleReaderOptionFunc(func(reader *ConsoleReader) {
+		reader.ctx.validator = validator
+	})
+}
+
+// ParallelParsing decodes the heavy deep mind lines (transaction traces, accepted blocks,
+// database and key/value operations) on `workerCount` goroutines ahead of the assembly of
+// the blocks. Only the decoding of those lines is parallel, the assembly and finalization
+// of the blocks (creation trees, dedupe, ABI tracking) stay sequential on the reading
+// goroutine, in the order the lines were read. A `workerCount` lower than 2 keeps the
+// sequential parsing.
+func ParallelParsing(workerCount int) ConsoleReaderOption {
+	return consoleReaderOptionFunc(func(reader *ConsoleReader) {
+		reader.parsingWorkerCount = workerCount
+	})
+}
+
 // ConsoleReader is what reads the `nodeos` output directly. It builds
 // up some LogEntry objects. See `LogReader to read those entries .
 type ConsoleReader struct {
@@ -68,6 +89,10 @@ type ConsoleReader struct {
 	readBuffer chan string
 	done       chan interface{}
 
+	// decodedLines is set when parsing in parallel, receiving `*deepMindLine` in read order
+	parsingWorkerCount int
+	decodedLines       <-chan interface{}
+
 	ctx *parseCtx
 }
 
@@ -80,13 +105,8 @@ func NewConsoleReader(reader io.Reader, opts ...ConsoleReaderOption) (*ConsoleRe
 	l := &ConsoleReader{
 		src:   reader,
 		close: func() {},
-		ctx: &parseCtx{
-			hydrator:   zswhq_v2_0.NewHydrator(zlog),
-			abiDecoder: newABIDecoder(),
-			block:      &pbcodec.Block{},
-			trx:        &pbcodec.TransactionTrace{},
-		},
-		done: make(chan interface{}),
+		ctx:   newParseCtx(),
+		done:  make(chan interface{}),
 	}
 
 	for _, opt := range opts {
@@ -94,6 +114,10 @@ func NewConsoleReader(reader io.Reader, opts ...ConsoleReaderOption) (*ConsoleRe
 	}
 
 	l.setupScanner()
+	if l.parsingWorkerCount > 1 {
+		l.setupDecodingPool()
+	}
+
 	return l, nil
 }
 
@@ -133,6 +157,89 @@ func (l *ConsoleReader) setupScanner() {
 	}()
 }
 
+// setupDecodingPool feeds the scanned lines to an ordered pool decoding them in parallel.
+// The hydrator a line is decoded with depends on the deep mind version, so the version
+// lines are peeked at here, the assembly reading them again to validate them.
+func (l *ConsoleReader) setupDecodingPool() {
+	pool := ordpool.New(l.parsingWorkerCount, func(in interface{}) (interface{}, error) {
+		job := in.(decodingLineJob)
+		return decodeLine(job.line, job.majorVersion, job.hydrator), nil
+	})
+	pool.Start()
+	l.decodedLines = pool.GetOutputCh()
+
+	go func() {
+		poolIn := pool.GetInputCh()
+		majorVersion, hydrator := l.ctx.majorVersion, l.ctx.hydrator
+
+		for line := range l.readBuffer {
+			line = line[6:]
+			if strings.HasPrefix(line, "DEEP_MIND_VERSION") {
+				if version, _, versionHydrator, err := l.ctx.readDeepmindVersion(line); err == nil {
+					majorVersion, hydrator = version, versionHydrator
+				}
+			}
+
+			poolIn <- decodingLineJob{line: line, majorVersion: majorVersion, hydrator: hydrator}
+		}
+
+		close(poolIn)
+	}()
+}
+
+type decodingLineJob struct {
+	line         string
+	majorVersion uint64
+	hydrator     zswhq.Hydrator
+}
+
+// deepMindLine is a deep mind line, without its `DMLOG ` prefix. When `decoded` is set,
+// `value` (or `err`) holds the line's content already decoded by `decodeLine`.
+type deepMindLine struct {
+	content string
+	decoded bool
+	value   interface{}
+	err     error
+}
+
+func decodeLine(line string, majorVersion uint64, hydrator zswhq.Hydrator) *deepMindLine {
+	out := &deepMindLine{content: line}
+
+	switch {
+	case strings.HasPrefix(line, "DB_OP"):
+		out.value, out.err = parseDBOp(line)
+	case strings.HasPrefix(line, "APPLIED_TRANSACTION"):
+		out.value, out.err = parseAppliedTransaction(hydrator, line)
+	case strings.HasPrefix(line, "KV_OP"):
+		out.value, out.err = parseKVOp(majorVersion, line)
+	case strings.HasPrefix(line, "ACCEPTED_BLOCK"):
+		out.value, out.err = parseAcceptedBlock(hydrator, line)
+	default:
+		return out
+	}
+
+	out.decoded = true
+	return out
+}
+
+func (l *ConsoleReader) nextLine() (*deepMindLine, bool) {
+	if l.decodedLines != nil {
+		line, ok := <-l.decodedLines
+		if !ok {
+			return nil, false
+		}
+
+		return line.(*deepMindLine), true
+	}
+
+	line, ok := <-l.readBuffer
+	if !ok {
+		return nil, false
+	}
+
+	return &deepMindLine{content: line[6:]}, true
+}
+
 func (l *ConsoleReader) Done() <-chan interface{} {
 	return l.done
 }
@@ -150,129 +257,207 @@ type parseCtx struct {
 	block          *pbcodec.Block
 	activeBlockNum int64
 
-	trx         *pbcodec.TransactionTrace
-	creationOps []*creationOp
+	trx           *pbcodec.TransactionTrace
+	creationOps   []*creationOp
+	actionReturns []*actionReturn
 
 	conversionOptions []zswhq.ConversionOption
+	validator         *BlockValidator
+}
+
+func newParseCtx() *parseCtx {
+	return &parseCtx{
+		hydrator:   zswhq_v2_0.NewHydrator(zlog),
+		abiDecoder: newABIDecoder(),
+		block:      &pbcodec.Block{},
+		trx:        &pbcodec.TransactionTrace{},
+	}
 }
 
 func (l *ConsoleReader) Read() (out interface{}, err error) {
-	ctx := l.ctx
+	for {
+		line, ok := l.nextLine()
+		if !ok {
+			break
+		}
 
-	for line := range l.readBuffer {
-		line = line[6:]
+		block, err := l.ctx.readLine(line)
+		if err != nil {
+			return nil, formatLineError(line.content, err)
+		}
 
-		if traceEnabled {
-			zlog.Debug("extracing deep mind data from line", zap.String("line", line))
+		if block != nil {
+			return block, nil
 		}
+	}
 
-		// Order of conditions is based (approximately) on those that will appear more often
-		switch {
-		case strings.HasPrefix(line, "RAM_OP"):
-			err = ctx.readRAMOp(line)
+	if l.scanner.Err() == nil {
+		return nil, io.EOF
+	}
 
-		case strings.HasPrefix(line, "CREATION_OP"):
-			err = ctx.readCreationOp(line)
+	return nil, l.scanner.Err()
+}
 
-		case strings.HasPrefix(line, "DB_OP"):
-			err = ctx.readDBOp(line)
+// readLine processes a single deep mind line, returning the block once it is complete
+func (ctx *parseCtx) readLine(dmLine *deepMindLine) (block *pbcodec.Block, err error) {
+	if dmLine.decoded {
+		return ctx.readDecodedLine(dmLine)
+	}
 
-		case strings.HasPrefix(line, "RLIMIT_OP"):
-			err = ctx.readRlimitOp(line)
+	line := dmLine.content
+	if traceEnabled {
+		zlog.Debug("extracing deep mind data from line", zap.String("line", line))
+	}
 
-		case strings.HasPrefix(line, "TRX_OP"):
-			err = ctx.readTrxOp(line)
+	// Order of conditions is based (approximately) on those that will appear more often
+	switch {
+	case strings.HasPrefix(line, "RAM_OP"):
+		err = ctx.readRAMOp(line)
 
-		case strings.HasPrefix(line, "APPLIED_TRANSACTION"):
-			err = ctx.readAppliedTransaction(line)
+	case strings.HasPrefix(line, "CREATION_OP"):
+		err = ctx.readCreationOp(line)
 
-		case strings.HasPrefix(line, "TBL_OP"):
-			err = ctx.readTableOp(line)
+	case strings.HasPrefix(line, "DB_OP"):
+		err = ctx.readDBOp(line)
 
-		case strings.HasPrefix(line, "PERM_OP"):
-			err = ctx.readPermOp(line)
+	case strings.HasPrefix(line, "RLIMIT_OP"):
+		err = ctx.readRlimitOp(line)
 
-		case strings.HasPrefix(line, "KV_OP"):
-			err = ctx.readKVOp(line)
+	case strings.HasPrefix(line, "TRX_OP"):
+		err = ctx.readTrxOp(line)
 
-		case strings.HasPrefix(line, "DTRX_OP CREATE"):
-			err = ctx.readCreateOrCancelDTrxOp("CREATE", line)
+	case strings.HasPrefix(line, "APPLIED_TRANSACTION"):
+		err = ctx.readAppliedTransaction(line)
 
-		case strings.HasPrefix(line, "DTRX_OP MODIFY_CREATE"):
-			err = ctx.readCreateOrCancelDTrxOp("MODIFY_CREATE", line)
+	case strings.HasPrefix(line, "TBL_OP"):
+		err = ctx.readTableOp(line)
 
-		case strings.HasPrefix(line, "DTRX_OP MODIFY_CANCEL"):
-			err = ctx.readCreateOrCancelDTrxOp("MODIFY_CANCEL", line)
+	case strings.HasPrefix(line, "PERM_OP"):
+		err = ctx.readPermOp(line)
 
-		case strings.HasPrefix(line, "RAM_CORRECTION_OP"):
-			err = ctx.readRAMCorrectionOp(line)
+	case strings.HasPrefix(line, "KV_OP"):
+		err = ctx.readKVOp(line)
 
-		case strings.HasPrefix(line, "DTRX_OP PUSH_CREATE"):
-			err = ctx.readCreateOrCancelDTrxOp("PUSH_CREATE", line)
+	case strings.HasPrefix(line, "ACTION_RETURN"):
+		err = ctx.readActionReturn(line)
 
-		case strings.HasPrefix(line, "DTRX_OP CANCEL"):
-			err = ctx.readCreateOrCancelDTrxOp("CANCEL", line)
+	case strings.HasPrefix(line, "DTRX_OP CREATE"):
+		err = ctx.readCreateOrCancelDTrxOp("CREATE", line)
 
-		case strings.HasPrefix(line, "DTRX_OP FAILED"):
-			err = ctx.readFailedDTrxOp(line)
+	case strings.HasPrefix(line, "DTRX_OP MODIFY_CREATE"):
+		err = ctx.readCreateOrCancelDTrxOp("MODIFY_CREATE", line)
 
-		case strings.HasPrefix(line, "ACCEPTED_BLOCK"):
-			block, err := ctx.readAcceptedBlock(line)
-			if err != nil {
-				return nil, l.formatError(line, err)
-			}
+	case strings.HasPrefix(line, "DTRX_OP MODIFY_CANCEL"):
+		err = ctx.readCreateOrCancelDTrxOp("MODIFY_CANCEL", line)
 
-			return block, nil
+	case strings.HasPrefix(line, "RAM_CORRECTION_OP"):
+		err = ctx.readRAMCorrectionOp(line)
 
-		case strings.HasPrefix(line, "START_BLOCK"):
-			err = ctx.readStartBlock(line)
+	case strings.HasPrefix(line, "DTRX_OP PUSH_CREATE"):
+		err = ctx.readCreateOrCancelDTrxOp("PUSH_CREATE", line)
 
-		case strings.HasPrefix(line, "FEATURE_OP ACTIVATE"):
-			err = ctx.readFeatureOpActivate(line)
+	case strings.HasPrefix(line, "DTRX_OP CANCEL"):
+		err = ctx.readCreateOrCancelDTrxOp("CANCEL", line)
 
-		case strings.HasPrefix(line, "FEATURE_OP PRE_ACTIVATE"):
-			err = ctx.readFeatureOpPreActivate(line)
+	case strings.HasPrefix(line, "DTRX_OP FAILED"):
+		err = ctx.readFailedDTrxOp(line)
 
-		case strings.HasPrefix(line, "SWITCH_FORK"):
-			zlog.Info("fork signal, restarting state accumulation from beginning")
-			ctx.resetBlock()
+	case strings.HasPrefix(line, "ACCEPTED_BLOCK"):
+		block, err = ctx.readAcceptedBlock(line)
 
-		case strings.HasPrefix(line, "ABIDUMP START"):
-			err = ctx.readABIStart(line)
-		case strings.HasPrefix(line, "ABIDUMP ABI"):
-			err = ctx.readABIDump(line)
-		case strings.HasPrefix(line, "ABIDUMP END"):
-			//noop
+	case strings.HasPrefix(line, "START_BLOCK"):
+		err = ctx.readStartBlock(line)
 
-		case strings.HasPrefix(line, "DEEP_MIND_VERSION"):
-			ctx.majorVersion, ctx.minorVersion, ctx.hydrator, err = ctx.readDeepmindVersion(line)
+	case strings.HasPrefix(line, "FEATURE_OP ACTIVATE"):
+		err = ctx.readFeatureOpActivate(line)
 
-		default:
-			zlog.Info("unknown log line", zap.String("line", line))
-		}
+	case strings.HasPrefix(line, "FEATURE_OP PRE_ACTIVATE"):
+		err = ctx.readFeatureOpPreActivate(line)
 
-		if err != nil {
-			return nil, l.formatError(line, err)
-		}
+	case strings.HasPrefix(line, "SWITCH_FORK"):
+		zlog.Info("fork signal, restarting state accumulation from beginning")
+		ctx.resetBlock()
+
+	case strings.HasPrefix(line, "ABIDUMP START"):
+		err = ctx.readABIStart(line)
+	case strings.HasPrefix(line, "ABIDUMP ABI"):
+		err = ctx.readABIDump(line)
+	case strings.HasPrefix(line, "ABIDUMP END"):
+		//noop
+
+	case strings.HasPrefix(line, "DEEP_MIND_VERSION"):
+		ctx.majorVersion, ctx.minorVersion, ctx.hydrator, err = ctx.readDeepmindVersion(line)
+
+	default:
+		zlog.Info("unknown log line", zap.String("line", line))
 	}
 
-	if l.scanner.Err() == nil {
-		return nil, io.EOF
+	return block, err
+}
+
+func (ctx *parseCtx) readDecodedLine(line *deepMindLine) (*pbcodec.Block, error) {
+	if line.err != nil {
+		return nil, line.err
 	}
 
-	return nil, l.scanner.Err()
+	switch value := line.value.(type) {
+	case *pbcodec.DBOp:
+		ctx.recordDBOp(value)
+	case *appliedTransaction:
+		return nil, ctx.recordAppliedTransaction(value)
+	case *pbcodec.KVOp:
+		ctx.recordKVOp(value)
+	case *acceptedBlock:
+		return ctx.finalizeBlock(value)
+	default:
+		return nil, fmt.Errorf("unknown decoded line value %T", value)
+	}
+
+	return nil, nil
 }
 
-func (l *ConsoleReader) formatError(line string, err error) error {
+func formatLineError(line string, err error) error {
 	chunks := strings.SplitN(line, " ", 2)
 	return fmt.Errorf("%s: %s (line %q)", chunks[0], err, line)
 }
 
+// LineReader reads deep mind lines one at a time, in the same way as a `ConsoleReader`
+// reading them sequentially. It's meant for tools needing to know which line a block
+// or an error comes from, a `ConsoleReader` is more efficient otherwise.
+type LineReader struct {
+	ctx *parseCtx
+}
+
+func NewLineReader() *LineReader {
+	return &LineReader{ctx: newParseCtx()}
+}
+
+// ReadLine processes the next line of the deep mind output, returning the block it
+// completes, if any. Lines not prefixed by `DMLOG ` are ignored.
+func (r *LineReader) ReadLine(line string) (*pbcodec.Block, error) {
+	if !strings.HasPrefix(line, "DMLOG ") {
+		return nil, nil
+	}
+
+	dmLine := &deepMindLine{content: line[6:]}
+	block, err := r.ctx.readLine(dmLine)
+	if err != nil {
+		return nil, formatLineError(dmLine.content, err)
+	}
+
+	return block, nil
+}
+
 type creationOp struct {
 	kind        string // ROOT, NOTIFY, CFA_INLINE, INLINE
 	actionIndex int
 }
 
+type actionReturn struct {
+	actionIndex uint32
+	resultType  string
+}
+
 func (ctx *parseCtx) resetBlock() {
 	// The nodeos bootstrap phase at chain initialization happens before the first block is ever
 	// produced. As such, those operations needs to be attached to initial block. Hence, let's
@@ -287,12 +472,17 @@ func (ctx *parseCtx) resetBlock() {
 func (ctx *parseCtx) resetTrx() {
 	ctx.trx = &pbcodec.TransactionTrace{}
 	ctx.creationOps = nil
+	ctx.actionReturns = nil
 }
 
 func (ctx *parseCtx) recordCreationOp(operation *creationOp) {
 	ctx.creationOps = append(ctx.creationOps, operation)
 }
 
+func (ctx *parseCtx) recordActionReturn(actionReturn *actionReturn) {
+	ctx.actionReturns = append(ctx.actionReturns, actionReturn)
+}
+
 func (ctx *parseCtx) recordDBOp(operation *pbcodec.DBOp) {
 	ctx.trx.DbOps = append(ctx.trx.DbOps, operation)
 }
@@ -365,7 +555,7 @@ func (ctx *parseCtx) recordTransaction(trace *pbcodec.TransactionTrace) error {
 		// transferred RAM op, so it's all good to attach it directly.
 		ctx.block.UnfilteredTransactionTraces = append(ctx.block.UnfilteredTransactionTraces, failedTrace)
 
-		if err := ctx.abiDecoder.processTransaction(failedTrace); err != nil {
+		if err := ctx.abiDecoder.processTransaction(failedTrace, nil); err != nil {
 			return fmt.Errorf("abi decoding failed trace: %w", err)
 		}
 
@@ -396,9 +586,14 @@ func (ctx *parseCtx) recordTransaction(trace *pbcodec.TransactionTrace) error {
 	trace.RlimitOps = ctx.trx.RlimitOps
 	trace.TableOps = ctx.trx.TableOps
 
+	returnTypes, err := ctx.actionReturnTypes(trace)
+	if err != nil {
+		return fmt.Errorf("action return types: %w", err)
+	}
+
 	ctx.block.UnfilteredTransactionTraces = append(ctx.block.UnfilteredTransactionTraces, trace)
 
-	if err := ctx.abiDecoder.processTransaction(trace); err != nil {
+	if err := ctx.abiDecoder.processTransaction(trace, returnTypes); err != nil {
 		return fmt.Errorf("abi decoding trace: %w", err)
 	}
 
@@ -406,6 +601,24 @@ func (ctx *parseCtx) recordTransaction(trace *pbcodec.TransactionTrace) error {
 	return nil
 }
 
+// actionReturnTypes returns the result type of the actions that reported one, keyed by
+// action index, so their return value (set by the hydrator) can be decoded.
+func (ctx *parseCtx) actionReturnTypes(trace *pbcodec.TransactionTrace) (returnTypes map[uint32]string, err error) {
+	for _, actionReturn := range ctx.actionReturns {
+		if int(actionReturn.actionIndex) >= len(trace.ActionTraces) {
+			return nil, fmt.Errorf("action return references action index %d but transaction %s has %d action traces", actionReturn.actionIndex, trace.Id, len(trace.ActionTraces))
+		}
+
+		if returnTypes == nil {
+			returnTypes = map[uint32]string{}
+		}
+
+		returnTypes[actionReturn.actionIndex] = actionReturn.resultType
+	}
+
+	return returnTypes, nil
+}
+
 func (ctx *parseCtx) revertOpsDueToFailedTransaction() {
 	// We must keep the deferred removal, as this RAM changed is **not** reverted by nodeos, unlike all other ops
 	// as well as the RLimitOps, which happens at a location that does not revert.
@@ -464,6 +677,22 @@ func (ctx *parseCtx) readStartBlock(line string) error {
 // Line format:
 //   ACCEPTED_BLOCK ${block_num} ${block_state_hex}
 func (ctx *parseCtx) readAcceptedBlock(line string) (*pbcodec.Block, error) {
+	accepted, err := parseAcceptedBlock(ctx.hydrator, line)
+	if err != nil {
+		return nil, err
+	}
+
+	return ctx.finalizeBlock(accepted)
+}
+
+// acceptedBlock is an `ACCEPTED_BLOCK` line, its block being hydrated with the header and
+// transactions of the block state, but not yet with the traces and ops of the block.
+type acceptedBlock struct {
+	blockNum int64
+	block    *pbcodec.Block
+}
+
+func parseAcceptedBlock(hydrator zswhq.Hydrator, line string) (*acceptedBlock, error) {
 	chunks := strings.SplitN(line, " ", 3)
 	if len(chunks) != 3 {
 		return nil, fmt.Errorf("expected 3 fields, got %d", len(chunks))
@@ -474,60 +703,94 @@ func (ctx *parseCtx) readAcceptedBlock(line string) (*pbcodec.Block, error) {
 		return nil, fmt.Errorf("block_num not a valid string, got: %q", chunks[1])
 	}
 
-	if ctx.activeBlockNum != blockNum {
-		return nil, fmt.Errorf("block_num %d doesn't match the active block num (%d)", blockNum, ctx.activeBlockNum)
-	}
-
 	blockStateHex, err := hex.DecodeString(chunks[2])
 	if err != nil {
 		return nil, fmt.Errorf("unable to decode block %d state hex: %w", blockNum, err)
 	}
 
-	if err := ctx.hydrator.HydrateBlock(ctx.block, blockStateHex); err != nil {
+	block := &pbcodec.Block{}
+	if err := hydrator.HydrateBlock(block, blockStateHex); err != nil {
 		return nil, fmt.Errorf("hydrate block %d: %w", blockNum, err)
 	}
 
-	block := ctx.block
+	return &acceptedBlock{blockNum: blockNum, block: block}, nil
+}
+
+func (ctx *parseCtx) finalizeBlock(accepted *acceptedBlock) (*pbcodec.Block, error) {
+	if ctx.activeBlockNum != accepted.blockNum {
+		return nil, fmt.Errorf("block_num %d doesn't match the active block num (%d)", accepted.blockNum, ctx.activeBlockNum)
+	}
+
+	block := accepted.block
+	block.RlimitOps = ctx.block.RlimitOps
+	block.UnfilteredImplicitTransactionOps = ctx.block.UnfilteredImplicitTransactionOps
+	block.UnfilteredTransactionTraces = ctx.block.UnfilteredTransactionTraces
+	zswhq.AttachTransactionTraces(block)
 
 	zlog.Debug("blocking until abi decoder has decoded every transaction pushed to it")
-	err = ctx.abiDecoder.endBlock(ctx.block)
+	err := ctx.abiDecoder.endBlock(block)
 	if err != nil {
 		return nil, fmt.Errorf("abi decoding post-process failed: %w", err)
 	}
 
 	zlog.Debug("abi decoder terminated all decoding operations, resetting block")
 	ctx.resetBlock()
+
+	if ctx.validator != nil {
+		if err := ctx.validator.Validate(block); err != nil {
+			return nil, err
+		}
+	}
+
 	return block, nil
 }
 
 // Line format:
 //   APPLIED_TRANSACTION ${block_num} ${trace_hex}
 func (ctx *parseCtx) readAppliedTransaction(line string) error {
+	applied, err := parseAppliedTransaction(ctx.hydrator, line)
+	if err != nil {
+		return err
+	}
+
+	return ctx.recordAppliedTransaction(applied)
+}
+
+type appliedTransaction struct {
+	blockNum int64
+	trace    *pbcodec.TransactionTrace
+}
+
+func parseAppliedTransaction(hydrator zswhq.Hydrator, line string) (*appliedTransaction, error) {
 	chunks := strings.SplitN(line, " ", 3)
 	if len(chunks) != 3 {
-		return fmt.Errorf("expected 3 fields, got %d", len(chunks))
+		return nil, fmt.Errorf("expected 3 fields, got %d", len(chunks))
 	}
 
 	blockNum, err := strconv.ParseInt(chunks[1], 10, 64)
 	if err != nil {
-		return fmt.Errorf("block_num not a valid number, got: %q", chunks[1])
-	}
-
-	if ctx.activeBlockNum != blockNum {
-		return fmt.Errorf("saw transactions from block %d while active block is %d", blockNum, ctx.activeBlockNum)
+		return nil, fmt.Errorf("block_num not a valid number, got: %q", chunks[1])
 	}
 
 	trxTraceHex, err := hex.DecodeString(chunks[2])
 	if err != nil {
-		return fmt.Errorf("unable to decode transaction trace hex at block num %d: %w", blockNum, err)
+		return nil, fmt.Errorf("unable to decode transaction trace hex at block num %d: %w", blockNum, err)
 	}
 
-	trxTrace, err := ctx.hydrator.DecodeTransactionTrace(trxTraceHex)
+	trxTrace, err := hydrator.DecodeTransactionTrace(trxTraceHex)
 	if err != nil {
-		return fmt.Errorf("decode transaction trace %d: %w", blockNum, err)
+		return nil, fmt.Errorf("decode transaction trace %d: %w", blockNum, err)
 	}
 
-	return ctx.recordTransaction(trxTrace)
+	return &appliedTransaction{blockNum: blockNum, trace: trxTrace}, nil
+}
+
+func (ctx *parseCtx) recordAppliedTransaction(applied *appliedTransaction) error {
+	if ctx.activeBlockNum != applied.blockNum {
+		return fmt.Errorf("saw transactions from block %d while active block is %d", applied.blockNum, ctx.activeBlockNum)
+	}
+
+	return ctx.recordTransaction(applied.trace)
 }
 
 // Line formats:
@@ -568,14 +831,24 @@ func (ctx *parseCtx) readCreationOp(line string) error {
 //   DB_OP UPD ${action_id} ${opayer}:${npayer} ${table_code} ${scope} ${table_name} ${primkey} ${odata}:${ndata}
 //   DB_OP REM ${action_id} ${payer} ${table_code} ${scope} ${table_name} ${primkey} ${odata}
 func (ctx *parseCtx) readDBOp(line string) error {
+	op, err := parseDBOp(line)
+	if err != nil {
+		return err
+	}
+
+	ctx.recordDBOp(op)
+	return nil
+}
+
+func parseDBOp(line string) (*pbcodec.DBOp, error) {
 	chunks := strings.SplitN(line, " ", 9)
 	if len(chunks) != 9 {
-		return fmt.Errorf("expected 9 fields, got %d", len(chunks))
+		return nil, fmt.Errorf("expected 9 fields, got %d", len(chunks))
 	}
 
 	actionIndex, err := strconv.Atoi(chunks[2])
 	if err != nil {
-		return fmt.Errorf("action_index is not a valid number, got: %q", chunks[2])
+		return nil, fmt.Errorf("action_index is not a valid number, got: %q", chunks[2])
 	}
 
 	opString := chunks[1]
@@ -593,7 +866,7 @@ func (ctx *parseCtx) readDBOp(line string) error {
 
 		dataChunks := strings.SplitN(chunks[8], ":", 2)
 		if len(dataChunks) != 2 {
-			return fmt.Errorf("should have old and new data in field 8, found only one")
+			return nil, fmt.Errorf("should have old and new data in field 8, found only one")
 		}
 
 		oldData = dataChunks[0]
@@ -601,7 +874,7 @@ func (ctx *parseCtx) readDBOp(line string) error {
 
 		payerChunks := strings.SplitN(chunks[3], ":", 2)
 		if len(payerChunks) != 2 {
-			return fmt.Errorf("should have two payers in field 3, separated by a ':', found only one")
+			return nil, fmt.Errorf("should have two payers in field 3, separated by a ':', found only one")
 		}
 
 		oldPayer = payerChunks[0]
@@ -611,25 +884,25 @@ func (ctx *parseCtx) readDBOp(line string) error {
 		oldData = chunks[8]
 		oldPayer = chunks[3]
 	default:
-		return fmt.Errorf("unknown operation: %q", opString)
+		return nil, fmt.Errorf("unknown operation: %q", opString)
 	}
 
 	var oldBytes, newBytes []byte
 	if len(oldData) != 0 {
 		oldBytes, err = hex.DecodeString(oldData)
 		if err != nil {
-			return fmt.Errorf("couldn't decode old_data: %s", err)
+			return nil, fmt.Errorf("couldn't decode old_data: %s", err)
 		}
 	}
 
 	if len(newData) != 0 {
 		newBytes, err = hex.DecodeString(newData)
 		if err != nil {
-			return fmt.Errorf("couldn't decode new_data: %s", err)
+			return nil, fmt.Errorf("couldn't decode new_data: %s", err)
 		}
 	}
 
-	ctx.recordDBOp(&pbcodec.DBOp{
+	return &pbcodec.DBOp{
 		Operation:   op,
 		ActionIndex: uint32(actionIndex),
 		OldPayer:    oldPayer,
@@ -640,9 +913,7 @@ func (ctx *parseCtx) readDBOp(line string) error {
 		PrimaryKey:  chunks[7],
 		OldData:     oldBytes,
 		NewData:     newBytes,
-	})
-
-	return nil
+	}, nil
 }
 
 // Line formats:
@@ -652,14 +923,24 @@ func (ctx *parseCtx) readDBOp(line string) error {
 //
 // **Note** Added in deep mind log version 13
 func (ctx *parseCtx) readKVOp(line string) error {
+	op, err := parseKVOp(ctx.majorVersion, line)
+	if err != nil {
+		return err
+	}
+
+	ctx.recordKVOp(op)
+	return nil
+}
+
+func parseKVOp(majorVersion uint64, line string) (*pbcodec.KVOp, error) {
 	chunks := strings.SplitN(line, " ", 7)
 	if len(chunks) != 7 {
-		return fmt.Errorf("expected 7 fields, got %d", len(chunks))
+		return nil, fmt.Errorf("expected 7 fields, got %d", len(chunks))
 	}
 
 	actionIndex, err := strconv.Atoi(chunks[2])
 	if err != nil {
-		return fmt.Errorf("action_index is not a valid number, got: %q", chunks[2])
+		return nil, fmt.Errorf("action_index is not a valid number, got: %q", chunks[2])
 	}
 
 	opString := chunks[1]
@@ -677,11 +958,11 @@ func (ctx *parseCtx) readKVOp(line string) error {
 
 		payerChunks := strings.SplitN(chunks[4], ":", 2)
 		if len(payerChunks) != 2 {
-			if ctx.majorVersion == 13 {
-				return fmt.Errorf("upgrade to EOSIO >= 2.1.1 as the 2.1.0 version did not had old payer value in it")
+			if majorVersion == 13 {
+				return nil, fmt.Errorf("upgrade to EOSIO >= 2.1.1 as the 2.1.0 version did not had old payer value in it")
 			}
 
-			return fmt.Errorf("should have old and new payer in field 4, found only one")
+			return nil, fmt.Errorf("should have old and new payer in field 4, found only one")
 		}
 
 		oldPayer = payerChunks[0]
@@ -689,7 +970,7 @@ func (ctx *parseCtx) readKVOp(line string) error {
 
 		dataChunks := strings.SplitN(chunks[6], ":", 2)
 		if len(dataChunks) != 2 {
-			return fmt.Errorf("should have old and new data in field 6, found only one")
+			return nil, fmt.Errorf("should have old and new data in field 6, found only one")
 		}
 
 		oldData = dataChunks[0]
@@ -699,30 +980,30 @@ func (ctx *parseCtx) readKVOp(line string) error {
 		oldData = chunks[6]
 		oldPayer = chunks[4]
 	default:
-		return fmt.Errorf("unknown operation: %q", opString)
+		return nil, fmt.Errorf("unknown operation: %q", opString)
 	}
 
 	key, err := hex.DecodeString(chunks[5])
 	if err != nil {
-		return fmt.Errorf("couldn't decode key: %w", err)
+		return nil, fmt.Errorf("couldn't decode key: %w", err)
 	}
 
 	var oldBytes, newBytes []byte
 	if len(oldData) != 0 {
 		oldBytes, err = hex.DecodeString(oldData)
 		if err != nil {
-			return fmt.Errorf("couldn't decode old_data: %w", err)
+			return nil, fmt.Errorf("couldn't decode old_data: %w", err)
 		}
 	}
 
 	if len(newData) != 0 {
 		newBytes, err = hex.DecodeString(newData)
 		if err != nil {
-			return fmt.Errorf("couldn't decode new_data: %w", err)
+			return nil, fmt.Errorf("couldn't decode new_data: %w", err)
 		}
 	}
 
-	ctx.recordKVOp(&pbcodec.KVOp{
+	return &pbcodec.KVOp{
 		Operation:   op,
 		ActionIndex: uint32(actionIndex),
 		OldPayer:    oldPayer,
@@ -731,11 +1012,52 @@ func (ctx *parseCtx) readKVOp(line string) error {
 		Key:         key,
 		OldData:     oldBytes,
 		NewData:     newBytes,
-	})
+	}, nil
+}
 
+// Line format:
+//   ACTION_RETURN ${action_id} ${result_type} ${return_value}
+//
+// The `${result_type}` is the type the receiver's ABI declares as the result of the action
+// (in its `action_results` section), `-` when none is declared in which case the return value
+// cannot be decoded. The `${return_value}` is the one of the action trace, already set by the
+// hydrator, so only the result type is kept.
+//
+// **Note** Added in deep mind log version 14
+func (ctx *parseCtx) readActionReturn(line string) error {
+	actionReturn, err := parseActionReturn(line)
+	if err != nil {
+		return err
+	}
+
+	if actionReturn.resultType != "" {
+		ctx.recordActionReturn(actionReturn)
+	}
 	return nil
 }
 
+func parseActionReturn(line string) (*actionReturn, error) {
+	chunks := strings.Split(line, " ")
+	if len(chunks) != 4 {
+		return nil, fmt.Errorf("expected 4 fields, got %d", len(chunks))
+	}
+
+	actionIndex, err := strconv.ParseUint(chunks[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("action_index is not a valid number, got: %q", chunks[1])
+	}
+
+	resultType := chunks[2]
+	if resultType == "-" {
+		resultType = ""
+	}
+
+	return &actionReturn{
+		actionIndex: uint32(actionIndex),
+		resultType:  resultType,
+	}, nil
+}
+
 // Line formats:
 //   DTRX_OP MODIFY_CANCEL ${action_id} ${sender} ${sender_id} ${payer} ${published} ${delay} ${expiration} ${trx_id} ${trx}
 //   DTRX_OP MODIFY_CREATE ${action_id} ${sender} ${sender_id} ${payer} ${published} ${delay} ${expiration} ${trx_id} ${trx}
@@ -1039,7 +1361,7 @@ func normalizeRAMOpAction(input string) string {
 //  Version 12
 //    DEEP_MIND_VERSION ${major_version}
 //
-//  Version 13
+//  Version 13 & 14
 //    DEEP_MIND_VERSION ${major_version} ${minor_version}
 func (ctx *parseCtx) readDeepmindVersion(line string) (majorVersion uint64, minorVersion uint64, hydrator zswhq.Hydrator, err error) {
 	chunks, err := splitNToM(line, 2, 3)
@@ -1064,7 +1386,8 @@ func (ctx *parseCtx) readDeepmindVersion(line string) (majorVersion uint64, mino
 	}
 
 	zlog.Info("read deep mind version", zap.Uint64("major_version", majorVersion))
-	if majorVersion == 13 {
+	// Version 14 adds the `ACTION_RETURN` line but keeps the 2.1.x binary formats
+	if majorVersion == 13 || majorVersion == 14 {
 		return majorVersion, minorVersion, zswhq_v2_1.NewHydrator(zlog), nil
 	}
 
@@ -31,7 +31,6 @@ import (
 	_ "net/http/pprof"
 
 	"github.com/andreyvit/diff"
-	zswhq_v2_0 "github.com/zhongshuwen/histnew/codec/zswhq/v2.0"
 	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
 	"github.com/golang/protobuf/proto"
 	"github.com/streamingfast/jsonpb"
@@ -138,6 +137,96 @@ func TestParseFromFile(t *testing.T) {
 	}
 }
 
+func TestParseFromFile_ParallelParsing(t *testing.T) {
+	for _, deepMindFile := range []string{"testdata/deep-mind.dmlog", "testdata/deep-mind-2.1.x.dmlog"} {
+		t.Run(filepath.Base(deepMindFile), func(t *testing.T) {
+			if !fileExists(deepMindFile) {
+				t.Skipf("deep mind file %q not present", deepMindFile)
+			}
+
+			expected := readAllBlocksJSON(t, testFileConsoleReader(t, deepMindFile))
+			actual := readAllBlocksJSON(t, testFileConsoleReader(t, deepMindFile, ParallelParsing(4)))
+
+			if !assert.Equal(t, expected, actual) {
+				t.Error("parallel parsing diff:\n" + unifiedDiff(t, []byte(expected), []byte(actual)))
+			}
+		})
+	}
+}
+
+func readAllBlocksJSON(t *testing.T, cr *ConsoleReader) string {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	for {
+		out, err := cr.Read()
+		if err == io.EOF {
+			return buf.String()
+		}
+		require.NoError(t, err)
+
+		buf.WriteString(protoJSONMarshalIndent(t, out.(*pbcodec.Block)))
+		buf.WriteString("\n")
+	}
+}
+
+func Test_decodeLine(t *testing.T) {
+	line := `KV_OP INS 0 battlefield john b6876876616c7565 78c159f95d672d640539`
+
+	decoded := decodeLine(line, 0, nil)
+	require.True(t, decoded.decoded)
+	require.NoError(t, decoded.err)
+
+	ctx := newParseCtx()
+	_, err := ctx.readLine(decoded)
+	require.NoError(t, err)
+
+	sequentialCtx := newParseCtx()
+	require.NoError(t, sequentialCtx.readKVOp(line))
+
+	assert.Equal(t, protoJSONMarshalIndent(t, sequentialCtx.trx.KvOps[0]), protoJSONMarshalIndent(t, ctx.trx.KvOps[0]))
+
+	decoded = decodeLine(`KV_OP UPD 1 battlefield jane b6876876616c7565 78c159f95d672d640539:78c159f95d672d640561`, 13, nil)
+	require.True(t, decoded.decoded)
+	_, err = newParseCtx().readLine(decoded)
+	assert.Equal(t, errors.New("upgrade to EOSIO >= 2.1.1 as the 2.1.0 version did not had old payer value in it"), err)
+
+	decoded = decodeLine(`RAM_OP 0 eosio.bios create account:eosio eosio 0 2952`, 0, nil)
+	assert.False(t, decoded.decoded)
+}
+
+func BenchmarkConsoleReader(b *testing.B) {
+	for _, deepMindFile := range []string{"testdata/deep-mind.dmlog", "testdata/deep-mind-2.1.x.dmlog"} {
+		if !fileExists(deepMindFile) {
+			b.Logf("deep mind file %q not present, skipping", deepMindFile)
+			continue
+		}
+
+		content, err := ioutil.ReadFile(deepMindFile)
+		require.NoError(b, err)
+
+		for _, workerCount := range []int{0, 2, 4, 8} {
+			b.Run(fmt.Sprintf("%s/workers-%d", filepath.Base(deepMindFile), workerCount), func(b *testing.B) {
+				b.SetBytes(int64(len(content)))
+				b.ReportAllocs()
+
+				for i := 0; i < b.N; i++ {
+					cr, err := NewConsoleReader(bytes.NewReader(content), ParallelParsing(workerCount))
+					require.NoError(b, err)
+
+					for {
+						_, err := cr.Read()
+						if err == io.EOF {
+							break
+						}
+						require.NoError(b, err)
+					}
+				}
+			})
+		}
+	}
+}
+
 func unifiedDiff(t *testing.T, cnt1, cnt2 []byte) string {
 	file1 := "/tmp/gotests-linediff-1"
 	file2 := "/tmp/gotests-linediff-2"
@@ -491,6 +580,26 @@ func Test_readKvOp(t *testing.T) {
 			nil,
 			errors.New("upgrade to EOSIO >= 2.1.1 as the 2.1.0 version did not had old payer value in it"),
 		},
+		{
+			"update payer change on deep_mind version 14",
+			`KV_OP UPD 1 battlefield john:jane b6876876616c7565 78c159f95d672d640539:78c159f95d672d640539`,
+			func() *parseCtx {
+				ctx := newParseCtx()
+				ctx.majorVersion = 14
+				return ctx
+			},
+			&pbcodec.KVOp{
+				Operation:   pbcodec.KVOp_OPERATION_UPDATE,
+				ActionIndex: 1,
+				Code:        "battlefield",
+				OldPayer:    "john",
+				NewPayer:    "jane",
+				Key:         toBytes("b6876876616c7565"),
+				OldData:     toBytes("78c159f95d672d640539"),
+				NewData:     toBytes("78c159f95d672d640539"),
+			},
+			nil,
+		},
 		{
 			"remove standard",
 			`KV_OP REM 2 battlefield jane b6876876616c7565 78c159f95d672d640561`,
@@ -528,6 +637,72 @@ func Test_readKvOp(t *testing.T) {
 	}
 }
 
+func Test_readActionReturn(t *testing.T) {
+	tests := []struct {
+		name        string
+		line        string
+		expected    *actionReturn
+		expectedErr error
+	}{
+		{
+			"standard",
+			`ACTION_RETURN 1 uint64 2a00000000000000`,
+			&actionReturn{actionIndex: 1, resultType: "uint64"},
+			nil,
+		},
+		{
+			"invalid action index",
+			`ACTION_RETURN a uint64 2a`,
+			nil,
+			errors.New(`action_index is not a valid number, got: "a"`),
+		},
+		{
+			"missing field",
+			`ACTION_RETURN 0 uint64`,
+			nil,
+			errors.New("expected 4 fields, got 3"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := newParseCtx()
+			err := ctx.readActionReturn(test.line)
+
+			require.Equal(t, test.expectedErr, err)
+
+			if test.expectedErr == nil {
+				require.Len(t, ctx.actionReturns, 1)
+				assert.Equal(t, test.expected, ctx.actionReturns[0])
+			}
+		})
+	}
+}
+
+func Test_readActionReturn_NoResultType(t *testing.T) {
+	ctx := newParseCtx()
+	require.NoError(t, ctx.readActionReturn(`ACTION_RETURN 0 - 2a`))
+	assert.Empty(t, ctx.actionReturns)
+}
+
+func Test_actionReturnTypes(t *testing.T) {
+	ctx := newParseCtx()
+	require.NoError(t, ctx.readActionReturn(`ACTION_RETURN 1 uint64 2a00000000000000`))
+	require.NoError(t, ctx.readActionReturn(`ACTION_RETURN 0 - 2a`))
+
+	trace := &pbcodec.TransactionTrace{Id: "trx.1", ActionTraces: []*pbcodec.ActionTrace{{}, {ReturnValue: []byte{0x2a, 0, 0, 0, 0, 0, 0, 0}}}}
+	returnTypes, err := ctx.actionReturnTypes(trace)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[uint32]string{1: "uint64"}, returnTypes)
+	assert.Empty(t, trace.ActionTraces[0].ReturnValue)
+	assert.Equal(t, []byte{0x2a, 0, 0, 0, 0, 0, 0, 0}, trace.ActionTraces[1].ReturnValue)
+
+	require.NoError(t, ctx.readActionReturn(`ACTION_RETURN 2 uint64 2a`))
+	_, err = ctx.actionReturnTypes(trace)
+	assert.EqualError(t, err, "action return references action index 2 but transaction trx.1 has 2 action traces")
+}
+
 func Test_readPermOp(t *testing.T) {
 	auth := &pbcodec.Authority{
 		Threshold: 1,
@@ -749,10 +924,16 @@ func Test_readDeepMindVersion(t *testing.T) {
 			nil,
 		},
 		{
-			"version 13, unsupported",
+			"version 14",
 			`DEEP_MIND_VERSION 14 0`,
 			14, 0,
-			errors.New("deep mind reported version 14, but this reader supports only 12, 13"),
+			nil,
+		},
+		{
+			"version 15, unsupported",
+			`DEEP_MIND_VERSION 15 0`,
+			15, 0,
+			errors.New("deep mind reported version 15, but this reader supports only 12, 13, 14"),
 		},
 	}
 
@@ -768,6 +949,21 @@ func Test_readDeepMindVersion(t *testing.T) {
 	}
 }
 
+func TestLineReader(t *testing.T) {
+	reader := NewLineReader()
+
+	block, err := reader.ReadLine(`info  2020-01-01T00:00:00.000 nodeos    main.cpp:123  main ] nodeos started`)
+	require.NoError(t, err)
+	assert.Nil(t, block)
+
+	block, err = reader.ReadLine(`DMLOG DEEP_MIND_VERSION 13 0`)
+	require.NoError(t, err)
+	assert.Nil(t, block)
+
+	_, err = reader.ReadLine(`DMLOG DEEP_MIND_VERSION 15 0`)
+	assert.Equal(t, errors.New(`DEEP_MIND_VERSION: deep mind reported version 15, but this reader supports only 12, 13, 14 (line "DEEP_MIND_VERSION 15 0")`), err)
+}
+
 func Test_readABIDump_ABI(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -849,12 +1045,3 @@ func blockWithConsole(block *pbcodec.Block) bool {
 
 	return false
 }
-
-func newParseCtx() *parseCtx {
-	return &parseCtx{
-		hydrator:   zswhq_v2_0.NewHydrator(zlog),
-		abiDecoder: newABIDecoder(),
-		block:      &pbcodec.Block{},
-		trx:        &pbcodec.TransactionTrace{},
-	}
-}
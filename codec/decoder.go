@@ -29,8 +29,8 @@ func BlockDecoder(blk *bstream.Block) (interface{}, error) {
 		return nil, fmt.Errorf("expected kind %s, got %s", pbbstream.Protocol_EOS, blk.Kind())
 	}
 
-	if blk.Version() != 1 {
-		return nil, fmt.Errorf("this decoder only knows about bstream.Block version 1, got %d", blk.Version())
+	if blk.Version() != StandardBlockEncodingVersion && blk.Version() != CompactBlockEncodingVersion {
+		return nil, fmt.Errorf("this decoder only knows about bstream.Block version %d and %d, got %d", StandardBlockEncodingVersion, CompactBlockEncodingVersion, blk.Version())
 	}
 
 	block := new(pbcodec.Block)
@@ -39,6 +39,14 @@ func BlockDecoder(blk *bstream.Block) (interface{}, error) {
 		return nil, fmt.Errorf("unable to decode payload: %s", err)
 	}
 
+	// Expanding is idempotent, as the payload of a compact block re-encoded from its memoized
+	// native block by `bstream.Block.ToProto` is expanded while still labelled compact
+	if blk.Version() == CompactBlockEncodingVersion {
+		if err := ExpandBlock(block, GetActionABI); err != nil {
+			return nil, fmt.Errorf("unable to expand compact block: %w", err)
+		}
+	}
+
 	// This whole BlockDecoder method is being called through the `bstream.Block.ToNative()`
 	// method. Hence, it's a great place to add temporary data normalization calls to backport
 	// some features that were not in all blocks yet (because we did not re-process all blocks
@@ -30,7 +30,8 @@ func BlockReaderFactory(reader io.Reader) (bstream.BlockReader, error) {
 
 // BlockReader reads the dbin format where each element is assumed to be a `bstream.Block`.
 type BlockReader struct {
-	src *dbin.Reader
+	src     *dbin.Reader
+	version int32
 }
 
 func NewBlockReader(reader io.Reader) (out *BlockReader, err error) {
@@ -41,12 +42,13 @@ func NewBlockReader(reader io.Reader) (out *BlockReader, err error) {
 	}
 
 	protocol := pbbstream.Protocol(pbbstream.Protocol_value[contentType])
-	if protocol != pbbstream.Protocol_EOS && version != 1 {
-		return nil, fmt.Errorf("reader only knows about %s block kind at version 1, got %s at version %d", protocol, contentType, version)
+	if protocol != pbbstream.Protocol_EOS || (version != StandardBlockEncodingVersion && version != CompactBlockEncodingVersion) {
+		return nil, fmt.Errorf("reader only knows about %s block kind at version %d or %d, got %s at version %d", pbbstream.Protocol_EOS, StandardBlockEncodingVersion, CompactBlockEncodingVersion, contentType, version)
 	}
 
 	return &BlockReader{
-		src: dbinReader,
+		src:     dbinReader,
+		version: version,
 	}, nil
 }
 
@@ -59,6 +61,11 @@ func (l *BlockReader) Read() (*bstream.Block, error) {
 			return nil, fmt.Errorf("unable to read block proto: %w", err)
 		}
 
+		// Compact payloads are passed along as-is and expanded when decoded, see `BlockDecoder`
+		if l.version == CompactBlockEncodingVersion {
+			pbBlock.PayloadVersion = CompactBlockEncodingVersion
+		}
+
 		blk, err := bstream.BlockFromProto(pbBlock)
 		if err != nil {
 			return nil, err
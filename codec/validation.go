@@ -0,0 +1,363 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+	"go.uber.org/zap"
+)
+
+// BlockCheck is a semantic invariant of the blocks assembled from deep mind output, a
+// violation of it pointing to an instrumentation bug in nodeos (or in this package).
+type BlockCheck string
+
+const (
+	// CheckTraceCounts verifies the transaction and action counts of the block match its
+	// transaction traces, and that each trace index is its position in the block.
+	CheckTraceCounts BlockCheck = "trace-counts"
+
+	// CheckOpActionIndexes verifies the database, key/value, RAM, table, permission, deferred
+	// transaction and feature operations of a transaction reference one of its action traces.
+	CheckOpActionIndexes BlockCheck = "op-action-indexes"
+
+	// CheckRAMDeltas verifies the RAM deltas reported by each action trace match the sum of the
+	// RAM operations of the action, per account. Only executed transactions are verified, and the
+	// deferred transactions RAM operations, not attributed to actions by nodeos, are ignored.
+	CheckRAMDeltas BlockCheck = "ram-deltas"
+
+	// CheckCreationTree verifies the creation tree of a transaction holds each of its action
+	// traces exactly once, and that the creator of each action matches its creator action ordinal.
+	CheckCreationTree BlockCheck = "creation-tree"
+)
+
+var AllBlockChecks = []BlockCheck{CheckTraceCounts, CheckOpActionIndexes, CheckRAMDeltas, CheckCreationTree}
+
+var blockChecks = map[BlockCheck]func(block *pbcodec.Block, report func(trxID string, format string, args ...interface{})){
+	CheckTraceCounts:     checkTraceCounts,
+	CheckOpActionIndexes: checkOpActionIndexes,
+	CheckRAMDeltas:       checkRAMDeltas,
+	CheckCreationTree:    checkCreationTree,
+}
+
+// ViolationPolicy defines what happens when a block violates a check
+type ViolationPolicy string
+
+const (
+	// ViolationReport logs the violation, the block is still produced
+	ViolationReport ViolationPolicy = "report"
+
+	// ViolationReject fails the read of the block
+	ViolationReject ViolationPolicy = "reject"
+)
+
+type BlockViolation struct {
+	Check         BlockCheck
+	BlockNum      uint64
+	TransactionID string
+	Message       string
+}
+
+func (v *BlockViolation) String() string {
+	if v.TransactionID == "" {
+		return fmt.Sprintf("%s: block #%d: %s", v.Check, v.BlockNum, v.Message)
+	}
+
+	return fmt.Sprintf("%s: block #%d: transaction %s: %s", v.Check, v.BlockNum, v.TransactionID, v.Message)
+}
+
+// CheckBlock runs the checks against the block, returning their violations
+func CheckBlock(block *pbcodec.Block, checks ...BlockCheck) (out []*BlockViolation) {
+	for _, check := range checks {
+		blockChecks[check](block, func(trxID string, format string, args ...interface{}) {
+			out = append(out, &BlockViolation{
+				Check:         check,
+				BlockNum:      block.Num(),
+				TransactionID: trxID,
+				Message:       fmt.Sprintf(format, args...),
+			})
+		})
+	}
+
+	return out
+}
+
+// BlockValidator runs a set of checks against the blocks, applying the policy of each
+// check to its violations.
+type BlockValidator struct {
+	policies map[BlockCheck]ViolationPolicy
+	checks   []BlockCheck
+}
+
+func NewBlockValidator(policies map[BlockCheck]ViolationPolicy) *BlockValidator {
+	validator := &BlockValidator{policies: policies}
+	for check := range policies {
+		validator.checks = append(validator.checks, check)
+	}
+
+	sort.Slice(validator.checks, func(i, j int) bool { return validator.checks[i] < validator.checks[j] })
+	return validator
+}
+
+// ParseBlockValidator parses a comma separated list of `<check>[=<policy>]`, the policy being
+// `report` (the default) or `reject`. The check `all` applies the policy to every check, more
+// specific entries coming after it overriding it, i.e. `all,ram-deltas=reject`.
+func ParseBlockValidator(spec string) (*BlockValidator, error) {
+	policies := map[BlockCheck]ViolationPolicy{}
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.SplitN(entry, "=", 2)
+		policy := ViolationReport
+		if len(parts) == 2 {
+			policy = ViolationPolicy(parts[1])
+			if policy != ViolationReport && policy != ViolationReject {
+				return nil, fmt.Errorf("invalid policy %q for check %q, valid policies are %q and %q", parts[1], parts[0], ViolationReport, ViolationReject)
+			}
+		}
+
+		if parts[0] == "all" {
+			for _, check := range AllBlockChecks {
+				policies[check] = policy
+			}
+			continue
+		}
+
+		check := BlockCheck(parts[0])
+		if _, found := blockChecks[check]; !found {
+			return nil, fmt.Errorf("unknown check %q, valid checks are %s", parts[0], blockChecksString())
+		}
+
+		policies[check] = policy
+	}
+
+	if len(policies) == 0 {
+		return nil, fmt.Errorf("no check specified, valid checks are %s", blockChecksString())
+	}
+
+	return NewBlockValidator(policies), nil
+}
+
+// Checks returns the checks run by the validator, sorted by name
+func (v *BlockValidator) Checks() []BlockCheck {
+	return v.checks
+}
+
+func blockChecksString() string {
+	values := make([]string, len(AllBlockChecks))
+	for i, check := range AllBlockChecks {
+		values[i] = string(check)
+	}
+
+	return strings.Join(values, ", ")
+}
+
+// Validate logs the violations of the block, returning an error when any of them is
+// for a check configured to reject the block.
+func (v *BlockValidator) Validate(block *pbcodec.Block) error {
+	var rejected []string
+	for _, violation := range CheckBlock(block, v.checks...) {
+		zlog.Warn("block semantic violation", zap.Stringer("violation", violation))
+
+		if v.policies[violation.Check] == ViolationReject {
+			rejected = append(rejected, violation.String())
+		}
+	}
+
+	if len(rejected) > 0 {
+		return fmt.Errorf("block #%d rejected by validation: %s", block.Num(), strings.Join(rejected, "; "))
+	}
+
+	return nil
+}
+
+func checkTraceCounts(block *pbcodec.Block, report func(trxID string, format string, args ...interface{})) {
+	if block.FilteringApplied {
+		return
+	}
+
+	traces := block.UnfilteredTransactionTraces
+	if int(block.UnfilteredTransactionTraceCount) != len(traces) {
+		report("", "transaction trace count is %d but block has %d transaction traces", block.UnfilteredTransactionTraceCount, len(traces))
+	}
+
+	if int(block.UnfilteredTransactionCount) != len(block.UnfilteredTransactions) {
+		report("", "transaction count is %d but block has %d transactions", block.UnfilteredTransactionCount, len(block.UnfilteredTransactions))
+	}
+
+	var totalActionCount, inputActionCount int
+	for i, trace := range traces {
+		if trace.Index != uint64(i) {
+			report(trace.Id, "index is %d but transaction trace is at position %d", trace.Index, i)
+		}
+
+		totalActionCount += len(trace.ActionTraces)
+		for _, actionTrace := range trace.ActionTraces {
+			if actionTrace.IsInput() {
+				inputActionCount++
+			}
+		}
+	}
+
+	if int(block.UnfilteredExecutedTotalActionCount) != totalActionCount {
+		report("", "executed total action count is %d but transaction traces have %d action traces", block.UnfilteredExecutedTotalActionCount, totalActionCount)
+	}
+
+	if int(block.UnfilteredExecutedInputActionCount) != inputActionCount {
+		report("", "executed input action count is %d but transaction traces have %d input action traces", block.UnfilteredExecutedInputActionCount, inputActionCount)
+	}
+}
+
+func checkOpActionIndexes(block *pbcodec.Block, report func(trxID string, format string, args ...interface{})) {
+	for _, trace := range block.TransactionTraces() {
+		actionCount := len(trace.ActionTraces)
+		checkIndex := func(kind string, opIndex int, actionIndex uint32) {
+			// Transactions without action traces (failed early) still attach their operations to index 0
+			if int(actionIndex) < actionCount || (actionCount == 0 && actionIndex == 0) {
+				return
+			}
+
+			report(trace.Id, "%s op #%d references action index %d but transaction has %d action traces", kind, opIndex, actionIndex, actionCount)
+		}
+
+		for i, op := range trace.DbOps {
+			checkIndex("db", i, op.ActionIndex)
+		}
+		for i, op := range trace.KvOps {
+			checkIndex("kv", i, op.ActionIndex)
+		}
+		for i, op := range trace.RamOps {
+			checkIndex("ram", i, op.ActionIndex)
+		}
+		for i, op := range trace.TableOps {
+			checkIndex("table", i, op.ActionIndex)
+		}
+		for i, op := range trace.PermOps {
+			checkIndex("perm", i, op.ActionIndex)
+		}
+		for i, op := range trace.DtrxOps {
+			checkIndex("dtrx", i, op.ActionIndex)
+		}
+		for i, op := range trace.FeatureOps {
+			checkIndex("feature", i, op.ActionIndex)
+		}
+	}
+}
+
+func checkRAMDeltas(block *pbcodec.Block, report func(trxID string, format string, args ...interface{})) {
+	for _, trace := range block.TransactionTraces() {
+		if trace.Receipt == nil || trace.Receipt.Status != pbcodec.TransactionStatus_TRANSACTIONSTATUS_EXECUTED {
+			continue
+		}
+
+		opDeltas := make([]map[string]int64, len(trace.ActionTraces))
+		for _, op := range trace.RamOps {
+			if op.Namespace == pbcodec.RAMOp_NAMESPACE_DEFERRED_TRX || int(op.ActionIndex) >= len(trace.ActionTraces) {
+				continue
+			}
+
+			if opDeltas[op.ActionIndex] == nil {
+				opDeltas[op.ActionIndex] = map[string]int64{}
+			}
+			opDeltas[op.ActionIndex][op.Payer] += op.Delta
+		}
+
+		for actionIndex, actionTrace := range trace.ActionTraces {
+			reported := map[string]int64{}
+			for _, delta := range actionTrace.AccountRamDeltas {
+				reported[delta.Account] += delta.Delta
+			}
+
+			for _, account := range ramDeltaAccounts(reported, opDeltas[actionIndex]) {
+				if reported[account] != opDeltas[actionIndex][account] {
+					report(trace.Id, "action #%d reports a RAM delta of %d for %s but its RAM ops sum to %d", actionIndex, reported[account], account, opDeltas[actionIndex][account])
+				}
+			}
+		}
+	}
+}
+
+func ramDeltaAccounts(deltas ...map[string]int64) (out []string) {
+	seen := map[string]bool{}
+	for _, accountDeltas := range deltas {
+		for account := range accountDeltas {
+			if !seen[account] {
+				seen[account] = true
+				out = append(out, account)
+			}
+		}
+	}
+
+	sort.Strings(out)
+	return out
+}
+
+func checkCreationTree(block *pbcodec.Block, report func(trxID string, format string, args ...interface{})) {
+	for _, trace := range block.TransactionTraces() {
+		tree := trace.CreationTree
+		if len(tree) == 0 {
+			continue
+		}
+
+		actionCount := len(trace.ActionTraces)
+		if len(tree) != actionCount {
+			report(trace.Id, "creation tree has %d nodes but transaction has %d action traces", len(tree), actionCount)
+			continue
+		}
+
+		seen := make([]bool, actionCount)
+		for i, node := range tree {
+			if int(node.ExecutionActionIndex) >= actionCount {
+				report(trace.Id, "creation tree node #%d references action index %d but transaction has %d action traces", i, node.ExecutionActionIndex, actionCount)
+				continue
+			}
+
+			if seen[node.ExecutionActionIndex] {
+				report(trace.Id, "creation tree references action index %d more than once", node.ExecutionActionIndex)
+			}
+			seen[node.ExecutionActionIndex] = true
+
+			if node.CreatorActionIndex < -1 || int(node.CreatorActionIndex) >= len(tree) {
+				report(trace.Id, "creation tree node #%d references creator node %d but tree has %d nodes", i, node.CreatorActionIndex, len(tree))
+				continue
+			}
+
+			actionTrace := trace.ActionTraces[node.ExecutionActionIndex]
+			if actionTrace.ActionOrdinal == 0 {
+				// Action ordinals are absent from EOSIO 1.x deep mind output
+				continue
+			}
+
+			var creatorOrdinal uint32
+			if node.CreatorActionIndex != -1 {
+				creatorNode := tree[node.CreatorActionIndex]
+				if int(creatorNode.ExecutionActionIndex) >= actionCount {
+					continue
+				}
+				creatorOrdinal = trace.ActionTraces[creatorNode.ExecutionActionIndex].ActionOrdinal
+			}
+
+			if creatorOrdinal != actionTrace.CreatorActionOrdinal {
+				report(trace.Id, "action #%d has creator action ordinal %d but creation tree gives %d", node.ExecutionActionIndex, actionTrace.CreatorActionOrdinal, creatorOrdinal)
+			}
+		}
+	}
+}
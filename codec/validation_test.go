@@ -0,0 +1,150 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zhongshuwen/histnew/codec/zswhq"
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+)
+
+func TestCheckBlock(t *testing.T) {
+	validBlock := func() *pbcodec.Block {
+		block := &pbcodec.Block{
+			Number: 10,
+			Header: &pbcodec.BlockHeader{},
+			UnfilteredTransactionTraces: []*pbcodec.TransactionTrace{
+				{
+					Id:      "trx.1",
+					Receipt: &pbcodec.TransactionReceiptHeader{Status: pbcodec.TransactionStatus_TRANSACTIONSTATUS_EXECUTED},
+					ActionTraces: []*pbcodec.ActionTrace{
+						{ActionOrdinal: 1, AccountRamDeltas: []*pbcodec.AccountRAMDelta{{Account: "alice", Delta: 100}}},
+						{ActionOrdinal: 2, CreatorActionOrdinal: 1},
+					},
+					RamOps: []*pbcodec.RAMOp{
+						{ActionIndex: 0, Payer: "alice", Delta: 120},
+						{ActionIndex: 0, Payer: "alice", Delta: -20},
+						{ActionIndex: 1, Payer: "bob", Delta: 50, Namespace: pbcodec.RAMOp_NAMESPACE_DEFERRED_TRX},
+					},
+					DbOps: []*pbcodec.DBOp{{ActionIndex: 1}},
+					CreationTree: []*pbcodec.CreationFlatNode{
+						{CreatorActionIndex: -1, ExecutionActionIndex: 0},
+						{CreatorActionIndex: 0, ExecutionActionIndex: 1},
+					},
+				},
+			},
+		}
+		zswhq.AttachTransactionTraces(block)
+
+		return block
+	}
+
+	tests := []struct {
+		name     string
+		mutate   func(block *pbcodec.Block)
+		expected []string
+	}{
+		{"valid", func(block *pbcodec.Block) {}, nil},
+		{
+			"trace counts",
+			func(block *pbcodec.Block) { block.UnfilteredExecutedTotalActionCount = 3 },
+			[]string{"trace-counts: block #10: executed total action count is 3 but transaction traces have 2 action traces"},
+		},
+		{
+			"op action index",
+			func(block *pbcodec.Block) { block.UnfilteredTransactionTraces[0].DbOps[0].ActionIndex = 2 },
+			[]string{"op-action-indexes: block #10: transaction trx.1: db op #0 references action index 2 but transaction has 2 action traces"},
+		},
+		{
+			"ram deltas",
+			func(block *pbcodec.Block) { block.UnfilteredTransactionTraces[0].RamOps[1].Delta = -10 },
+			[]string{"ram-deltas: block #10: transaction trx.1: action #0 reports a RAM delta of 100 for alice but its RAM ops sum to 110"},
+		},
+		{
+			"ram deltas of failed transaction",
+			func(block *pbcodec.Block) {
+				trace := block.UnfilteredTransactionTraces[0]
+				trace.Receipt.Status = pbcodec.TransactionStatus_TRANSACTIONSTATUS_HARDFAIL
+				trace.RamOps[1].Delta = -10
+			},
+			nil,
+		},
+		{
+			"creation tree duplicated action",
+			func(block *pbcodec.Block) {
+				block.UnfilteredTransactionTraces[0].CreationTree[1] = &pbcodec.CreationFlatNode{CreatorActionIndex: -1, ExecutionActionIndex: 0}
+			},
+			[]string{
+				"creation-tree: block #10: transaction trx.1: creation tree references action index 0 more than once",
+			},
+		},
+		{
+			"creation tree creator mismatch",
+			func(block *pbcodec.Block) {
+				block.UnfilteredTransactionTraces[0].ActionTraces[1].CreatorActionOrdinal = 2
+			},
+			[]string{"creation-tree: block #10: transaction trx.1: action #1 has creator action ordinal 2 but creation tree gives 1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			block := validBlock()
+			test.mutate(block)
+
+			var actual []string
+			for _, violation := range CheckBlock(block, AllBlockChecks...) {
+				actual = append(actual, violation.String())
+			}
+
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestBlockValidator(t *testing.T) {
+	_, err := ParseBlockValidator("unknown")
+	assert.EqualError(t, err, `unknown check "unknown", valid checks are trace-counts, op-action-indexes, ram-deltas, creation-tree`)
+
+	_, err = ParseBlockValidator("ram-deltas=fail")
+	assert.Error(t, err)
+
+	validator, err := ParseBlockValidator("all,ram-deltas=reject")
+	require.NoError(t, err)
+	assert.Equal(t, ViolationReport, validator.policies[CheckCreationTree])
+	assert.Equal(t, ViolationReject, validator.policies[CheckRAMDeltas])
+	assert.Equal(t, []BlockCheck{CheckCreationTree, CheckOpActionIndexes, CheckRAMDeltas, CheckTraceCounts}, validator.Checks())
+
+	block := &pbcodec.Block{
+		Number: 10,
+		Header: &pbcodec.BlockHeader{},
+		UnfilteredTransactionTraces: []*pbcodec.TransactionTrace{{
+			Id:           "trx.1",
+			Receipt:      &pbcodec.TransactionReceiptHeader{Status: pbcodec.TransactionStatus_TRANSACTIONSTATUS_EXECUTED},
+			ActionTraces: []*pbcodec.ActionTrace{{ActionOrdinal: 1}},
+			DbOps:        []*pbcodec.DBOp{{ActionIndex: 1}},
+		}},
+	}
+	zswhq.AttachTransactionTraces(block)
+
+	// Reported only
+	require.NoError(t, validator.Validate(block))
+
+	block.UnfilteredTransactionTraces[0].RamOps = []*pbcodec.RAMOp{{ActionIndex: 0, Payer: "alice", Delta: 10}}
+	assert.EqualError(t, validator.Validate(block), "block #10 rejected by validation: ram-deltas: block #10: transaction trx.1: action #0 reports a RAM delta of 0 for alice but its RAM ops sum to 10")
+}
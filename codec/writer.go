@@ -22,30 +22,82 @@ import (
 	"github.com/streamingfast/dbin"
 	pbbstream "github.com/streamingfast/pbgo/dfuse/bstream/v1"
 	"github.com/golang/protobuf/proto"
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+)
+
+const (
+	// StandardBlockEncodingVersion is the dbin content version of blocks stored as-is, and the
+	// `bstream.Block` payload version of their payload.
+	StandardBlockEncodingVersion = 1
+
+	// CompactBlockEncodingVersion is the dbin content version of blocks stored compacted
+	// through `CompactBlock`, see `CompactEncoding`, and the `bstream.Block` payload version of
+	// their payload, which `BlockDecoder` expands when decoding it.
+	CompactBlockEncodingVersion = 2
 )
 
 func BlockWriterFactory(writer io.Writer) (bstream.BlockWriter, error) {
 	return NewBlockWriter(writer)
 }
 
+func CompactBlockWriterFactory(writer io.Writer) (bstream.BlockWriter, error) {
+	return NewBlockWriter(writer, CompactEncoding())
+}
+
+type BlockWriterOption interface {
+	apply(writer *BlockWriter)
+}
+
+type blockWriterOptionFunc func(writer *BlockWriter)
+
+func (f blockWriterOptionFunc) apply(writer *BlockWriter) {
+	f(writer)
+}
+
+// CompactEncoding makes the writer store blocks using the compact encoding, see `CompactBlock`.
+// The `BlockReader` passes those blocks along as-is, `BlockDecoder` expanding them back when
+// they are decoded.
+func CompactEncoding() BlockWriterOption {
+	return blockWriterOptionFunc(func(writer *BlockWriter) {
+		writer.version = CompactBlockEncodingVersion
+	})
+}
+
 // BlockWriter reads the dbin format where each element is assumed to be a `bstream.Block`.
 type BlockWriter struct {
-	src *dbin.Writer
+	src     *dbin.Writer
+	version int
 }
 
-func NewBlockWriter(writer io.Writer) (*BlockWriter, error) {
-	dbinWriter := dbin.NewWriter(writer)
-	err := dbinWriter.WriteHeader(pbbstream.Protocol_EOS.String(), 1)
+func NewBlockWriter(writer io.Writer, options ...BlockWriterOption) (*BlockWriter, error) {
+	blockWriter := &BlockWriter{
+		src:     dbin.NewWriter(writer),
+		version: StandardBlockEncodingVersion,
+	}
+
+	for _, option := range options {
+		option.apply(blockWriter)
+	}
+
+	err := blockWriter.src.WriteHeader(pbbstream.Protocol_EOS.String(), blockWriter.version)
 	if err != nil {
 		return nil, fmt.Errorf("unable to write file header: %s", err)
 	}
 
-	return &BlockWriter{
-		src: dbinWriter,
-	}, nil
+	return blockWriter, nil
 }
 
 func (w *BlockWriter) Write(block *bstream.Block) error {
+	// The memoized native block of a compact block is expanded, so it is compacted again
+	if block.PayloadVersion != int32(w.version) || (block.PayloadBuffer == nil && w.version == CompactBlockEncodingVersion) {
+		encoded, err := w.encodePayload(block)
+		if err != nil {
+			return fmt.Errorf("unable to encode block %s: %w", block, err)
+		}
+
+		block = encoded
+	}
+
 	pbBlock, err := block.ToProto()
 	if err != nil {
 		return err
@@ -58,3 +110,35 @@ func (w *BlockWriter) Write(block *bstream.Block) error {
 
 	return w.src.WriteMessage(bytes)
 }
+
+// encodePayload returns a copy of the block with its payload in the writer's encoding. It
+// works on a copy of the native block so the memoized one, which might be shared with other
+// consumers, is left untouched.
+func (w *BlockWriter) encodePayload(block *bstream.Block) (*bstream.Block, error) {
+	var native *pbcodec.Block
+	if block.PayloadBuffer == nil {
+		native = proto.Clone(block.ToNative().(*pbcodec.Block)).(*pbcodec.Block)
+	} else {
+		decoded, err := BlockDecoder(block)
+		if err != nil {
+			return nil, err
+		}
+
+		native = decoded.(*pbcodec.Block)
+	}
+
+	if w.version == CompactBlockEncodingVersion {
+		CompactBlock(native)
+	}
+
+	payload, err := proto.Marshal(native)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal payload: %w", err)
+	}
+
+	encoded := block.Clone()
+	encoded.PayloadVersion = int32(w.version)
+	encoded.PayloadBuffer = payload
+
+	return encoded, nil
+}
@@ -11,3 +11,25 @@ type Hydrator interface {
 	// correct struct for this version of EOSIO supported by this hydrator.
 	DecodeTransactionTrace(input []byte, opts ...ConversionOption) (*pbcodec.TransactionTrace, error)
 }
+
+// AttachTransactionTraces indexes the transaction traces of the block and stamps them with
+// the block's header, computing the block's trace and executed action counts from them.
+func AttachTransactionTraces(block *pbcodec.Block) {
+	block.UnfilteredTransactionTraceCount = uint32(len(block.UnfilteredTransactionTraces))
+	block.UnfilteredExecutedTotalActionCount = 0
+	block.UnfilteredExecutedInputActionCount = 0
+
+	for idx, t := range block.UnfilteredTransactionTraces {
+		t.Index = uint64(idx)
+		t.BlockTime = block.Header.Timestamp
+		t.ProducerBlockId = block.Id
+		t.BlockNum = uint64(block.Number)
+
+		for _, actionTrace := range t.ActionTraces {
+			block.UnfilteredExecutedTotalActionCount++
+			if actionTrace.IsInput() {
+				block.UnfilteredExecutedInputActionCount++
+			}
+		}
+	}
+}
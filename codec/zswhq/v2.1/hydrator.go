@@ -66,20 +66,7 @@ func (h *Hydrator) HydrateBlock(block *pbcodec.Block, input []byte) error {
 		block.UnfilteredTransactions = append(block.UnfilteredTransactions, deosTransaction)
 	}
 
-	block.UnfilteredTransactionTraceCount = uint32(len(block.UnfilteredTransactionTraces))
-	for idx, t := range block.UnfilteredTransactionTraces {
-		t.Index = uint64(idx)
-		t.BlockTime = block.Header.Timestamp
-		t.ProducerBlockId = block.Id
-		t.BlockNum = uint64(block.Number)
-
-		for _, actionTrace := range t.ActionTraces {
-			block.UnfilteredExecutedTotalActionCount++
-			if actionTrace.IsInput() {
-				block.UnfilteredExecutedInputActionCount++
-			}
-		}
-	}
+	zswhq.AttachTransactionTraces(block)
 
 	return nil
 }
@@ -0,0 +1,228 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"context"
+
+	"github.com/streamingfast/derr"
+	"github.com/streamingfast/dgraphql"
+	"github.com/streamingfast/dgraphql/analytics"
+	commonTypes "github.com/streamingfast/dgraphql/types"
+	"github.com/streamingfast/dmetering"
+	"github.com/streamingfast/logging"
+	pbabicodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/abicodec/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+type ABIVersionsArgs struct {
+	Account string
+	WithABI bool
+}
+
+func (r *Root) QueryAbiVersions(ctx context.Context, args ABIVersionsArgs) ([]*ABIVersion, error) {
+	if err := r.RateLimit(ctx, "abicodec"); err != nil {
+		return nil, err
+	}
+
+	resp, err := r.abiCodecClient.ListAbiVersions(ctx, &pbabicodec.ListAbiVersionsRequest{
+		Account: args.Account,
+		WithAbi: args.WithABI,
+	})
+	if err != nil {
+		logging.Logger(ctx, zlog).Info("failed to list abi versions", zap.String("account", args.Account), zap.Error(err))
+		return nil, dgraphql.UnwrapError(ctx, derr.Wrap(err, "failed to retrieve ABI versions of requested account"))
+	}
+
+	/////////////////////////////////////////////////////////////////////////
+	// DO NOT change this without updating BigQuery analytics
+	analytics.TrackUserEvent(ctx, "dgraphql", "QueryAbiVersions", "ABIVersionsArgs", args, "Versions", len(resp.Versions))
+	/////////////////////////////////////////////////////////////////////////
+
+	//////////////////////////////////////////////////////////////////////
+	// Billable event on GraphQL Query - One Request, Many Oubound Documents
+	// WARNING: Ingress / Egress bytess is taken care by the middleware
+	//////////////////////////////////////////////////////////////////////
+	dmetering.EmitWithContext(dmetering.Event{
+		Source:         "dgraphql",
+		Kind:           "GraphQL Query",
+		Method:         "AbiVersions",
+		RequestsCount:  1,
+		ResponsesCount: countMinOne(len(resp.Versions)),
+	}, ctx)
+	//////////////////////////////////////////////////////////////////////
+
+	out := make([]*ABIVersion, len(resp.Versions))
+	for i, version := range resp.Versions {
+		out[i] = &ABIVersion{version: version}
+	}
+
+	return out, nil
+}
+
+type ABIDiffArgs struct {
+	Account      string
+	FromBlockNum commonTypes.Uint32
+	ToBlockNum   commonTypes.Uint32
+}
+
+func (r *Root) QueryAbiDiff(ctx context.Context, args ABIDiffArgs) (*ABIDiff, error) {
+	if err := r.RateLimit(ctx, "abicodec"); err != nil {
+		return nil, err
+	}
+
+	if args.FromBlockNum > args.ToBlockNum {
+		return nil, dgraphql.Status(ctx, codes.InvalidArgument, "'fromBlockNum' must be lower than or equal to 'toBlockNum'")
+	}
+
+	resp, err := r.abiCodecClient.DiffAbis(ctx, &pbabicodec.DiffAbisRequest{
+		Account:      args.Account,
+		FromBlockNum: uint32(args.FromBlockNum),
+		ToBlockNum:   uint32(args.ToBlockNum),
+	})
+	if err != nil {
+		logging.Logger(ctx, zlog).Info("failed to diff abis", zap.String("account", args.Account), zap.Error(err))
+		return nil, dgraphql.UnwrapError(ctx, derr.Wrap(err, "failed to diff ABIs of requested account"))
+	}
+
+	/////////////////////////////////////////////////////////////////////////
+	// DO NOT change this without updating BigQuery analytics
+	analytics.TrackUserEvent(ctx, "dgraphql", "QueryAbiDiff", "ABIDiffArgs", args)
+	/////////////////////////////////////////////////////////////////////////
+
+	//////////////////////////////////////////////////////////////////////
+	// Billable event on GraphQL Query - One Request, One Outbound Document
+	// WARNING: Ingress / Egress bytess is taken care by the middleware
+	//////////////////////////////////////////////////////////////////////
+	dmetering.EmitWithContext(dmetering.Event{
+		Source:         "dgraphql",
+		Kind:           "GraphQL Query",
+		Method:         "AbiDiff",
+		RequestsCount:  1,
+		ResponsesCount: 1,
+	}, ctx)
+	//////////////////////////////////////////////////////////////////////
+
+	return &ABIDiff{diff: resp}, nil
+}
+
+type ABIVersion struct {
+	version *pbabicodec.AbiVersion
+}
+
+func (v *ABIVersion) StartBlockNum() commonTypes.Uint32 {
+	return commonTypes.Uint32(v.version.StartBlockNum)
+}
+
+func (v *ABIVersion) EndBlockNum() *commonTypes.Uint32 {
+	if v.version.EndBlockNum == 0 {
+		return nil
+	}
+
+	endBlockNum := commonTypes.Uint32(v.version.EndBlockNum)
+	return &endBlockNum
+}
+
+func (v *ABIVersion) TrxID() string { return v.version.TrxId }
+
+func (v *ABIVersion) ABI() *commonTypes.JSON {
+	if v.version.JsonAbi == "" {
+		return nil
+	}
+
+	abi := commonTypes.JSON(v.version.JsonAbi)
+	return &abi
+}
+
+type ABIDiff struct {
+	diff *pbabicodec.DiffAbisResponse
+}
+
+func (d *ABIDiff) FromABIBlockNum() commonTypes.Uint32 {
+	return commonTypes.Uint32(d.diff.FromAbiBlockNum)
+}
+func (d *ABIDiff) ToABIBlockNum() commonTypes.Uint32 { return commonTypes.Uint32(d.diff.ToAbiBlockNum) }
+func (d *ABIDiff) AddedActions() []string            { return nonNilStrings(d.diff.AddedActions) }
+func (d *ABIDiff) RemovedActions() []string          { return nonNilStrings(d.diff.RemovedActions) }
+func (d *ABIDiff) ChangedActions() []*ABITypeChange  { return newABITypeChanges(d.diff.ChangedActions) }
+func (d *ABIDiff) AddedTables() []string             { return nonNilStrings(d.diff.AddedTables) }
+func (d *ABIDiff) RemovedTables() []string           { return nonNilStrings(d.diff.RemovedTables) }
+func (d *ABIDiff) ChangedTables() []*ABITypeChange   { return newABITypeChanges(d.diff.ChangedTables) }
+func (d *ABIDiff) AddedStructs() []string            { return nonNilStrings(d.diff.AddedStructs) }
+func (d *ABIDiff) RemovedStructs() []string          { return nonNilStrings(d.diff.RemovedStructs) }
+
+func (d *ABIDiff) ChangedStructs() []*ABIStructChange {
+	out := make([]*ABIStructChange, len(d.diff.ChangedStructs))
+	for i, change := range d.diff.ChangedStructs {
+		out[i] = &ABIStructChange{change: change}
+	}
+
+	return out
+}
+
+type ABIStructChange struct {
+	change *pbabicodec.StructChange
+}
+
+func (c *ABIStructChange) Name() string               { return c.change.Name }
+func (c *ABIStructChange) FromBase() string           { return c.change.FromBase }
+func (c *ABIStructChange) ToBase() string             { return c.change.ToBase }
+func (c *ABIStructChange) AddedFields() []*ABIField   { return newABIFields(c.change.AddedFields) }
+func (c *ABIStructChange) RemovedFields() []*ABIField { return newABIFields(c.change.RemovedFields) }
+func (c *ABIStructChange) ChangedFields() []*ABITypeChange {
+	return newABITypeChanges(c.change.ChangedFields)
+}
+
+type ABITypeChange struct {
+	change *pbabicodec.TypeChange
+}
+
+func newABITypeChanges(changes []*pbabicodec.TypeChange) []*ABITypeChange {
+	out := make([]*ABITypeChange, len(changes))
+	for i, change := range changes {
+		out[i] = &ABITypeChange{change: change}
+	}
+
+	return out
+}
+
+func (c *ABITypeChange) Name() string     { return c.change.Name }
+func (c *ABITypeChange) FromType() string { return c.change.FromType }
+func (c *ABITypeChange) ToType() string   { return c.change.ToType }
+
+type ABIField struct {
+	field *pbabicodec.FieldDef
+}
+
+func newABIFields(fields []*pbabicodec.FieldDef) []*ABIField {
+	out := make([]*ABIField, len(fields))
+	for i, field := range fields {
+		out[i] = &ABIField{field: field}
+	}
+
+	return out
+}
+
+func (f *ABIField) Name() string { return f.field.Name }
+func (f *ABIField) Type() string { return f.field.Type }
+
+func nonNilStrings(in []string) []string {
+	if in == nil {
+		return []string{}
+	}
+
+	return in
+}
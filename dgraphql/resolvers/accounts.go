@@ -0,0 +1,202 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes"
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/streamingfast/derr"
+	"github.com/streamingfast/dgraphql"
+	"github.com/streamingfast/dgraphql/analytics"
+	commonTypes "github.com/streamingfast/dgraphql/types"
+	"github.com/streamingfast/dmetering"
+	"github.com/streamingfast/kvdb"
+	"github.com/streamingfast/logging"
+	"github.com/streamingfast/opaque"
+	"github.com/zhongshuwen/histnew/dgraphql/types"
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+const maxAccountsCreatedByLimit = 1000
+
+type AccountsCreatedByArgs struct {
+	Creator string
+	Limit   types.Int64
+	Cursor  *string
+}
+
+type AccountCreationsConnection struct {
+	Edges    []*AccountCreationEdge
+	PageInfo PageInfo
+}
+
+type AccountCreationEdge struct {
+	Cursor string
+	Node   *AccountCreation
+}
+
+func (r *Root) QueryAccountsCreatedBy(ctx context.Context, args AccountsCreatedByArgs) (*AccountCreationsConnection, error) {
+	if err := r.RateLimit(ctx, "blockmeta"); err != nil {
+		return nil, err
+	}
+
+	zlogger := logging.Logger(ctx, zlog)
+
+	limit := int(args.Limit)
+	if limit <= 0 || limit > maxAccountsCreatedByLimit {
+		return nil, dgraphql.Errorf(ctx, "'limit' must be between 1 and %d", maxAccountsCreatedByLimit)
+	}
+
+	afterAccount := ""
+	if args.Cursor != nil && *args.Cursor != "" {
+		account, err := opaque.FromOpaque(*args.Cursor)
+		if err != nil {
+			return nil, dgraphql.Status(ctx, codes.InvalidArgument, "invalid or malformed cursor")
+		}
+		afterAccount = account
+	}
+
+	refs, err := r.accountsReader.ListAccountsCreatedBy(ctx, args.Creator, afterAccount, limit+1)
+	if err != nil {
+		zlogger.Warn("call to dbReader failed", zap.Error(err))
+		return nil, dgraphql.UnwrapError(ctx, derr.Wrap(err, "failed to retrieve accounts created by requested creator"))
+	}
+
+	hasNextPage := len(refs) > limit
+	if hasNextPage {
+		refs = refs[:limit]
+	}
+
+	edges := make([]*AccountCreationEdge, len(refs))
+	for i, ref := range refs {
+		cursor, err := opaque.ToOpaque(ref.Account)
+		if err != nil {
+			return nil, dgraphql.UnwrapError(ctx, derr.Wrap(err, "unable to create cursor"))
+		}
+
+		edges[i] = &AccountCreationEdge{Cursor: cursor, Node: newAccountCreation(ref)}
+	}
+
+	/////////////////////////////////////////////////////////////////////////
+	// DO NOT change this without updating BigQuery analytics
+	analytics.TrackUserEvent(ctx, "dgraphql", "QueryAccountsCreatedBy", "AccountsCreatedByArgs", args, "Edges", len(edges))
+	/////////////////////////////////////////////////////////////////////////
+
+	//////////////////////////////////////////////////////////////////////
+	// Billable event on GraphQL Query - One Request, Many Oubound Documents
+	// WARNING: Ingress / Egress bytess is taken care by the middleware
+	//////////////////////////////////////////////////////////////////////
+	dmetering.EmitWithContext(dmetering.Event{
+		Source:         "dgraphql",
+		Kind:           "GraphQL Query",
+		Method:         "AccountsCreatedBy",
+		RequestsCount:  1,
+		ResponsesCount: countMinOne(len(edges)),
+	}, ctx)
+	//////////////////////////////////////////////////////////////////////
+
+	pageInfo := PageInfo{HasNextPage: hasNextPage, HasPreviousPage: afterAccount != ""}
+	if len(edges) != 0 {
+		pageInfo.StartCursor = edges[0].Cursor
+		pageInfo.EndCursor = edges[len(edges)-1].Cursor
+	}
+
+	return &AccountCreationsConnection{Edges: edges, PageInfo: pageInfo}, nil
+}
+
+type AccountLineageArgs struct {
+	Account string
+}
+
+func (r *Root) QueryAccountLineage(ctx context.Context, args AccountLineageArgs) ([]*AccountCreation, error) {
+	if err := r.RateLimit(ctx, "blockmeta"); err != nil {
+		return nil, err
+	}
+
+	zlogger := logging.Logger(ctx, zlog)
+
+	refs, err := r.accountsReader.GetAccountLineage(ctx, args.Account)
+	if err != nil {
+		if err != kvdb.ErrNotFound {
+			zlogger.Warn("call to dbReader failed", zap.Error(err))
+		}
+
+		//////////////////////////////////////////////////////////////////////
+		// Billable event on GraphQL Query - One Request, Many Oubound Documents
+		// WARNING: Ingress / Egress bytess is taken care by the middleware
+		//////////////////////////////////////////////////////////////////////
+		dmetering.EmitWithContext(dmetering.Event{
+			Source:         "dgraphql",
+			Kind:           "GraphQL Query",
+			Method:         "AccountLineage",
+			RequestsCount:  1,
+			ResponsesCount: 1,
+		}, ctx)
+		//////////////////////////////////////////////////////////////////////
+		return nil, dgraphql.UnwrapError(ctx, derr.Wrap(err, "failed to retrieve lineage for requested account"))
+	}
+
+	/////////////////////////////////////////////////////////////////////////
+	// DO NOT change this without updating BigQuery analytics
+	analytics.TrackUserEvent(ctx, "dgraphql", "QueryAccountLineage", "AccountLineageArgs", args)
+	/////////////////////////////////////////////////////////////////////////
+
+	//////////////////////////////////////////////////////////////////////
+	// Billable event on GraphQL Query - One Request, Many Oubound Documents
+	// WARNING: Ingress / Egress bytess is taken care by the middleware
+	//////////////////////////////////////////////////////////////////////
+	dmetering.EmitWithContext(dmetering.Event{
+		Source:         "dgraphql",
+		Kind:           "GraphQL Query",
+		Method:         "AccountLineage",
+		RequestsCount:  1,
+		ResponsesCount: countMinOne(len(refs)),
+	}, ctx)
+	//////////////////////////////////////////////////////////////////////
+
+	out := make([]*AccountCreation, len(refs))
+	for i, ref := range refs {
+		out[i] = newAccountCreation(ref)
+	}
+
+	return out, nil
+}
+
+type AccountCreation struct {
+	ref *pbcodec.AccountCreationRef
+}
+
+func newAccountCreation(ref *pbcodec.AccountCreationRef) *AccountCreation {
+	return &AccountCreation{ref: ref}
+}
+
+func (a *AccountCreation) Account() string              { return a.ref.Account }
+func (a *AccountCreation) Creator() string              { return a.ref.Creator }
+func (a *AccountCreation) BlockNum() commonTypes.Uint32 { return commonTypes.Uint32(a.ref.BlockNum) }
+func (a *AccountCreation) BlockID() string              { return a.ref.BlockId }
+func (a *AccountCreation) TrxID() string                { return a.ref.TransactionId }
+
+func (a *AccountCreation) BlockTime() (graphql.Time, error) {
+	t, err := ptypes.Timestamp(a.ref.BlockTime)
+	if err != nil {
+		return graphql.Time{}, err
+	}
+
+	return graphql.Time{Time: t}, nil
+}
@@ -0,0 +1,261 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes"
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/streamingfast/derr"
+	"github.com/streamingfast/dgraphql"
+	"github.com/streamingfast/dgraphql/analytics"
+	commonTypes "github.com/streamingfast/dgraphql/types"
+	"github.com/streamingfast/dmetering"
+	"github.com/streamingfast/logging"
+	"github.com/streamingfast/opaque"
+	"github.com/zhongshuwen/histnew/dgraphql/types"
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+	"github.com/zhongshuwen/histnew/trxdb"
+	zsw "github.com/zhongshuwen/zswchain-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+const maxDeferredTransactionsLimit = 1000
+
+type DeferredTransactionsBySenderArgs struct {
+	Sender string
+	Limit  types.Int64
+	Cursor *string
+}
+
+type PendingDeferredTransactionsArgs struct {
+	AtBlockNum *commonTypes.Uint32
+	Limit      types.Int64
+	Cursor     *string
+}
+
+type DeferredTransactionsConnection struct {
+	Edges    []*DeferredTransactionEdge
+	PageInfo PageInfo
+}
+
+type DeferredTransactionEdge struct {
+	Cursor string
+	Node   *DeferredTransaction
+}
+
+func (r *Root) QueryDeferredTransactionsBySender(ctx context.Context, args DeferredTransactionsBySenderArgs) (*DeferredTransactionsConnection, error) {
+	if err := r.RateLimit(ctx, "blockmeta"); err != nil {
+		return nil, err
+	}
+
+	out, err := r.listDeferredTransactions(ctx, "DeferredTransactionsBySender", int(args.Limit), args.Cursor, func(after *trxdb.TransactionRef, limit int) ([]*trxdb.TransactionRef, error) {
+		return r.trxsReader.ListDeferredTransactionRefsBySender(ctx, args.Sender, after, limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	/////////////////////////////////////////////////////////////////////////
+	// DO NOT change this without updating BigQuery analytics
+	analytics.TrackUserEvent(ctx, "dgraphql", "QueryDeferredTransactionsBySender", "DeferredTransactionsBySenderArgs", args, "Edges", len(out.Edges))
+	/////////////////////////////////////////////////////////////////////////
+
+	return out, nil
+}
+
+func (r *Root) QueryPendingDeferredTransactions(ctx context.Context, args PendingDeferredTransactionsArgs) (*DeferredTransactionsConnection, error) {
+	if err := r.RateLimit(ctx, "blockmeta"); err != nil {
+		return nil, err
+	}
+
+	var atBlockNum uint32
+	if args.AtBlockNum != nil {
+		atBlockNum = uint32(*args.AtBlockNum)
+	} else {
+		blockID, err := r.blocksReader.GetLastWrittenBlockID(ctx)
+		if err != nil {
+			return nil, dgraphql.UnwrapError(ctx, derr.Wrap(err, "failed to retrieve last written block"))
+		}
+		atBlockNum = zsw.BlockNum(blockID)
+	}
+
+	out, err := r.listDeferredTransactions(ctx, "PendingDeferredTransactions", int(args.Limit), args.Cursor, func(after *trxdb.TransactionRef, limit int) ([]*trxdb.TransactionRef, error) {
+		return r.trxsReader.ListPendingDeferredTransactionRefs(ctx, atBlockNum, after, limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	/////////////////////////////////////////////////////////////////////////
+	// DO NOT change this without updating BigQuery analytics
+	analytics.TrackUserEvent(ctx, "dgraphql", "QueryPendingDeferredTransactions", "PendingDeferredTransactionsArgs", args, "Edges", len(out.Edges))
+	/////////////////////////////////////////////////////////////////////////
+
+	return out, nil
+}
+
+// listDeferredTransactions resolves the lifecycle of the deferred transactions refs returned by
+// `listRefs`, the cursor being the `<blockID>:<trxID>` of the creation ref.
+func (r *Root) listDeferredTransactions(ctx context.Context, method string, limit int, cursor *string, listRefs func(after *trxdb.TransactionRef, limit int) ([]*trxdb.TransactionRef, error)) (*DeferredTransactionsConnection, error) {
+	zlogger := logging.Logger(ctx, zlog)
+
+	if limit <= 0 || limit > maxDeferredTransactionsLimit {
+		return nil, dgraphql.Errorf(ctx, "'limit' must be between 1 and %d", maxDeferredTransactionsLimit)
+	}
+
+	var after *trxdb.TransactionRef
+	if cursor != nil && *cursor != "" {
+		key, err := opaque.FromOpaque(*cursor)
+		parts := strings.Split(key, ":")
+		if err != nil || len(parts) != 2 {
+			return nil, dgraphql.Status(ctx, codes.InvalidArgument, "invalid or malformed cursor")
+		}
+		after = &trxdb.TransactionRef{BlockID: parts[0], ID: parts[1]}
+	}
+
+	refs, err := listRefs(after, limit+1)
+	if err != nil {
+		zlogger.Warn("call to dbReader failed", zap.Error(err))
+		return nil, dgraphql.UnwrapError(ctx, derr.Wrap(err, "failed to retrieve deferred transactions"))
+	}
+
+	hasNextPage := len(refs) > limit
+	if hasNextPage {
+		refs = refs[:limit]
+	}
+
+	trxIDs := make([]string, len(refs))
+	for i, ref := range refs {
+		trxIDs[i] = ref.ID
+	}
+
+	eventsList, err := r.trxsReader.GetTransactionEventsBatch(ctx, trxIDs)
+	if err != nil {
+		zlogger.Warn("call to dbReader failed", zap.Error(err))
+		return nil, dgraphql.UnwrapError(ctx, derr.Wrap(err, "failed to retrieve deferred transactions events"))
+	}
+
+	edges := []*DeferredTransactionEdge{}
+	for i, ref := range refs {
+		lifecycle := pbcodec.MergeTransactionEvents(eventsList[i], func(id string) bool { return true })
+		if lifecycle.CreatedBy == nil {
+			continue
+		}
+
+		edgeCursor, err := opaque.ToOpaque(ref.BlockID + ":" + ref.ID)
+		if err != nil {
+			return nil, dgraphql.UnwrapError(ctx, derr.Wrap(err, "unable to create cursor"))
+		}
+
+		edges = append(edges, &DeferredTransactionEdge{
+			Cursor: edgeCursor,
+			Node:   newDeferredTransaction(lifecycle, r),
+		})
+	}
+
+	//////////////////////////////////////////////////////////////////////
+	// Billable event on GraphQL Query - One Request, Many Oubound Documents
+	// WARNING: Ingress / Egress bytess is taken care by the middleware
+	//////////////////////////////////////////////////////////////////////
+	dmetering.EmitWithContext(dmetering.Event{
+		Source:         "dgraphql",
+		Kind:           "GraphQL Query",
+		Method:         method,
+		RequestsCount:  1,
+		ResponsesCount: countMinOne(len(edges)),
+	}, ctx)
+	//////////////////////////////////////////////////////////////////////
+
+	pageInfo := PageInfo{HasNextPage: hasNextPage, HasPreviousPage: after != nil}
+	if len(edges) != 0 {
+		pageInfo.StartCursor = edges[0].Cursor
+		pageInfo.EndCursor = edges[len(edges)-1].Cursor
+	}
+
+	return &DeferredTransactionsConnection{Edges: edges, PageInfo: pageInfo}, nil
+}
+
+type DeferredTransaction struct {
+	lifecycle *pbcodec.TransactionLifecycle
+	root      *Root
+}
+
+func newDeferredTransaction(lifecycle *pbcodec.TransactionLifecycle, root *Root) *DeferredTransaction {
+	return &DeferredTransaction{lifecycle: lifecycle, root: root}
+}
+
+func (d *DeferredTransaction) op() *pbcodec.DTrxOp { return d.lifecycle.CreatedBy.DtrxOp }
+
+func (d *DeferredTransaction) ID() string           { return d.lifecycle.Id }
+func (d *DeferredTransaction) Sender() string       { return d.op().Sender }
+func (d *DeferredTransaction) SenderID() string     { return d.op().SenderId }
+func (d *DeferredTransaction) Payer() string        { return d.op().Payer }
+func (d *DeferredTransaction) DelayUntil() string   { return d.op().DelayUntil }
+func (d *DeferredTransaction) ExpirationAt() string { return d.op().ExpirationAt }
+func (d *DeferredTransaction) CreatedByTrxID() string {
+	return d.lifecycle.CreatedBy.SourceTransactionId
+}
+func (d *DeferredTransaction) BlockNum() commonTypes.Uint32 {
+	return commonTypes.Uint32(d.lifecycle.CreatedBy.BlockNum)
+}
+func (d *DeferredTransaction) BlockID() string            { return d.lifecycle.CreatedBy.BlockId }
+func (d *DeferredTransaction) CreationIrreversible() bool { return d.lifecycle.CreationIrreversible }
+
+func (d *DeferredTransaction) BlockTime() (graphql.Time, error) {
+	t, err := ptypes.Timestamp(d.lifecycle.CreatedBy.BlockTime)
+	if err != nil {
+		return graphql.Time{}, err
+	}
+
+	return graphql.Time{Time: t}, nil
+}
+
+func (d *DeferredTransaction) Status() string {
+	if d.lifecycle.CanceledBy != nil {
+		return "CANCELED"
+	}
+
+	if d.lifecycle.ExecutionTrace == nil || d.lifecycle.ExecutionTrace.Receipt == nil {
+		return "PENDING"
+	}
+
+	switch d.lifecycle.ExecutionTrace.Receipt.Status {
+	case pbcodec.TransactionStatus_TRANSACTIONSTATUS_SOFTFAIL:
+		return "SOFT_FAIL"
+	case pbcodec.TransactionStatus_TRANSACTIONSTATUS_HARDFAIL:
+		return "HARD_FAIL"
+	case pbcodec.TransactionStatus_TRANSACTIONSTATUS_EXPIRED:
+		return "EXPIRED"
+	}
+	return "EXECUTED"
+}
+
+func (d *DeferredTransaction) CanceledByTrxID() *string {
+	if d.lifecycle.CanceledBy == nil {
+		return nil
+	}
+	return &d.lifecycle.CanceledBy.SourceTransactionId
+}
+
+func (d *DeferredTransaction) ExecutionTrace() *TransactionTrace {
+	if d.lifecycle.ExecutionTrace == nil {
+		return nil
+	}
+	return newTransactionTrace(d.lifecycle.ExecutionTrace, d.lifecycle.ExecutionBlockHeader, nil, d.root.abiCodecClient)
+}
@@ -0,0 +1,78 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"context"
+
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+	"github.com/zhongshuwen/histnew/trxdb"
+)
+
+type SearchTransactionsProjection struct {
+	ActionData bool
+	Receipts   bool
+	DBOps      bool
+}
+
+func (p *SearchTransactionsProjection) native() *trxdb.TraceProjection {
+	if p == nil {
+		return nil
+	}
+
+	return &trxdb.TraceProjection{
+		ActionData: p.ActionData,
+		Receipts:   p.Receipts,
+		DBOps:      p.DBOps,
+	}
+}
+
+// getTransactionTraces retrieves the execution traces of `idPrefix`, pruned by the trxdb read
+// when `projection` is set and the driver supports it, which is reported by `projected`.
+func (r *Root) getTransactionTraces(ctx context.Context, idPrefix string, actionIndexes []uint32, projection *trxdb.TraceProjection) (events []*pbcodec.TransactionEvent, projected bool, err error) {
+	reader, ok := r.trxsReader.(trxdb.ProjectedTracesReader)
+	if projection == nil || !ok {
+		events, err = r.trxsReader.GetTransactionTraces(ctx, idPrefix)
+		return events, false, err
+	}
+
+	rows, err := reader.GetProjectedTransactionTracesBatch(ctx, []string{idPrefix}, [][]uint32{actionIndexes}, projection)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return rows[0], true, nil
+}
+
+// project prunes the response's trace with `projection`, unless it was already pruned while
+// read from trxdb. The transaction receipt header is always kept, the trace status is derived
+// from it. The matching actions of a projected trace are all of its actions.
+func (t *SearchTransactionBackwardResponse) project(projection *trxdb.TraceProjection, projectedOnRead bool) {
+	if projection == nil || t.trxTrace == nil {
+		return
+	}
+
+	if !projectedOnRead {
+		receipt := t.trxTrace.Receipt
+		t.trxTrace, _ = projection.Apply(t.trxTrace, t.matchingActionIndexes)
+		t.trxTrace.Receipt = receipt
+	}
+
+	t.matchingActionIndexes = make([]uint32, len(t.trxTrace.ActionTraces))
+	for i := range t.matchingActionIndexes {
+		t.matchingActionIndexes[i] = uint32(i)
+	}
+	t.projected = true
+}
@@ -3,6 +3,7 @@ package resolvers
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/streamingfast/logging"
@@ -16,14 +17,31 @@ func init() {
 	ratelimiter.RegisterServices(services)
 }
 
+// quotaChecker are credentials with per-period quotas, like the ones of the `apikey` plugin
+type quotaChecker interface {
+	CheckQuota() error
+}
+
+// streamAcquirer are credentials limiting the number of concurrent streams, a stream
+// being closed when `onQuotaExceeded` is called
+type streamAcquirer interface {
+	AcquireStream(onQuotaExceeded func(err error)) (release func(), err error)
+}
+
 func (r *Root) RateLimit(ctx context.Context, method string) error {
+	creds := authenticator.GetCredentials(ctx)
+	if checker, ok := creds.(quotaChecker); ok {
+		if err := checker.CheckQuota(); err != nil {
+			return err
+		}
+	}
+
 	if r.requestRateLimiter == nil {
 		return nil
 	}
 
 	zlogger := logging.Logger(ctx, zlog)
 
-	creds := authenticator.GetCredentials(ctx)
 	userID := creds.GetUserID()
 
 	if !r.requestRateLimiter.Gate(userID, method) {
@@ -39,3 +57,53 @@ func (r *Root) RateLimit(ctx context.Context, method string) error {
 	}
 	return nil
 }
+
+// subscription is the concurrent stream reserved by a subscription resolver. Its context
+// is canceled when the credentials exhaust their documents quota, `Err` then telling why.
+type subscription struct {
+	ctx     context.Context
+	release func()
+
+	lock sync.Mutex
+	err  error
+}
+
+// startSubscription must be called by every subscription resolver, it reserves one of the
+// concurrent streams of the credentials, when they limit them. The subscription must be
+// released once the stream is over and the stream must end when its context is done.
+func (r *Root) startSubscription(ctx context.Context) (*subscription, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	sub := &subscription{ctx: ctx, release: cancel}
+
+	acquirer, ok := authenticator.GetCredentials(ctx).(streamAcquirer)
+	if !ok {
+		return sub, nil
+	}
+
+	release, err := acquirer.AcquireStream(func(err error) {
+		sub.lock.Lock()
+		sub.err = err
+		sub.lock.Unlock()
+
+		cancel()
+	})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	sub.release = func() {
+		release()
+		cancel()
+	}
+
+	return sub, nil
+}
+
+// Err returns the quota error that ended the subscription, if any
+func (s *subscription) Err() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.err
+}
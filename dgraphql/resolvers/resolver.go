@@ -48,6 +48,7 @@ import (
 	"github.com/zhongshuwen/histnew/trxdb"
 	zsw "github.com/zhongshuwen/zswchain-go"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
 )
 
 type AccounthistClient struct {
@@ -101,6 +102,7 @@ type SearchArgs struct {
 	Limit            types.Int64
 	Cursor           *string
 	IrreversibleOnly bool
+	Projection       *SearchTransactionsProjection
 }
 
 func (r *Root) QuerySearchTransactionsForward(ctx context.Context, args SearchArgs) (*SearchTransactionsForwardResponse, error) {
@@ -233,6 +235,8 @@ func (r *Root) querySearchTransactionsBoth(ctx context.Context, forward bool, ar
 		return nil, dgraphql.Errorf(ctx, "backend error")
 	}
 
+	projection := args.Projection.native()
+
 	var res []*SearchTransactionForwardResponse
 	for {
 		if ctx.Err() != nil {
@@ -265,6 +269,7 @@ func (r *Root) querySearchTransactionsBoth(ctx context.Context, forward bool, ar
 			Undo: match.Undo,
 		}
 
+		projectedOnRead := false
 		if eosMatch.Block != nil {
 			out.blockID = eosMatch.Block.BlockID
 			out.blockHeader = eosMatch.Block.BlockHeader
@@ -272,7 +277,8 @@ func (r *Root) querySearchTransactionsBoth(ctx context.Context, forward bool, ar
 		} else {
 			// FIXME: this should rather call a function like:
 			//    dbReader.GetIrreversibleTransactionTraces(ctx, idPrefix)
-			events, err := r.trxsReader.GetTransactionTraces(ctx, match.TrxIdPrefix)
+			var events []*pbcodec.TransactionEvent
+			events, projectedOnRead, err = r.getTransactionTraces(ctx, match.TrxIdPrefix, eosMatch.ActionIndexes, projection)
 			if err != nil {
 				if err != context.Canceled {
 					zlogger.Error("error retrieving raw transaction traces", zap.Error(err), zap.String("trx_id_prefix", match.TrxIdPrefix))
@@ -307,6 +313,7 @@ func (r *Root) querySearchTransactionsBoth(ctx context.Context, forward bool, ar
 			out.blockID = lifecycle.ExecutionTrace.ProducerBlockId
 			out.trxTrace = lifecycle.ExecutionTrace
 		}
+		out.project(projection, projectedOnRead)
 
 		zlogger.Debug("sending message", zap.String("trx_id", match.TrxIdPrefix))
 		res = append(res, out)
@@ -324,6 +331,7 @@ type StreamSearchArgs struct {
 	Limit              types.Int64
 	IrreversibleOnly   bool
 	LiveMarkerInterval commonTypes.Uint32
+	Projection         *SearchTransactionsProjection
 }
 
 func (r *Root) SubscriptionSearchTransactionsForward(ctx context.Context, args StreamSearchArgs) (<-chan *SearchTransactionForwardResponse, error) {
@@ -335,7 +343,12 @@ func (r *Root) SubscriptionSearchTransactionsForward(ctx context.Context, args S
 	analytics.TrackUserEvent(ctx, "dgraphql", "SubscriptionSearchTransactionsForward", "StreamSearchArgs", args)
 	/////////////////////////////////////////////////////////////////////////
 
-	return r.streamSearchTracesBoth(true, ctx, args)
+	sub, err := r.startSubscription(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.streamSearchTracesBoth(true, ctx, sub, args)
 }
 
 func (r *Root) SubscriptionSearchTransactionsBackward(ctx context.Context, args StreamSearchArgs) (<-chan *SearchTransactionForwardResponse, error) {
@@ -347,7 +360,12 @@ func (r *Root) SubscriptionSearchTransactionsBackward(ctx context.Context, args
 	analytics.TrackUserEvent(ctx, "dgraphql", "SubscriptionSearchTransactionsBackward", "StreamSearchArgs", args)
 	/////////////////////////////////////////////////////////////////////////
 
-	return r.streamSearchTracesBoth(false, ctx, args)
+	sub, err := r.startSubscription(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.streamSearchTracesBoth(false, ctx, sub, args)
 }
 
 type matchOrError struct {
@@ -355,7 +373,7 @@ type matchOrError struct {
 	err   error
 }
 
-func processMatchOrError(ctx context.Context, m *matchOrError, rows [][]*pbcodec.TransactionEvent, rowMap map[string]int, abiCodecClient pbabicodec.DecoderClient) (*SearchTransactionForwardResponse, error) {
+func processMatchOrError(ctx context.Context, m *matchOrError, rows [][]*pbcodec.TransactionEvent, rowMap map[string]int, abiCodecClient pbabicodec.DecoderClient, projection *trxdb.TraceProjection, projectedOnRead bool) (*SearchTransactionForwardResponse, error) {
 	zl := logging.Logger(ctx, zlog)
 	if m.err != nil {
 		return &SearchTransactionForwardResponse{
@@ -385,6 +403,7 @@ func processMatchOrError(ctx context.Context, m *matchOrError, rows [][]*pbcodec
 		out.blockID = eosMatch.Block.BlockID
 		out.blockHeader = eosMatch.Block.BlockHeader
 		out.trxTrace = eosMatch.Block.Trace
+		out.project(projection, false)
 		return out, nil
 	}
 
@@ -432,10 +451,12 @@ func processMatchOrError(ctx context.Context, m *matchOrError, rows [][]*pbcodec
 	out.blockHeader = lifecycle.ExecutionBlockHeader
 	out.blockID = lifecycle.ExecutionTrace.ProducerBlockId
 	out.trxTrace = lifecycle.ExecutionTrace
+	out.project(projection, projectedOnRead)
 	return out, nil
 }
 
-func (r *Root) streamSearchTracesBoth(forward bool, ctx context.Context, args StreamSearchArgs) (<-chan *SearchTransactionForwardResponse, error) {
+func (r *Root) streamSearchTracesBoth(forward bool, subscriberCtx context.Context, sub *subscription, args StreamSearchArgs) (<-chan *SearchTransactionForwardResponse, error) {
+	ctx := sub.ctx
 	zl := logging.Logger(ctx, zlog)
 	c := make(chan *SearchTransactionForwardResponse) // FIXME: should be buffered at least a bit
 
@@ -465,6 +486,7 @@ func (r *Root) streamSearchTracesBoth(forward bool, ctx context.Context, args St
 		LiveMarkerInterval: uint64(args.LiveMarkerInterval.Native()),
 	})
 	if err != nil {
+		sub.release()
 		zl.Error("failed StreamTransactionTraceRefs request", zap.Error(err))
 		return nil, dgraphql.Errorf(ctx, "internal server error: connection to live search failed")
 	}
@@ -487,12 +509,17 @@ func (r *Root) streamSearchTracesBoth(forward bool, ctx context.Context, args St
 	}, ctx)
 	//////////////////////////////////////////////////////////////////////
 
+	projection := args.Projection.native()
+	projectedReader, projectedOnRead := r.trxsReader.(trxdb.ProjectedTracesReader)
+	projectedOnRead = projectedOnRead && projection != nil
+
 	// this function converts search matchOrError into SearchTransactionForwardResponse
 	// by batching the lookup to kvdb
 	hammer := dhammer.NewHammer(30, 20, func(ctx context.Context, batch []interface{}) ([]interface{}, error) {
 		zl.Debug("inside hammer func", zap.Int("len_batch", len(batch)))
 
 		var prefixesToLookupInKvdb []string
+		var actionIndexesToLookupInKvdb [][]uint32
 		rowToIndex := map[string]int{}
 		for _, v := range batch {
 			m := v.(*matchOrError)
@@ -515,6 +542,7 @@ func (r *Root) streamSearchTracesBoth(forward bool, ctx context.Context, args St
 
 			if eosMatch.Block == nil {
 				prefixesToLookupInKvdb = append(prefixesToLookupInKvdb, m.match.TrxIdPrefix)
+				actionIndexesToLookupInKvdb = append(actionIndexesToLookupInKvdb, eosMatch.ActionIndexes)
 				rowToIndex[m.match.TrxIdPrefix] = len(prefixesToLookupInKvdb) - 1
 			}
 		}
@@ -526,7 +554,11 @@ func (r *Root) streamSearchTracesBoth(forward bool, ctx context.Context, args St
 
 		var rows [][]*pbcodec.TransactionEvent
 		if len(prefixesToLookupInKvdb) != 0 {
-			rows, err = r.trxsReader.GetTransactionTracesBatch(ctx, prefixesToLookupInKvdb)
+			if projectedOnRead {
+				rows, err = projectedReader.GetProjectedTransactionTracesBatch(ctx, prefixesToLookupInKvdb, actionIndexesToLookupInKvdb, projection)
+			} else {
+				rows, err = r.trxsReader.GetTransactionTracesBatch(ctx, prefixesToLookupInKvdb)
+			}
 			if err != nil {
 				return nil, err
 			}
@@ -535,7 +567,7 @@ func (r *Root) streamSearchTracesBoth(forward bool, ctx context.Context, args St
 		var out []interface{}
 		for _, v := range batch {
 			m := v.(*matchOrError)
-			resp, err := processMatchOrError(ctx, m, rows, rowToIndex, r.abiCodecClient)
+			resp, err := processMatchOrError(ctx, m, rows, rowToIndex, r.abiCodecClient, projection, projectedOnRead)
 			if err != nil {
 				return out, err
 			}
@@ -578,7 +610,15 @@ func (r *Root) streamSearchTracesBoth(forward bool, ctx context.Context, args St
 	var documentCount int64
 	go func() {
 		defer func() {
+			if err := sub.Err(); err != nil {
+				select {
+				case <-subscriberCtx.Done():
+				case c <- &SearchTransactionForwardResponse{err: dgraphql.Status(subscriberCtx, codes.ResourceExhausted, err.Error())}:
+				}
+			}
+
 			close(c)
+			sub.release()
 			if documentCount == 0 {
 				//////////////////////////////////////////////////////////////////////
 				// Billable event on GraphQL Subscriptions
@@ -679,6 +719,7 @@ type SearchTransactionBackwardResponse struct {
 
 	irreversibleBlockNum  uint32
 	matchingActionIndexes []uint32
+	projected             bool
 
 	//FIXME: shouldn't this be shared betweeen the two Single search responses?
 	ResolverError error
@@ -719,18 +760,23 @@ func (t *SearchTransactionBackwardResponse) Trace() *TransactionTrace {
 		return nil
 	}
 
-	return newTransactionTrace(
+	tr := newTransactionTrace(
 		t.trxTrace,
 		t.blockHeader,
 		t.matchingActionIndexes,
 		t.abiCodecClient,
 	)
+	tr.projected = t.projected
+	return tr
 }
 
 type TransactionTrace struct {
 	t                     *pbcodec.TransactionTrace
 	blockHeader           *pbcodec.BlockHeader
 	matchingActionIndexes []uint32
+	// projected is true when `t` was pruned by a `trxdb.TraceProjection`, the operations
+	// of its actions are then indexed by position instead of execution index.
+	projected bool
 
 	abiCodecClient pbabicodec.DecoderClient
 
@@ -775,8 +821,12 @@ func (t *TransactionTrace) flattenActions() (out []*ActionTrace) {
 		return *t.memoizedFlattenActionTraces
 	}
 
-	for _, actionTrace := range t.t.ActionTraces {
-		out = append(out, newActionTrace(actionTrace, t, t.abiCodecClient))
+	for i, actionTrace := range t.t.ActionTraces {
+		act := newActionTrace(actionTrace, t, t.abiCodecClient)
+		if t.projected {
+			act.opsActionIndex = uint32(i)
+		}
+		out = append(out, act)
 	}
 
 	for _, match := range t.matchingActionIndexes {
@@ -976,6 +1026,8 @@ type ActionTrace struct {
 	actionTrace *pbcodec.ActionTrace
 	trxTrace    *TransactionTrace
 	matched     bool
+	// opsActionIndex is the action index of this action's operations in `trxTrace`
+	opsActionIndex uint32
 
 	abiCodecClient pbabicodec.DecoderClient
 }
@@ -985,6 +1037,7 @@ func newActionTrace(actionTrace *pbcodec.ActionTrace, trxTrace *TransactionTrace
 		abiCodecClient: abiCodecClient,
 		actionTrace:    actionTrace,
 		trxTrace:       trxTrace,
+		opsActionIndex: actionTrace.ExecutionIndex,
 	}
 }
 
@@ -1089,7 +1142,7 @@ func (t *ActionTrace) ClosestUnnotifiedAncestorAction() (out *ActionTrace) {
 }
 
 func (t *ActionTrace) RAMOps() (out []*RAMOp) {
-	ramOps := t.trxTrace.t.RAMOpsForAction(t.actionTrace.ExecutionIndex)
+	ramOps := t.trxTrace.t.RAMOpsForAction(t.opsActionIndex)
 	out = make([]*RAMOp, len(ramOps))
 
 	for i, ramOp := range ramOps {
@@ -1105,7 +1158,7 @@ type DBOpsArgs struct {
 }
 
 func (t *ActionTrace) DBOps(args DBOpsArgs) (out []*DBOp) {
-	for _, dbOp := range t.trxTrace.t.DBOpsForAction(t.actionTrace.ExecutionIndex) {
+	for _, dbOp := range t.trxTrace.t.DBOpsForAction(t.opsActionIndex) {
 		if args.Table != nil && *args.Table != "" && dbOp.TableName != *args.Table {
 			continue
 		}
@@ -1120,7 +1173,7 @@ func (t *ActionTrace) DBOps(args DBOpsArgs) (out []*DBOp) {
 	return
 }
 func (t *ActionTrace) DTrxOps() (out []*DTrxOp) {
-	dtrxOps := t.trxTrace.t.DtrxOpsForAction(t.actionTrace.ExecutionIndex)
+	dtrxOps := t.trxTrace.t.DtrxOpsForAction(t.opsActionIndex)
 	out = make([]*DTrxOp, len(dtrxOps))
 
 	for i, dtrxOp := range dtrxOps {
@@ -1130,7 +1183,7 @@ func (t *ActionTrace) DTrxOps() (out []*DTrxOp) {
 	return
 }
 func (t *ActionTrace) TableOps() (out []*TableOp) {
-	tableOps := t.trxTrace.t.TableOpsForAction(t.actionTrace.ExecutionIndex)
+	tableOps := t.trxTrace.t.TableOpsForAction(t.opsActionIndex)
 	out = make([]*TableOp, len(tableOps))
 
 	for i, dtrxOp := range tableOps {
@@ -16,6 +16,7 @@ package resolvers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"testing"
@@ -27,6 +28,7 @@ import (
 	"github.com/streamingfast/logging"
 	pbsearch "github.com/streamingfast/pbgo/dfuse/search/v1"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/streamingfast/dauth/authenticator"
 	"github.com/streamingfast/dgraphql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -159,7 +161,10 @@ func TestSubscriptionSearchForward(t *testing.T) {
 				trxsReader:   trxdb.NewTestTransactionsReader(test.fromDB),
 			}
 
-			res, err := root.streamSearchTracesBoth(true, ctx, StreamSearchArgs{})
+			sub, err := root.startSubscription(ctx)
+			require.NoError(t, err)
+
+			res, err := root.streamSearchTracesBoth(true, ctx, sub, StreamSearchArgs{})
 			if test.expectError != nil {
 				require.Error(t, err)
 			} else {
@@ -174,3 +179,66 @@ func TestSubscriptionSearchForward(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchTransactionBackwardResponse_project(t *testing.T) {
+	resp := &SearchTransactionBackwardResponse{
+		trxIDPrefix: "trx1",
+		trxTrace: &pbcodec.TransactionTrace{
+			Id:      "trx1",
+			Receipt: &pbcodec.TransactionReceiptHeader{Status: pbcodec.TransactionStatus_TRANSACTIONSTATUS_EXECUTED},
+			ActionTraces: []*pbcodec.ActionTrace{
+				{Receiver: "zswhq", ExecutionIndex: 0, Action: &pbcodec.Action{Account: "zswhq", Name: "newaccount"}},
+				{Receiver: "zswhq.token", ExecutionIndex: 1, Action: &pbcodec.Action{Account: "zswhq.token", Name: "transfer"}},
+			},
+			DbOps: []*pbcodec.DBOp{
+				{ActionIndex: 0, TableName: "accounts"},
+				{ActionIndex: 1, TableName: "stat"},
+			},
+		},
+		matchingActionIndexes: []uint32{1},
+	}
+
+	resp.project(&trxdb.TraceProjection{DBOps: true}, false)
+
+	trace := resp.Trace()
+	assert.Equal(t, "EXECUTED", trace.Status())
+
+	matching := trace.MatchingActions()
+	require.Len(t, matching, 1)
+	assert.Equal(t, "zswhq.token", matching[0].Receiver())
+	assert.Equal(t, matching, trace.ExecutedActions())
+
+	dbOps := matching[0].DBOps(DBOpsArgs{})
+	require.Len(t, dbOps, 1)
+	assert.Equal(t, "stat", dbOps[0].op.TableName)
+}
+
+func TestStartSubscription_QuotaExceeded(t *testing.T) {
+	creds := &testStreamCredentials{}
+	ctx := authenticator.WithCredentials(context.Background(), creds)
+
+	sub, err := (&Root{}).startSubscription(ctx)
+	require.NoError(t, err)
+	assert.NoError(t, sub.Err())
+	assert.NoError(t, sub.ctx.Err())
+
+	creds.onQuotaExceeded(errors.New("quota exceeded"))
+	assert.EqualError(t, sub.Err(), "quota exceeded")
+	assert.Error(t, sub.ctx.Err())
+
+	sub.release()
+	assert.True(t, creds.released)
+}
+
+type testStreamCredentials struct {
+	onQuotaExceeded func(err error)
+	released        bool
+}
+
+func (c *testStreamCredentials) GetUserID() string         { return "user" }
+func (c *testStreamCredentials) GetLogFields() []zap.Field { return nil }
+
+func (c *testStreamCredentials) AcquireStream(onQuotaExceeded func(err error)) (release func(), err error) {
+	c.onQuotaExceeded = onQuotaExceeded
+	return func() { c.released = true }, nil
+}
@@ -1,5 +1,6 @@
 // Code generated by go-bindata.
 // sources:
+// abi.graphql
 // accounthist.graphql
 // block.graphql
 // blockmeta.graphql
@@ -77,6 +78,26 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
+var _abiGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9d\x54\x3d\x6f\xdb\x30\x10\xdd\xf5\x2b\x2e\x5a\xba\x18\x19\xda\xcd\x5b\xd2\xb4\x40\x8a\x22\x1d\xe2\x76\x29\x0a\x98\x22\x4f\x11\x11\x8a\x34\xc8\x53\x54\xa3\xe8\x7f\xef\xf1\x43\x91\xed\x5a\x49\x51\x0f\x06\xc5\x3b\xbe\x7b\xf7\xee\x91\x75\x5d\x57\x57\xf0\x84\x3e\x68\x67\xc1\xb5\x40\x1d\xc2\xd5\xf5\x6d\x5c\x0a\x0b\x42\x4a\x37\x58\x5a\x81\x08\x10\x90\xa0\xd9\x83\x80\x2d\xaf\x44\xa3\xb7\x1c\x25\x3e\x75\x59\xd5\x0c\x42\xfb\x5d\x3a\xf8\xad\x40\xfd\xaa\x80\x7f\xf5\xb5\x71\xf2\x11\xec\xd0\x37\xe8\xa1\xf5\xae\x87\xb1\xd3\xb2\xe3\x32\x3a\x9c\x2b\x2b\x76\x3b\xa3\x31\xd4\xe9\x74\x20\xe1\x29\x21\xdc\x0d\xfd\x1a\xbe\x6a\x4b\xef\xde\x5e\x54\x19\xf9\xb3\x08\xcc\xe7\x10\x7e\xec\xd0\xe3\x6b\xc8\x2b\xd8\xda\xc1\x98\x6d\xcc\xb6\xa0\xe9\x4d\x48\x19\x72\xf0\x1e\x2d\x4d\xad\xc7\xad\xd2\x7b\xa6\x82\x56\x9d\x12\x29\x3c\x36\x5e\xd8\x90\x95\x80\xdb\x1b\xe8\x9c\x51\xda\x3e\x24\x84\x53\xa1\xe0\x43\xbf\xa3\x3d\xb4\xce\xc7\x3a\x01\xa4\x90\x1d\xaa\x24\x6a\xa3\xa5\x53\x28\xa1\x41\x8e\x72\x13\x47\xa0\x01\x46\xcc\x9b\xf2\x11\xd5\x0a\x14\x1a\xa4\xa9\xc8\xf3\xd1\x84\x06\xc1\xe5\xa6\xc2\xde\x4a\xc6\x16\x0f\x42\xdb\x2c\x7c\x4c\x4e\x7a\x05\x68\xb5\x31\xa9\xef\xfe\x32\xb7\x47\xfe\xe7\xed\xcd\x1a\xee\xc9\x33\xec\xa4\xf0\xa6\x28\xa7\x29\xa0\x69\x57\xe0\xac\xd9\x83\x47\x1a\xbc\x65\xe4\xa4\xdf\x76\xd4\xd4\x71\xce\x16\x74\x32\x48\x46\x63\x4a\x6b\xf8\x74\xff\xe5\xae\xfa\x5d\x25\x73\x44\xa4\x40\x7e\x90\x7c\x56\x18\x50\xba\x6d\xb9\x21\x26\x18\xb8\x61\x1a\x91\x91\x68\x74\xd3\xd4\xc2\xa2\x0f\x2f\xe1\x4e\xf4\x7c\x48\xf8\xd8\xa8\x27\x54\x95\x30\xbb\x4e\x30\x88\x96\xc2\x98\xfd\xb1\x17\x6f\xb8\xce\x59\x23\x0a\x7a\xb6\x61\xae\x22\x5d\xbf\x63\x50\x55\x2c\x2a\x0e\x9a\x89\x3b\x9c\xb2\x64\xc3\x7f\xc4\x25\x77\x8c\x4a\x6e\x19\x53\x28\x85\xea\x2a\x0d\x3f\xac\xe1\x7b\x19\xca\x8f\x8b\x14\xf4\xd8\xbb\xa7\xe5\x70\x5d\x02\xcc\xc3\x85\x49\x74\x48\x82\xc8\x4e\xd8\x07\x54\xb9\x7e\xf9\x98\x61\x98\xce\x86\xb3\xde\xa7\xfd\x88\x36\x53\xd9\x88\xc6\xe0\x22\x93\x85\x68\x9d\xf7\x0b\x0f\xef\xc6\x57\xb9\x3c\x03\xbd\x40\xe5\x3e\x61\x2c\x72\x59\x0a\xd7\x25\x50\xd8\x34\x82\xff\xf8\x12\xb6\x1a\x8d\x0a\x67\xc9\xcc\x48\xcc\x26\x7f\xcc\x7c\xd8\xd5\x93\xc5\x66\xa2\x93\xd1\xa2\x43\xe7\x27\x24\xca\xbb\x02\x8a\xad\xc5\x8a\x45\x83\x54\x38\xd7\xb3\x9c\x3e\x5f\xbc\xc9\x70\x11\xf6\x78\x97\xdc\xf1\xde\x01\x87\x43\x7a\xe7\x58\xe4\xa2\xe7\xca\x15\x0b\x8b\xd9\x29\xd3\xfb\xd3\x4d\x33\x5a\x01\xa6\x47\x2b\x5d\xf7\xf4\x84\xc4\x6c\xa5\x15\x58\x47\x25\x67\xbe\x28\x11\xea\x25\x78\xd1\x12\x5f\x93\xff\x41\x27\x77\x06\x3b\x79\xe2\x63\x9a\x62\x9e\x54\x5a\x9f\x98\x62\x31\x5e\x46\x7d\x18\x3f\xf6\xdd\x81\xc6\x29\xa9\x88\xfb\xf7\xc8\xe8\x64\x34\x7f\x00\x52\x2b\x10\x39\x56\x07\x00\x00")
+
+func abiGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		_abiGraphql,
+		"abi.graphql",
+	)
+}
+
+func abiGraphql() (*asset, error) {
+	bytes, err := abiGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "abi.graphql", size: 1878, mode: os.FileMode(436), modTime: time.Unix(1792396620, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _accounthistGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x4f\x73\xdb\xc6\x0f\xbd\xf3\x53\xc0\xf2\x21\x76\x46\xd6\xe1\xf7\xeb\xf4\xa0\x5b\x52\xa7\x89\x3a\xa9\x9d\xc4\x6e\x33\xd3\x4c\xc7\x5c\x2d\x41\x72\x13\x12\xcb\x2c\x96\x96\xd4\x4e\xbe\x7b\x07\xbb\x4b\x8a\xb2\xe5\xa6\x07\x5b\xe4\xfe\x79\x78\x00\x1e\x00\x9e\x66\xa7\x00\x1f\x90\x3b\x4b\x8c\x0c\xa5\x75\xf0\xbe\x47\xb7\xcb\x4e\xb3\xcc\xef\x3a\x84\x17\x5a\xdb\x9e\xfc\x1b\xc3\xde\xba\xdd\x0b\xed\x8d\x25\xfe\xc9\x12\x61\x78\x84\xbf\x33\x00\x2c\x2a\xe4\x25\x7c\xba\x31\x6d\xd7\x60\x3c\x73\xeb\x94\xc6\x57\x45\x85\x27\x7f\x9e\x64\x00\x9d\xaa\x70\x45\xa5\x5d\xc2\xbb\xf4\x74\x92\x9d\xc2\x15\x6e\x3d\xb0\xc7\x0e\x0c\xc1\x6b\xa7\xba\xfa\xfd\xdb\x8b\x46\x51\xb1\x14\x5a\x64\x8b\xe3\xb0\x02\xf9\x2d\xf1\x3b\x6a\x33\xb0\xd2\xbd\x63\xeb\x96\x70\xe3\x9d\xa1\x4a\x48\x08\xe0\xf2\xf1\x8d\x00\x36\x9b\xcd\xb2\x47\x3b\x50\xdb\xa6\x60\xf0\x35\x82\x97\x77\x06\x5b\x02\x6e\x51\xf7\xc1\x77\x5b\x82\x02\x36\x54\x35\x08\x2a\x86\x63\x63\x7c\x6d\x08\x94\x9c\x27\x8e\x8b\x73\x58\xf7\x1e\xb4\x25\xaf\x0c\x31\x34\xc8\x1c\xde\x70\xeb\x7b\xd5\x80\xa1\xd2\xba\x56\x85\xeb\xa5\xb3\xed\x60\x6e\xb8\x0e\xbe\x56\x04\x8a\x60\x42\x6c\x91\x65\xb7\xb5\x61\xb0\xeb\xcf\xa8\x3d\x18\x86\x9e\xb1\x08\xd6\xc3\xf5\xbc\x42\x9f\x72\x95\xc7\x84\x2e\x82\x87\xc7\x43\x16\xc2\x75\x2a\x7f\xf0\xb2\xb1\xfa\xcb\xc0\x2e\xac\x66\x00\xb3\xb8\x4a\x7d\xbb\x46\x27\xa9\xda\xd4\x46\x8b\x21\xc3\xa3\xdf\x8a\xa1\x73\x56\x23\x33\x16\xb3\x0c\x60\x2d\x57\xae\xfa\x76\x09\xbf\x19\xf2\x3f\xfe\x70\x32\x01\x5a\x5d\xfe\x07\x90\xc5\x88\xb2\xba\xdc\x27\x51\x40\x6e\x4d\x8b\xa0\xfc\x08\x80\xf1\xd8\x70\xbd\xe8\x35\x16\x73\x50\x5a\x5b\x57\x18\xaa\xc0\xdb\xc9\xa1\x74\xc0\x8d\xe8\x82\xb6\x04\xf9\x1f\xd0\x63\x18\x6e\x27\xe1\x7f\x18\x8c\xe9\xde\xea\x12\xac\x33\x95\x21\xd5\x34\xbb\xa0\x96\x60\x70\xef\x93\x98\xf1\x6e\xfb\xc0\x85\x68\x24\x66\x00\x0a\xe5\xd5\x08\x3e\x9b\xbd\x6e\xec\x5a\x35\xc0\xf8\xb5\x47\xd2\x28\x26\xa4\x2a\xa7\x71\x32\x14\x5f\x75\xad\x0c\xc1\x19\xd7\xd6\x89\x46\x8a\x70\xd0\xa1\x46\xd3\xf9\x45\x15\x70\xee\x06\x9c\xf3\x85\xe4\x1f\x04\xf7\x30\x25\xb3\xd9\x1f\xe8\xec\xc5\x5a\x89\x80\x0c\x15\xb8\x15\x5d\x1f\xa4\x25\x6a\xfa\x81\x2c\xe7\x42\xe3\x6e\x2c\x86\x3b\xb0\xae\x40\x97\xac\x8c\xcb\x2b\x01\x8c\x06\xff\xff\xbf\xc1\x60\x72\x3c\x31\x85\xb3\x98\xc8\xc2\x94\x25\x3a\xde\xd7\xc0\x34\xd0\xe9\xec\xf9\x42\x20\x6e\x6b\x1c\x2f\x6f\x4c\xd3\xc0\x1a\x81\xfa\xa6\x81\x4d\x8d\x04\xa5\x32\x4d\xef\xa4\x56\xb5\xee\xdd\x02\x7e\x47\x67\xca\x5d\x2c\x0b\xf6\xca\xf7\x9c\x67\x00\xa5\xc1\xa6\x00\x4b\x0f\x2d\xc5\x82\x88\x75\xb5\x48\x7c\x33\x18\xcc\x2d\x53\xd2\x3e\xc4\xd7\xfd\x7e\x6a\x93\x49\x93\xda\x16\x18\xe4\x18\xe3\x80\x85\x68\x30\x29\x2f\x86\x76\xdf\x44\x7c\xaa\x67\x80\x9b\xa3\x79\x0c\xbf\xf7\xe8\x0e\xa9\xdc\xa3\x3b\x2c\x8a\xd9\xec\x56\xb9\x0a\x3d\xb4\xe8\x6b\x5b\x3c\xe3\x50\x00\x42\x89\x54\x8b\x0b\x09\x9a\x61\x28\x2c\x32\x3c\x27\xeb\x9f\x83\xb6\xce\x85\xbe\x5f\x0c\x05\x22\x42\x77\x4a\xfb\x48\x7f\x8d\x22\xe5\xc1\x81\xb9\x74\xa1\xd0\x68\x36\xf2\x9b\x0f\x24\x72\x68\x51\x11\x0f\xf8\xf7\xaa\xe9\x31\x58\xe4\x2e\xf4\xcb\x9d\xed\x5d\xd2\x11\x1f\x71\x52\x69\xbf\x18\x78\x1a\x82\x5c\x3a\xb4\xe5\x3c\x75\xdb\xe8\x6f\xda\xff\xbe\xbb\x21\x9c\xd1\xdb\x3d\x99\x39\x68\xdb\xae\x0d\x1d\x74\xc7\x04\x99\xcf\xa1\x40\x8f\xae\x35\x84\x3c\x4d\x5d\xa7\x7c\x3d\x2a\x6b\xcc\x61\xaa\x81\x89\xef\x07\x11\x7b\xc2\x3d\x21\x74\xc4\xb7\x54\x28\xb2\xfb\xd0\xb3\x1b\x53\x91\xf2\xd6\x19\x64\x70\xf8\xb5\x37\x2e\x0a\x28\x11\x99\xd6\xe6\x11\x9b\x79\x88\x69\xef\x6b\xeb\xcc\x5f\x61\xaa\xe4\x4f\x9b\x3f\x38\xb7\x84\x4f\xef\x24\x18\xcc\xc6\xd2\x5b\xbc\xc7\x46\x06\x6d\xe4\x74\xa9\xbc\x82\x4e\xed\x1a\xab\x8a\x05\xfc\x6a\xaa\xda\x4b\x6c\x14\x70\xa0\x0e\xe2\x2c\xfc\x72\x73\x7d\x95\x4a\x47\x22\xdb\x21\x85\x76\x28\x2d\xa4\x46\x5f\xcb\xec\xf0\xb1\x4b\x5b\x66\xb3\x6e\x50\xbc\xea\xa9\x53\xfa\x8b\xec\xf4\x32\x4a\xc3\xa0\x7b\xb9\x7a\xca\x31\x69\x96\xff\xe2\x8f\x6c\x2f\x03\x8f\xc4\x3b\x50\xba\x8e\x53\xd2\x61\xe7\x90\x91\x7c\xec\xd1\xe3\xcc\xee\x94\x53\xad\xe8\x80\x85\xb5\xa4\xb2\x00\x5f\x3b\xdb\x57\x51\x2e\x42\x07\x3e\x26\x35\xe4\xd2\x68\x72\x30\x65\xf2\x85\x9e\xf9\x03\x77\x22\x00\x18\x7f\x5c\x0e\x92\xb3\xcf\x6c\x29\xd2\x95\xa7\x03\xba\x6f\x70\x7b\x81\x14\x29\xa4\xc8\x3e\x62\xed\xd4\x26\xf8\x99\xc6\x02\x7e\x47\x0a\x35\x6e\xef\xbe\x13\xb5\x1a\xb7\x97\x21\x70\x87\x3a\xbc\xee\x7d\xd7\xfb\x38\x0d\x06\x2b\xcf\x18\xf2\xce\x19\xf2\x67\xe7\x39\x48\x37\xc5\x16\xc9\xa7\xa6\x3d\x19\x14\xdc\x2a\xe7\xc7\xfa\x48\x76\xb4\x25\xb6\xcd\x23\xbd\x7f\x4c\xe2\x38\x18\x71\x0c\x6a\x98\xbc\x17\xa5\xc3\xd1\xcb\x11\x49\x76\x7e\x76\x88\x4b\x78\x69\x6d\x83\x8a\x22\xdc\x93\x60\x64\xbd\x29\x8d\x56\x71\x76\x49\xd7\x8a\x01\x95\xae\x16\x55\xe9\x9d\xa9\x2a\x94\x62\x53\x1c\x3e\xec\x0a\x04\x2c\xcb\x20\x1d\xdb\x82\x22\x1b\x90\x07\xa7\xe6\x20\x71\xd6\xb6\x33\x41\x2f\xd2\x28\xa4\x15\xb0\xd4\x7b\xaa\x94\xfd\x2c\x1b\xbe\x12\xa6\x13\x74\x68\x9a\xb5\xea\x3a\x24\x8e\xd3\x6b\x67\x7b\xd0\xaa\x69\x86\x56\x13\xca\xff\x2e\x90\xdf\xe5\xa9\xdb\xed\x61\x5f\x5d\xdf\xac\xae\xc1\x90\xc4\x93\x8d\x66\x38\x9b\x26\x42\xc5\x34\x5c\x0c\x8c\xcf\xc3\x37\x95\xe1\xab\x80\x36\x09\xdc\xb7\xec\x9f\x00\x00\x00\xff\xff\x19\xa3\xc5\x8a\x08\x0c\x00\x00")
 
 func accounthistGraphqlBytes() ([]byte, error) {
@@ -117,7 +138,7 @@ func blockGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _blockmetaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8e\xcd\x4a\xc6\x30\x14\x44\xf7\xf7\x29\xa6\xd9\xbb\xd1\x5d\x77\x55\x4b\x11\x2a\xd5\x5a\x1f\x20\xda\x5b\x0d\x36\x49\x4d\x6e\x10\x91\xbe\xbb\xf4\x07\xb1\xe2\xb7\x1a\x38\x9c\x19\x46\x3e\x27\xc6\xe5\xe8\x9f\xdf\x6e\xae\x5b\x8e\x93\x77\x91\xf1\x45\x00\xa0\x94\xda\x52\x42\x62\x05\x33\x40\xe3\x69\x31\x61\x7a\x7c\xe8\x88\xc1\x27\xd7\x1f\x54\x31\x96\x73\x74\xc6\x72\x46\xc7\x8d\x3d\x5d\xb2\x39\x1e\x8d\x93\x8b\xf3\x13\x86\xe9\x73\x3c\x48\x30\xee\x25\xa3\x99\x88\x5d\xb2\xb8\x6a\x6e\xef\x8a\xb6\xe8\x9a\x76\xbd\xa6\xaa\xc0\x5a\x38\x9c\xc9\xab\x76\xf0\x01\xfc\x9e\xf4\x08\xf1\xcb\x42\xd5\x95\xf4\xd7\xd9\xf8\x8a\x6b\x8e\xf1\xdf\x5e\xbd\xf7\x7e\x84\x0d\xae\xac\xfc\xe5\x95\xf7\x34\xd3\x77\x00\x00\x00\xff\xff\x98\x77\x32\x21\x36\x01\x00\x00")
+var _blockmetaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x95\x92\x4f\x4f\xc2\x40\x10\xc5\xef\xfd\x14\xd3\x9e\xd5\x83\xde\x7a\x43\x20\xa4\x09\x02\x62\x3d\x19\x13\x96\xed\x94\x6e\xa4\xbb\xb8\xbb\x0d\x1a\xe3\x77\x77\xff\x15\x4b\x81\x44\x39\xd0\x32\xbc\xf9\xed\x9b\x7d\xa3\x3f\x77\x08\xf7\x5b\x41\xdf\xb2\xd1\x12\xd5\x4e\x70\x85\xf0\x15\x81\xf9\x24\x49\xe2\x9f\x5a\x36\x98\x00\x2b\x81\xc0\xda\x2a\x81\x15\xb0\x27\x0a\x4a\xd1\xf0\xe2\x48\xaa\x59\x8d\x29\xe4\xe6\x3b\x8e\x8e\x19\xe1\xc9\x9b\x3a\x85\x67\xc6\xf5\xdd\xed\x05\x05\x2b\x52\x78\xd2\x92\xf1\x4d\x1c\x7d\x47\x91\x2d\xe7\x15\x02\x95\x48\x34\x13\x1c\x84\xb1\xc1\x81\x50\x6a\x0e\xd7\x57\x60\x6c\x48\xa4\x42\x16\x58\x40\x29\x45\x0d\x4c\x2b\x58\x71\xdc\x07\xc5\xca\x48\x6d\xdf\x8d\x23\x69\x3b\xed\xc0\xff\x33\x6c\x89\x61\xda\x19\xa9\xd1\xd2\x75\x7b\x9a\x21\x06\x88\x77\x16\x7e\xfc\xda\x3b\xed\x0b\x12\xf3\x4e\xf4\x01\xb2\x6a\xad\x78\x8c\x2b\x0b\xd9\xc7\xb8\x0c\xec\xfd\xac\x51\x02\xe3\xb0\xaf\x18\xad\x8e\xa0\xf6\xca\x03\xd3\x93\x5c\x18\xb3\xd3\x1b\xf5\xa8\x6c\xf4\x1f\x4c\x36\xea\x1b\xb2\x29\xb6\x73\x85\xd8\xff\x8e\xcb\x4f\x16\x21\x97\x84\x2b\x9f\x85\xb5\x56\x89\x6d\x61\x0e\x73\xa8\x33\x71\x85\x75\x92\x1f\x5d\x5f\x66\x1d\xce\x05\xa8\x86\x82\x73\xa4\x9d\x2c\xb1\xd8\xa0\x4a\xe1\xa5\x27\x1c\x9b\x72\xfc\x1a\x3b\xc9\x8e\x6c\x30\xe3\xa5\x48\x61\x11\xde\x2e\xf2\x6d\x5b\x00\xd3\x46\xaa\x6e\x74\x6e\xa5\x45\x61\x46\xed\xf5\x38\x18\x9a\x34\x61\x38\x7f\x58\x0c\x96\x83\x7c\xbe\x74\x8c\x64\xe2\xee\x4a\x5e\x9b\x15\x31\xcb\x2c\x01\xdf\x1b\xb2\x05\x2d\xec\xc4\x93\x7c\x1c\xf5\x35\xbe\xee\xca\x53\x54\xea\x6c\xdf\x34\xf4\x1d\x04\xbe\xe8\x6a\xe3\x8e\x6e\xfc\x68\x6c\xfd\x00\xe3\xfc\xee\x36\xf2\x03\x00\x00")
 
 func blockmetaGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -132,12 +153,12 @@ func blockmetaGraphql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "blockmeta.graphql", size: 310, mode: os.FileMode(436), modTime: time.Unix(1587588369, 0)}
+	info := bindataFileInfo{name: "blockmeta.graphql", size: 1010, mode: os.FileMode(436), modTime: time.Unix(1792384393, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _queryGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x41\x6f\xdb\xc6\x12\xbe\xeb\x57\x4c\xfc\x2e\x76\x20\x0b\xb2\x5f\x92\x83\x80\x1c\x24\x45\x2f\x16\x9e\x2d\xb5\xb2\xda\x00\xb9\x58\x23\x72\x48\x2e\xb2\xdc\x65\x76\x97\x52\x98\xa2\xff\xbd\x98\xdd\xa5\x44\xbb\x36\x1a\xb4\x29\x92\x43\x72\x89\x24\xce\xee\x7c\xf3\xcd\x37\xdf\x98\xae\xa9\x08\x7e\xae\xc9\x34\xf0\x5b\x0f\xe0\xe4\xe4\xa4\x07\xf0\x6e\xbc\x5a\xcc\x17\x6f\x47\xb0\x2e\x84\x05\x61\x01\x61\x32\x5b\x8f\x43\xdc\x00\xe6\x6b\xb8\x99\xbf\xbd\x5a\xc3\xed\x7a\x7e\x7d\x0d\xd3\xab\xf1\xe2\xed\x6c\xd0\xeb\x01\xac\xc8\x19\x41\x3b\x02\x57\x10\x48\xb4\x0e\x30\x71\x42\x2b\xdb\x07\x57\xa0\x03\x34\x04\xc2\x18\xda\x91\xb1\x62\x2b\xa9\x0f\xa8\x52\xff\x68\xd4\x03\xb8\x38\x03\xa5\x9d\xc8\x04\xa5\x80\x0a\x30\x49\x74\xad\x5c\x1f\xb4\xe9\x01\x5c\x9e\xc1\x1e\x2d\x60\xed\x0a\x6d\xc4\x67\x4a\x61\xdb\x74\xa2\x62\x7a\x5b\x4b\x67\x7d\x9a\xbb\x98\xf9\xae\x0f\x86\x5c\x6d\x14\xa5\x20\x14\x84\xdc\x04\xda\xa4\x64\xe0\x34\x33\xba\x04\x43\x09\x29\x07\x4e\x83\x96\xfc\x6b\x3c\x79\xe6\xef\x5c\x2c\xd7\xb3\x11\xd4\xb6\x46\x29\x9b\xbe\x2f\x6c\x8b\xc9\x07\xa1\x72\xb0\x64\x76\x22\x21\xd0\x19\x38\x26\xaa\x24\x57\xe8\x14\x0a\x2d\x53\xa6\xec\xce\x99\x5a\x25\xe8\x28\xbd\x83\xbd\x50\xa9\xde\x73\x64\x21\xac\xd3\xa6\xe9\x43\xe5\x33\x75\xc1\x63\xea\xaf\xdf\x24\xb5\xb1\xda\x6c\x18\x2e\x7f\x37\x64\x2b\xad\x6c\x24\xab\x42\x6b\x41\x38\x0f\x82\x21\x1f\xa2\x9d\x86\x44\x2b\x27\x54\x4d\x50\x61\x2e\x14\x3a\xa1\xf2\x41\xa7\x87\xb6\xd0\xc6\x9d\x4b\xb1\xa3\x14\xc2\xa9\x3e\x90\xad\x28\x11\x5c\x1b\xa0\xf5\xe9\x22\x6a\xa1\x55\x8b\x3a\xd7\x64\x61\xdb\x0c\x0e\xfa\xc8\xc9\x8d\x03\xf2\xab\x50\xcd\x38\x30\x76\xda\x03\x00\x38\x89\xcf\x40\x61\x49\x0c\xeb\xa3\x97\x57\xa6\x0f\xcc\x9e\xf8\xb8\x58\xfc\x08\x6e\x9d\x11\x2a\x7f\xd6\x0b\xa7\xa7\x5a\x39\x83\xc9\x5f\x1e\x4f\x62\x5c\x7b\x3e\x1e\xbf\xc1\x4f\xa2\xac\x4b\x50\x75\xb9\x25\xc3\x8c\xc7\x53\xf7\x64\xe0\xfb\x55\x61\x4e\x03\x80\x1b\xfc\x04\x52\x94\xc2\x01\x4a\xa9\xf7\x94\xfa\x5c\x3e\x22\x41\x29\x99\xbb\x8b\xe1\x70\x18\xb2\xfa\xc0\x11\xcc\x95\x7b\xf5\x02\x5e\xf3\x83\x98\x77\x59\x71\x16\x94\x91\xd9\x7b\xed\xd8\x17\x64\x08\x1a\x5d\x83\xa4\xcc\x81\xce\xb2\x3e\x38\xfc\x40\x0a\xa2\xfe\x82\x6c\x19\x2b\x54\x86\x76\x42\xd7\x31\xb7\xd3\x01\xc8\xc6\x53\xee\xeb\xd8\x04\x42\x06\x91\x05\x9f\xed\xc0\x01\xc0\xd9\x08\x1e\xed\xcd\x54\x2b\x45\xfe\xe3\xb3\x5e\x84\x7c\x12\xae\xb8\x25\x34\x49\x11\x94\x2d\x75\xf2\x21\x29\x50\x28\xe6\x60\x8f\x26\x72\x61\x50\xd9\x40\x23\x3c\xa7\x4f\x94\xd4\xfe\x23\xd3\x4f\xf6\x39\x6c\xd1\x52\x0a\x5a\xc1\xc6\x23\xdb\x0c\xc2\xfd\xef\x0a\x6a\x05\x1c\x89\x3f\x2a\xdb\x02\x95\x95\x6b\xfa\xac\xe4\x92\x50\x59\xcf\x4e\x81\x3b\x8e\xc6\xa4\xa0\x30\x0a\xa4\xd2\x30\x5d\x04\x5e\xa7\xde\x1a\x3c\x48\x30\xa8\x72\x6a\x33\x8d\x57\x8b\x11\xa0\xdc\x63\x63\x99\x75\x2b\x78\x8c\xfd\x2c\xd5\x2a\xd5\x1b\xc8\x04\x49\xdf\xf7\xb6\x2a\xeb\x6b\x26\xdb\x87\x7d\x21\x92\x02\xac\xc8\xb9\x77\xde\xa4\xf8\x5c\x89\x2e\x29\x78\xc6\x49\x52\xc9\xe6\xc0\xde\xc3\xe7\x59\x98\xab\xd9\xcd\xf2\xd7\xd9\x9b\xd0\x3c\x8e\x0e\x8c\x6d\x29\xc1\xda\x7a\x3b\xf0\x10\x59\x71\xda\xe4\xa8\xc4\x67\x3f\x4e\x11\xec\x2d\x11\xa0\xb4\x3a\x54\xe5\x0c\x61\xc9\x89\xbc\x25\x6a\x05\xb5\x62\xec\x9b\xdb\x7a\x6b\x13\x23\xbc\xa8\x36\xf7\xda\x15\xa0\xaf\x8f\x2d\xb1\xff\x0b\x45\x85\xe9\xf3\xa1\x69\xc6\x40\x62\x63\x83\xbb\x5f\xa3\xca\x6b\xcc\x7d\x4a\xa1\xf2\x93\x43\xb0\xef\xd9\x83\x21\xf4\x97\x5c\xeb\x3d\x99\xc8\xb6\xaa\x4b\xd8\xea\x5a\xa5\xc8\xd6\x25\x54\x22\x6b\x2b\x76\x24\x9b\x01\x8c\x41\x51\x8e\x4e\xec\x08\x76\x28\x6b\x8a\xfd\xc4\xb6\x4f\x24\xc3\x43\x17\x2a\x2e\xd8\xe6\xb4\x09\xdb\xa1\xbb\x0b\x62\xfc\x69\x4a\x15\xa9\x94\x29\x61\x45\x75\x23\x96\x4a\x36\x9b\xb3\xc1\x11\xba\xd4\xfb\x09\x1f\x5a\xd4\x65\x1c\xc9\x0e\xfc\x2b\x91\x17\x5f\x86\xff\x33\x19\xcd\x90\xbe\x59\x1d\x85\xc8\x8b\xa7\x0b\x59\x56\xf8\xb1\x26\x48\xd1\x21\x54\x82\x12\x0a\x32\xe5\x81\x49\x50\x85\x85\xd0\x6e\x83\x83\xe5\x34\xba\x36\x51\x2a\x20\x32\x1e\x33\x4e\x0f\xa9\xb0\x49\x30\x02\x4a\x07\xc7\x75\x2d\xdc\x51\xcc\x87\x21\x3d\x0c\x4d\x77\x09\xd9\xc3\xb6\x63\x7f\x1a\xc0\xdc\xf1\x30\x5b\xcc\x3c\x31\xac\x3a\x2f\x6b\xb6\xee\x68\x84\x42\xc1\x64\xb9\xbe\x82\x54\x18\x8a\x4e\x7c\xda\x8e\x21\x2f\x34\x86\xce\x5f\xba\x84\x3c\x70\xb5\x8e\x26\xbd\x4f\x73\x8a\xa3\xbf\xb7\xf6\xc9\x0b\x95\x2d\xbd\xfb\xdb\x69\x4a\x19\xfa\x4f\x4e\xb3\x59\xdf\x53\xcf\x13\x56\xee\x13\x05\xf3\x32\x35\xf5\x41\x2b\xd9\xc4\x41\x0d\x3c\x1f\xfc\x5a\xf9\x5e\x50\x13\x7a\xc0\xa8\x8e\x6d\x16\x52\xb8\xe6\xa0\xb9\x01\x2c\x5d\x41\x66\x2f\xfc\x1a\xe7\x35\x03\x19\x45\x8b\x69\xaf\xab\xab\x7b\xda\xf2\x32\xea\xc0\x7d\xa8\xa0\x11\x4c\xb4\x96\x84\x0a\x5e\x43\x86\xd2\x92\x8f\x3c\x1b\xc5\xb1\x7f\xc4\x20\x56\xb1\x87\xcf\xbe\xc4\xff\xdb\xb6\x7c\xaf\x0b\xc0\x67\xe8\x2e\x81\xaf\xef\xab\x93\x48\xc1\x37\x33\xd6\xe0\x43\x3a\x83\x61\xe4\xc8\xf7\x88\x72\xa1\x94\xf7\x95\xec\xb8\x7d\x7e\xf8\xf0\x0f\x1f\xfe\xe1\xc3\xdf\xb9\x0f\xb7\x86\xf2\xc0\x88\xff\x03\xe7\x7f\xeb\x5f\x3c\x3c\xb9\x5e\x4e\xff\x0f\x37\xb3\xf5\xf8\x9f\xdf\xd6\x9a\xe1\xca\x3b\xf6\x71\x27\xc0\xfc\x0d\x64\xcc\x21\xa0\xf1\xff\xf1\x93\x5c\xec\x48\xc1\xc6\x89\x92\x36\xfd\xe3\x12\x08\xe2\xd5\x65\x85\x06\x1d\x0b\xb8\x32\x7a\x27\x52\x4a\x07\xf7\x52\xf8\x7b\xe7\x6f\x26\xcd\x5a\x94\xd4\xb1\x58\xfe\x6a\x1d\x96\x95\xdf\x3c\xe1\x1e\x61\xb5\xea\xc7\xbf\xdf\x4b\x74\x70\x39\x1c\xbe\x3a\x1f\x5e\x9c\x0f\x2f\xd7\x17\x2f\x47\xc3\x17\xa3\xe1\xcb\xf7\x6c\x02\x8f\xfc\x3e\xb8\xb8\xfc\xef\xfb\x63\xf7\x18\xec\x08\x38\x47\xd7\x91\x3b\x8a\x3f\xe0\x1e\xc1\x74\x79\xf3\xd3\x78\x35\x5e\x2f\x57\xf0\x1a\xae\xd7\xb3\xb6\xb1\x93\x80\xfc\xeb\x76\x71\x3c\x9d\x2e\x7f\x59\xac\xff\xed\x3e\x2e\xc2\xb8\x86\x37\xd2\xd8\xc0\xf8\x22\xbe\xf1\x2f\x39\x89\x21\x74\x4f\xf4\x6a\xdc\xbe\xf6\x4f\x39\x48\x68\x75\xfa\x18\x85\x7f\x7a\xaf\x7f\x8a\xb6\xdf\x7b\x7f\x04\x00\x00\xff\xff\xb2\x12\xab\xb2\x7e\x12\x00\x00")
+var _queryGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xed\x5a\xdb\x72\x1b\x37\x12\x7d\xd7\x57\xc0\xda\x17\x29\x45\xb3\x28\xe5\xf2\xc0\xaa\x7d\x20\x69\xad\xc5\x5a\x49\xcc\x4a\x4c\x52\x9b\xad\x2d\x13\x9c\xe9\x21\xb1\x9e\x5b\x00\x8c\x18\x7a\x6b\xff\x7d\x4f\x03\x98\x0b\x29\x4a\x56\x12\xa5\xbc\x17\xa7\x5c\x31\x39\x03\xa0\xbb\x4f\x9f\x3e\x0d\x80\xb6\xdb\x92\xc4\x5f\x2a\xd2\x5b\xf1\xcf\x23\x21\x8e\x8f\x8f\xf1\xff\x1f\x46\xb7\x37\xd3\x9b\xb7\x43\x31\x5f\x2b\x23\xf0\x47\x8a\xf1\xc5\x7c\xe4\xc7\xf5\xc5\x74\x2e\xae\xa7\x6f\x2f\xe7\xe2\x6e\x3e\xbd\xba\x12\x93\xcb\xd1\xcd\xdb\x8b\xfe\x11\x26\xde\x92\xd5\x8a\xee\x49\xd8\x35\x89\x54\x1a\x2b\x64\x64\x55\x91\x9b\x1e\x9e\x48\x7c\xd3\x24\x94\xd6\x18\xa1\x8d\x5a\xa6\xd4\x13\x32\x8f\xdd\xab\x21\x66\x9f\x9d\x8a\xbc\xb0\x2a\x51\x14\xe3\x39\xa6\x46\x45\x95\xdb\x9e\x28\x34\x5e\x9e\x9f\x8a\x8d\x84\x27\x95\x5d\x17\x5a\x7d\xc0\x90\xe5\xb6\x33\x2a\x98\x37\x55\x6a\x8d\x33\xf3\x2e\x58\x7e\xd7\x13\x9a\x6c\xa5\x73\xcc\x50\xb9\xf0\xb6\x09\x6b\xc6\xa4\xc5\x49\xa2\x8b\x0c\xcf\x22\xca\xad\xb0\x85\x28\x52\x7e\x1a\x66\x9e\xba\x35\x6f\x66\xf3\x8b\xa1\xa8\x4c\x25\xd3\x74\xdb\x73\x81\x2d\x65\xf4\x5e\xe5\x2b\x61\x48\xdf\xab\x08\x6b\x25\x78\x0c\x94\x32\x82\x6f\xb1\x58\x63\x15\x86\xec\x9d\xd5\x55\x1e\x49\x4b\xf1\x3b\xb1\x51\x79\x5c\x6c\x78\x24\x06\xda\x42\x63\xa5\xd2\x59\xea\x3a\x2f\x63\xb7\xfc\x22\xaa\xb4\x29\xf4\x82\xdd\xe5\xef\x9a\x4c\x09\x77\x02\x58\xa5\x34\x48\x89\x75\x4e\xb0\xcb\xcd\x68\x7c\x8e\x8a\xdc\xaa\xbc\x22\x0c\x5a\xa9\x5c\xe2\xf3\xaa\xdf\xc9\xa1\x01\x72\xf6\x75\xaa\xee\x01\x85\x9f\xd5\x13\x58\x9b\x22\xc5\xb1\x09\xa0\xcb\xe6\x82\xd7\x40\xa0\xf6\x7a\x55\x90\x01\xda\xfd\x86\x1f\x2b\xb2\x23\xef\xf9\xa5\x8f\x66\xe4\x11\x3b\xc1\x3b\x8c\x09\xef\x44\x2e\x33\x62\xb7\x7e\x72\xf4\x4a\x8a\x06\xd9\x63\x37\x2e\x04\x3f\x14\x77\x20\x4d\xbe\x7a\x75\xe4\x67\x4f\x10\x84\xc6\xc0\x8f\x4d\x8f\xc2\xb8\x7a\x7e\x98\x7e\x2d\x7f\x56\x59\x95\x89\xbc\xca\x96\x40\x18\x88\x87\x59\x3b\x34\x70\xf9\x02\x4a\xd4\x17\x02\x33\x44\xaa\x32\x60\x0a\x18\x8a\x0d\x06\xb0\x2d\x37\x22\xc2\x13\xc6\xee\x6c\x30\x18\x78\xab\x6e\xe0\x50\x4c\x73\xfb\xcd\x57\xe2\x8f\xfc\x22\xd8\x9d\x95\x6c\x45\xa6\x01\xd9\x9d\x74\x6c\xd6\x04\x46\x6e\x8b\x4a\xa4\x94\x58\xf8\x94\x80\x48\xf2\x3d\xe5\x22\xf0\xcf\xd3\x96\x7d\x15\x25\x18\xaa\x8a\x2a\xd8\xc6\x2a\xce\x91\x85\x83\xdc\xc5\xb1\xf0\x80\xf4\x03\x0a\xce\x5a\x83\x81\x10\xa7\x43\x71\x30\x37\x80\x35\x27\xf7\x11\x40\x7b\x97\x8f\xfd\x12\x77\x24\x75\xb4\xf6\xcc\x4e\x8b\xe8\x7d\xb4\x96\x40\x08\x18\x6c\xa4\x0e\x58\x68\x99\x1b\x0f\xa3\xf8\x82\x7e\xa6\xa8\x72\x1f\x19\x7e\x32\x5f\x80\x8a\x06\xa0\xe1\xc1\xc2\x79\xb6\xe8\xfb\xf5\x7f\x58\x53\x4d\xe0\x00\x7c\xcb\x6c\x23\x28\x2b\x2d\xaa\x00\xa8\x67\x84\xd5\x1d\x3a\x6b\x79\xcf\xa3\x65\xb4\x26\x5f\x0a\x04\xc2\xbb\xea\x22\xe1\x78\xea\xa4\xc1\x39\x29\xe0\x12\xb2\x17\x2c\x41\xaf\x86\xc8\xde\x46\x6e\x0d\xa3\x6e\x14\x97\xb1\xab\xa5\x0a\x0c\x5e\x08\xcc\x4b\x5d\xde\xeb\xa8\x8c\x8b\x99\xa0\x4b\x9b\xb5\x42\xf0\x46\xad\x38\x77\x4e\xa4\x78\x5e\x26\x6d\xb4\xe6\x1a\xa7\x94\x32\x16\x07\xd6\x1e\x9e\xcf\xc4\xbc\xbd\xb8\x9e\x7d\x7f\xf1\xc6\x27\x8f\x47\x7b\xc4\x96\x14\xc9\xca\x38\x39\x70\x2e\x32\xe3\x0a\xbd\x92\xb9\xfa\xe0\xca\x29\x38\x7b\x47\x04\x57\x4d\xe1\xa3\xb2\x08\x37\x63\x43\x4e\x12\x81\x21\x1c\x86\xef\x8b\xbb\x6a\x69\x22\xad\x1c\xa9\x16\x3b\xe9\xf2\xae\xcf\xdb\x94\x98\x3f\xf9\xa0\x7c\xf5\xb9\xa1\x71\xc2\x8e\x84\xc4\x7a\x75\xbf\x02\x5e\x15\x08\xcf\x26\x61\xef\xb8\x19\xec\x72\xb6\x57\x84\x6e\x91\x2b\xd4\x82\x0e\x68\xa3\x9a\xc4\x12\xa4\x8a\x25\x4b\x97\xca\xa3\xb4\x32\xd0\x91\x14\xdd\x60\x24\x72\x5a\x21\x40\xa4\xee\x5e\xa6\x60\xbb\xcf\xa7\xac\xf3\x44\xa9\x7f\x69\x7d\xc4\x6b\x96\x39\x70\xca\x75\x87\x6e\x2f\x08\xe3\x4f\x62\x2a\x91\x76\x86\x84\x19\xd5\x1d\x31\xcb\xd3\xed\xe2\xb4\xdf\xba\x8e\x6a\x1d\xf3\xa4\x9b\x2a\x0b\x25\xd9\x71\xff\x52\xad\xd6\xcf\xf3\xff\x03\xe9\x82\x5d\xfa\x64\x71\xac\xe1\xea\xe3\x81\xcc\x4a\x89\x1c\x89\x58\x5a\xa8\x83\x42\xaf\xf2\x34\xe5\x82\x89\xd0\x00\x5d\x43\xa8\xbb\x41\x23\x39\x78\xab\x03\x55\x84\x4a\xb8\xcc\xd8\xbc\x88\x95\x89\xbc\x10\x50\xdc\x6f\xdb\x35\x5e\x37\x64\x6e\x8a\xb4\x29\x9a\x6e\x13\x32\x4d\xb7\x63\x7d\xc2\x5e\xc0\x72\x31\x1b\x99\x38\x60\x98\x75\x8e\xd6\x2c\xdd\x41\x08\xb1\xc0\x78\x36\xbf\x84\x69\x4d\x41\x89\x4f\xea\x32\xe4\x86\xc6\xae\xf3\x97\x2e\x20\x7b\xaa\xd6\xe1\xa4\xd3\x69\x36\xd1\xea\x7b\x2d\x9f\xdc\x50\x59\xd2\xbb\xcf\x90\x85\x44\xba\x4f\xf0\x0e\x62\xbd\xc3\x9e\x47\xa4\xdc\x19\xf2\xe2\xa5\x2b\x74\xdd\x02\xe9\x0a\x85\xea\x71\x6e\xf4\x3a\x77\xb9\xa0\xad\xcf\x01\x7b\xd5\xa6\x59\xa5\xca\x6e\x1b\xce\xf5\xc5\x0c\xaf\xf5\x46\xb9\x36\xce\x6d\x46\x24\x14\x24\xa6\x5e\xae\x2a\x77\xb8\xe5\x68\xd4\x71\x77\x9f\x41\x43\x31\x2e\x8a\x14\x1c\x85\xef\x09\x04\x85\xf6\xbd\x37\x64\x83\xf3\x3b\x8a\x56\xb7\x43\xa0\xc4\x4a\xbb\x23\xee\xbc\x71\xd2\x81\x14\x31\xb6\x28\xd8\x0a\xb0\x12\x7b\xaf\x4a\xa9\xe1\xa5\x81\x22\x32\x7b\x04\xf7\xb4\xbe\x58\xb8\x26\xd0\xf7\x4d\x81\xe2\xba\x47\xf5\xea\x17\xb6\x28\xaf\xb0\x58\xda\x34\x2f\x4e\x79\x78\x57\xbb\xd4\xbc\xb3\xec\x36\xb7\x3d\xdf\x31\x0e\xfa\xdd\x41\xa4\xd4\xc5\x3f\x3c\xa5\x86\x41\xeb\xba\xaa\xf8\x6d\xf3\xd6\x4d\x38\x3d\x34\x26\x28\xe7\x6d\x20\xf7\xab\xe7\x34\xc6\x9a\xaf\xff\xa9\x9d\xd1\x59\xe8\x76\xc7\x97\x6f\x38\xe3\x00\xc1\x27\xeb\x38\x5e\xa0\x11\xff\x20\x60\xe4\x72\x44\xd8\xf4\xe6\x4e\x70\x93\xb6\x2d\x7f\x6e\x50\x9f\x1b\xd4\xe7\x06\xf5\xb9\x41\xfd\x77\x36\xa8\x5a\x69\xf7\x3a\xd4\x1f\xc4\xeb\x5f\xf5\x5f\x98\x3c\xbe\x9a\x4d\xfe\x2c\xae\x2f\xe6\xa3\xdf\xbe\x5a\xdd\x25\x6e\x5b\x44\xbc\xbe\x4c\x71\x40\x62\x72\x21\x67\xee\x2f\x7e\xb3\x82\x3e\x41\x6b\xac\xca\x08\x19\x68\xba\xa3\xaf\xea\x22\x43\x02\xa5\xe5\xca\x06\x70\xf7\x38\xc3\xc5\xfd\x1d\x13\x6e\xdd\xe9\x9b\xf1\x76\x8e\xf9\x9d\xde\xc3\x5f\x8d\x95\x59\xe9\x5a\xb2\x5f\x47\x99\x22\xef\x85\x13\x1f\x32\x24\xce\x07\x83\x6f\x5e\x0f\xce\x5e\x0f\xce\xe7\x67\x5f\x0f\x07\x5f\x0d\x07\x5f\xff\xc8\xea\x78\xe0\x79\xff\xec\xfc\xcb\x1f\xdb\x24\xb2\xb3\x43\xc1\x36\xba\xad\xaa\x23\x05\x8d\xdf\x43\x31\x99\x5d\x7f\x3b\xba\x1d\xcd\x67\xb7\xe0\xfc\xd5\xfc\xa2\x4e\xec\xd8\x7b\xfe\xb2\x59\x1c\x4d\x26\xb3\xef\x6e\xe6\xbf\x77\x1e\x6f\xbc\x8e\xf9\x3b\x8c\x90\xc0\x70\x75\xb3\x70\xc7\xe2\x08\xc2\x63\x1f\xc9\xd5\xa8\xbe\x28\x9a\xf0\x20\x30\xfa\xe4\x10\x84\x0f\x6e\x82\x9e\x84\xed\x80\xa7\x61\x81\xc6\x17\xbe\x1b\xec\xf0\xcd\x3d\x65\x5e\x75\xae\x14\xb1\xe9\xf1\xe3\xf8\x9a\x29\xec\x94\xda\x2b\x38\x96\xe9\x69\x9e\x14\x7d\x74\xc8\xc9\x4b\x5e\xc7\xed\x44\x50\xbb\x3d\xf1\x5e\x8f\xb7\x1d\x74\x76\x2e\xd1\xea\x0d\x8d\x8f\xa3\xc3\x3d\xff\xe0\xd0\x5e\xea\xd0\x3d\x58\x00\xe9\x91\x8b\xb0\x5f\x74\x0f\xf6\xd1\xfe\xf4\x3b\xdf\x87\x3d\x80\x6e\xf7\x5a\xec\xe0\xd5\x58\xf7\x72\xac\xe6\xe3\xee\xbd\xd8\x23\xf4\x8a\xc2\xe0\x3a\x0f\x7b\x55\xd0\x03\x50\x01\xb1\xc0\xbb\xee\x04\x55\xd3\x92\x2f\x30\xc1\x18\x6c\xc4\x59\x9a\xaa\xd2\x59\x09\xad\x2b\x51\xda\x5d\x96\xfb\x9c\x07\xa6\xf9\xf3\x86\xdb\x4b\x71\x9d\xe5\x85\xed\xf2\x5b\x8a\x45\x4e\x9b\xa6\x12\x43\x83\x3c\x71\xbb\x9b\xad\xb1\x94\xd5\xcb\x79\xaa\x05\x05\x2e\xb8\xcb\x3f\xac\x17\x98\x58\x51\x4e\x46\xf1\xad\xf7\x01\x8a\x5e\xa9\x9c\x40\x92\x5f\x54\xbd\x7f\xdb\x83\xfa\xd5\xdf\x1f\x47\x18\xbb\x20\xd2\x5c\x90\x9d\x66\x8f\x56\xce\xc7\x9d\x2a\x7d\x50\xcf\x86\xf8\xcc\xd2\x29\xe7\xac\x00\x7a\xe1\x1a\xdf\x41\xf9\x89\x0a\xba\x0e\x63\xa7\x85\x6f\xef\x9c\xbb\x1f\xa9\x6d\x1f\x53\x8b\xaa\xff\xfe\xbc\xca\x3e\x8c\xde\xff\x42\x99\x3f\x05\xe8\x73\x2b\xfe\xcd\x81\x35\x9e\x53\xf5\x8f\x70\xd2\x2a\x38\xb8\x91\x8a\xb3\xcf\x8e\x86\x9d\x25\x97\x90\x6f\x98\x0b\x69\xeb\xb3\xd2\x22\xfc\xee\xa5\xba\x95\xe6\xec\x00\xaa\x25\x01\x78\xf2\x81\xfa\x99\xcc\x3f\xae\xf2\x2d\xd9\x7a\x59\x6c\x72\x71\x86\x8a\x88\x8b\x20\xc7\xa4\x44\xaa\x94\x4f\x46\x73\xae\x65\x14\x2c\xc6\x95\x8a\xa3\x0d\x12\x82\xdd\x08\x7d\xd2\x12\x08\x67\xca\x43\xa0\x77\x2a\x60\x5c\x1f\x7a\x99\xc1\xac\x6f\xee\x16\xfe\x30\xe2\x59\x85\xb8\x96\x54\xaf\xdc\x13\xdd\x23\x53\xf3\x43\xe3\x46\x2b\x6b\x41\x2d\x87\x64\x47\x9c\x6c\x7b\x6e\xfd\x4e\xe5\xf6\xcb\xf3\xff\xd3\x4a\x7a\x22\x2f\x2f\x5a\x48\xbf\x75\x53\x3b\x9e\x8a\xcb\xe9\x1d\x76\xd0\x7f\x7d\xf1\x3d\x2d\x9f\x4e\xb7\xcd\xbd\x57\xd0\x5d\x36\xf8\x58\x5b\xe7\x9f\x83\x4d\xe8\x28\x3d\xb1\x51\xd6\x5f\x06\xba\xcb\xb5\xf6\x67\x1e\x67\xc2\x67\xc8\x9d\x57\x6b\x03\xb2\x2c\x53\x45\x66\xaf\x9f\x2e\xd5\xf7\xfe\xbd\xf9\xd8\x66\x2f\xfc\xbe\xf9\x44\xa3\x3d\x78\x1b\xd0\x09\x6a\xc7\x1d\xb5\xcf\x63\x48\x02\xbc\x09\xd7\x28\xad\x15\x0e\x13\xf3\x1f\x9e\xde\x9b\xb6\x3e\x9e\x86\x10\x9e\xea\xe8\x06\xde\x44\xf8\x06\x42\xc7\x2a\x01\x71\x08\x2a\x66\xc4\x89\x8c\x63\xf2\xf7\x2a\x9a\xb2\x82\x7f\x8a\x6e\xff\x89\x80\x5c\xa6\x18\xe2\x36\x49\x6e\x36\x9e\x61\x0f\x04\xb0\x51\x8f\xdb\x92\x4c\xb3\x93\xf1\xaf\xbd\xe7\xe6\x14\xe2\x60\x37\x14\xae\x54\x43\xe8\xed\x11\xa5\xd5\x65\xae\x95\x46\x99\xdd\x3a\xbc\x37\xe3\x09\xed\x18\x5b\x34\x23\x1e\x24\xee\x0d\xe2\x78\xc9\xa4\x1d\x16\xc1\x3a\x08\x7f\xae\x64\x51\xe1\x12\x0f\x64\x6a\xd7\xed\x06\x53\x4b\xdb\xaf\x58\x1c\xfa\xf0\x60\xe9\x16\x83\x76\xe1\x7a\xf7\x3c\x9e\x32\x0a\xaf\x8e\xfe\x75\xf4\x6f\x7a\xdf\x7b\xc1\x3a\x22\x00\x00")
 
 func queryGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -152,7 +173,7 @@ func queryGraphql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "query.graphql", size: 4734, mode: os.FileMode(436), modTime: time.Unix(1619013861, 0)}
+	info := bindataFileInfo{name: "query.graphql", size: 8762, mode: os.FileMode(436), modTime: time.Unix(1792397079, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -197,7 +218,7 @@ func schemaGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _search_transactionGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\xcb\x6e\xe4\x36\x10\xbc\xf3\x2b\xda\xe3\x8b\xbd\x70\x74\x48\x6e\x73\x1b\x6f\x9c\xc4\xc0\x62\x8c\xd8\xde\x2c\x82\x20\x80\x5a\x64\x6b\x48\x98\x22\xb5\x7c\x8c\x22\x04\xf9\xf7\xa0\x29\x8d\xe7\x61\x3b\x87\x5c\x92\x05\xf6\x26\x88\xfd\x28\x56\x57\x17\xcf\xc5\x39\xc0\x3d\xc5\xde\xbb\x48\x11\x5a\x1f\xe0\xe7\x4c\x61\x14\xe7\x42\xa4\xb1\x27\x78\x20\x0c\x52\x3f\x06\x74\x11\x65\x32\xde\xc5\x1f\x7c\x18\x30\xa8\x5d\x12\xfc\x29\x16\x8f\xda\x44\x30\x11\x10\xa4\x46\xe3\xbe\x19\x8c\x22\x90\x39\x44\x1f\xae\xc0\x38\x65\x24\x26\xe3\x36\x90\x34\x41\x1f\xfc\x26\x50\x8c\xe0\x5b\x40\x88\xa5\x7c\x05\xbf\xfa\x0c\x12\x1d\xf4\x18\x23\x98\x04\x0d\xca\x27\x48\xbe\x64\x28\xd3\xb6\x14\xc8\xa5\x39\x1a\x3a\x4a\xda\xab\xc8\xe7\xd2\xbb\x64\x5c\x26\x68\x29\x49\xcd\x3d\x3a\x1f\x08\x02\xc5\x6c\x53\xe4\xe6\xf0\x8e\x4c\xd2\x14\x40\x99\x40\xd3\x0d\xde\xc1\x05\x6d\xc9\xf1\x21\xd7\x0f\xb4\xa5\x10\x69\x1f\x70\x59\xc1\x0a\x6a\x97\xad\xad\xe7\x5b\x40\x47\xe8\x62\x89\x26\xa7\x18\x7a\x40\xb7\x21\xd0\x18\xa1\x21\x72\x10\x08\xa5\x26\x55\x2d\xc4\x94\xb0\x84\x87\x14\x8c\xdb\x08\x31\x43\x59\xc2\x6f\x2f\xa8\x3c\x61\xf2\xec\x77\xf1\xd7\x9b\xac\x5f\xa3\x7c\x3a\xa1\xfd\xb8\xd3\xd9\x3f\xb6\x3a\x4d\x9f\x7a\x09\xc1\xf3\x7f\x65\xfc\x80\x4e\xc1\x43\x6e\xa2\x0c\xa6\x2f\xfd\x59\x10\x8b\xc5\x42\xac\x20\x1a\xb7\xb1\x04\x69\x5f\x9c\xbf\x65\x21\xbd\xd4\xb9\x82\x0e\xe7\x61\x20\x97\xe4\xbe\xbb\xd1\x7d\xe6\xea\x95\x10\x9f\x56\xf7\xeb\xdb\xf5\x8f\x4b\x50\x1e\xd6\x77\x8f\x1c\xb6\xa1\xc4\x13\x35\x4e\xda\xac\xa8\x70\x5d\x67\xa7\x7c\x0d\xad\x21\xab\xf8\x4c\x51\xa2\xd0\x19\x47\x60\xda\x12\xd0\x51\x8c\xb8\xa1\xa2\x3d\x99\x32\x5a\x3b\x02\xc2\xfd\xcd\x2f\x37\xf7\x0f\xab\x0f\x3c\x27\x8e\x3a\x80\x5a\x09\xb1\xbe\x7b\xbc\x59\x02\xda\x01\xc7\x08\x52\x13\x0b\x4d\x13\x6c\xd1\x66\xe2\x8c\xba\xdc\xa6\x8a\x09\x53\x8e\x35\xb7\xed\xf0\x89\x20\xe6\x40\xac\x4c\x13\xa1\xa6\x3f\x48\xe6\x44\xaa\x66\x1c\xa3\xcf\x30\xa0\x4b\xc7\x91\x78\x44\x50\x87\xaa\x24\xcf\x8a\x2e\x5b\x52\x4d\x84\xbe\x3e\xef\x57\x96\x6c\xb1\xf8\xa4\xa9\x28\x39\xf1\xba\xed\xd8\x2e\x77\x77\xf0\x71\xfd\xfd\x1d\xf8\x9e\x02\x96\x86\x65\xb9\xfa\x40\x5b\xe3\x73\xb4\x23\x44\x5e\x9f\x5d\x4a\x25\xc4\xad\x7b\x31\x9b\xab\x03\xf4\x63\x59\xc7\x24\xf5\xd4\x8b\xd5\x30\x13\xb6\xa5\x60\xda\x11\x4c\x8a\x13\x63\x55\x05\x58\x36\xd1\xf9\x04\xca\xe7\xc6\x12\xf8\x00\x29\x98\xde\x12\x48\x9f\x99\x98\x03\x1d\x57\xe5\xd2\x3c\xd7\x25\x5c\x7b\x6f\x09\xdd\x59\x21\xe2\xfd\x4b\xe7\x40\x6b\xfd\xc0\x32\x62\x38\x87\xcb\x5e\x54\xc4\x07\x83\x26\xc7\xbf\xdd\xb4\xb8\xcc\x85\x71\x89\x42\xc8\x7d\x22\xc5\x9d\x5e\x2c\xc9\x11\x8f\x04\x8d\xf5\xf2\x09\x02\xf5\x81\x98\x23\x52\xa0\x29\x14\x52\x4d\x98\x8c\xc1\x34\x96\xb8\x92\x89\xb7\x07\x7f\x4e\xd0\x3f\x6a\x02\x8b\x31\xc1\x93\xf3\x83\x3b\xca\x9d\x5b\x14\xa8\x7d\xf0\x92\x62\x9c\xbc\xd0\xc4\x23\x65\x02\x14\x1b\x75\xb9\x6b\x28\x14\x33\x6c\x08\xe8\x73\x46\xfb\x2c\x9b\x1c\x8a\x0d\x4e\x05\x5d\xee\xe0\x22\x3b\x45\x61\x27\xd9\xf2\xbf\x72\xb9\xab\x2f\x8b\xf3\x0d\xda\x48\x0d\x12\x79\x25\xeb\x63\xf8\x35\x94\xee\x99\x2a\x80\xdb\x43\x1b\x2c\x88\x23\x6d\x3a\x6e\x34\xef\x4f\x91\xeb\xd5\x84\x78\x86\x37\x18\x6b\x19\x9f\x0a\xa6\x2d\xd6\x8e\x03\x8e\xd0\x8c\x25\x7e\x8e\xf1\xed\x84\x34\x42\xa4\x1e\xc3\xf4\x04\x68\x42\x35\x5f\x80\x55\xf5\xe1\xf6\xba\x2a\xe4\x1e\x60\xbb\xe6\xd3\x75\xee\x96\xf0\xd1\xb8\xf4\xdd\xb7\x67\x42\x94\x84\x25\x94\x93\x9f\x08\x15\x85\x33\x21\x00\x00\x98\x7a\xbe\x7b\x79\x4e\xa6\xb5\x9c\xe5\x7f\xb2\xf8\x45\x3c\x68\x5c\x79\x22\x9e\xed\x69\xa7\x49\xf1\xbe\xf8\x40\xbd\x3b\x59\x4d\x07\x35\x34\x64\xfd\xc0\xfc\x5b\xd3\xf1\xfe\xea\xbd\xcd\xf1\x5f\xef\xd8\x70\xa6\xe0\x7d\xd9\xd1\xe7\x70\x6c\x78\xb0\xb2\x49\xfb\xbc\xd1\x45\xd4\xcf\x19\x6d\xf0\xdd\x29\xd0\x08\xc8\xf6\xb1\x45\x63\x91\x67\x71\x11\x89\xf6\x8e\xb3\x03\x76\x39\xed\x51\x99\xfb\x12\x0e\x8c\xa3\xb0\xc1\xde\xfe\x6f\xcc\xba\x99\x5f\x89\x53\xb7\xfe\xff\x58\xe6\xdb\x8e\xf9\xca\x03\xf9\x65\xbb\xca\x57\x5b\xf9\x6f\x6c\xe5\xab\xa5\xbc\x61\x29\x7f\x07\x00\x00\xff\xff\xb4\x8d\x8a\xd9\x2c\x0c\x00\x00")
+var _search_transactionGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xed\x56\x4d\x6f\x23\x45\x10\xbd\xcf\xaf\xa8\x38\x97\x64\x65\x7c\x80\x9b\x25\x0e\xce\x6e\x80\x88\x25\x81\x24\xcb\x0a\x21\xa4\x69\xcf\x94\x3d\x4d\x66\xba\xbd\xfd\x11\x63\x10\xff\x9d\x57\xdd\xe3\x64\xec\xd8\x8b\xc4\x05\x90\x36\x8a\x94\xc9\x74\x75\xd5\xeb\x57\xaf\x5e\xcf\x69\x71\x4a\x34\x73\xcb\xd8\xb1\x09\x9e\x16\xd6\xd1\x0f\x91\xdd\x86\x94\xa9\xe9\x2e\xce\x7d\xe5\xf4\x2a\x68\x6b\x7c\x71\x5a\x14\xa3\xd1\xa8\xb8\xe3\x96\x2b\x84\x86\x86\x69\xa5\x1c\x9e\xec\x22\xfd\xd3\xa9\x50\x35\xda\x2c\x49\x55\x69\x03\x39\x0e\x4e\xf3\x23\xd7\x34\x47\x3e\xf2\xac\x5c\xd5\x4c\xe8\xde\x29\xe3\x73\x0c\xb5\x58\x6e\x69\xa1\xb9\xad\x3d\x9d\xe9\x7a\x4c\xf3\xd6\x56\x0f\x63\xf2\x41\x85\xe8\x13\x0a\xc7\xde\x46\x57\x31\x45\xaf\x96\x7c\x4e\xca\x31\xa9\x76\xad\x36\x58\x7e\x54\xba\x55\xf3\x96\xc7\x09\xc1\xed\xec\x3b\x3c\xe4\xff\x6b\x5e\xb0\x73\xa8\x1d\x06\xe5\x24\x9d\x45\xa4\xeb\x31\x92\x36\x35\xff\x86\x20\xbb\x62\xa7\x32\x6a\x03\x48\x4e\x8a\x4c\xd2\x71\xb5\x59\xc5\x40\x77\x09\xfb\x00\xb9\xff\xde\xd9\x5f\x39\x27\xf9\x43\x02\xbf\x65\x5e\x65\x52\x54\x0c\x8d\x75\xfa\xf7\x3e\x9f\xd4\xac\x55\x50\xc7\x68\x1a\x93\x35\xed\x46\x96\xb4\xc0\xaa\x6c\x34\x21\x6d\x32\xaa\xe3\x7c\xd8\xed\x29\x33\xf6\xb5\xf6\x3c\x11\x68\x39\xc1\x1b\xe4\x9e\xd2\x85\xb5\x2d\x2b\x43\x5f\xd2\x42\xb5\x9e\x8b\x5d\x48\x8e\x2b\x46\x1b\x8f\x41\x48\xd9\xfa\x18\xff\x77\xb9\xe4\x2c\x73\xe5\x79\xc8\xd9\xc7\xf2\xd6\xf3\x9b\xd5\x81\xa4\x7f\x16\x10\x14\xb4\x77\xcb\x7e\x85\x50\x1e\x68\x4f\x94\x16\x36\x2b\x3e\xc0\xfa\x57\xd6\xad\x95\xab\xb7\x9b\x84\xfa\xfb\x46\x7b\xc2\xaf\xa2\xaa\x51\xda\x7c\xb6\xd6\x35\x53\x15\x9d\xb7\x6e\x2c\xfd\xd5\x15\x40\x02\x53\xd2\xab\xb3\x4b\xc8\x29\x01\x7e\x16\xe4\x4f\x36\x52\x05\x68\x2b\x85\x15\x1d\x68\xae\xaa\x07\x0a\x36\x9f\x56\x2f\xa0\x23\xcc\x46\x1f\x4d\x1d\xa3\xbb\x10\x2b\xd6\x2b\x6b\x90\x39\x32\x2d\xb8\x3f\x77\x67\x9d\x90\xed\x63\x1b\xbc\x14\xa7\x57\xac\x93\xdc\x6a\xed\xb2\x58\xfc\x2b\x3a\x83\xc0\x44\x79\x7d\x67\xa0\x36\xcf\xcf\x01\xe7\x13\x9a\x51\x69\x62\xdb\x96\xfd\x29\x50\x12\x04\xa4\x68\x16\xfd\x2e\x08\x84\x2c\x99\x1a\xe5\x69\xce\x48\xe5\x58\x55\x0d\xd7\x93\x51\x91\x37\x4c\xe9\x0e\x83\x67\x96\x45\xd1\x43\x99\xd2\xcf\x2f\xa8\xdc\x63\xf2\xe4\x17\xe9\xc8\x11\xd6\x2f\x40\xc8\x1e\xed\xbb\x95\x4e\x3e\x5a\x6a\x7f\x7b\xae\x95\xfb\x7f\xa0\xfd\xc7\xad\x67\x46\x1e\xc5\x30\x07\xc3\x99\xc6\x73\x95\x48\x4f\x79\xc6\x03\x11\x4a\x4a\xa9\xbb\x6d\xdd\x07\xc9\x3e\x29\x8a\xf7\xb3\xdb\xeb\xab\xeb\xaf\xa7\x54\x5b\xba\xbe\xb9\x97\xb0\x25\x07\xe9\xa8\x36\x55\x1b\x21\x1f\xe1\xba\x8c\xa6\xb6\x65\xf6\x26\x59\xab\x39\xb0\xeb\xb4\x61\xd2\xbd\xda\x21\x24\x18\x52\xd2\x5e\x15\xa2\x6a\x5b\x71\xb9\xdb\xcb\x1f\x2f\x6f\xef\x66\x6f\xb7\x33\x31\x80\x8a\xd2\x28\x77\x39\xdd\xba\x17\x9a\x26\x42\x43\xd0\xa3\x6a\x21\x23\xec\x28\xd3\x69\x26\xd9\xfd\x4a\x29\xdb\xa9\x07\x26\x1f\xa1\x2b\x28\x13\xa5\x4a\xf8\x55\x15\x03\xd7\xa5\xe0\xd8\x40\xba\x6b\x65\xc2\x6e\xa4\xda\x21\xa8\x53\x75\xda\xdc\x2b\x3a\x4d\xc9\x24\x13\x7a\xb8\xdf\x07\x86\x6c\x34\x7a\xdf\x70\x52\x72\x90\x71\xdb\xb2\x9d\xce\x6e\xe8\xdd\xf5\x9b\x9b\x67\x3b\xc8\xc3\xb5\x82\xb2\xb5\x8d\x1e\xa4\x78\x19\x9f\xed\x16\x54\xbe\x32\x2f\x7a\x33\x1e\xa0\xdf\xa4\x71\x44\x13\x73\x2d\x51\x43\x4f\x18\x46\x45\x2f\x36\x38\x8b\xcf\x8c\x4d\x26\xa4\xd2\x24\x1a\x1b\xd0\xcc\x98\x2c\x12\x10\xa1\x1b\x3c\x65\x27\x1d\x30\xe1\xb3\xa3\x4b\x5f\x9f\xfc\xe8\x24\x11\xf1\xfa\xa5\x73\xa0\x9d\x76\x2d\x32\x12\x38\xc3\x61\x4f\x2a\x92\x85\x75\x83\xd9\xc3\x6b\xd3\x5f\x03\x62\x43\x06\x1a\x71\x71\x85\xf6\x48\xa5\x17\x43\xb2\xc3\x23\xe7\xbb\x0e\xcc\x80\x2b\xe1\x08\xf7\x10\x56\x12\xa9\xda\x65\x63\xd0\x38\x52\xba\x85\xfc\xd5\xe0\xcd\x1e\xfa\x7b\xa4\x6a\x95\x0f\xf4\x60\xec\xda\xec\xec\xed\x4b\x24\xa8\x30\xbf\x0a\x92\xcd\x5e\x88\x1a\x43\x65\x12\x25\x1b\x35\xb1\x9b\x03\x9b\x98\xe1\x1c\x6e\xf3\x01\xa2\x7e\x92\x4d\x74\xc9\x06\x73\x42\x04\xd2\x19\x88\x44\x70\x2f\xd9\xf4\x7e\x82\xf7\xe5\x79\x72\xbe\x75\xa3\xd1\xc1\x4a\xc9\x48\x96\xbb\xf0\x4b\x4a\xd5\xd1\x3f\xa2\xab\xa1\x0d\x26\xc4\x9e\x97\xf2\x2d\xb2\x9d\x9f\x24\xd7\x71\x46\xdc\xc3\x5b\xeb\xb6\x15\x7c\x35\xd4\x90\xac\x5d\x41\x1d\xf2\x8d\x21\xf1\x7d\x0c\x76\x27\x44\x1e\xf9\xf0\x95\x92\xaf\x80\x86\x55\xdd\x1f\x40\x54\xf5\xf6\xea\x22\xdd\x50\x43\xc2\x2e\x64\xf5\x3a\x76\x53\x7a\x87\x66\x7e\xf1\x39\x18\x4e\x1b\x40\xb9\xfc\xf9\x06\x19\xd8\xe1\x25\xe1\x47\xa8\x97\xb3\xa7\xeb\x24\x8f\x65\x2f\xff\xbd\xc1\x4f\xe2\xc1\x31\xd2\x15\xb1\x7f\x47\x16\xc5\xeb\xe4\x03\xe5\x76\x65\x96\x17\x4a\x1c\x11\x12\x14\xfe\x5b\xdd\xc9\xfc\x36\xcf\x36\x27\x6f\xd3\x67\xc3\xf6\x5b\xeb\x29\x2d\xe4\xea\x76\x0d\x8f\x66\x2d\xae\xac\xb8\x6c\x92\xa8\x9f\x76\x2c\x9c\xed\xf6\x81\xfa\xbd\xef\x8d\x33\xcf\xfc\xec\x38\x5b\x60\xe7\x79\x8e\x52\xdf\xa7\xc3\xcf\xb9\xc4\x86\x78\xfb\x3f\x31\xeb\x79\x7f\x4b\xec\xbb\xf5\x7f\xc7\x32\x8f\x3b\xe6\x81\x0b\xf2\xff\xed\x2a\x9f\x6c\xe5\xdf\xb1\x95\x4f\x96\x72\xc4\x52\xfe\x02\x06\x99\x2d\x6c\xa8\x0e\x00\x00")
 
 func search_transactionGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -212,12 +233,12 @@ func search_transactionGraphql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "search_transaction.graphql", size: 3116, mode: os.FileMode(436), modTime: time.Unix(1587588369, 0)}
+	info := bindataFileInfo{name: "search_transaction.graphql", size: 3752, mode: os.FileMode(436), modTime: time.Unix(1792397079, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _subscriptionGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\x4d\x6f\xdb\x46\x10\xbd\xe7\x57\xbc\xea\xe4\x04\x8a\x90\x7e\xa0\x07\x01\x39\xd8\xa8\x8b\x18\x48\x2c\xd4\x76\x9b\x2b\x47\xcb\xa1\xb8\xf0\x72\x56\xde\x0f\xb1\x4a\xd1\xff\x5e\xcc\x92\x92\x68\xb7\x6e\xd1\x53\x72\xc8\x49\x14\xb9\x33\xf3\xe6\xed\x9b\x37\x69\xbf\x65\xdc\xe6\x75\x34\xc1\x6e\x93\xf5\x82\x3f\x5e\x00\xc0\x6c\x36\x2b\xbf\xb7\x4c\xc1\xb4\x48\x2d\x63\xed\xbc\xb9\x37\x2d\x59\x41\xe3\x43\x4f\xa1\xd6\x5f\xa4\x40\x12\xc9\x94\xd8\x57\xfc\x3b\x9b\x5c\x1e\x53\x20\xc3\xf1\x15\xd6\x14\xb9\x86\x17\x54\x0f\x99\xc3\xbe\x5a\xbc\x28\x79\x3f\x9e\xdf\x5c\x2f\x41\xae\xa7\x7d\x84\xf1\x12\x6d\xcd\xa1\x94\xa9\xb2\xd4\xbe\x42\x63\xd9\xd5\x98\xd4\x8a\x05\x09\xc7\x39\xfa\xd6\x9a\x16\xd1\x6e\x84\x1c\x52\x4b\xa9\xc4\x75\x94\x4c\x6b\x65\x03\x76\xdc\xb1\xa4\x52\xa6\xa7\x58\x72\x90\x49\xb8\xb9\xfc\xb0\xfa\xed\xf2\x27\x34\xc1\x77\x25\x62\xe8\x65\xcd\x86\x72\x64\xf8\x66\xe8\x30\x22\xb0\x0f\x1b\x12\xfb\x89\xb4\x93\xc5\x23\x3e\x06\x14\x77\xa7\x9e\xe3\xcf\x03\xbe\xb3\xf2\xb9\x1c\xad\x1b\xcd\x37\x32\xf7\x8b\x76\x8d\xf7\x24\x9b\x4c\x1b\x46\x4c\xc1\xca\x66\x76\x3c\x5c\x48\x59\xe2\xb6\xbc\xfe\xe6\xc5\x29\xc9\x7b\xdf\x73\x18\x10\x41\x72\x87\xb5\xcf\x52\x53\xd8\xcf\x61\xc5\xb8\x1c\xed\x8e\xdd\x7e\x81\x73\x08\x6f\x28\xd9\x1d\x63\x47\x2e\x33\x3a\x26\x89\xa0\x31\x32\xb0\x1b\x3e\x26\x5f\x5a\x6e\x99\x6a\xf8\x00\x47\x31\xc1\x86\xc0\x3b\x0e\xd1\xae\xdd\x78\xbb\x38\xab\x79\xcb\x52\x2b\x8d\x7a\x65\xd3\x13\x2b\x71\xfb\xea\xe5\xe2\x04\xdd\xf9\xfe\x42\x83\xae\x73\xb7\xc4\x95\xa4\x1f\x7f\x98\xc0\x7f\x67\x37\xed\x17\x89\x1f\xe7\xa8\x24\x3b\x57\x3d\xaa\x27\x1e\xed\x80\xd8\xd9\xce\xa6\x38\xd7\x6a\x81\x1b\x1f\x78\xbc\x72\x4d\x69\x65\x84\xd1\xe4\x94\x43\x91\xcc\x51\x47\x13\x62\x34\xd3\xf3\xcc\xac\xb6\xf4\x90\x19\x35\x25\xc2\xd6\xb2\xe1\x41\xc2\x7b\x9f\x61\x48\xb0\xa5\x18\xb1\x26\x73\xaf\x2d\x1b\x2f\xc9\x4a\x66\xfd\x1a\x46\x20\xb0\x0d\x6c\x82\xf6\x85\xda\x46\xe3\x45\xd8\x24\xae\x17\xb8\xe1\x14\x2c\xef\x58\x3f\x1f\x45\x5e\x99\x1c\xa2\x0f\x93\x81\xd2\xb7\x81\xe3\xd6\x4b\xe4\x38\xf4\x60\x23\x0c\x39\xb7\xc0\x55\x82\x8d\x88\xd4\x14\xc6\x55\xc6\x7a\x3a\x52\xc7\x18\xf2\x68\x82\x8b\xd5\xdd\x3b\xd4\x36\xf0\x30\x00\x38\x3b\x8c\x28\x49\x5d\xa0\xeb\x9f\xa9\x52\x86\xd0\x83\xca\xa7\x22\x57\xb2\x4b\x09\xc9\xdd\x9a\x83\xa2\x09\x1c\xb3\x4b\x51\x27\x85\xa9\xe3\x21\xe3\x54\x76\x1a\x33\xd2\x8a\xb7\x78\x33\x49\xf7\xb1\x65\x35\x9e\xcc\x73\x78\x71\xfb\x31\xc5\xc0\xe6\x21\xad\x97\xc2\x38\xef\x07\xa6\xb5\xf6\x49\x25\xd6\xd9\xb4\x3f\x4a\x75\x81\x95\xaa\xa0\xb7\x91\xe7\x20\xe7\x7c\x8f\x86\x47\x93\x39\xa4\xcb\xdb\x47\xd2\x2c\x2a\x9c\x80\x7d\x2a\xc0\x25\x2e\xbc\x77\x4c\x82\xb7\x68\xc8\x45\x9e\xa0\x9f\xcd\xae\x1a\x88\x97\xd7\x9f\x38\x78\x1d\x93\xda\x1a\x4a\x1c\x8b\x34\x7a\x92\xa4\x95\x3a\x0a\xf7\xc3\x9d\x0c\xbd\xf5\xda\x72\x60\x1a\x50\x39\x1d\x95\xc1\xc5\x54\xe8\x7a\x98\x83\xde\x28\x1d\x6f\x1c\xbd\x4d\x2d\x08\x55\x31\xe8\x0a\xfc\x90\xd5\x45\xfd\x38\x15\x07\x77\xed\xad\x73\x58\xab\xf8\x25\x81\x12\xb4\x02\x2a\xcd\xff\xa1\x24\xbd\x92\xc4\x61\x47\xae\x3a\x96\xbb\xd3\xb9\xb0\x21\xa6\x63\xea\xe4\x35\xc3\x93\x02\x2a\x20\x3a\xa0\x7f\xdc\x23\x05\x86\xf8\x1e\xdb\xe0\x0d\xc7\xf8\xb4\xa1\x13\x55\x5a\x6a\x98\x5e\x5d\x40\xff\x88\xaa\xf4\x7c\x12\xd5\x21\xc5\x84\xec\x89\xa0\x9e\x86\x2f\xf1\xab\x95\xf4\xfd\x77\x27\x79\xbd\x5c\x8e\x7e\x3e\x71\xfe\xd1\xf8\x6f\x46\x62\x47\xf3\xfe\xf7\xc5\x79\x98\x8e\xbf\x6d\xce\xa7\x8b\xf3\xb9\xbd\x79\xbd\xba\xbb\x5c\x16\x02\x1e\xef\x49\xb5\xb0\xa4\x03\x5b\x46\xfc\x50\x26\x8e\x9e\xf1\x5f\x3b\xec\x62\x3c\xff\x99\x96\x98\xb6\x43\xeb\xc8\x3a\x9a\xbe\x01\x0d\x97\x3b\x57\xa3\x1f\x9f\xf5\xf5\x9b\xd1\xab\x0b\xa5\xbc\xb1\x22\xc5\xe6\xa7\x1e\xfc\x75\x1d\xfe\x4f\xfc\x73\xbc\xfe\x16\x6b\xd6\x03\xcf\x7a\xd8\xd7\x75\xf6\x05\xaf\xb3\x42\x76\x4b\x3b\x2e\x4c\x73\xfd\xf9\x17\xda\x73\x76\x79\xf0\x98\x89\x5f\xfe\xf9\x57\x00\x00\x00\xff\xff\x93\x1e\x2f\x48\x7e\x0c\x00\x00")
+var _subscriptionGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xed\x56\x4d\x73\xdb\x36\x10\xbd\xfb\x57\x6c\x75\x72\x32\x8a\x26\xfd\x98\x1e\x34\xd3\x83\x3d\x75\x27\x9e\x71\xac\xd6\x76\x9b\x2b\x57\xe4\x52\x44\x03\x01\x0c\x3e\xcc\x2a\x99\xfe\xf7\xee\x02\xa0\x44\xb9\x76\x3b\x3d\xd5\x07\xeb\x22\x89\x00\x76\xdf\x3e\xbc\xdd\xc7\xb0\xeb\x09\x6e\xe3\xda\xd7\x4e\xf5\x41\x59\x03\x5f\x4e\x80\x3f\xb3\xd9\x2c\x7d\xdf\x12\xba\xba\x83\xd0\x11\xac\xb5\xad\x3f\xd6\x1d\x2a\x03\xad\x75\x03\xba\x46\xbe\x21\x38\x34\x1e\xeb\x74\xf6\x35\xfd\x41\x75\x4c\x3f\xf9\x71\x4d\xfe\x35\xac\xd1\x53\x03\xfc\xa0\xfa\x14\xc9\xed\xaa\xc5\x49\x8a\xfb\xe1\xec\xe6\x7a\x09\xa8\x07\xdc\x79\xa8\xad\xf1\xaa\x21\x97\xd2\x54\xd1\x34\xb6\x82\x56\x91\x6e\x60\x92\xcb\x27\x24\xe4\xe7\x30\x74\x8a\x21\x79\xb5\x31\xa8\xf9\x08\x86\x74\x6e\x8b\xa1\xee\x94\xd9\x00\x69\xda\x92\x09\x29\xcd\x80\x3e\xc5\x60\x7c\x70\x73\xf1\x7e\xf5\xdb\xc5\x8f\xd0\x3a\xbb\x4d\x27\x72\x2d\x6b\xaa\x31\x7a\x02\xdb\xe6\x0a\x3d\x38\xb2\x6e\x83\x46\x7d\x46\xa9\x64\x71\xc4\x47\x46\x71\x77\xa8\xd9\xff\x94\xf1\x9d\xa6\xe5\xb4\xb5\x69\x25\x5e\x61\xee\x17\xa9\x1a\xae\xd0\x6c\x22\x6e\x08\x7c\x70\x8c\x71\xb6\xdf\x9c\x48\x59\xc2\x6d\x7a\xfc\xd5\xc9\x21\xc8\x95\x1d\x98\x90\x84\x08\x4c\xdc\xc2\xda\x32\x2f\xe8\x76\x73\xae\xa7\xd6\xd1\xab\x7b\xd2\xbb\x05\x9c\x81\xa1\x0d\xe3\xbc\x27\xb8\x47\x1d\x99\x06\x62\x68\x80\xe5\xa4\x23\x9d\x17\x83\x4d\x25\x77\x84\x7c\x19\x0e\x34\xfa\x00\xca\x39\xba\x27\xe7\xd5\x5a\x97\xdb\x85\xd3\x86\x7a\x32\x8d\xd0\x28\x57\x36\xdd\xb1\x32\x7a\x57\xbd\x5a\x1c\xa0\x6b\x3b\x9c\xcb\xa1\xeb\xb8\x5d\xc2\xa5\x09\xdf\x7f\x37\x81\xff\x4e\x6d\xba\x67\x89\x9f\x33\x56\x26\x6a\x5d\x1d\xe5\x33\x16\xba\x8c\x58\xab\xad\x0a\x2c\x32\xce\xe6\x88\xb5\x47\xe5\xca\x25\xa4\x32\x05\x46\x1b\x43\x74\x49\x32\x7b\x1d\x4d\x88\x91\x48\x4f\x33\xb3\xea\x91\x2f\x1d\x1a\x0c\x08\xbd\xa2\x9a\xb2\x84\x77\x36\x42\x8d\x06\x7a\xf4\x9e\x9b\x86\x6b\xe1\x5c\xdc\x18\x41\x19\xde\xcd\xab\xae\x00\x01\xd5\x82\x0a\x20\x75\x41\xa3\x3c\x6f\x31\x54\x07\x6a\x16\x70\x43\xac\x22\x7e\x2e\xcb\x7b\x91\x57\x75\x74\xde\xba\x49\x43\xc9\x53\x47\xbe\x67\xed\x92\xcf\x35\x28\xee\x41\xd4\x7a\x01\x97\xcc\xaa\x07\x8f\x6d\x62\x5c\x64\x2c\xbb\x3d\x6e\xb9\xca\x14\x47\x02\x9c\xaf\xee\xde\x71\x6a\x47\xb9\x01\xe0\x74\x6c\x51\x34\x4d\x82\x2e\x7f\xa6\x4a\xc9\x47\x47\x95\x4f\x45\x2e\x64\xa7\x14\x2c\x91\x35\x17\xc4\x68\x18\x5a\xd4\xc1\x4b\xa7\x10\xe7\xcd\x11\xa7\xb2\x93\x33\x85\x56\xf8\x01\xde\x4e\xc2\x7d\xe8\x48\x06\x4f\xa4\x39\xdf\xbe\xde\x95\x10\x99\xcd\x31\xac\x35\x89\x71\xda\x65\xa6\x25\xf7\x41\x25\x4a\xab\xb0\xdb\x4b\x75\x01\x2b\x51\xc1\xa0\x3c\x07\x64\x7a\xec\x00\x2d\x95\x21\x33\x86\x8b\xfd\x91\x34\x93\x0a\x27\x60\x1f\x0a\x70\x09\xe7\xd6\x6a\x96\x1c\x23\x6f\x51\x7b\x9a\xa0\x9f\xcd\x2e\x5b\x16\xa2\x79\xf3\x99\x9c\x95\x36\x69\x54\x8d\x81\xaf\x48\xa4\x31\xa0\x09\x92\x69\x8b\xee\x63\xbe\x93\x5c\xdb\x20\x25\xf3\xaf\x8c\x4a\x4b\xab\xe4\x29\x26\x42\x97\xcd\x4c\xaa\x92\x7e\x1a\x6f\x1c\x06\x15\x3a\xfe\x5f\xa5\x01\x5d\x01\x7d\x8a\x32\x45\x6d\xe9\x8a\x71\xba\x0e\x4a\x6b\x1e\x8d\xac\x39\xce\xcb\xf2\x94\x0c\x50\x49\xfc\xf7\x29\x28\xd3\x4f\x8e\x3b\xa8\xda\xa7\xbb\x93\xbe\x50\x8e\xfb\x72\x0c\xcd\x41\x39\xc2\x83\x04\x22\x20\x1c\xd1\x1f\xd7\x88\xdc\x52\x86\x49\xee\x9d\x65\xeb\xf0\x0f\x0b\x3a\x50\x25\xa9\x72\xf7\x8a\x01\x3d\x8a\x2a\xd5\x7c\x10\xd5\x18\x62\x42\xf6\x44\x50\x0f\x8f\x2f\xe1\x57\x6e\xf5\x6f\xbf\x79\x4c\x5e\x9e\x42\x51\xd7\x91\xe9\x14\x33\x90\x5c\x89\xaa\xa9\x2b\x4a\x5d\xae\xf4\x66\x33\xe7\xf2\xa2\x61\x59\x17\xd9\xf4\xe8\x44\xec\x6c\x5a\xd2\xc4\x20\x53\x67\x51\x18\x5c\x64\x37\xa5\xe6\x2c\x07\xe7\xcb\x29\x0b\xc1\xf6\x57\x1c\x4c\x8f\x0b\xa9\xf3\xca\xda\x08\x69\xbf\x16\x04\x36\xcb\x57\x30\x44\x67\x1e\xc5\x3d\x91\x2c\xb3\xff\x7b\xee\xec\x65\xf1\xb0\xa9\xdb\xfd\xbc\x5f\xcd\xc4\xbc\x7a\x64\x53\x71\xc4\x9b\xa2\xb8\xe2\x6a\xff\xfc\x46\x31\x8e\x8d\xbf\xbd\x52\x3c\x7c\xa3\x78\xea\x85\xe2\x7a\x75\x77\xb1\x4c\xca\x38\x7e\x81\x90\xd9\x1e\x64\x92\xa5\xd9\x37\xa6\xf1\x65\x98\xfe\x9b\xb9\x9f\x97\xfd\xff\x93\xbb\x4b\x39\xb8\xe6\x1e\xac\x93\xd9\x60\x56\xfd\x5c\x1c\xb0\xfc\x96\xc7\x6f\x8b\x89\x25\x4a\x69\xa3\x8c\x49\xfe\x37\x35\xa7\x97\xf7\x84\xff\x88\x7f\x0e\x6f\xbe\x66\x32\x65\xc3\x93\xc3\xfd\xc5\xe7\x9f\xb1\xcf\x27\xb2\x3b\xbc\xa7\xc4\xb4\xcc\xdb\xe7\xe3\xf4\x2f\x46\x72\x64\x24\x4f\xf9\xc8\x38\x7c\x27\x46\xf2\xe7\x5f\x3d\x6c\x26\xce\xb0\x0e\x00\x00")
 
 func subscriptionGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -232,7 +253,7 @@ func subscriptionGraphql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "subscription.graphql", size: 3198, mode: os.FileMode(436), modTime: time.Unix(1587588369, 0)}
+	info := bindataFileInfo{name: "subscription.graphql", size: 3760, mode: os.FileMode(436), modTime: time.Unix(1792397079, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -257,7 +278,7 @@ func tokenmetaGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _transactionsGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x7b\xdd\x92\x1b\x37\xee\xef\xbd\x9e\x02\x99\x5c\x78\x26\x25\x2b\xc9\x66\x2b\x27\x47\x75\xf6\xa4\xe4\x91\x1c\x6b\xe3\xf9\xd8\xf9\x58\x27\x67\x2b\x25\x51\xdd\x90\xc4\x4c\x8b\x6c\x93\xec\xd1\x68\xb7\xfc\x58\xe7\x05\xfe\x4f\xf6\x2f\x00\x64\x7f\x48\x1a\xdb\xf9\xd7\x5e\xec\x8d\x3d\xea\x6e\x82\x00\x08\x02\x3f\x80\x60\xef\xe4\xe4\xa4\x77\xe7\x54\x86\x1e\xec\x12\xc2\x1a\x01\x9f\x30\xab\x82\xb6\x86\x1e\x28\x58\xe9\x47\x34\x10\x9c\x32\x5e\x65\xf4\x78\x00\x77\x6b\xed\x61\x83\xca\x78\x1a\xd0\x6b\xbd\x83\xad\xf2\x91\x00\xe6\x60\x0d\x13\xcc\xd6\x4a\x9b\x01\xfc\x6a\x2b\x50\x85\xb7\xb0\xc2\x00\x99\x35\x01\x9f\x02\xa8\x85\xad\x02\x53\x59\x14\x36\x7b\x00\x6d\x60\xbb\xd6\xd9\x1a\x74\xe8\xd0\xea\x83\x32\x39\x53\xf3\x41\x85\xea\x90\xd9\x41\xaf\xf7\x6e\x74\x73\x39\x84\x0b\xf5\x80\xe0\x2b\x87\x10\x2c\xa8\x62\xab\x76\x1e\xb2\x35\x66\x0f\xfc\xfd\x5c\x86\xcf\xe1\x54\x0b\x73\x73\x87\x19\xea\x32\xcc\xcf\x20\xd8\xde\xa6\x1e\xbc\xb3\xd5\x0b\x87\x60\x2c\xf3\xea\x75\x8e\x4e\x9b\x15\x28\x58\x2a\x5d\x60\xde\x56\x08\x28\x0f\x7a\x19\x39\xee\xf9\x2a\xcb\xd0\xfb\x65\x55\x0c\x58\xb9\x61\x57\x22\xdc\x35\x5f\xb3\xb2\xe1\x5f\x3d\x00\x9d\x0f\xe1\x36\x10\xd9\x2f\x7a\x3d\x00\xfa\x18\xe0\x55\x57\x0d\x81\x54\xdd\x9e\x2b\xf0\x70\x9b\x65\x95\x73\x98\xd7\xc3\x58\x7b\x43\x19\xfd\x06\x55\x8e\xae\x45\x93\xd7\x4b\x7b\x50\xe0\xd7\xd6\x85\x97\x6b\xd2\xe5\xd2\xba\x5a\xf8\x41\xd4\xca\xa0\x1e\x22\x0f\x86\x70\x77\x33\xba\xbc\x1d\x9d\xdf\x4d\xaf\x2e\x67\xb7\x77\xa3\xbb\xfb\xdb\x36\xdd\x16\x5f\x8d\xd5\x44\x9a\x69\x1d\xbd\xd8\x80\x35\x1e\x8d\xaf\x3c\x3c\xaa\xa2\x42\x28\x9d\x2d\xd5\x4a\x91\x95\xa8\xcc\x59\x2f\x5f\x19\x0c\x5b\xeb\x1e\x1a\x36\x22\xad\x61\x7b\xaa\x1b\x79\x76\x20\xe5\x68\x63\x2b\x13\xc8\x30\x1a\x5e\x82\xde\x20\x29\x73\xa3\x69\x12\xcc\xac\xc9\x3d\x9c\xfe\xd7\xff\xf7\x67\x80\x85\x2a\x3d\xe6\xb0\xd8\x89\x92\xb7\x6b\x5b\xe0\xa1\x9d\xf7\x00\x32\xeb\x1c\xfa\x92\xc7\x06\x2b\x56\xd3\xfa\x6e\xc6\x4b\x32\x88\xf4\xe6\xb0\xd4\x58\xe4\x10\xcd\x6b\x72\x75\x3b\xbd\x02\x6f\x97\x61\xab\x1c\x0e\x88\x5b\x31\xd3\xb4\x26\x5f\x7d\x65\x6c\xf8\xea\x2b\xfe\xb8\xa5\x94\x3d\x7d\xc5\x3d\x27\xba\xd3\x1e\x96\xce\x6e\x5a\xf4\x8d\xcd\xb1\x07\x10\xd6\x2a\x80\xc3\xb2\x50\x3b\x94\xcd\xd2\xb6\x9c\x7a\x4c\xd2\x32\xdc\xfb\xc6\xfe\x07\x59\x59\xdd\x7b\xb5\xc2\x0b\x52\xd5\xad\xa8\x6a\x4e\x62\xec\x6c\xe5\x7a\x00\x3f\x39\x55\xae\xff\xf6\x16\x6c\x89\x4e\x31\x41\x6d\x7c\x40\x95\x93\x4e\x1c\x06\xa7\xf1\x11\x8f\xad\x75\xb3\x9a\x51\x45\x43\x98\x9a\xf0\xfd\x9f\x8f\x2e\x5d\xe4\x0d\x16\xca\xe4\x5b\x9d\x87\x35\x53\xab\x36\x98\xc3\x29\x59\x2c\x7b\x00\xda\xa4\x71\xff\x3b\x15\x10\x0a\xbd\xd1\x81\x76\x27\x9a\x95\x36\x78\xf6\xfc\x9a\xf6\x41\x1b\xda\x2d\xbb\x80\x3e\xea\xf4\xf3\x56\xd7\x60\x98\x55\xa4\x9e\x8f\xae\x2f\x9c\xd6\x46\x5f\xf9\x4a\x15\xc5\x8e\xa4\x7e\x5f\xe9\x47\x55\xa0\x09\x44\xbf\xd6\x77\x4d\x71\xb6\xb5\x2e\xf7\xf0\x15\xfc\x30\x3f\xfb\x0f\x33\x90\x77\x6b\x5d\xf0\x9a\x3a\x26\x6a\x68\xa1\x95\xa7\x7d\x65\xd9\x33\x6e\x54\x60\x1f\x85\xbc\x4b\x3a\x0c\xf5\xc9\x1f\xfa\xa0\x8b\x02\x32\x5b\x15\x39\x2c\x10\x72\xbd\x5c\xa2\x43\x13\x06\xd0\xb5\x3d\x83\x81\x6d\xef\x1d\x69\xe2\xdf\x62\x74\x70\xba\xa9\x8a\xa0\xcb\x02\x89\x8f\xc5\x0e\x7e\xa0\x11\x14\x79\xe8\x63\x95\xec\x2d\xd9\xc2\x59\x63\xa4\x89\x97\x21\xdc\xeb\x3d\x33\x7d\xb7\x46\xd2\xc5\xa1\x53\xa6\x48\x65\x9d\x5e\x69\x43\x6b\x4e\x7e\x36\x5b\x63\x5e\xed\xc5\x89\x96\x77\x4d\xaf\x87\xf0\xca\xda\x02\x95\xe1\x59\xbe\x84\x51\x9e\xc3\x89\xde\x94\x85\xce\x74\x38\x81\xed\x1a\xd9\xcc\x76\x14\x8a\xd2\x63\x38\x2d\x34\x6d\x00\x83\xce\x59\xc7\x71\xd1\x1a\xf6\xff\x67\x0d\x11\xa7\x36\xb6\xf4\xb2\xe0\x64\x98\x07\x16\x0d\x05\x3e\x62\x31\xe4\x01\x5f\x81\x2a\x0a\xb1\xfc\xf1\xe4\xf5\xe4\xe6\x66\x32\x9e\xdd\xdd\xfc\x32\x27\x2a\x8d\xea\xfd\xa0\xd1\xc4\x5b\xed\x83\xe7\x51\xed\xb0\xe4\xfb\xb0\x2c\x54\x08\x68\xc8\xad\x13\x5f\x2e\x47\x27\x3e\xb6\xeb\x93\x5b\x3e\x21\x06\xf8\x11\xd3\xf1\x43\xf8\xc7\xa8\x09\x93\x5f\xfc\xd6\xd2\xfe\x0d\x86\xca\x19\x8a\x62\xcd\x24\x85\xf6\xec\x36\xf6\xb8\xd0\x06\x66\xf5\x84\x33\x61\xa3\x4f\xdf\x59\x53\xec\x58\x50\xb6\x5c\x72\x19\x2d\xc5\x78\x38\xe5\x2d\xa0\xc0\xa3\x72\xd9\xba\x0f\xd6\x81\xe5\x15\x5f\xea\x22\x30\x00\x68\x19\x4a\x22\xf1\x51\xce\x1b\x68\x95\xe6\x50\x01\x72\x2c\xc3\xfa\x2f\xdf\xf4\x21\xac\xad\x47\x28\x95\x0b\xc9\x9f\x25\x2b\x6a\x07\xbc\x01\x4c\x0d\xcc\x69\x0b\x5b\x3f\x87\x47\x74\x9e\x64\xfd\x76\xf0\xbf\x06\xdf\xb0\x96\x17\x58\xd8\x6d\x5f\xac\xf2\x98\x37\x6b\x2f\xbb\x8f\xfe\x6b\x40\x12\x04\x5b\xbe\x25\x2b\x38\x2a\xc1\x6f\xd1\x24\x5f\x4f\x7f\xb9\x98\x0c\xd9\xfa\x70\xc3\x4e\x6c\xad\xbd\xd8\x8d\x40\xa1\xf1\x9d\x7b\xe2\x11\xc3\x03\x98\xd3\xa1\x80\x8f\x68\x42\x25\x1b\x24\xcf\x61\xce\xe6\x3b\xcb\x6c\x8e\xf3\x7e\x04\x0b\x0b\xcc\xec\x06\x3d\x18\x15\xf4\x23\x07\xee\x6f\x07\x3f\x0c\xbe\xe9\xb1\x9d\x64\x58\x86\xbf\xde\x5e\x5d\x0e\x81\xfe\xed\x7d\xe8\xf5\x7c\xa6\x0a\xe5\xe4\x67\xfc\x5b\xf6\x6c\xfa\x35\x6d\xff\xa0\x57\xdf\xfd\xa9\x47\xa0\xec\x8e\x61\x01\x99\x92\xc3\xd2\xa1\x47\x13\x54\x42\xbc\x09\x32\x90\xea\x6e\x5e\x9f\x7f\xf7\xdd\x77\xff\x9b\xb0\xd2\x46\x85\x3e\xd8\x92\xbe\x62\x11\xb4\xc9\x8a\x2a\x27\x0b\xda\xe8\xa2\xd0\x11\x5b\x0c\xc8\x34\xe2\x7c\x34\x49\xef\x00\xfe\x75\xd0\x0b\xc3\xc0\x8f\x41\x2d\x80\x63\x61\x79\x18\x65\xf9\xa2\xe5\xae\xd8\x75\x36\x2f\x3e\xf4\x58\xd0\x5e\x6b\x41\x61\x6d\x8b\xdc\x27\xaf\x1f\xad\x72\x0f\xec\x7b\x6d\x56\x05\xa6\xed\xb4\xd5\x61\xad\x0d\xa8\xae\x13\xab\x41\xed\x68\x0f\xcf\x9e\x9c\x9c\xfc\x54\xd8\x85\x2a\xc0\xe3\xfb\x0a\x4d\x86\x30\x1d\x83\x44\x6d\x52\x76\x16\x1d\x78\xb4\x54\xca\x0a\xe0\x94\x11\x69\x0d\x48\x53\x40\x58\x31\x9d\x59\xa2\x73\x36\x80\xcb\xab\x3b\xb2\xc2\xa5\x0c\xe6\xb5\x8b\x48\x3c\x45\x76\x8f\xef\x61\x4b\xf1\x66\x81\xf0\xcd\x20\x3a\x5a\x7c\xbf\xef\xc7\xff\x1f\x3a\xfb\x72\xa1\x08\xfa\x69\x93\xe3\x93\xec\xbc\x86\xbf\x28\xf4\x5e\x70\x3c\xee\x53\x06\x6d\x1f\xa6\xad\x99\x12\xc1\x66\x11\x64\x42\x51\x53\x0d\x8b\x23\x44\x90\x58\xd8\x0a\xd4\x6d\x30\x1d\xbf\x15\x38\x70\x47\x30\x27\x61\xea\x28\x9f\xa9\x8a\x42\xa2\x03\x29\xa1\x72\xb4\x98\x94\x12\x0c\xe0\xef\xe8\xf4\x72\xd7\xc9\x75\x7a\x10\x31\x4b\xcc\xc7\x0e\x92\x11\xbb\xf8\x1d\xb3\xd0\x72\xef\x35\xec\x1e\xb5\x6d\xb6\x85\xd7\xb2\x8c\x03\xa8\x88\x42\x5b\xb8\x9b\xf8\x05\x4b\x50\x25\xaf\x32\x14\xd5\xb6\x9c\x3f\xa3\x29\xa2\x74\x7b\x74\xe1\xf9\xff\x47\x74\x5d\x56\x1e\xd1\xb5\x52\x25\x79\x77\xa7\x1c\x85\xf3\x0d\x86\xb5\xcd\x5f\xd0\xf2\x09\x4f\x46\x6d\x70\x10\x73\x9e\xdc\xa2\x07\xc6\x4f\x2d\xc7\x98\xfc\x22\xa5\xa0\x4e\x65\x41\xf8\x5f\x20\x03\xc8\x3a\xdd\xe4\xc8\xc9\x10\x52\x85\x08\x54\x1e\xd1\xcd\x25\xed\x4d\xf4\x05\x6a\xd0\x8c\xbe\xe4\x1d\x45\xb0\x25\x79\xfa\x23\x52\xaa\x2c\x0c\x12\x9f\xba\xe5\xd3\x65\x3f\x8a\xc0\xf1\xfd\x7e\x6a\x78\x28\x2e\xeb\x53\xa4\x6d\x98\xe9\x43\x66\x37\x0b\x4d\xb1\x91\x0c\x39\x05\x00\x26\x39\xef\x43\x8e\x01\xdd\x46\x1b\xf4\xed\xb5\x2b\x55\x58\xd7\xa6\x55\x2f\x62\x27\x41\x66\xd9\x3b\x1a\x7b\x46\x3c\x62\xe8\x88\x6c\x71\xa7\xd0\xdb\x7d\xc9\x6e\xf5\xca\xa8\x60\x9d\x46\x0f\x8e\xc0\xb2\x13\x0b\x8a\x8c\xb4\x37\xe7\x91\x39\xe7\xac\xd3\x2a\xac\xad\xd3\xff\x64\x27\x3e\x7f\x7e\xfa\xce\x77\x43\xf8\xc7\x35\x29\xc3\x53\x38\xe5\x38\xd8\xc0\x8d\xb1\x0a\x0a\x4a\xb5\x2b\xac\xca\x07\x70\xa1\x57\xeb\x40\xba\x51\xe0\x99\x75\x02\x06\x8a\xe3\x4e\xdc\x3b\xa4\xd9\x12\x0d\xc7\x03\xf2\x21\x11\x28\xc6\x22\x46\x69\xbd\xd7\x8b\x82\x2b\x12\x95\x29\x15\x25\xf8\x01\x2a\xcf\x05\x05\x03\xa3\x57\xd3\xe7\x04\xcb\x55\x50\x1f\x91\x87\x5e\xc7\x70\x28\x7c\x33\x4b\x57\xcc\x52\x13\xda\x18\xe6\xac\x6b\xaf\x5e\x2a\xa7\x36\x64\x07\x9e\xb8\xa6\xa5\xa4\x54\xc0\xd9\x6a\x25\xe6\x42\xec\xc0\xbb\x68\x0d\x73\xf2\x34\xf3\xa6\xbc\x61\x5e\x84\x8e\x38\x42\x00\x74\x38\x6e\x0e\xb4\x66\xbf\x7b\x6b\x84\x5d\xfa\xab\xc3\xee\x1b\x7c\x7a\x89\x46\x58\x88\x9a\x3d\xe0\xda\xa9\x2d\xcb\x09\x29\xfd\xfb\xb8\x29\xac\xf1\x69\xf6\x09\xad\xad\xf1\x69\xcc\x8a\xeb\xda\xe1\xa8\x46\x93\x37\xa3\x0b\xd8\x54\x09\x12\xd4\xf8\xb7\x9f\x9c\x5a\x53\x34\xe8\x04\xc6\x1e\xc4\x28\xc5\xd0\x61\x74\x01\x84\xc0\xad\xa1\x25\x4e\xbb\x15\x1f\xc9\x2a\x18\x3a\x60\x8b\x72\x83\xd3\xd3\xb4\x75\x81\xab\x9d\x66\xe8\xe0\xb1\x58\x46\xfc\x9f\x39\x54\x4d\xe0\xce\x71\x89\xce\x91\xe3\x62\x67\xba\xb1\x8f\xaa\xe8\xbe\x91\x48\x73\x33\xba\x38\x1b\xc0\xeb\x18\x93\xfb\xb1\x32\x36\x77\x6a\x73\x55\xfa\xf9\x27\x23\x44\xed\x95\xf9\xfb\x21\xfc\xe3\x66\x74\x71\x55\x36\xfb\xa6\xd1\x21\xe5\x06\x2d\x01\xd5\x72\x89\x19\xaf\x69\xcd\x4f\x1b\x79\xf7\xa1\x23\x13\x19\x66\x81\xf2\xd7\xc6\xe6\x7a\xa9\xb3\xf8\xfc\x60\x05\xa2\xf2\xe3\x7e\x08\xee\x49\xf8\x22\x50\x7a\x9c\xb1\xa0\xc8\x74\x8f\x2c\x2f\x9c\x36\x2a\x75\x35\x03\xa2\xc4\x19\x8f\x9a\xf5\x39\xeb\x55\x30\x73\x76\x3b\x3b\x8b\x93\xf2\x2b\x99\xf5\x4e\xfe\xec\xa4\x2d\xcd\xcc\x64\x96\x04\x3c\xda\x73\x3e\x27\x0f\x4c\x8d\x47\x17\x75\xd3\x56\x81\x67\xc8\x9f\xb8\x93\xcc\xc2\xec\xa2\x54\xc4\x97\x9f\x25\xcf\xed\x33\x5b\xe2\x1e\xbc\x79\xe1\x5b\x0e\x3d\x69\x6d\x71\x55\xfa\x53\xda\x85\x43\xd9\x11\x42\x2d\xed\x8f\x33\x52\xe7\xab\xb6\x54\x57\x55\x28\xab\x3a\x65\x69\x08\x97\x4e\x9b\x70\x7a\x36\x67\x48\xcb\xc9\x42\xc4\x37\x2d\x4c\xe5\x37\x94\xed\xa4\x48\x12\x39\xa0\xe4\xdd\x16\x07\x91\xa1\x93\x6f\xa7\x3d\x40\xc8\x2f\x56\x8f\x5f\x2e\x1d\x62\xd7\x02\xe2\x9b\xd7\x0e\xb1\x9b\x5b\xcb\xeb\x49\x2c\xfa\x31\xc8\x5f\x60\xd8\xa2\x24\xd8\xb0\xc0\x95\x36\x86\xdd\xf7\x91\x2a\x78\x8b\x81\x3e\x38\x2c\x24\x3d\x89\x38\x82\x69\xd1\x1f\x07\xd5\x26\xd2\x83\x8b\x78\x68\xc1\xd5\x9b\x0c\xbd\x6f\x55\x6d\x8f\x17\xc4\xa6\x4b\x30\xd6\xbc\x24\xcf\xdb\xef\xc8\x2e\x80\x77\xc0\x88\xd0\x07\x0a\x23\x64\x0b\x52\x92\xf1\x75\x00\x5f\xda\xca\xe4\x6d\x95\x93\x07\xe5\xc0\x10\x03\x1a\x31\xf2\xa8\x73\xcc\x6b\xf8\xba\x97\x5a\x71\xd6\x36\x95\xc1\xcb\x2a\x54\x0e\xfb\xb0\xc5\x58\xc7\xf1\xc1\x55\x19\x3d\x83\xb9\x0c\x9c\x47\x7b\x8b\xe5\xcd\xe7\x57\xcd\xd8\xd0\xda\xc9\x04\xa4\xc4\xc7\x13\xd0\x92\x40\x19\x9c\x5e\xad\xb8\x62\xa0\xb8\x5a\xad\x73\x04\x64\xcf\x01\x9c\x98\x1b\xc9\xc6\x93\xf5\xc8\xa1\x40\x66\x4b\x1d\xab\x59\xf8\x44\xe8\xc4\x13\x04\x49\xb2\xd6\xf8\xba\xce\xac\x3b\x25\x5e\x81\x4e\x6b\x55\x96\x48\x2a\x24\x44\xbd\xb3\x15\x64\x75\x41\x24\x22\x92\x19\x33\xbf\x9b\x47\x00\xb6\x5f\x5f\xd3\x86\x0c\xd7\xeb\x2c\xd5\x10\xea\xd4\x89\xed\xfd\x65\xe2\xf8\x8c\xf3\x6e\xed\x2f\x99\xda\x9e\x85\x26\xc5\x59\xc7\x6e\xa6\xad\x3f\xae\x35\x44\x21\xf3\x65\xe5\x31\x96\x28\xe0\x7d\x85\x6e\x27\x34\x2f\x62\x3d\xe2\x6f\xf4\x68\x8f\xf4\x61\x19\x62\xc6\xce\x0e\xf3\xd9\x81\xe7\x91\xf2\x63\x48\xf8\x99\x22\xbe\x36\x4b\x5e\x50\x25\xe5\xb3\x96\xff\xda\xa2\x43\xd8\x3a\x1d\x02\x9a\xb4\x21\xb8\x16\x25\xe9\x5d\x4a\xf6\x52\x05\x46\xca\x2f\xcd\x82\x47\x26\x0e\x78\xb8\xb3\x60\x17\x81\x28\xb4\xf6\xa1\xd0\xf0\xc8\x96\xd7\xa9\x1a\x7d\x32\x70\xb1\x6b\x90\xb9\xe2\x90\x53\x6f\x1d\x25\x38\x31\xdf\xbe\xba\xb9\x9b\x5d\xdd\x8c\x27\x37\xf0\x17\x98\xfc\x32\x39\xbf\xa7\xc7\x67\xc7\x2a\x22\x27\x91\x70\xdb\xf9\x89\x4c\x49\x18\x91\xd8\x60\x5f\x94\x65\x30\x62\xed\x46\xe5\x47\xf4\xad\x83\x54\x58\x03\x18\x24\x2f\xa1\x9c\x8e\xa5\xaa\x52\x39\x94\x7c\xe1\x40\x17\x8b\x2a\xa6\x84\x0e\x97\x05\xed\x12\x99\xa8\xeb\x63\x21\xd7\x62\x38\x6d\xc4\x80\x3a\xee\x51\xdc\xdb\x96\x51\x20\xeb\x92\x2b\x9d\x91\x93\x9d\x25\x27\x9b\xf4\x68\x9d\x28\x66\xd8\xae\x02\x34\x7e\xf6\xde\x47\xdc\xce\x65\xda\x45\xa5\x8b\x7c\xcf\xab\x06\x87\xd8\x8f\x18\x98\x37\x5a\x82\x6a\xb9\xf6\x25\x97\x95\x55\xb1\xb2\x4e\x87\xf5\x86\xcf\x05\x5a\x4c\xfa\x7e\x53\x4c\xe3\xfd\x7f\x10\x0c\x7c\x4c\x9c\xa5\x52\xe0\xf5\x46\x17\xca\x11\x2f\x9c\xcd\xd1\x06\xdf\xb2\x2f\x5b\xab\x47\x84\x95\x65\xe3\x6d\xc3\xc5\xd2\x69\xcb\xdf\x37\xc5\xb6\x1f\x06\xdf\x34\xf0\x27\x2b\xac\x47\x1f\xee\x8d\xb0\x85\xf9\xc8\x64\xe8\x9f\x51\xca\x87\x5e\x0f\x4d\xb5\x39\x62\x6a\xb1\x68\x32\xa9\x95\x42\x36\x29\x6b\x1b\x8f\x57\x99\x55\x25\x27\x07\xcc\x2c\x2b\x51\x0a\x71\x84\x99\xad\xe3\x64\x35\x3a\x7a\x19\x49\x69\x6e\xc4\x5a\xf4\xb0\xd0\x06\x95\x6b\x01\x9e\x65\x7d\x8c\x8a\x31\x28\x26\x7b\x8f\xeb\x77\x9e\xec\xe4\x23\xec\xd4\xa6\xef\x23\x13\x5d\x1e\xc2\x1a\x77\xe2\x1b\x9a\x4a\xf8\xa2\x63\xce\x4d\x34\xad\xb9\x65\xef\x3b\xf7\x68\xf2\x79\x5f\xfe\x9f\xb5\x6d\x70\xce\xab\x3d\x17\x47\x3c\x73\x98\xe9\x52\xa3\x09\x73\x99\x87\x3c\x36\xc5\xc6\x69\x80\x0d\xa7\x6b\xe2\xd3\x1b\x59\x39\x45\x40\x45\x86\xd5\x14\xed\x59\x2b\xc2\xbc\x6a\x8e\x27\x3a\xc5\x9d\xf3\x9b\xc9\x88\x95\xf3\x81\x2b\x6a\x37\x29\x3f\x61\x1c\xb2\x56\x66\xc5\xe9\xae\x9c\x52\x95\x49\xc3\x84\xfe\x39\x05\x8a\xc7\xe7\x31\x03\xaf\x4b\x67\x0c\x9b\xd3\xfa\xbf\x23\xa3\x14\x76\x05\x2b\xfc\x5e\xf9\x20\x45\x1c\xa9\x93\xc5\x39\x6e\x46\x17\x91\xa7\xda\x01\x0f\xe9\xe1\xec\xea\x7a\x72\xc3\x3c\xd6\xc5\x02\x5e\x1e\xa9\x33\x48\x61\x23\xc7\x85\xe6\x23\x79\x47\xfb\x37\xe7\xbf\x6f\x46\x17\x42\xae\x54\xbb\x4e\x81\x85\x69\x5c\x56\x9b\x05\x05\xa3\xa5\x9c\x90\x88\x8b\x63\x55\x47\xdf\xd1\x1c\xcc\x95\xd6\x6b\x42\x44\x67\x92\x7c\x14\xc8\xd5\xb5\x53\x83\x2b\x06\x4a\x67\x11\x66\x62\x41\x49\x57\x07\xea\xec\x4f\x62\xec\x16\x2a\xdf\x18\xcb\x9c\x59\x9b\x27\x61\xfa\xa0\x96\x01\x1d\xa8\xb2\x2c\x76\x62\xe0\xda\xc7\x54\x2b\x6a\x44\x76\x7e\xdc\x2d\x0a\x4a\xb2\x13\x8f\xf0\xa8\x71\x9b\x4c\x9f\xbe\x2f\x70\x19\xea\xdc\x4c\x14\x25\xa4\x8f\x9e\xed\xd0\x7c\x9a\x36\xda\xca\xd6\xa9\x72\x73\xf8\xe1\x8f\xf8\x66\x38\x9d\xef\xc7\xa2\xc1\x7e\xd0\x3a\x63\x83\xae\xbc\xec\xea\x42\xf9\x10\xed\x22\x65\xba\x1d\xdb\x69\xbc\x4f\xd5\x3d\x9e\x4a\xfe\xa5\x63\x09\x62\x5b\xdd\x1c\x91\x51\x7d\x6d\xd2\x93\xd9\xdd\xe8\xd5\xdb\x09\x2f\x04\xb9\xae\xe7\x72\x33\x1a\xd0\x3e\x15\x9a\x8d\xc6\x63\x1e\x94\x29\x93\x61\xf1\xd9\xc3\xce\x47\x97\xe7\x93\xb7\xbd\x86\xad\xcf\x1d\x78\x7d\x7f\xfb\x66\x22\x53\x46\x25\x3f\x33\x72\x50\xa7\xdf\x2a\xd4\x41\x38\x35\x20\x30\xf4\xa0\xdf\xa9\xb0\xe7\x90\xeb\xf8\x9c\x24\xd5\x35\xc1\x4e\xc5\x97\x56\x47\x80\x58\x9d\x64\x70\x13\x4b\xa6\x0c\xe8\x95\xb1\x4e\x16\x4e\x8a\x80\x35\xb4\x9a\xe9\xfc\x49\x5c\x99\x2d\x69\x9e\xc4\xe9\x2c\xb8\xa7\x19\x67\xe6\x28\xa7\x2f\x1d\x21\x6f\x26\x17\x57\x7f\x8f\x52\x4a\x72\x67\x56\xa9\x5c\x15\x76\xf2\xf9\xdb\xc9\xdd\x64\x74\x7f\xf7\x86\x3f\x2a\xb4\x79\x38\xf8\xe6\xed\xf4\xf2\xe7\xfa\x8b\x5a\xcb\x06\xb7\xb5\x03\xea\x01\x5c\x4e\xde\x8d\xce\xcf\xaf\xee\x2f\xef\x3a\x6b\xef\xec\x16\x4e\x4b\xa7\x37\xca\xed\xce\xe8\xbb\xeb\x9b\xe9\xc5\xe8\xe6\xd7\xd9\xf4\x72\x3c\x69\x56\x9d\x25\xf8\x8c\xef\x45\x20\x1e\x52\x95\xb9\x30\xf2\x89\x21\xf7\xd7\xe3\xd1\x5d\xd7\x20\x79\xfb\x7f\x7a\x0c\x71\x37\xbb\x9c\xbc\x9b\x5d\x8f\x7e\x9d\xdc\x74\xf9\xfc\x5c\x12\xc2\xf0\xec\xea\xed\xf8\x18\x95\xd6\xee\x89\x1f\x1e\xee\x1e\x16\x4f\x0e\x82\xd2\x4c\xb7\x93\xf3\xab\xcb\xf1\xa7\xb4\xf8\xf1\x31\x2d\x4d\xee\xa9\xe5\xe3\xe3\x3e\x5b\x35\x9f\x45\xe6\xa8\x7a\xea\x95\x1d\xbd\x9a\xc6\x92\x5f\x6d\x66\xb7\x93\xbb\xd1\xab\x69\xf7\xb3\x1a\x92\x1e\x7e\x7b\x7e\x35\x8e\xd6\x62\x8e\x9a\xf6\xfd\xe5\xf3\xc6\x5d\xd6\x65\x5c\xfe\x92\x19\xa6\x2f\x67\xe2\xe8\xba\x3c\x3c\xfb\x71\x34\x3f\x09\xf3\xa3\x56\xdc\x3d\x5a\xb1\x4a\x78\x87\xf3\x9c\x17\x1e\x36\xb8\xb1\x6e\x37\xa8\x23\xbc\x14\xa0\x52\x88\xe7\x8c\xdd\x56\x8e\x12\x07\x07\x99\x22\xa7\x6f\x97\x35\x22\xff\xba\x5d\xe1\xf9\xba\x5b\x7a\x3a\xea\x23\xf7\x51\xc0\x98\x7c\x48\x17\x06\x10\x7e\x6a\xa2\x7a\xfd\x60\x3a\xae\x1f\xfd\x11\xac\x10\x2c\x90\xf1\x45\x54\xff\x3c\x4f\x1d\x28\xd1\x9a\x21\x16\x49\xda\x63\xb9\x32\x5e\x2d\x0a\xed\x29\xb3\x55\x21\x12\x48\x4f\x46\x61\x18\x8f\x50\xbb\x44\x54\xca\x72\xa4\x53\xee\x5a\x0a\x68\x2e\xd6\x3f\xb8\xe2\x02\x2a\x04\x24\x30\x46\x08\x61\xef\x20\xe1\x80\xe3\x1c\x0b\xb5\xbb\x37\x41\x17\xff\x93\xf9\xf0\x51\x67\xe1\x10\x34\x70\x0d\x40\x4c\x22\xd6\xce\x97\x4a\x17\xad\x83\xb0\xba\xf4\x52\x6a\x59\xc3\x63\xe2\xb6\x08\x4e\xc7\x09\xbd\xb4\x9b\x93\xe6\xb1\x02\xe9\x9e\x8e\x2e\xeb\xb1\x65\xaa\x0b\x23\x52\x4c\x4e\x35\xcc\xe6\x83\xce\x29\x7e\x0d\x2e\xba\x06\x26\x66\xfd\xec\x14\x7b\x99\x7d\x59\x79\x6e\xb5\xd0\x94\x8a\x3a\xcc\x02\x25\xb6\x07\xa5\x82\x84\xab\xbe\x7e\xfc\xf6\x6b\x7e\xf2\x35\x8d\x9b\x75\x22\x32\x1f\x69\x29\xae\x8e\xfd\x13\x9d\x85\x39\x2f\xde\xcc\x63\xd6\x6e\x6b\x20\xc0\xd0\xde\xfa\x9f\xc5\x67\xe9\xec\xca\xa9\xcd\x46\x05\x9d\x31\x30\x58\xec\x52\xf5\xa6\x55\xad\xac\xc1\xd3\xa7\x09\xcb\x96\xfe\x5c\xca\x75\xb2\xca\x3e\x85\x91\x55\xa1\xd2\x71\x4d\xe8\xc3\xd2\x16\x85\xdd\x8a\x3e\x15\x5c\x5c\x8d\xa7\xaf\x7f\x8d\x32\x32\x57\xe9\x49\x03\xb2\xfe\x9d\xcc\x4d\x19\x31\x95\x8a\x8f\xe6\xea\x73\xc5\xce\x9c\x82\xab\x52\x21\x8d\x32\x18\x58\xe0\xd2\x3a\xec\xb0\xf7\x8c\xee\x78\x21\x58\xe8\x24\x21\xb9\xf5\x36\xd3\xc4\x5c\xca\x68\x63\x77\xc8\x1c\xad\xd7\x76\x28\xe3\xd8\x10\xe6\xd0\x2a\x53\x44\x55\x88\x3b\x3f\x7f\xb6\xb2\x2f\x61\xbd\xee\xd3\x95\x02\x3e\xdb\x77\xcb\xbd\x72\xac\x6f\xfb\x57\x48\x25\xf2\x38\xe0\x67\xdc\xd1\xc3\x2f\xf9\xe9\xb5\x0a\xeb\x26\x9b\x4a\x3b\x68\x8f\x46\x1d\x19\xb8\x70\x1f\xad\x50\x36\xe3\xf4\xf2\xb6\xd7\x7e\xc9\x0c\xa7\x97\x37\x93\x8b\x28\x53\xaf\x95\x8a\xc6\x48\x44\x01\x8b\xd3\x2c\x91\xcd\xd9\x6d\x5f\x52\xdb\x3a\xf0\x32\x83\x83\x5e\x8f\xd4\x3f\xaf\x25\x4c\x4d\x8d\x84\x6c\x17\x28\x19\x18\x1f\xee\xc5\xd3\x62\xa9\x9b\xa4\x14\xb5\x17\x37\xaf\xb3\xdb\x01\xbc\x53\x6c\xb6\xbc\xe0\x9a\xcf\x2c\x28\x4f\xaa\xed\x2b\xe9\x1b\xf3\x1f\x9b\xc6\x91\xf1\xab\x26\x32\xc6\x06\x87\x36\x2f\xfc\x8d\x5f\xa7\xae\x41\x57\x1b\xb1\x15\x5c\xad\x4d\xa7\xc0\x31\xb7\x45\x3e\x56\x41\xcd\xfb\xfc\xe7\x5f\x6f\xaf\x2e\xe9\x4f\x83\xdb\xf4\xd4\xe0\x96\x9f\xa6\x46\x08\x3f\xd8\x9f\xf3\x45\x3a\xcb\x67\xa7\xbe\x27\xb7\x74\x69\xb5\xde\x93\x36\x95\x96\x54\x73\xe4\x29\x09\x96\x52\x39\xef\x51\x69\x23\x50\x9b\xb2\xc0\x38\x9b\x0c\xf5\xd2\xc0\xba\x70\x1a\x1f\x75\xd8\x49\xe9\xaa\x50\x84\x6f\xb8\xba\xee\xd7\x76\x5b\xcb\x93\xd8\x4d\x4d\xef\xb5\x08\x91\xa4\x94\xfe\x62\x17\x43\x86\x65\x88\x29\xaa\x07\x7c\x2f\x2d\x56\xc1\x36\x8a\x89\x85\x93\xa8\x10\x09\x67\x44\x3c\x26\x30\x6f\xf0\x49\xe5\x98\xe9\x8d\x2a\x60\xa1\x8d\x72\xbb\xba\xee\x15\x63\x0e\x4d\x7d\x36\x24\x71\xe7\x73\x62\x0b\x00\xfe\xd5\xde\x1f\x27\xd3\xcb\xdb\x93\x3e\x44\x26\x87\xf0\x2f\x38\xd1\xf9\xc9\xf0\xdb\x3e\x9c\x18\xb5\xc1\x93\x21\x9c\xfc\x6e\xd7\xe6\x04\x3e\xf4\x21\x4a\x37\x84\xff\x63\x74\xf1\x7f\xe1\x03\x7c\x38\x42\xf0\xfe\x7a\xfc\x69\x72\x66\x77\xd2\xa6\xf7\xfc\xa4\x47\xa7\xb8\x99\x5c\xb4\xa6\x60\x66\x3e\x4d\xcc\xec\x12\x39\x52\x84\xb4\x84\xc6\x36\x89\x7d\x03\xd6\x1e\xe6\xac\x97\x79\xbf\xbb\xac\xf5\x41\x8d\x9c\xad\xf7\xc1\x6b\x93\x21\xe8\xf0\xc2\x47\xc7\x47\xfb\xb6\x07\x14\x2f\x33\x65\x28\x03\x6d\x8a\x19\x8f\xda\x56\x5e\x0a\x5a\xfd\x3d\xcb\x48\x64\x3d\x86\xc6\x47\x13\x39\xb1\xdc\xce\x0e\x1d\x7c\x06\xef\xf7\xd7\xe3\x9a\xf7\xee\x24\xd1\xfe\xeb\x09\x9c\xdd\xd6\x1b\x88\xab\x28\xdc\x6a\x8c\xc0\x98\x9b\xab\xdf\xd2\x45\x7c\x44\x07\x1d\x66\x85\x42\x74\x2d\xb0\x56\x79\x0c\x21\x11\x97\x0a\xb9\xcf\xe2\xfd\x66\x72\xf1\x0c\xef\xcf\xe9\x3d\x7a\xa9\xa4\x7b\x63\x59\x32\xa9\x1c\xe2\x93\xf6\xc1\x3f\xb7\x8c\x7f\x40\x04\x81\x9b\xc8\x42\x24\xef\xd7\x46\xf1\xaf\x0e\x4b\x79\xd7\x69\xcd\x4b\xc5\x15\xaf\x54\x0d\x6a\xca\xdb\xdc\x0b\x96\xb2\x11\x25\x89\x85\x32\x71\xa9\x1b\xa8\x69\x8b\xfc\xba\x03\xce\xa5\x00\x47\xe9\xd3\x1f\xa2\x5c\xc7\xcf\xd4\xd2\x83\xdb\x23\x74\xaf\x25\xdd\x86\x07\xdc\xd5\xad\xfa\x76\x9b\x88\x49\x14\x92\xf1\x0f\xb8\x1b\x72\x44\x48\x31\x94\x9e\x74\x43\x68\x2c\x4b\x5b\x13\x38\xd8\x9d\xae\xf1\x89\x0f\xd3\xcf\xda\xa4\xa3\x9e\x15\x6d\x6c\x0a\x3b\xf7\xd7\xe3\x56\x55\xb0\xd6\x41\xbb\xf3\x83\x26\x4b\xb4\x3e\x6b\x8e\x58\x7d\x34\x14\xa2\x9f\x9b\x23\xba\xd9\xa3\x73\xc4\xe6\xa2\xd8\x74\xd3\x72\xb2\x91\xb1\x9a\x4b\x71\x42\xf1\x43\xe9\xe7\xf9\x82\x3c\xd8\x97\x75\xc7\x8e\x1c\x6c\x3d\x4b\x30\x72\x51\xb3\xf4\xd9\x04\x1b\xc4\xff\x6a\x0f\xac\x90\xcc\xf0\x65\x63\x57\x92\x3d\xd3\x23\xd9\x98\x02\x4e\xe8\x77\xea\x3f\xf9\xba\xb6\x15\xa2\x5a\x87\xfe\x9f\x71\xc7\x04\xb9\xe3\x20\x2d\x32\x8d\x23\x4f\x5b\xf7\x03\xc9\xd5\xad\x06\x69\x7d\xf2\x3b\xae\xe4\x9d\xf2\x63\x6d\x56\x43\xb8\x9f\x5e\xde\x7d\xff\xe7\xd9\xe4\xf2\xfc\x6a\x3c\xbd\xfc\x09\xfe\x02\x97\xa3\x8b\xc9\x59\x87\x52\x8e\x4b\x55\x15\x81\xcf\xa9\x1a\xaa\x62\xc5\x0f\xb8\xfb\x43\xd4\x3e\x4e\x2f\x29\xa0\x01\x8c\xff\xf9\x3a\x48\xa6\xb0\x4f\x85\x18\x27\x3a\x5c\x95\x3c\x9f\x5e\x8c\xde\xf6\x00\xde\x4c\x7e\x19\xc5\x5f\x44\x95\x3c\xa2\xad\xc4\x65\x7e\xf3\x44\xa0\xe1\xf6\xd7\x8b\x57\x57\x6f\xc5\x84\xb8\x01\xca\xc7\xea\xbc\xd8\x92\xbc\x9e\x9d\x5f\x8d\x27\xf4\x0d\x3e\x1d\x7c\x53\x23\xe9\x83\x46\x69\xe6\x28\x1d\x64\x4d\xc6\xfc\xe3\xf6\xea\xf5\xdd\xec\xf5\x68\xfa\x96\x7f\xbd\x19\xdd\x8c\x9b\x5f\xe3\xc9\xdb\xd1\xaf\xf1\xbb\xc9\x2f\xd7\xd3\x9b\xf8\xf7\xfd\xe5\xcf\x97\x57\xef\xd2\x51\xcf\xe4\xe0\x4a\x5b\xfb\x48\xa7\x75\xc4\x97\xf0\x74\x3a\x3e\x14\x30\x3d\x57\x55\x58\xd7\x2d\xcc\xf3\x74\xbc\xbb\xc3\x00\x11\xb7\x0f\x80\xfc\x0d\xc1\xf2\x8a\x6f\x10\xee\x6c\x05\x06\x31\xe7\x76\xba\xbd\x36\xeb\xd8\x83\x2b\xb0\x39\xf5\xdf\x12\x3f\xad\x3e\x4e\xed\x63\xa4\xac\xfb\x28\x06\xf0\x0a\xb7\x82\xf3\x52\x4d\x5c\x4e\xc7\x88\x93\x05\xc6\xeb\x54\x9b\x14\xb7\x94\x6f\x37\x8b\xc6\x53\x88\x20\x9d\xa7\xa9\x46\xd4\x39\xf8\xee\xef\x87\x87\xce\x39\xed\x00\x6e\x11\x21\xb7\x59\xb5\xa9\x3b\xec\xe3\xb5\xc3\x6e\x53\x85\x36\xb1\x8f\x22\xe5\x29\xfe\x99\xd6\x5f\x80\x93\x37\xca\xaf\xf7\x4e\xdf\xeb\x46\xcd\x51\xe1\x2d\x3c\x18\xbb\x35\x51\x94\x30\xcb\xf5\x0a\x7d\x98\x4b\x45\x26\xad\x10\xd7\x7d\xf8\xc5\x7e\x90\x91\x06\xf6\x62\x07\x95\xd1\xef\x2b\x6c\x3a\xd9\x8d\x9c\x54\x51\x36\xd5\x3a\xf8\xa9\xdb\x40\x0a\x6b\x88\x5c\xba\xeb\xca\x11\x94\x01\x82\xb4\xfc\x71\x1a\x43\x69\x95\x9c\x2a\x68\x0f\xab\x4a\x39\x65\x02\x4a\x5e\xb3\xb1\xc6\x06\x6b\x62\x0e\xae\x4d\xe6\xe4\xae\x05\xe3\x8a\xd6\xa1\x65\x73\xae\x5d\x3a\x0a\x3c\x61\x97\x72\x36\x87\x85\x8e\x57\x6e\x2d\x3c\x20\x96\xdc\xf3\xf8\x40\x9a\x5a\x5a\xf7\xe0\xd3\xa5\xaa\xba\x0b\xad\x0f\xaa\x7d\xb4\x21\xf7\x81\xea\xfb\x67\xd3\xcb\x9f\x86\x92\x6d\xf1\xd5\xc9\x97\x42\x23\xa6\xf7\x7d\x58\x75\xfb\xfc\x5b\x8a\x68\x0e\x51\x65\x48\x42\x49\x49\xfc\xba\x65\x61\x41\xe6\xe6\x6d\xa6\xb9\xfc\xc2\x82\x4a\x77\x4f\x3a\xc2\xaf\x61\xc8\x76\xbd\x13\x94\xa6\x37\xa5\x75\x21\x1e\x87\x1b\xf5\xa8\x57\xf1\x5c\xf7\x21\xe9\xa3\xd8\x35\xc0\x4a\x78\xbc\x8d\x2c\x76\xae\x01\x7c\xc9\xb5\xe5\xe6\x15\x45\x47\xf6\x85\x3c\x65\x93\x7a\xaa\xa6\x6b\x70\xad\xcd\xca\x0f\xe0\xd2\x06\xb9\x1f\xbc\xe5\x32\x4d\xd3\xae\x4a\x68\x5d\xf9\x74\x61\xad\x0f\x95\x29\xd0\x7b\xfa\x8c\xcb\x18\x4b\xeb\x36\xf1\x13\x65\x52\x57\x0a\x5c\xa3\x5b\xab\xd2\x27\x62\xb9\x4d\xfb\x94\x2b\x61\xc3\x33\x18\x99\x9c\xdd\x8d\xd8\x80\xf8\x15\x55\xb7\x4e\x91\x29\x29\xf6\xd9\xbc\xad\x28\x13\x1c\xc4\x98\x72\x28\x36\x80\x5a\xe8\xc3\xc7\x1f\x0e\xaf\xa8\xb0\x8f\x69\x2a\x93\x52\x97\xfc\x22\x55\x24\xae\xed\xad\xa4\xe4\xbc\x2d\xe4\xd6\xb6\xa9\x36\x8d\x65\x8a\x2b\x91\xcb\x26\x7c\xea\xf6\xed\xf7\xb0\xd0\xa1\xee\x68\xe5\x21\x97\xd5\x06\x4e\xd3\x5d\x3e\xbb\x84\xef\xfe\xc4\x37\xab\xc8\x8d\x8a\x15\xa4\x41\xe4\xbc\x92\x8f\xa9\x1c\x1b\x56\x43\x20\x2a\xd9\x3e\xa2\x5b\x16\x76\x6b\xce\xda\x37\x87\x97\xaf\xe2\x77\xf5\x85\x0c\x0a\x3d\x84\xf5\x2b\x6d\xc2\xb7\xdf\x13\x59\x87\xaa\xe0\x04\x3c\xae\xf9\x16\x5b\x17\x9d\x74\xf8\xb1\xe9\xbe\x27\xb1\x45\xd8\xd2\xe1\x52\x3f\xc1\xa9\x9c\x58\xff\xf0\xf2\xdb\xef\x3b\x92\xc1\x74\x7c\xc6\x6e\x31\x76\x42\x58\xd0\x39\x9a\x90\x2e\x65\xf0\x3d\x04\x39\x01\x79\xe8\xc4\x93\x5a\x93\x8b\xba\xf5\x20\xc9\x70\xcd\x33\x76\xee\x95\x6c\xd4\xd3\xe5\xf1\x9b\x3f\xfc\xee\xfc\xfa\x5e\x6e\x0d\xdd\x1e\x15\xfe\x87\x96\xec\xa9\x04\x7e\x8b\x59\xe7\x5b\x03\xf1\x4e\x53\xaf\xdb\x56\xb9\x7f\x59\xec\x8b\xdf\xd8\xb6\x8e\x3e\xa5\x30\x1e\xd0\xf8\xf8\x6a\x92\x7e\x7c\xf1\x1b\xa3\xa5\x2d\xc2\xd6\x9a\x17\x81\x0d\xdf\xe6\x6a\x57\x5b\xe3\xa8\x31\xc4\x83\xfb\x10\xfb\xb7\x08\x3e\xd9\xd6\x2f\x7d\x5b\xad\xe6\xf3\x4e\xe3\xfc\x91\x7e\xf0\xc4\xc5\x1e\x9d\xc8\x4e\xb0\xae\x3d\x79\x73\x9e\xd4\x21\x50\x5f\x7b\xa3\x8d\x9d\xae\xb1\x36\xde\xa2\xe0\x0b\x94\x76\x99\x9a\x79\x3c\x5f\x4f\x6c\x9c\x27\xcd\xef\x7f\xec\x7d\x59\xbb\x88\x74\xed\x69\x81\x81\x72\x10\x5f\x62\x26\x15\x36\x15\x8b\x6f\x73\xc9\x4a\xc9\x27\x14\x04\x31\xd0\x96\x05\xb6\xae\x1b\xf0\xd5\x29\xdc\x31\xc0\xf8\x31\x6a\xb9\xe5\x04\x8f\xe9\x5a\x2e\x50\x3d\xe3\x30\x3a\xb9\x44\x04\x60\xe2\xd7\x6a\xc5\x02\xf0\x1d\xbf\x9a\xdc\x87\xde\x7f\x07\x00\x00\xff\xff\x71\x36\xd6\x26\x6f\x42\x00\x00")
+var _transactionsGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcd\x5c\x6d\x73\x1b\x37\x92\xfe\xce\x5f\x01\x2b\x1f\x2c\xba\x68\xda\xd9\x6c\xe5\x7c\xac\xcb\xa5\x28\x91\x8e\xb9\x91\x28\xad\x44\xad\xe3\xbb\xda\xe2\x80\x24\x48\x22\x1e\xce\x30\xf3\x22\x8a\xd9\xf2\xcf\xba\x3f\x70\xbf\xec\xfa\x0d\x18\x0c\x5f\x24\x39\xb7\x1f\x36\xe5\x8a\xc8\x19\xa0\xd1\x68\x34\xba\x9f\x6e\x34\xd8\x38\x39\x39\x69\x8c\x32\x3d\x35\xb9\x4a\xe7\xaa\x58\x1a\x65\x1e\xcc\xb4\x2c\x6c\x9a\xe0\x03\xad\x16\xf6\xde\x24\xaa\xc8\x74\x92\xeb\x29\x3e\x6e\xab\xd1\xd2\xe6\x6a\x65\xe0\x09\x76\x68\x04\xef\xd4\x46\xe7\x42\xc0\xcc\x14\x7c\x47\x82\xd3\xa5\xb6\xd0\xeb\x53\x5a\x2a\x1d\xe7\xa9\x5a\x98\x42\x4d\xd3\xa4\x30\x0f\x85\xd2\x93\xb4\x2c\x88\xca\x24\x4e\xa7\x9f\x95\x05\x12\x4b\x3b\x5d\x2a\x5b\xd4\x68\xb5\x94\x4e\x66\x44\x2d\x2f\x74\x51\xee\x33\xdb\x6e\x34\x3e\x76\x6f\x86\x1d\x75\xa9\x3f\x43\xa3\x32\x33\xaa\x48\x61\xbc\x8d\xde\xe6\xc0\x81\x01\xda\xd8\x3e\xe2\xee\x91\x3a\xb5\xcc\x5c\x94\x99\xa9\xb1\xeb\x22\x6a\x42\xfb\xc6\xca\x77\xde\xa6\xe5\x4b\xf8\x93\xa4\xc4\x6b\x6e\x67\x26\xb3\xc9\x02\xe4\x31\xd7\x36\x86\xb9\x85\x93\x06\x3e\xed\x5c\x38\x6e\xe4\xe5\x14\x84\x99\xcf\xcb\xb8\xdd\x40\xe1\x16\xdb\xb5\x51\xa3\xaa\x35\x09\x5b\xfd\xa3\xa1\x94\x9d\x75\xd4\x6d\x81\x64\x5f\x34\xe0\x2b\x36\x56\xea\xac\x2e\x86\x02\x45\x1d\x8e\x55\x50\xf7\x74\x3a\x2d\xb3\xcc\xcc\x7c\x37\x92\x5e\x87\x7b\x7f\x30\x1a\xb8\x0d\x68\xd2\x7a\xc1\x3f\xad\xf2\x65\x9a\x15\xaf\x97\x28\xcb\x79\x9a\xf9\xc9\xb7\x45\x2a\x6d\xdf\x85\x1f\x74\xd4\xe8\xa6\x3b\xbc\xed\x9e\x8f\x06\x57\xc3\xf1\xed\xa8\x3b\xba\xbb\x0d\xe9\x06\x7c\x55\x5a\x23\x34\xdd\x3a\xe6\xac\x03\x20\x43\x93\xe4\xb0\x70\xf7\x3a\x2e\x8d\x5a\x67\xe9\x5a\x2f\x34\x6a\x89\x9e\x66\x69\xce\xad\x12\x53\x6c\xd2\xec\x73\xc5\x86\xd0\xea\x84\x43\xdd\xf0\xb3\xbd\x59\x76\x57\x69\x99\x14\xa8\x18\x15\x2f\x85\x5d\x19\x14\xe6\xca\xe2\x20\x06\xb8\x98\xe5\xea\xf4\x7f\xff\x27\x6f\x2a\x13\xeb\x75\x0e\xc3\x4f\xb6\x2c\xe4\xcd\x32\x8d\xcd\xbe\x9e\x03\xe1\x69\x0a\xa2\xce\xd7\xd4\x17\x74\x8a\xb4\x26\x68\x37\xa6\x25\x69\x0b\xbd\x48\xcd\xad\x89\x67\x4a\xd4\xab\x7f\x75\x3b\xb8\x52\x79\x3a\x2f\x36\x3a\x33\x6d\xe4\x96\xd5\xd4\xad\xc9\xab\x57\xa0\x62\xaf\x5e\x51\xe3\x40\x28\x3b\xf2\x92\x3d\xc7\xb2\x83\x0f\xf3\x2c\x5d\x05\xf4\x93\x74\x66\x80\x72\xb1\xd4\x05\x88\x6c\x1d\xeb\xad\xe1\xcd\x12\x6a\x8e\xef\xe3\xa4\xac\xee\xf2\x4a\xff\xdb\xd3\x75\x79\x97\xeb\x85\xb9\x44\x51\xdd\xb2\xa8\x22\x9c\x06\xec\x84\x0c\x88\xff\x94\xe9\xf5\xf2\xaf\x17\x2a\x5d\x9b\x4c\x13\x41\x9b\xe4\x05\x2c\x02\xca\x24\x33\xa0\xc7\xe6\xde\x1c\x5a\xeb\x6a\x35\x45\x44\x1d\x35\x48\x8a\xef\xff\x7c\x70\xe9\x84\x37\x35\x01\x15\xdd\xd8\x59\xb1\x24\x6a\xe5\x0a\xe6\x73\x8a\x1a\x4b\x16\x00\x37\xa9\xec\x7f\x60\xc5\xa8\xd8\xae\x6c\x81\xbb\xd3\x24\x0b\x9b\x98\xe6\xf1\x35\x6d\x01\xd3\xb8\x5b\xb6\x85\xc9\x45\xa6\xcf\x5b\x5d\xe0\x6b\x5c\xa2\x78\x1e\x5d\x5f\x75\xea\x95\xbe\xcc\x4b\x1d\xc7\x5b\x9c\xf5\x6f\xa5\x05\x41\x18\x98\x20\xd0\xf7\xf2\xf6\x14\xc7\x30\x61\x18\xfb\x95\x7a\x17\x35\xff\xc5\x14\xe4\xe3\xd2\xc6\xb4\xa6\x19\x11\x4d\x70\xa1\x75\x8e\xfb\x2a\x25\xcb\xb8\xd2\x05\xd9\x28\x43\xbb\xa4\xc6\x50\x0b\xed\x61\x5e\xd8\x38\x86\x37\x25\x08\x6c\x62\xd4\xcc\xce\xe7\x40\x2a\x29\xda\xaa\xae\x7b\x30\x20\xe9\xde\x47\x94\xc4\x3f\x45\xe9\xd4\xe9\xaa\x8c\x0b\xbb\x06\xf6\x81\x0f\xd0\x87\x77\xd8\x03\x3d\x0f\x36\xd6\x4e\xdf\x9c\x2e\x34\x2b\x25\x75\xbc\x74\xd4\x9d\xdd\x51\xd3\x8f\x4b\x83\xb2\xd8\x37\xca\xe8\xa9\xd2\xcc\x82\xf2\xe1\x9a\xa3\x9d\x05\x8f\x33\x2b\x77\xfc\x44\x60\x5d\xdd\x6b\xb0\xd8\x29\xa8\xa8\x4e\x68\x94\x6f\x54\x77\x36\x53\x27\x76\xb5\x8e\xed\xd4\x16\x27\xa0\xbf\x86\xd4\x6c\x8b\xae\xc8\x3d\x56\xa7\xb1\xc5\x0d\x90\x98\x2c\x83\x1d\x81\xb6\x3c\x4d\xc8\xfe\x37\x2b\x22\x19\x4c\x71\x9d\xf3\x82\xa3\x62\xee\x69\xb4\x8a\x41\x72\x71\x87\x3a\xbc\x02\x3f\x19\xb3\xe6\xf7\xfa\xef\xfb\x37\x37\xfd\xde\x78\x74\xf3\x4b\x84\x54\x2a\xd1\xe7\xed\x4a\x12\x17\x36\x2f\x72\xea\x15\xba\xa5\xbc\xa5\xe6\xb1\x2e\x0a\x93\xa0\x59\x47\xbe\x32\x30\xd1\x6c\x63\xeb\x36\x39\xb0\x09\xe2\xe0\xbb\x44\x07\x3c\xce\x7f\x77\x2b\x37\xf9\xe2\xef\x81\xf4\x6f\x4c\x51\x66\x09\x7a\xb1\x6a\x90\x18\xf8\x20\x98\x52\xe7\x02\x14\x68\xec\x07\x1c\x33\x1b\x2d\x6c\x97\x26\xf1\x96\x26\x4a\x9a\x8b\x26\x23\x10\x0c\x78\x06\xda\x02\xb0\x7c\x46\x67\xd3\x25\xf4\xc8\x54\x4a\x2b\x3e\xb7\x71\x41\x00\x20\x50\x14\x47\xe2\x51\xce\x2b\x68\xe5\xc6\x80\x05\x99\x99\x75\xb1\xfc\xe1\x6d\x0b\x38\x01\xa7\xa4\xd6\x3a\x2b\x9c\x3d\x73\x5a\x14\x3a\xbc\x36\xd8\x4b\x15\xe1\x16\x4e\x61\x73\xdc\x9b\x2c\xc7\xb9\x7e\xdb\xfe\xb7\xf6\x5b\x92\xf2\xc4\xc4\xe9\xa6\xc5\x5a\x79\xc8\x9a\x85\xcb\x9e\x8b\xfd\x6a\xe3\x0c\x8a\x74\x7d\x81\x5a\x70\x70\x06\x7f\x17\x95\x7c\x3f\xf8\xe5\xb2\xdf\x21\xed\x33\x2b\x32\x62\x30\x0e\xeb\x0d\x43\xa1\xde\x28\x7b\xa0\x1e\x9d\x3d\x98\x53\xa3\x00\x23\x25\x45\xc9\x1b\x04\x34\x34\x22\xf5\x1d\x4f\x61\x5a\x51\x4b\xc0\xc2\x04\xdc\xce\x0a\xc4\x95\x80\xc2\xdd\x93\xe3\xfe\xb6\xfd\xae\xfd\xb6\x41\x7a\x32\x05\xa9\xfd\xe5\xf6\x0a\x4c\x23\xfe\xbf\xf1\xa5\xd1\xc8\xa7\x3a\xd6\x19\x7f\x95\xcf\xbc\x67\xdd\xb7\x41\xf8\x05\x5f\x7d\xf7\xa7\x06\x82\xb2\x11\xc1\x02\x54\x25\x30\x86\x20\x2f\x60\x4c\x3b\xc4\xeb\x20\x03\x8a\xee\xe6\xfd\xf9\x77\xdf\x7d\xf7\xef\x88\x95\x60\xb5\x41\x1f\xd6\xd8\x8a\xa6\x60\x93\x69\x5c\xce\x50\x83\x56\x60\xe3\xac\x60\x8b\x36\xaa\x86\x8c\x87\x83\x34\xf6\xe0\x5f\x0d\xbd\x10\x0c\x7c\x0c\x6a\x81\x59\x3d\xe0\x96\x3b\x32\x97\x17\x81\xb9\x22\xd3\x59\xbd\x00\xe9\x10\xfa\x0c\x16\x54\x81\x33\x9c\xe5\xce\xea\x8b\x56\xee\x80\xfd\x1c\x26\x04\x26\xd3\x99\x35\x0b\x6b\x0d\xf0\xb6\x6e\xc4\x3c\xa8\xed\xee\xe0\x59\x78\xf1\x53\x9c\x4e\x40\x79\x73\xf0\x79\x26\x81\xc7\x83\x9e\x62\xaf\x8d\xc2\x9e\x8a\x01\x17\x4d\xc5\xa8\x40\x9d\x12\x22\xf5\x80\xd4\x39\x84\x05\xd1\x19\x3b\x3a\xcd\xb6\x1a\x5e\x8d\x50\x0b\xe7\xdc\x99\xd6\x4e\x90\xb8\xf3\xec\xd0\x18\x38\x06\x93\x04\x9e\xe6\x6d\x5b\x0c\xad\xf9\x6d\xd7\x8e\xff\x97\xc9\xd2\xd7\x13\x8d\xd0\xcf\x26\x33\xf3\xc0\x3b\xaf\xe2\x4f\x26\xbd\xe3\x1c\x0f\xdb\x94\x76\x68\xc3\xe0\xf1\x00\x09\x56\x8b\xc0\x03\xb2\x98\x3c\x2c\x16\x88\xc0\xbe\x30\x70\xd4\x21\x98\x96\xb6\x0c\x07\x46\x08\x73\x1c\xa6\x96\xf9\x25\x25\xfc\x25\xef\x80\x42\x80\x78\x25\xe7\x90\xa0\xad\xfe\x06\x66\x6a\xbe\xad\xc5\x3a\x40\x83\x31\x8b\xc4\x63\x7b\xc1\x48\x3a\xf9\xd5\x4c\x8b\xc0\xbc\x7b\xd8\xdd\x0d\x75\x36\xc0\x6b\xd3\x29\x39\x50\x9e\x0a\x6e\xe1\x7a\xe0\x07\x96\x07\xa0\xca\xac\x9c\x1a\x16\x6d\x60\xfc\x09\x4d\x21\xa5\xdb\x83\x0b\x4f\x7f\xc1\xc4\xd5\x59\x81\x07\x41\xa8\xc4\xef\x46\x3a\x43\x77\xbe\x02\x97\x9c\xce\x5e\xe2\xf2\x31\x4f\x89\x06\xf7\x22\x31\xcf\x2c\x05\xc1\x10\x7e\x0a\x0c\xa3\xb3\x8b\x18\x82\x02\x37\x05\xf3\x3f\x31\x04\x20\x7d\xb8\x49\x9e\x93\x20\x24\xfc\x8d\x1c\x17\x11\x87\xbd\x8e\x3e\x43\x0d\x1c\x31\x5f\xd3\x8e\x42\xd8\xe2\x2c\xfd\x81\x59\xc2\x9b\xb6\xe3\xd3\x06\x36\x9d\xf7\x23\x4f\x58\xde\xef\x86\x86\xfb\xd3\x25\x79\xf2\x6c\x2b\x66\x5a\x30\x9b\xd5\xc4\xa2\x6f\x44\x45\x76\x0e\x80\x48\x82\x99\x9d\x19\x70\x63\x2b\x78\x9d\x87\x6b\xb7\xd6\xd0\xd2\xa9\x96\x5f\xc4\x5a\x80\x4c\x73\xaf\x49\xec\xc8\xf4\x90\xa1\x03\x73\x93\x9d\x82\x6f\x77\x67\x76\x6b\x17\x60\xef\xc1\xf1\x01\x53\x19\x82\xe5\x8c\x35\x48\x18\x09\x37\xe7\x81\x31\x23\x92\x69\x09\x52\xc9\xec\xef\x64\xc4\xa3\xe3\xc3\xd7\xda\x81\xbb\xbb\x46\x61\xe4\xe8\x4e\xc9\x0f\x56\x70\xa3\xa7\x0b\x0d\x62\xd9\xc6\xa9\x9e\xb5\xd5\xa5\x5d\x2c\x0b\x94\x0d\x18\x48\x62\x1d\x81\x81\x26\xbf\x23\x7b\x07\x25\xbb\x36\x09\xf9\x03\xb4\x21\x02\x14\x25\x89\xb1\x86\xf8\xd6\x4e\x62\xca\x48\x94\x09\x68\xca\x67\x7c\x53\xe6\x94\x50\x48\x54\xf7\x6c\x70\x6c\x62\x33\xe0\xe3\x91\xf9\xe0\x6b\x71\x87\xcc\x37\xb1\x74\x45\x2c\x55\xae\x8d\x60\xce\xd2\x5b\x75\x80\x1b\xb0\x0a\xa0\x07\x39\x72\x8d\x4b\x89\xa1\x40\x96\x96\x0b\x56\x17\x64\x47\x7d\x14\x6d\x88\xd0\xd2\x44\x55\x7a\x23\x79\x59\xd4\xa6\xc3\x04\xe0\xe5\x61\x75\xc0\x35\xfb\x15\x02\x06\x66\x17\x3f\xd5\xd8\xfd\x60\x1e\x5e\x83\x7d\x27\x16\x44\xb2\x7b\x5c\x67\x7a\x43\xf3\x54\x2e\xfc\x7b\x5c\x15\x96\xe6\x61\xfc\x84\xd4\xa0\x49\x8f\x04\x57\xd7\xc3\xae\x47\x93\x37\xdd\x4b\xb5\x2a\x1d\x24\xf0\xf8\xb7\xe5\x8c\x5a\x95\x34\xa8\x39\x46\x20\xc3\x5e\x8a\xa0\x03\xd0\x40\x04\x8e\xe9\xa1\xc4\xef\x56\xd0\xb2\x4c\xa0\x83\x09\x28\x57\x38\xdd\x0d\xeb\x13\x5c\x61\x98\x61\x8b\xdc\xc4\x73\xc1\xff\x53\x08\xc5\x2a\xc7\x3d\x33\xe0\x4d\x32\x34\x5c\x64\x4c\x57\x29\x58\x83\xfa\x1b\xf6\x34\xc0\x16\x38\xd3\xf7\xe2\x93\x5b\x92\x19\x8b\x40\x23\xae\xd6\x20\xa7\xa7\x3c\x84\xb7\xca\xd4\x1e\x76\x10\xd0\xbb\x5a\x57\xfb\xa6\x92\x21\xc6\x06\xc1\x04\x35\x78\xbb\x29\xad\xa9\xe7\x27\x44\xde\x2d\x55\x9b\x13\x2a\x66\x6c\xf8\xd3\x2a\x05\x57\x69\xa7\xf2\x7c\x6f\x05\x44\xf8\xb2\x1f\x8a\xec\x81\xf9\x42\x50\x7a\x98\xb1\x42\xa3\xea\x1e\x58\x5e\x75\x5a\x89\x34\xf3\x0c\xb0\x10\xc7\xd4\x6b\xdc\xa2\xa8\x17\xbe\x66\xe9\x66\xdc\x94\x41\xe9\x15\x8f\x3a\xe2\x8f\xb5\xb0\xa5\x1a\x19\xd5\x12\x81\x47\x38\xe6\xb1\xf9\x00\x74\xcd\x4d\x26\xb2\x09\x45\x90\x13\xe4\x77\xdc\x71\x64\x91\x6c\x65\x56\xc8\x57\x3e\x76\x96\x3b\x9f\xc2\x40\x3b\xf0\x06\x3c\x47\x65\xd0\x9d\xd4\x26\xc0\xfd\x29\xee\xc2\x0e\xef\x08\xa6\xe6\xf6\x47\x13\xc5\x79\x16\xce\xea\xaa\x2c\xd6\xa5\x0f\x59\x2a\xc2\x6b\x68\x5e\x9c\x36\x23\x82\xb4\x14\x2c\x08\xbe\x09\x30\x55\xbe\xc2\x68\xc7\x79\x12\xe1\x00\x83\xf7\x34\xde\xf3\x0c\xb5\x78\xdb\xed\x01\x44\x7e\x92\x3d\x7e\x3d\xcf\x8c\xa9\x6b\x80\xbc\x79\x0f\x2f\xea\xb1\x35\xbf\xee\x4b\xd2\x8f\x40\xfe\xc4\x14\x1b\xc3\x01\x36\x7c\x86\xb0\x2b\x21\xf3\x7d\x20\x0b\x1e\x30\xd0\x82\xdd\x15\x73\x78\x22\x38\x82\x68\xe1\x87\xbd\x6c\x13\xca\x21\x13\x3c\x34\xa1\xec\x0d\x66\x86\x83\xac\xed\xe1\x84\xd8\x60\x0e\x6a\x96\xbc\x46\xcb\xdb\xaa\xcd\x9d\x01\x6f\x9b\x10\x21\x90\x86\x8d\x8b\xba\xc0\x29\x99\xdc\x3b\xf0\x39\xf8\xfa\x59\x28\x72\xb4\xa0\xe4\x18\xc4\xa1\x21\x23\xf7\x16\x6c\xae\x87\xaf\x3b\xa1\x15\x45\x6d\x03\xee\x3c\x2f\x21\xea\x06\x58\xb1\x31\x92\xc7\x01\x3b\x5d\x4e\xf1\x19\x44\x70\xd4\x31\x12\x7d\x93\xf4\xe6\xf1\x55\x83\xbd\x13\xec\x64\x04\x52\x6c\xe3\x11\x68\xb1\xa3\x84\xd5\x5f\x2c\x28\x63\xa0\x29\x5b\x0d\x4c\x2a\x43\x96\x43\x51\x60\x9e\x70\x34\xee\xb4\x87\x0f\x05\x40\xcb\xad\x64\xb3\xcc\x03\xa2\x93\x1c\x21\x88\x9b\xab\xc7\xd7\x3e\xb2\xae\xa5\x78\x19\x3a\x2d\xf5\x1a\xbc\x77\xce\x88\x1a\x30\x9c\x9a\xfa\x84\x88\x20\x92\x31\x31\xbf\x8d\x04\x80\xed\xe6\xd7\x40\xef\x41\x71\x73\x3b\x75\x39\x04\x1f\x3a\x91\xbe\xbf\x76\x1c\x37\x29\xee\xb6\xf9\x90\xa8\xed\x68\xa8\x13\x1c\x58\x1f\x34\x33\xa1\xfc\x28\xd7\x20\x93\x9c\xcd\x4b\xb0\x21\x9c\xa2\x50\x10\x1f\x65\x5b\xa6\x79\x29\xf9\x88\xbf\xe2\xa3\x1d\xd2\xfb\x69\x88\x31\x19\x3b\x33\x1b\xef\x59\x1e\x4e\x3f\x16\x0e\x3f\xa3\xc7\xb7\xc9\x9c\x16\x54\x73\xfa\x2c\xb0\x5f\x1b\x4c\x0d\x6e\x32\x8b\xf9\x18\xb7\x21\x28\x17\xc5\xe1\x9d\x0b\xf6\x5c\x06\x86\xd3\x2f\xd5\x82\x0b\x13\x7b\x3c\x8c\x52\x70\x39\x05\x52\x08\xf6\x21\xd3\xc8\x0d\x69\x5e\x2d\x6b\xf4\xa4\xe3\x22\xd3\xc0\x63\x49\x97\xd3\x1c\xa0\x03\x04\x38\x12\x6f\x5f\xdd\x8c\xc6\x57\x37\xbd\xfe\x8d\xfa\x41\xf5\x7f\xe9\x9f\xdf\xe1\xe3\xe6\xa1\x8c\xc8\x89\x10\x0e\x8d\x1f\xcf\xc9\x4d\x86\x67\x9c\x98\x16\x0b\x2b\x31\x82\xb5\x2b\x91\x1f\x90\xb7\x2d\x38\xc3\x0a\x01\x8c\x41\x2b\xa1\x33\x2b\xa9\x2a\xc0\x6b\x86\xe3\x85\x3d\x59\x4c\x4a\x09\x09\x33\x33\x8f\x71\x97\xf0\x40\x75\x1b\x0b\xa1\x26\x2b\x4e\x88\x18\x8c\x95\x3d\x6a\x76\xb6\xa5\x4c\x28\xcd\x9c\x29\x1d\xa3\x91\x1d\x3b\x23\xeb\xe4\x98\x66\x2c\x98\x4e\x98\x05\xa8\xec\x2c\x66\x77\x19\x20\x61\x9a\x76\x52\xda\x78\xb6\x63\x55\x0b\xa0\xda\x12\x0c\x4c\x1b\xcd\x41\xb5\x99\x85\x58\x0a\xd3\xca\x3a\x5e\xc0\x9e\x2d\x96\x2b\x3a\x17\x08\x98\xcc\x5b\x55\x32\x8d\xf6\xff\x9e\x33\xc8\x25\x70\xe6\x4c\x41\x6e\x57\x16\x13\x32\xc0\x0b\x45\x73\xb8\xc1\x37\x64\xcb\x96\x1a\xcc\xf8\x22\x25\xe5\x0d\xe1\x22\x38\xb2\x94\xda\x57\xc9\xb6\x77\xed\xb7\x15\xfc\x99\xc6\x69\x6e\xf2\xe2\x2e\x61\xb6\x40\xa9\x12\x58\xb3\x23\x42\xf9\xd2\x68\x98\xa4\x5c\x1d\x50\x35\x49\x9a\xf4\xbd\x50\x50\x27\x79\x6d\xe5\x78\x95\x58\xd5\x7c\x72\x40\xcc\x92\x10\x39\x11\x87\x98\x39\xcd\x28\x58\x15\x43\xcf\x3d\x31\xcc\x15\xac\x85\x0f\x63\x88\xf6\x60\xf2\x15\xe0\x99\xfb\x63\x54\x23\x4e\xd1\xe9\xbb\xac\xdf\xb9\xd3\x93\x47\xd8\xf1\xaa\x9f\x0b\x13\x75\x1e\x30\x67\xcd\xb6\xa1\xca\x84\x4f\x6a\xea\x5c\x79\x53\xcf\x2d\x59\xdf\x08\xb0\xff\x0c\x22\x55\xfa\x3b\x0e\x75\x30\xa2\xd5\x8e\xd8\x10\x8f\x01\xc4\x58\xb0\xfb\x10\xd5\xf2\x38\x68\xb1\xd1\x37\x0e\x20\x46\xa6\x70\x8d\x6d\x7a\x35\x57\x0a\x11\x8c\x46\xc5\xaa\x92\xf6\x24\x15\x66\x5e\x57\xc7\x13\xb5\xe4\xce\xf9\x4d\xbf\x4b\xc2\xf9\x42\x19\xb5\x1b\x17\x9f\x10\x0e\x81\xd8\x63\x41\xe1\x2e\x9f\x52\xad\x9d\x84\x11\xfd\x53\x08\x24\xc7\xe7\x12\x81\xfb\xd4\x19\xc1\x66\xb7\xfe\x1f\x51\x29\x99\x5d\xc6\x0a\xbf\x96\x79\xc1\x49\x1c\xce\x93\xc9\x18\xd0\x49\x78\xf2\x06\xb8\x83\x0f\xc7\x57\xd7\xfd\x1b\xe2\xd1\x27\x0b\x68\x79\x38\xcf\xc0\x89\x8d\x99\x99\x58\x3a\x92\xcf\x70\xff\xce\xe8\x33\x74\x65\x72\xe0\x2b\x6b\x09\x16\xa2\x31\x2c\x57\x13\x74\x46\x73\x3e\x21\x61\x13\x47\xa2\x16\xdb\x51\x1d\xcc\x41\x40\x68\x11\x11\x35\x39\xf8\x00\xb7\x83\x18\xeb\x34\x31\x0b\x02\x4a\x4d\x81\x99\x26\xc6\xa0\xab\x06\x75\x76\x07\x49\xd2\x0d\xd8\x84\x4a\x59\x22\x62\x2d\x72\x93\x81\x8d\x3f\x87\xc0\x55\x81\xac\xe2\x2d\x2b\x38\x48\x88\x43\x2d\x91\x08\xef\x7c\xd9\x2d\x10\xc3\xa3\x9e\x80\x31\xba\xb7\x66\xe3\x54\x1f\xdb\xc7\x66\x5e\xf8\xd8\x8c\x05\xc5\xa4\x0f\x9e\xed\xe0\x78\x16\x37\xda\x22\xf5\xa1\x72\x75\xf8\x91\x1f\xb0\xcd\xea\x34\xda\xf5\x45\xed\x5d\xa7\xd5\x24\x85\x2e\x73\xde\xd5\xb1\x86\x28\x81\xf5\xc2\x45\xba\x35\xdd\xa9\xac\x4f\x59\x3f\x9e\x72\xf6\xa5\xa6\x09\xac\x5b\xf5\x18\x91\x50\xbd\x57\xe9\xfe\x78\xd4\x3d\xbb\xe8\xd3\x42\xa0\xe9\x3a\x16\x9b\x61\x87\xf0\x54\x68\xdc\xed\xf5\xa8\xd3\x54\x83\xd1\x8b\x9f\xdd\xed\xbc\x3b\x3c\xef\x5f\x34\x2a\xb6\x9e\xdb\xf1\xfa\xee\xf6\x43\x9f\x87\x14\x21\x1f\xe9\xd9\xf6\xe1\xb7\x2e\xbc\x13\x76\x05\x08\x04\x3d\xf0\xbb\x4b\xec\x01\x0f\x98\xc7\xa7\x20\xc9\xe7\x04\x6b\x19\x5f\x5c\x1d\x06\x62\x3e\xc8\xa0\x22\x16\x98\xb8\xb2\x8b\x24\xcd\x78\xe1\x38\x09\xe8\xa1\xd5\xd8\xce\x1e\xd8\x94\xa5\x6b\x1c\xc7\x71\x3a\x86\xd8\x74\x4c\x91\xb9\xe1\xd3\x97\xda\x24\x6f\xfa\x97\x57\x7f\x93\x59\x72\x70\x87\xb9\x21\x4e\x57\x15\x5b\x6e\x7e\xd1\x1f\xf5\xbb\x77\xa3\x0f\xd4\x08\x04\xff\x79\xaf\xcd\xc5\x60\xf8\xb3\x6f\xe1\xa5\x9c\x80\xea\x3b\x03\x84\x39\x8a\xfe\xc7\xee\xf9\xf9\xd5\xdd\x70\x54\x5b\x7b\x08\x1d\x61\x27\x67\x16\xb0\xc3\xb6\x89\xed\xae\x6f\x06\x97\xdd\x9b\x4f\xe3\xc1\xb0\xd7\xaf\x56\x9d\x66\xf0\x8c\xf6\x3c\x21\xea\x52\xae\x67\xcc\xc8\x13\x5d\xee\xae\x7b\xa0\x96\x35\xa6\x68\xfb\x3f\xdd\x07\xb9\x1b\xc3\xbc\xc6\xd7\xdd\x4f\xfd\x9b\x3a\x9f\xcf\x25\xc1\x0c\x8f\xaf\x2e\x7a\x87\xa8\x04\xbb\x47\x1a\xee\xef\x1e\x9a\x1e\x1f\x04\xb9\x91\x6e\xfb\xe7\x57\xc3\xde\x53\x52\x7c\xbc\x4f\x20\xc9\x1d\xb1\x3c\xde\xef\xd9\xa2\x79\x16\x99\x83\xe2\xf1\x2b\xdb\x3d\x1b\x48\xca\xcf\xab\xd9\x6d\x1f\x24\x34\xa8\x37\xf3\x90\x74\xbf\xed\xf9\x55\x4f\xb4\x25\x39\xa8\xda\x77\xc3\xe3\xca\xbd\xf6\x69\x5c\x6a\x49\x0c\x63\xcb\x31\x1b\xba\x3a\x0f\x47\x1b\x8b\xfa\xb1\x9b\xef\x06\x7e\xf7\x60\xc6\xca\xe1\x1d\x8a\x73\x5e\x62\x39\xdc\x2a\xcd\xb6\x6d\xef\xe1\x39\x01\xe5\x5c\x3c\x45\xec\x69\x99\x61\xe0\x00\x9e\x53\xa3\xd1\x07\x8b\xe1\xec\xf3\x9b\x30\xc3\xf3\xa6\x9e\x7a\x3a\x68\x23\x77\x51\x40\x0f\x6d\x48\x1d\x06\x20\x7e\xaa\xbc\xba\x7f\x30\xe8\xf9\x47\x5f\x83\x15\xc0\x3e\xa2\xf2\x09\xaa\x3f\xce\x53\x0d\x4a\x04\x23\x48\x92\x24\xec\x4b\x99\xf1\x72\x12\xdb\x1c\x23\x5b\x5d\x08\x01\xf7\xa4\x8b\x55\x5f\x74\x84\x5a\x27\xa2\x5d\x94\xc3\x95\x72\xd7\x9c\x40\xcb\x24\xff\x41\x19\x17\x85\xe5\x01\x08\xc6\x10\x21\xec\x1c\x24\xec\x71\x0c\xd2\xd6\xdb\xbb\xa4\xb0\xf1\x1f\x19\xcf\xdc\xdb\x69\xb1\x0f\x1a\x28\x07\xc0\x2a\x21\xb9\x73\xcc\xdd\x04\x07\x61\x3e\xf5\xb2\xb6\xbc\x86\x87\xa6\x1b\x10\x1c\xf4\x1c\x7a\x09\x8b\x93\x22\xc9\x40\x66\x0f\x07\x97\xf5\xd0\x32\xf9\xc4\x08\x27\x93\x5d\x0e\xb3\x6a\x50\x3b\xc5\xf7\xe0\xa2\xae\x60\xac\xd6\x47\x87\xd8\x89\xec\xd7\x65\x4e\xa5\x16\x16\x43\x51\xc0\x63\x05\x06\xb6\x7b\xa9\x02\x87\xab\xde\xdc\x7f\xfb\x86\x9e\xbc\xc1\x7e\xe3\x9a\x47\xa6\x23\x2d\x4d\xd9\xb1\xdf\x4d\x96\xaa\x88\x16\x6f\x0c\x06\x2c\x2c\x6b\x40\xc0\x10\x6e\xfd\x67\xf1\xb9\xce\xd2\x45\xa6\x57\x2b\x58\x8c\x29\x01\x83\xc9\xd6\x65\x6f\x82\x6c\xa5\x07\x4f\x4f\x13\xe6\x2d\xfd\x5c\xca\x3e\x58\x25\x9b\x42\xc8\x2a\xd6\xee\xb8\x06\x70\xef\x3c\x8d\xe3\x74\xc3\xf2\xd4\xea\xf2\xaa\x37\x78\xff\x49\xe6\x48\x5c\xb9\x27\x15\xc8\xfa\x67\x32\x37\x20\xc4\xb4\xd6\x74\x34\xe7\xcf\x15\x6b\x63\x32\xae\x72\x89\x34\x8c\x60\xc0\x9a\x80\x99\x37\x35\xf6\x8e\xc8\x8e\x16\x82\x26\xed\x66\x88\x66\x3d\x64\x1a\x99\x73\x11\xad\x54\x87\x44\xbf\xe7\x9b\xe5\x6f\x1d\xee\x47\x8a\x10\xa9\x20\x4d\x21\xa2\x60\x73\x7e\x7e\x34\xb3\xcf\x6e\xdd\xd7\xe9\x72\x02\x9f\xf4\x3b\x30\xaf\xe4\xeb\x43\xfb\xaa\x5c\x8a\x5c\x3a\xfc\x6c\xb6\xf8\xf0\x1b\x7a\x7a\xad\x8b\x65\x15\x4d\xb9\x1d\xb4\x43\xc3\x7b\x06\x4a\xdc\x8b\x16\xf2\x66\x1c\x0c\x6f\x1b\xe1\x4b\x62\xd8\xbd\x04\x3f\x2c\x73\x6a\x04\xa1\xa8\x78\x22\x74\x58\x14\x66\xf1\xdc\x32\x2c\x0b\xa2\xd0\xd6\x3b\x5e\x62\x10\x62\x24\x14\x7f\xe4\x67\xe8\x8a\x1a\x11\xd9\x4e\x0c\x47\x60\x74\xb8\x27\xa7\xc5\x9c\x37\x71\x21\x6a\x43\x36\x2f\x90\x6f\xab\x8f\x9a\xd4\x96\x16\xdc\xd2\x99\x05\xc6\x49\x5e\xbf\x9c\xbc\xcd\xec\xc7\xaa\x70\x04\x4f\x13\xdc\xfc\xa5\xc0\x21\xe4\x85\xda\xe4\x4b\x57\x35\x98\x79\x25\x4e\x19\x57\xc3\x30\x61\x82\x23\x4a\xe3\x19\x9e\xe7\x61\xd2\x00\x3e\x62\x06\x1b\x3f\x82\x06\xb9\xa7\xf0\x91\x9e\xba\x42\x88\xbc\xbd\x3b\xe6\x4b\x77\x96\x4f\x46\x7d\x67\xde\x5c\xa5\x15\xbc\x47\x69\x62\x49\x3b\xae\x52\x37\xc7\x20\x98\x53\xe5\xb4\x47\xb9\x8c\x40\x63\xb9\x94\x8c\xc6\x5d\x73\x2e\x60\x9d\x60\xc9\x22\x60\x1a\x4e\x5d\xc5\x1a\xf1\x0d\x65\xd7\x61\xc2\x1b\x3f\x1f\xc7\xae\x2b\x7a\xf7\x53\x10\x92\x9c\xfa\x93\x2a\x06\x4c\xc2\x4b\x88\x9a\x63\xb5\x29\xed\x63\x2c\x35\x75\x82\x91\xc4\x89\x08\x84\xdd\x19\x12\x97\x00\xe6\x03\xb0\x3b\x83\x30\x79\xa5\x63\x35\xb1\x09\x00\x41\x9f\xf7\x12\x9f\x83\x43\x37\x3b\x38\xdd\x28\x42\xb6\xe0\xbf\x7f\x84\xfb\xe3\x04\x54\xf6\xa4\xa5\x84\xc9\x0e\xbc\x3c\xb1\xb3\x93\xce\xb7\x2d\x75\x82\x27\xfe\x27\xd0\xe2\xd7\x74\x99\x9c\xa8\x2f\x2d\x25\xb3\xeb\xa8\xff\x48\x6c\xfc\x9f\xea\x8b\xfa\x72\x80\x20\x60\xb1\xa7\xc9\x25\xdb\x93\x90\xde\xf1\x41\x0f\x0e\x01\x1b\x29\x18\x82\x98\x79\x9a\x18\x0c\x29\xe4\x50\x10\x5c\x12\x2a\x65\x12\xbb\x0a\x0c\x9b\x22\x22\xb9\x44\xad\xfa\xb2\xfa\x83\x1a\x3e\x5b\x6f\x61\xd1\xd5\x14\x8f\xd0\x5f\xe6\x62\xf8\x70\xdf\x62\x4a\xbf\xc0\x0d\x89\x11\x68\x95\xcc\xb8\xb7\x69\x99\x73\x42\xab\xb5\xa3\x19\x8e\x6c\x6e\x8a\xca\x46\x23\x39\xd6\xdc\xda\x0e\x6d\x3f\x83\x77\x58\x03\xcf\x7b\x7d\x10\xd1\x7f\x3f\x00\xf0\xeb\x37\x10\x65\x51\xa8\xd4\x18\xec\x08\x62\x6e\xca\x7e\x73\x15\xf1\x01\x19\xd4\x98\x65\x0a\x62\x5a\x60\xd2\x33\x71\x21\x82\x4b\x99\xdc\xb3\x78\x87\xc5\x3d\xc2\xfb\x31\xb9\x8b\x95\x72\xb2\x4f\x52\x9a\x19\x67\x0e\xcd\x03\x96\xbb\xb6\xfe\xff\x53\x60\xb8\x69\x68\x12\xce\xfa\x85\x28\xfe\x6c\x3f\x95\x77\xed\xd6\x1c\x70\x1b\x05\x45\x92\x0d\xaa\xd2\xdb\x54\x0b\xe6\xa2\x11\xcd\x81\x85\x4e\x64\xa9\x2b\xa8\x09\x7c\x5f\xd7\xc0\x39\x27\xe0\x30\x7c\xfa\x2a\xca\xde\x7f\xba\x92\x1e\xb3\x39\x40\xf7\x9a\xc3\x6d\xf5\xd9\x6c\x7d\xa9\x3e\x08\xc4\x15\xd6\x91\x17\xe2\xfe\xd0\xa2\x43\x1e\xc1\xf9\x50\x7c\x52\x77\xa1\x92\x96\xc6\x5c\x30\x3a\xbb\xd3\xa5\x79\xa0\xc3\xf4\x66\x48\x5a\xe4\xac\x71\x63\xa3\xdb\x01\xf5\x0d\xb2\x82\x5e\x06\x61\xe5\x07\x0e\xe6\x68\x3d\x6b\x0c\xc9\x3e\x26\xe8\xa2\x8f\x8d\x21\x66\xf6\xe0\x18\x52\x5c\x24\x45\x37\x81\x91\x15\xc6\x3c\x97\x6c\x84\xa4\x21\xd7\xf3\xbc\x40\x0b\xf6\x8d\xaf\xd8\xe1\x83\xad\xa3\x04\x85\x0b\xcf\xd2\xb3\x09\x56\x88\xff\x6c\x07\xac\xe0\x9c\xa1\xbd\xd7\x2b\x8e\x9e\xf1\x11\x6f\x4c\x06\x27\xf8\xdd\xd5\x9f\xbc\xf1\xba\x82\x54\xbd\xeb\x87\x85\x26\x82\x54\x71\xe0\x16\x19\xfb\xa1\xa5\xf5\xf5\x40\x7c\x75\xab\x42\x5a\x4f\xb6\xa3\x4c\xde\x29\x3d\x86\x86\xe0\x43\x06\xc3\xd1\xf7\x7f\x1e\xf7\x87\xe7\x00\x3e\x87\x3f\xa9\x1f\xd4\xb0\x7b\xd9\x6f\xd6\x28\x01\x02\xd5\x65\x5c\xd0\x39\x55\x45\x95\xb5\x18\xd4\xf0\xab\xa8\x3d\x4e\xcf\x09\xa0\x02\x8c\xff\xfa\x32\x70\xaa\xb0\x4b\x05\x19\x47\x3a\x94\x95\x3c\x1f\x5c\x76\x2f\xe0\xd3\x87\xfe\x2f\x5d\xf9\x86\x54\xd1\x22\xa6\x25\x9b\xcc\xb7\x0f\x08\x1a\x6e\x3f\x5d\x9e\x5d\x5d\xb0\x0a\x51\x01\x54\x2e\xd9\x79\xd6\x25\x7e\x3d\xc6\x3c\x10\xb6\x31\x0f\x7b\x6d\x3c\x92\xde\x2b\x94\x26\x8e\xdc\x41\x56\xbf\x47\x5f\x6e\xaf\xde\x8f\xc6\xef\xbb\x83\x0b\xfa\xf6\xa1\x7b\xd3\xab\xbe\xf5\xfa\x17\xdd\x4f\xd2\xae\xff\xcb\xf5\xe0\x46\x3e\xdf\x0d\x7f\x1e\x5e\x7d\x74\x47\x3d\x8d\xee\xc1\x00\xaa\x85\xc5\x09\xb5\x63\x2d\x08\xa5\xab\xc3\x55\xae\x46\x93\x68\xad\x5c\xa3\x54\xf1\x7d\x78\x3e\x50\x0b\xf0\x82\x82\xea\x9e\x8c\x16\x96\x02\xf3\xcc\x76\xae\x0a\xc2\xae\xbf\xad\x5d\x83\x3c\xcc\x67\x74\xdd\x1f\xe2\x82\x45\xaa\xc4\xfc\x06\xbb\xba\xea\x76\x66\xe6\x63\xae\x13\xa2\xe9\x0a\xd1\x83\xc4\xf4\xc1\xcb\x7f\x30\xba\x2b\x00\xa6\xa0\x2f\xb8\xec\x72\x84\x15\xa1\x5f\xcb\x48\x39\x52\x83\x19\x16\xfb\x01\xac\xcd\xe4\xbc\xc3\x1d\xfd\x70\xf3\x88\x31\xbf\x8c\xe1\x30\xff\x53\x83\x54\xe9\x90\x5d\x8e\xc5\xd9\xb9\x23\x16\x3a\x3b\x3a\x2e\x43\xc1\x2e\x24\x38\xa9\xef\xe4\x61\xf6\x8f\xcc\x30\xa6\xc5\x7c\x11\x97\x7f\xc8\xe5\xcd\x23\x54\x31\xce\x92\x75\x60\x72\x61\x12\xea\x00\x4d\xf6\x3b\x4f\x10\xa5\x5c\x92\xa1\xab\xa8\x88\x19\xdd\x3a\xf3\x00\xf5\x44\xd3\xce\x10\xf5\x3c\xd3\x57\xad\xa9\x04\xae\x67\xdb\x51\x98\x83\x72\x94\x39\x65\x96\xf0\x21\x5f\x70\xa7\xf5\x91\xcc\x84\x1f\x98\xe9\x53\x82\x68\x58\xae\x6a\x45\xf5\x9e\x32\x70\xfb\xc7\xa9\xee\xb3\x4b\xb2\x16\x6d\x98\xec\xde\xc3\xfd\x4a\xea\x48\x8b\x33\x7a\x8e\x7a\x55\x17\xf5\x07\xa9\x23\x24\xb3\xd0\x84\xdc\xbc\x1c\x46\x28\x6f\x78\x06\xc1\x9b\x7a\xf5\xcf\x91\x25\xf6\x09\x97\xe3\x06\x84\xb6\x5e\xdd\x48\xb8\x6f\x3b\x2b\x2e\xe3\xf4\xeb\x85\xfe\x8f\x9b\x27\x8c\x5c\xf7\xd4\x54\xfa\x1f\xbb\x50\xe4\xd1\xc9\x71\x1b\x25\x26\x53\x6c\xdf\xd7\x39\x86\xd0\x19\x70\x02\x09\xbe\x78\xec\xb2\x6f\x9e\x73\x00\x8c\x89\x09\x2d\xb5\x99\x2d\x0c\x15\xa0\xee\x37\xee\xc3\x2b\x2c\xa2\x64\xfb\xb1\x30\x83\x64\x9e\x76\xd4\xb5\x7c\x7a\xf1\xd8\x38\xd8\x55\x06\x98\x96\x59\x9e\x06\xa6\x07\x9f\x25\x84\x22\x0e\xf4\x7b\x21\xae\xac\xbf\x77\x3b\x3b\xac\x4e\x08\xaa\x55\x5c\x6a\xc8\x55\xc2\x70\x5e\x28\xc2\xa3\x18\x7f\x1b\x27\x72\x95\x4a\x5b\x88\x7c\x24\x05\xd5\x56\x08\x9d\x31\xc3\x54\x92\x05\xc2\x4a\x91\xc4\xa0\xce\x16\x7b\x37\x86\xe4\x3a\x09\x67\x80\x9c\x5d\x46\x7e\x82\x2b\x09\x30\x02\x07\x7d\xbe\x24\xb0\xad\xce\xcc\x86\x53\x16\xee\x78\x97\x0b\x3d\x90\x93\x89\x91\x9b\xc1\x2b\x17\x82\xc1\xc6\x09\xee\x3d\x88\xb5\x2f\xf8\x12\x85\x3b\xee\xa8\xd5\x70\xb5\x76\x23\x9d\x5a\xc9\x51\x5b\xdd\x1a\xd0\xe3\x74\x5a\xae\xfc\x65\x31\xb9\x41\x5f\xaf\x0f\x04\x22\x5c\x12\xe8\x52\x6e\xf9\x91\x5b\x2c\x30\xf7\x0f\x3a\x5f\xee\x14\x92\xf9\x3b\x07\x5d\xfc\xa5\x84\xcf\x49\xba\x49\x64\x2a\xc5\x78\x66\x41\xb5\x8a\x88\x3d\x8c\x5b\x21\x3a\xc2\xa0\x17\xbb\xf1\x12\xdf\xc5\x8a\xb7\xe0\xfc\x2d\x2c\x5c\x75\x29\xab\xb2\xc7\x61\x0d\x83\xaf\x68\x8c\xd3\x04\xc9\xb9\x9f\x6d\xa0\x60\x90\x62\x5d\xae\x5e\xa7\x8c\x1c\x66\x08\xf9\x80\x1c\x5e\x2e\x4a\x0d\x1a\x57\x18\x4e\xd1\xad\x52\x10\x45\x9a\x48\x3a\x19\xa0\x5e\xc6\xd7\x06\x29\x44\x0e\xea\x6f\xaa\x12\x2d\xbc\xd5\x0c\x21\xc5\xd6\xa5\x1f\x33\x13\x5b\xf9\xf5\x08\x10\x81\x31\x6b\x32\x26\x9f\x51\x52\x20\xf2\xcf\xb9\xbb\x1f\xec\x0b\xaa\x5b\x4a\x87\xa7\xf4\x7c\xb5\xd5\x5f\xa5\x06\x23\xd0\x61\x4b\x46\xbf\x02\xf0\x9a\x69\x48\xa6\xba\xa5\x16\xf5\x2b\x6b\x81\x20\xaa\x7a\x20\xee\xe2\x02\x7e\x37\x7d\x5f\x7d\x87\x37\x43\xf2\x3c\x9d\x5a\x3a\x49\xa0\x89\x72\xa1\xaa\xab\x46\xf3\x11\xf5\x66\xb9\x65\x30\x61\x57\xeb\x34\x2b\xa4\xb2\x2b\xd1\xf7\x76\x21\x25\x4a\x9f\x9d\x3c\xe2\x6d\x95\x23\x60\x1e\x6f\x85\xc5\xda\x8d\xb6\x6f\xe8\x98\xb4\x7a\x85\x81\x1e\xc1\x7a\x1a\xb2\xca\xa2\xea\xaa\x00\x1e\x0f\x63\x80\xa7\x21\xec\x1b\xfa\xa9\x8b\x0d\x9d\x38\x54\x37\x2f\x30\xf1\x04\x3a\x27\x77\xaf\x5b\xa0\x3f\xb1\xc9\xb1\xd4\x93\xcd\x37\x5e\x86\x94\x26\xd0\x4b\x0a\x2c\xd5\xb5\xc9\x40\xa4\xb9\x23\x36\x4b\xdd\x3e\xa5\x43\x9d\x4e\x53\x75\x41\x59\xd0\xdc\xb0\x0e\xb0\x5d\xd1\xbe\x0a\x18\x55\x49\x53\xf8\x41\xdb\x0a\x5d\x43\x5b\xc2\xa3\xfd\x69\x2b\xa5\x27\x76\xff\xf1\x97\xfd\xdb\x96\x64\x63\x2a\xec\xe3\x1c\xb2\x24\xd7\xaf\xd3\x5b\xce\x2e\xd3\xb6\x98\x38\xb0\x52\x69\x26\x9b\x12\xbe\x37\x49\x05\x24\xdf\x7e\xaf\x26\x88\xe6\x43\x90\x00\xe8\x04\x7f\x4d\x84\xaf\xa5\xc3\x8b\xef\xfe\x44\x97\x84\xd1\x8c\xb2\x16\xb8\x4e\x68\xbc\x9c\x8d\xc1\x5f\xef\xc0\x03\x57\x4f\x40\x84\x9c\xc2\x16\x9b\x43\xec\x90\x34\xc3\x1f\xc1\x98\x9f\xed\xc2\x20\x8c\xa2\x30\x6d\x55\xc2\x57\xa0\x6f\x13\xaa\x67\xa1\x5c\xb2\xac\xf9\xc6\x04\x77\x76\x6d\xf1\x63\x75\x91\x0c\xa7\xcd\x93\x85\x05\x9f\xdb\x07\x75\xca\xc5\x57\xef\x5e\x03\xa5\x1a\xfc\x19\xf4\x9a\x64\x16\xa5\xa8\x0f\x22\x19\x06\xeb\x5b\x49\x3d\x67\x78\x9a\x47\x5a\x5b\xf3\x27\x93\x00\xf6\xb5\xeb\x73\xb8\xa6\x11\x6b\x68\x6e\xa5\x1f\x86\x87\x2f\xb1\xd2\xbb\xf3\xeb\x3b\xbe\x00\x7b\x7b\x70\xf2\xef\x82\xb9\xbb\xd3\xdc\x5b\x33\xad\xb5\x85\xf0\x81\x2f\xce\x36\xea\x37\x04\x76\xef\x3d\xb3\x73\xd6\x07\x9f\x62\x44\x5a\x80\xc1\x90\x57\x7d\xf7\xe5\xc5\xdf\x29\xf0\x07\x69\x6f\x52\x2c\x95\x46\xc5\x4f\x67\x7a\xeb\xb5\xb1\x5b\x29\xe2\xde\xd5\xbe\xdd\x0b\x71\x4f\xde\x50\xe3\x12\xe4\xe0\x1e\x55\xed\x0e\xd8\x81\xab\x4d\x8e\x8b\x1d\x3a\xc2\x4e\x51\x07\x12\x55\x69\x44\x8d\x80\xbf\xc1\x8d\x1b\xdb\xfd\x22\x43\x65\x2d\x62\xfa\x2d\x00\xac\x29\x66\x65\xcb\xe9\xa6\x7d\x65\x3c\x71\xfc\xfc\x47\xa0\xe2\x4c\x84\xbb\xc1\x3b\x31\x05\x86\x35\x78\x98\xc3\x87\x45\x5a\xce\x91\x22\x4e\xb0\xa2\x4d\x88\x11\x62\x98\x14\x0f\x53\xaa\x9b\x73\x74\x0b\xd8\x6c\x09\x60\xfc\x28\x52\x0e\x8c\xe0\x21\x59\xf3\x5d\xe0\x23\x06\xa3\x96\x16\x13\x98\xc5\x76\xcd\x0b\x16\xac\x08\x5e\x57\xf7\xe4\xbe\x34\xfe\x0f\x1b\x00\xc3\xf3\x3a\x49\x00\x00")
 
 func transactionsGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -272,7 +293,7 @@ func transactionsGraphql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "transactions.graphql", size: 17007, mode: os.FileMode(436), modTime: time.Unix(1619013861, 0)}
+	info := bindataFileInfo{name: "transactions.graphql", size: 18746, mode: os.FileMode(436), modTime: time.Unix(1792386373, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -329,6 +350,7 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"abi.graphql": abiGraphql,
 	"accounthist.graphql": accounthistGraphql,
 	"block.graphql": blockGraphql,
 	"blockmeta.graphql": blockmetaGraphql,
@@ -381,6 +403,7 @@ type bintree struct {
 	Children map[string]*bintree
 }
 var _bintree = &bintree{nil, map[string]*bintree{
+	"abi.graphql": &bintree{abiGraphql, map[string]*bintree{}},
 	"accounthist.graphql": &bintree{accounthistGraphql, map[string]*bintree{}},
 	"block.graphql": &bintree{blockGraphql, map[string]*bintree{}},
 	"blockmeta.graphql": &bintree{blockmetaGraphql, map[string]*bintree{}},
@@ -0,0 +1,305 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eosws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	v1 "github.com/invisible-train-40/eosws-go/mdl/v1"
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/bstream/forkable"
+	"github.com/streamingfast/derr"
+	"github.com/streamingfast/dtracing"
+	"github.com/streamingfast/logging"
+	"github.com/zhongshuwen/histnew/eosws/mdl"
+	"github.com/zhongshuwen/histnew/eosws/metrics"
+	"github.com/zhongshuwen/histnew/eosws/wsmsg"
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+	pbstatedb "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/statedb/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (ws *WSConn) onGetAccountResources(ctx context.Context, msg *wsmsg.GetAccountResources) {
+	zlogger := logging.Logger(ctx, zlog)
+	zlogger.Debug("handling get account resources stream", zap.String("account", msg.Data.Account))
+
+	authReq, ok := ws.AuthorizeRequest(ctx, msg)
+	if !ok {
+		return
+	}
+
+	startBlockNum := authReq.StartBlockNum
+	if msg.Fetch && authReq.IsFutureBlock {
+		ws.EmitErrorReply(ctx, msg, AppAccountResourcesCannotFetchInFutureError(ctx, startBlockNum))
+		return
+	}
+
+	startBlockID := ""
+	if msg.Fetch {
+		spanContext, fetchSpan := dtracing.StartSpan(ctx, "fetch account resources")
+
+		requestBlockNum := startBlockNum
+		if msg.StartBlock == 0 {
+			// Let statedb resolve its head block to prevent a race condition with the live stream
+			requestBlockNum = 0
+		}
+
+		ref, snapshot, err := fetchStateAccountResources(spanContext, ws.stateClient, msg.Data.Account, requestBlockNum)
+		if err != nil {
+			ws.EmitErrorReply(ctx, msg, fmt.Errorf("fetch account resources: %w", err))
+			fetchSpan.End()
+			return
+		}
+
+		if ref != nil {
+			startBlockID = ref.ID()
+			startBlockNum = uint32(ref.Num())
+			snapshot.Data.BlockNum = startBlockNum
+		}
+
+		metrics.DocumentResponseCounter.Inc()
+		ws.EmitReply(spanContext, msg, snapshot)
+		fetchSpan.End()
+	}
+
+	if !msg.Listen {
+		return
+	}
+
+	_, listenSpan := dtracing.StartSpan(ctx, "ws listen account resources")
+
+	var handler bstream.Handler
+	handler = newAccountResourcesHandler(ctx, msg, ws, zlogger)
+	if freq := msg.WithProgress; freq != 0 {
+		handler = NewProgressHandler(handler, ws, msg, ctx)
+	}
+
+	var err error
+	var irrID string
+	var forkablePostGate bstream.Handler
+	if startBlockID != "" {
+		irrID, err = ws.irreversibleFinder.IrreversibleIDAtBlockID(ctx, startBlockID)
+		if err != nil {
+			ws.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to retrieve irreversibility"))
+			return
+		}
+		forkablePostGate = bstream.NewBlockIDGate(startBlockID, bstream.GateExclusive, handler, bstream.GateOptionWithLogger(zlog))
+	} else {
+		irrID, err = ws.irreversibleFinder.IrreversibleIDAtBlockNum(ctx, startBlockNum)
+		if err != nil {
+			ws.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to retrieve irreversibility"))
+			return
+		}
+		forkablePostGate = bstream.NewBlockNumGate(uint64(startBlockNum), bstream.GateInclusive, handler, bstream.GateOptionWithLogger(zlog))
+	}
+
+	irrRef := bstream.NewBlockRefFromID(irrID)
+	forkableHandler := forkable.New(forkablePostGate, forkable.WithLogger(zlog), forkable.WithExclusiveLIB(irrRef))
+
+	metrics.IncListeners("get_account_resources")
+
+	source := ws.subscriptionHub.NewSourceFromBlockNumWithOpts(irrRef.Num(), forkableHandler, bstream.JoiningSourceTargetBlockID(irrRef.ID()), bstream.JoiningSourceRateLimit(300, ws.filesourceBlockRateLimit))
+	source.OnTerminating(func(_ error) {
+		metrics.CurrentListeners.Dec("get_account_resources")
+		listenSpan.End()
+	})
+
+	err = ws.RegisterListener(ctx, msg.ReqID, func() error {
+		zlogger.Debug("onGetAccountResources: canceller call", zap.String("req_id", msg.ReqID))
+		source.Shutdown(nil)
+		return nil
+	})
+	if err != nil {
+		source.Shutdown(nil) // important to ensure that OnRunFunc is run
+		ws.EmitErrorReply(ctx, msg, derr.Wrap(err, "unable to register listener to ws connection"))
+		return
+	}
+
+	ws.EmitReply(ctx, msg, wsmsg.NewListening(startBlockNum+1))
+	go source.Run()
+}
+
+// fetchStateAccountResources reads the account's row of the system contract `userres`
+// table, an account without any row getting a snapshot with nil resources.
+func fetchStateAccountResources(ctx context.Context, stateClient pbstatedb.StateClient, account string, blockNum uint32) (ref bstream.BlockRef, out *wsmsg.AccountResourcesSnapshot, err error) {
+	response, err := stateClient.GetTableRow(ctx, &pbstatedb.GetTableRowRequest{
+		BlockNum:   uint64(blockNum),
+		Contract:   "zswhq",
+		Table:      "userres",
+		Scope:      account,
+		PrimaryKey: account,
+		KeyType:    "name",
+		ToJson:     true,
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, wsmsg.NewAccountResourcesSnapshot(account, blockNum, nil), nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resources json.RawMessage
+	if response.Row != nil {
+		resources = json.RawMessage(response.Row.Json)
+	}
+
+	if response.UpToBlock != nil {
+		ref = bstream.NewBlockRef(response.UpToBlock.Id, response.UpToBlock.Num)
+	}
+
+	return ref, wsmsg.NewAccountResourcesSnapshot(account, blockNum, resources), nil
+}
+
+type accountResourcesState struct {
+	limits *wsmsg.AccountResourceLimits
+	usage  *wsmsg.AccountResourceUsage
+}
+
+// accountResourcesHandler emits the account's RAM operations and resource limits
+// changes block per block. The state seen before each reversible block is kept
+// so that an undo step can bring back the limits and usage as they were.
+type accountResourcesHandler struct {
+	ctx     context.Context
+	msg     *wsmsg.GetAccountResources
+	emitter Emitter
+	zlog    *zap.Logger
+
+	current  accountResourcesState
+	previous map[string]accountResourcesState
+}
+
+func newAccountResourcesHandler(ctx context.Context, msg *wsmsg.GetAccountResources, emitter Emitter, zlog *zap.Logger) *accountResourcesHandler {
+	return &accountResourcesHandler{
+		ctx:      ctx,
+		msg:      msg,
+		emitter:  emitter,
+		zlog:     zlog,
+		previous: map[string]accountResourcesState{},
+	}
+}
+
+func (h *accountResourcesHandler) ProcessBlock(block *bstream.Block, obj interface{}) error {
+	fObj := obj.(*forkable.ForkableObject)
+
+	switch fObj.Step {
+	case forkable.StepNew, forkable.StepRedo:
+		ramOps, state := accountResourcesFromBlock(block, h.msg.Data.Account)
+		h.previous[block.ID()] = h.current
+		if state.limits != nil {
+			h.current.limits = state.limits
+		}
+		if state.usage != nil {
+			h.current.usage = state.usage
+		}
+
+		h.emit(block, fObj.Step, ramOps, state)
+
+	case forkable.StepUndo:
+		ramOps, state := accountResourcesFromBlock(block, h.msg.Data.Account)
+		if previous, found := h.previous[block.ID()]; found {
+			h.current = previous
+			delete(h.previous, block.ID())
+		}
+
+		undoState := accountResourcesState{}
+		if state.limits != nil {
+			undoState.limits = h.current.limits
+		}
+		if state.usage != nil {
+			undoState.usage = h.current.usage
+		}
+
+		h.emit(block, fObj.Step, undoRAMOps(ramOps), undoState)
+
+	case forkable.StepIrreversible:
+		for blockID := range h.previous {
+			if bstream.NewBlockRefFromID(blockID).Num() <= block.Num() {
+				delete(h.previous, blockID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (h *accountResourcesHandler) emit(block *bstream.Block, step forkable.StepType, ramOps []*v1.RAMOp, state accountResourcesState) {
+	if len(ramOps) == 0 && state.limits == nil && state.usage == nil {
+		return
+	}
+
+	metrics.DocumentResponseCounter.Inc()
+	h.emitter.EmitReply(h.ctx, h.msg, wsmsg.NewAccountResourcesDelta(h.msg.Data.Account, uint32(block.Num()), step, ramOps, state.limits, state.usage))
+}
+
+// accountResourcesFromBlock extracts the RAM operations paid by `account` as well
+// as its last resource limits and usage found in the block, if any.
+func accountResourcesFromBlock(block *bstream.Block, account string) (ramOps []*v1.RAMOp, state accountResourcesState) {
+	blk := block.ToNative().(*pbcodec.Block)
+
+	applyRlimitOps := func(ops []*pbcodec.RlimitOp) {
+		for _, op := range ops {
+			if limits := op.GetAccountLimits(); limits != nil && limits.Owner == account {
+				state.limits = &wsmsg.AccountResourceLimits{
+					NetWeight: limits.NetWeight,
+					CPUWeight: limits.CpuWeight,
+					RAMBytes:  limits.RamBytes,
+					Pending:   limits.Pending,
+				}
+			}
+
+			if usage := op.GetAccountUsage(); usage != nil && usage.Owner == account {
+				state.usage = &wsmsg.AccountResourceUsage{
+					NetUsage: usage.NetUsage.GetConsumed(),
+					CPUUsage: usage.CpuUsage.GetConsumed(),
+					RAMUsage: usage.RamUsage,
+				}
+			}
+		}
+	}
+
+	for _, trxTrace := range blk.TransactionTraces() {
+		actionMatcher := blk.FilteringActionMatcher(trxTrace)
+
+		for _, ramOp := range trxTrace.RamOps {
+			if ramOp.Payer != account || !actionMatcher.Matched(ramOp.ActionIndex) {
+				continue
+			}
+
+			ramOps = append(ramOps, mdl.ToV1RAMOp(ramOp))
+		}
+
+		applyRlimitOps(trxTrace.RlimitOps)
+	}
+
+	applyRlimitOps(blk.RlimitOps)
+
+	return
+}
+
+// undoRAMOps reverts RAM operations, last one first, each usage becoming
+// the one before the operation was applied.
+func undoRAMOps(ramOps []*v1.RAMOp) (out []*v1.RAMOp) {
+	for i := len(ramOps) - 1; i >= 0; i-- {
+		op := *ramOps[i]
+		op.Usage = uint64(int64(op.Usage) - op.Delta)
+		op.Delta = -op.Delta
+		out = append(out, &op)
+	}
+	return
+}
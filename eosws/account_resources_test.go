@@ -0,0 +1,79 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eosws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/streamingfast/bstream/forkable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zhongshuwen/histnew/eosws/wsmsg"
+)
+
+func TestAccountResourcesHandler_ProcessBlock(t *testing.T) {
+	msg := &wsmsg.GetAccountResources{
+		CommonIn: wsmsg.CommonIn{Listen: true},
+		Data:     wsmsg.GetAccountResourcesData{Account: "alice"},
+	}
+
+	block2a := testBlock(t, "00000002a", "00000001a", "zswhq", 1, `{"id":"trx.1","ram_ops":[
+		{"action_index": 0, "payer": "alice", "delta": 100, "usage": 1100, "namespace": "NAMESPACE_TABLE_ROW", "action": "ACTION_ADD"},
+		{"action_index": 0, "payer": "bob", "delta": 50, "usage": 550, "namespace": "NAMESPACE_TABLE_ROW", "action": "ACTION_ADD"}
+	],"rlimit_ops":[
+		{"operation": "OPERATION_UPDATE", "account_usage": {"owner": "alice", "net_usage": {"consumed": 10}, "cpu_usage": {"consumed": 20}, "ram_usage": 1100}},
+		{"operation": "OPERATION_UPDATE", "account_limits": {"owner": "bob", "net_weight": 1, "cpu_weight": 2, "ram_bytes": 3}}
+	]}`)
+
+	block3a := testBlock(t, "00000003a", "00000002a", "zswhq", 1, `{"id":"trx.2","ram_ops":[
+		{"action_index": 0, "payer": "alice", "delta": -40, "usage": 1060, "namespace": "NAMESPACE_TABLE_ROW", "action": "ACTION_REMOVE"}
+	],"rlimit_ops":[
+		{"operation": "OPERATION_UPDATE", "account_usage": {"owner": "alice", "net_usage": {"consumed": 15}, "cpu_usage": {"consumed": 25}, "ram_usage": 1060}}
+	]}`)
+
+	block4a := testBlock(t, "00000004a", "00000003a", "zswhq", 1, `{"id":"trx.3","ram_ops":[
+		{"action_index": 0, "payer": "bob", "delta": 10, "usage": 560}
+	]}`)
+
+	emitter := NewTestEmitter(context.Background(), nil)
+	handler := newAccountResourcesHandler(context.Background(), msg, emitter, zlog)
+
+	require.NoError(t, handler.ProcessBlock(block2a, &forkable.ForkableObject{Step: forkable.StepNew}))
+	require.NoError(t, handler.ProcessBlock(block3a, &forkable.ForkableObject{Step: forkable.StepNew}))
+	require.NoError(t, handler.ProcessBlock(block4a, &forkable.ForkableObject{Step: forkable.StepNew}))
+	require.NoError(t, handler.ProcessBlock(block3a, &forkable.ForkableObject{Step: forkable.StepUndo}))
+
+	require.Len(t, emitter.messages, 3)
+
+	first := emitter.messages[0].(*wsmsg.AccountResourcesDelta)
+	assert.Equal(t, uint32(2), first.Data.BlockNum)
+	assert.Equal(t, "new", first.Data.Step)
+	require.Len(t, first.Data.RAMOps, 1)
+	assert.Equal(t, int64(100), first.Data.RAMOps[0].Delta)
+	assert.Nil(t, first.Data.Limits)
+	assert.Equal(t, &wsmsg.AccountResourceUsage{NetUsage: 10, CPUUsage: 20, RAMUsage: 1100}, first.Data.Usage)
+
+	undo := emitter.messages[2].(*wsmsg.AccountResourcesDelta)
+	assert.Equal(t, uint32(3), undo.Data.BlockNum)
+	assert.Equal(t, "undo", undo.Data.Step)
+	require.Len(t, undo.Data.RAMOps, 1)
+	assert.Equal(t, int64(40), undo.Data.RAMOps[0].Delta)
+	assert.Equal(t, uint64(1100), undo.Data.RAMOps[0].Usage)
+	assert.Equal(t, &wsmsg.AccountResourceUsage{NetUsage: 10, CPUUsage: 20, RAMUsage: 1100}, undo.Data.Usage)
+
+	require.NoError(t, handler.ProcessBlock(block2a, &forkable.ForkableObject{Step: forkable.StepIrreversible}))
+	assert.Len(t, handler.previous, 1)
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/zhongshuwen/histnew/eosws/metrics"
 	"github.com/zhongshuwen/histnew/eosws/rest"
 	stateHelper "github.com/zhongshuwen/histnew/eosws/statedb"
+	pbabicodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/abicodec/v1"
 	pbstatedb "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/statedb/v1"
 	"github.com/zhongshuwen/histnew/trxdb"
 	"github.com/streamingfast/dgrpc"
@@ -56,6 +57,8 @@ type Config struct {
 	HTTPListenAddr              string
 	NodeosRPCEndpoint           string
 	NodeosRPCPushExtraEndpoints []string
+	NodeosRPCSimulateEndpoint   string
+	ABICodecAddr                string
 	BlockmetaAddr               string
 	KVDBDSN                     string
 	BlockStreamAddr             string
@@ -417,12 +420,36 @@ func (a *App) Run() error {
 			true, true,
 		),
 	)
-	txPushRouter := rest.NewTxPushRouter(billedDumbAPIProxy, authTxPusher)
+	var authTxSimulator http.Handler
+	if a.Config.NodeosRPCSimulateEndpoint != "" {
+		simulateAPIURL := a.Config.NodeosRPCSimulateEndpoint
+		if !strings.HasPrefix(simulateAPIURL, "http") {
+			simulateAPIURL = "http://" + simulateAPIURL
+		}
+
+		zlog.Info("connecting to abicodec", zap.String("addr", a.Config.ABICodecAddr))
+		abiCodecConn, err := dgrpc.NewInternalClient(a.Config.ABICodecAddr)
+		if err != nil {
+			return fmt.Errorf("failed getting abicodec grpc client: %w", err)
+		}
+
+		authTxSimulator = dauthMiddleware.NewAuthMiddleware(auth, eosws.EOSChainErrorHandler).Handler(
+			dmetering.NewMeteringMiddleware(
+				rest.NewTransactionSimulator(zsw.New(simulateAPIURL), pbabicodec.NewDecoderClient(abiCodecConn), abiGetter),
+				meter,
+				"eosws", "Simulate Transaction",
+				true, true,
+			),
+		)
+	}
+
+	txPushRouter := rest.NewTxPushRouter(billedDumbAPIProxy, authTxPusher, authTxSimulator)
 	chainRouter.PathPrefix("/v1/chain").Handler(txPushRouter)
 
 	/// WebSocket endpoints
 	wsRouter.Use(authMiddleware)
 	wsRouter.Path("/v1/stream").Handler(wsHandler)
+	wsRouter.Path("/v1/stream/sse").Methods("GET").Handler(wsHandler.SSEHandler())
 
 	/// Primary REST API endpoints
 	restRouter.Use(compressionMiddleware)
@@ -443,6 +470,10 @@ func (a *App) Run() error {
 
 	restRouter.Path("/v0/search/transactions").Handler(searchQueryHandler)
 	restRouter.Path("/v0/block_id/by_time").Handler(rest.BlockTimeHandler(blockmetaClient))
+	restRouter.Path("/v0/transactions/deferred/pending").Handler(rest.ListPendingDeferredTransactionsHandler(db))
+	restRouter.Path("/v0/transactions/deferred/by_sender/{account}").Handler(rest.ListDeferredTransactionsBySenderHandler(db))
+	restRouter.Path("/v0/transactions/by_signer_key/{key}").Handler(rest.ListTransactionsBySignerKeyHandler(db))
+	restRouter.Path("/v0/transactions/by_action_data_hash/{hash}").Handler(rest.ListTransactionsByActionDataHashHandler(db))
 	restRouter.Path("/v0/transactions/{id}").Handler(rest.GetTransactionHandler(db))
 
 	// FluxDB (Chain State) REST API endpoints
@@ -27,6 +27,17 @@ type authStartBlocker interface {
 	AuthenticatedStartBlock() int64
 }
 
+// authQuotaChecker are credentials with per-period quotas, like the ones of the `apikey` plugin
+type authQuotaChecker interface {
+	CheckQuota() error
+}
+
+// authStreamAcquirer are credentials limiting the number of concurrent streams, a stream
+// being closed when `onQuotaExceeded` is called
+type authStreamAcquirer interface {
+	AcquireStream(onQuotaExceeded func(err error)) (release func(), err error)
+}
+
 type AuthorizedRequest struct {
 	StartBlockID  string // has precedence over startBlockNum
 	StartBlockNum uint32
@@ -46,6 +57,12 @@ func (ws *WSConn) AuthorizeRequest(ctx context.Context, msg wsmsg.IncomingMessag
 
 func (ws *WSConn) authorizeRequest(msg wsmsg.IncomingMessager, headBlock string) (*AuthorizedRequest, error) {
 	zlog.Debug("authorizeRequest: creation:", zap.String("head_block", headBlock))
+	if checker, ok := ws.creds.(authQuotaChecker); ok {
+		if err := checker.CheckQuota(); err != nil {
+			return nil, AuthQuotaExceededError(ws.Context, err)
+		}
+	}
+
 	common := msg.GetCommon()
 	reqStartBlock := common.StartBlock
 
@@ -45,6 +45,10 @@ type DB interface {
 	GetTransactions(ctx context.Context, ids []string) ([]*pbcodec.TransactionLifecycle, error)
 	ListTransactionsForBlockID(ctx context.Context, blockId string, startKey string, limit int) (*mdl.TransactionList, error)
 	ListMostRecentTransactions(ctx context.Context, startKey string, limit int) (*mdl.TransactionList, error)
+	ListTransactionsForSignerKey(ctx context.Context, publicKey string, startKey string, limit int) (*mdl.TransactionList, error)
+	ListTransactionsForActionDataHash(ctx context.Context, dataHash string, startKey string, limit int) (*mdl.TransactionList, error)
+	ListDeferredTransactionsForSender(ctx context.Context, sender string, startKey string, limit int) (*mdl.TransactionList, error)
+	ListPendingDeferredTransactions(ctx context.Context, atBlockNum uint32, startKey string, limit int) (*mdl.TransactionList, error)
 }
 
 // TRXDB
@@ -191,6 +195,112 @@ func (db *TRXDB) ListTransactionsForBlockID(ctx context.Context, blockID string,
 	}, nil
 }
 
+func (db *TRXDB) ListTransactionsForSignerKey(ctx context.Context, publicKey string, startKey string, limit int) (*mdl.TransactionList, error) {
+	return db.listTransactionsForRefs(ctx, startKey, limit, func(after *trxdb.TransactionRef) ([]*trxdb.TransactionRef, error) {
+		return db.ListTransactionRefsBySignerKey(ctx, publicKey, after, limit)
+	})
+}
+
+func (db *TRXDB) ListTransactionsForActionDataHash(ctx context.Context, dataHash string, startKey string, limit int) (*mdl.TransactionList, error) {
+	return db.listTransactionsForRefs(ctx, startKey, limit, func(after *trxdb.TransactionRef) ([]*trxdb.TransactionRef, error) {
+		return db.ListTransactionRefsByActionDataHash(ctx, dataHash, after, limit)
+	})
+}
+
+func (db *TRXDB) ListDeferredTransactionsForSender(ctx context.Context, sender string, startKey string, limit int) (*mdl.TransactionList, error) {
+	return db.listTransactionsForRefs(ctx, startKey, limit, func(after *trxdb.TransactionRef) ([]*trxdb.TransactionRef, error) {
+		return db.ListDeferredTransactionRefsBySender(ctx, sender, after, limit)
+	})
+}
+
+func (db *TRXDB) ListPendingDeferredTransactions(ctx context.Context, atBlockNum uint32, startKey string, limit int) (*mdl.TransactionList, error) {
+	return db.listTransactionsForRefs(ctx, startKey, limit, func(after *trxdb.TransactionRef) ([]*trxdb.TransactionRef, error) {
+		return db.ListPendingDeferredTransactionRefs(ctx, atBlockNum, after, limit)
+	})
+}
+
+// listTransactionsForRefs resolves the transaction refs returned by a trxdb
+// secondary index lookup. The cursor key is the `<blockID>:<trxID>` of the last
+// ref returned, it's empty once the end of the index has been reached.
+func (db *TRXDB) listTransactionsForRefs(ctx context.Context, startKey string, limit int, listRefs func(after *trxdb.TransactionRef) ([]*trxdb.TransactionRef, error)) (*mdl.TransactionList, error) {
+	if limit < 1 {
+		return &mdl.TransactionList{
+			Cursor: opaqueCursor(startKey),
+		}, nil
+	}
+
+	var after *trxdb.TransactionRef
+	if startKey != "" {
+		after = parseTransactionRefCursor(startKey)
+		if after == nil {
+			return nil, DBInvalidCursorError(ctx, startKey)
+		}
+	}
+
+	refs, err := listRefs(after)
+	if err != nil {
+		return nil, err
+	}
+
+	// The same transaction can be referenced once per fork it appeared in, lifecycles
+	// are resolved across forks so we list each transaction a single time.
+	var trxIDs []string
+	seenTrxIDs := map[string]bool{}
+	for _, ref := range refs {
+		if !seenTrxIDs[ref.ID] {
+			seenTrxIDs[ref.ID] = true
+			trxIDs = append(trxIDs, ref.ID)
+		}
+	}
+
+	trxList, err := db.GetTransactionEventsBatch(ctx, trxIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	lifecycles := []*v1.TransactionLifecycle{}
+	for _, evs := range trxList {
+		if len(evs) == 0 {
+			continue
+		}
+
+		lc, err := mdl.ToV1TransactionLifecycle(pbcodec.MergeTransactionEvents(evs, db.chainDiscriminator))
+		if err != nil {
+			return nil, fmt.Errorf("transactions list for refs: %w", err)
+		}
+		lifecycles = append(lifecycles, lc)
+	}
+
+	cursor := ""
+	if len(refs) == limit {
+		lastRef := refs[len(refs)-1]
+		cursor = opaqueCursor(lastRef.BlockID + ":" + lastRef.ID)
+	}
+
+	return &mdl.TransactionList{
+		Cursor:       cursor,
+		Transactions: lifecycles,
+	}, nil
+}
+
+// parseTransactionRefCursor returns the ref of a `<blockID>:<trxID>` cursor key,
+// nil if the key is not made of two 32 bytes hex encoded identifiers, the refs
+// being packed as-is in trxdb index keys.
+func parseTransactionRefCursor(startKey string) *trxdb.TransactionRef {
+	parts := strings.Split(startKey, ":")
+	if len(parts) != 2 {
+		return nil
+	}
+
+	for _, part := range parts {
+		if decoded, err := hex.DecodeString(part); err != nil || len(decoded) != 32 {
+			return nil
+		}
+	}
+
+	return &trxdb.TransactionRef{BlockID: parts[0], ID: parts[1]}
+}
+
 func (db *TRXDB) ListMostRecentTransactions(ctx context.Context, startKey string, limit int) (*mdl.TransactionList, error) {
 	if limit < 1 {
 		return &mdl.TransactionList{
@@ -428,6 +538,22 @@ func (db *MockDB) ListMostRecentTransactions(ctx context.Context, startKey strin
 	panic("Implement me!")
 }
 
+func (db *MockDB) ListTransactionsForSignerKey(ctx context.Context, publicKey string, startKey string, limit int) (*mdl.TransactionList, error) {
+	panic("Implement me!")
+}
+
+func (db *MockDB) ListTransactionsForActionDataHash(ctx context.Context, dataHash string, startKey string, limit int) (*mdl.TransactionList, error) {
+	panic("Implement me!")
+}
+
+func (db *MockDB) ListDeferredTransactionsForSender(ctx context.Context, sender string, startKey string, limit int) (*mdl.TransactionList, error) {
+	panic("Implement me!")
+}
+
+func (db *MockDB) ListPendingDeferredTransactions(ctx context.Context, atBlockNum uint32, startKey string, limit int) (*mdl.TransactionList, error) {
+	panic("Implement me!")
+}
+
 func (db *MockDB) ListTransactionsForBlockID(ctx context.Context, blockId string, startKey string, limit int) (*mdl.TransactionList, error) {
 	panic("Implement me!")
 }
@@ -446,6 +572,10 @@ func (db *MockDB) ListSiblingBlocks(ctx context.Context, blockNum uint32, spread
 	panic("implement me")
 }
 
+func (db *MockDB) ListBlocksInRange(ctx context.Context, lowBlockNum, highBlockNum uint32, mode trxdb.BlockRangeMode) ([]*pbcodec.BlockWithRefs, error) {
+	panic("implement me")
+}
+
 func (db *MockDB) GetAccount(ctx context.Context, name string) (out *pbcodec.AccountCreationRef, err error) {
 	return &pbcodec.AccountCreationRef{
 		Account: "eoscanadacom",
@@ -453,6 +583,14 @@ func (db *MockDB) GetAccount(ctx context.Context, name string) (out *pbcodec.Acc
 	}, nil
 }
 
+func (db *MockDB) ListAccountsCreatedBy(ctx context.Context, creator string, afterAccount string, limit int) ([]*pbcodec.AccountCreationRef, error) {
+	panic("implement me")
+}
+
+func (db *MockDB) GetAccountLineage(ctx context.Context, name string) ([]*pbcodec.AccountCreationRef, error) {
+	panic("implement me")
+}
+
 func (db *MockDB) ListAccountNames(ctx context.Context) (out []string, err error) {
 	return []string{"eoscanadacom"}, nil
 }
@@ -14,6 +14,32 @@
 
 package eosws
 
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zhongshuwen/histnew/trxdb"
+)
+
+func TestParseTransactionRefCursor(t *testing.T) {
+	blockID := "00000002aa" + strings.Repeat("0", 54)
+	trxID := strings.Repeat("ab", 32)
+
+	assert.Equal(t, &trxdb.TransactionRef{BlockID: blockID, ID: trxID}, parseTransactionRefCursor(blockID+":"+trxID))
+
+	for _, startKey := range []string{
+		blockID,
+		blockID + ":" + trxID + ":" + trxID,
+		blockID + ":" + trxID[:62],
+		blockID[:63] + ":" + trxID,
+		strings.Repeat("zz", 32) + ":" + trxID,
+		blockID + ":" + strings.Repeat("zz", 32),
+	} {
+		assert.Nil(t, parseTransactionRefCursor(startKey), startKey)
+	}
+}
+
 // FIXME: FIX THOSE TESTS IN HERE, they were moved from `bigtable`,
 // and it's the only place where we test
 // `ListMostRecentTransactions`.. and those tests, we don't know if
@@ -46,6 +46,13 @@ func AuthInvalidTierError(ctx context.Context, tier string, expectedTier string)
 	)
 }
 
+func AuthQuotaExceededError(ctx context.Context, cause error) *derr.ErrorResponse {
+	return derr.HTTPTooManyRequestsError(ctx, cause, derr.C("auth_quota_exceeded_error"),
+		"Your API key exhausted its quota.",
+		"reason", cause.Error(),
+	)
+}
+
 func AuthInvalidStreamingStartBlockError(
 	ctx context.Context,
 	actualBlockNum uint32,
@@ -123,6 +130,13 @@ func DBTrxAppearanceTimeoutError(ctx context.Context, blockID string, trxID stri
 	)
 }
 
+func DBInvalidCursorError(ctx context.Context, cursor string) *derr.ErrorResponse {
+	return derr.HTTPBadRequestError(ctx, nil, derr.C("data_invalid_cursor_error"),
+		"The requested cursor is invalid.",
+		"cursor", cursor,
+	)
+}
+
 // Application Errors
 
 func AppHeadInfoNotReadyError(ctx context.Context) *derr.ErrorResponse {
@@ -144,6 +158,13 @@ func AppTableRowsCannotFetchInFutureError(ctx context.Context, blockNum uint32)
 	)
 }
 
+func AppAccountResourcesCannotFetchInFutureError(ctx context.Context, blockNum uint32) *derr.ErrorResponse {
+	return derr.HTTPServiceUnavailableError(ctx, nil, derr.C("app_account_resources_cannot_fetch_in_future_error"),
+		"It's not valid to try fetching account resources for a block in the future.",
+		"start_block", blockNum,
+	)
+}
+
 func AppUnableToGetIrreversibleBlockIDError(ctx context.Context, identifier string) *derr.ErrorResponse {
 	return derr.HTTPInternalServerError(ctx, nil, derr.C("data_unable_to_get_irreversible_block_id_error"),
 		"Unable to get irreversible block ID.",
@@ -238,3 +259,26 @@ func WSUnableToUpgradeConnectionError(ctx context.Context, status int, cause err
 func sanitizeWebSocketReasonMessage(message string) string {
 	return strings.TrimPrefix(message, "websocket: ")
 }
+
+// Server-Sent Events Errors
+
+func SSEStreamingUnsupportedError(ctx context.Context) *derr.ErrorResponse {
+	return derr.HTTPInternalServerError(ctx, nil, derr.C("sse_streaming_unsupported_error"),
+		"The connection does not support streaming responses.",
+	)
+}
+
+func SSEUnsupportedMessageError(ctx context.Context, messageType string) *derr.ErrorResponse {
+	return derr.HTTPBadRequestError(ctx, nil, derr.C("sse_unsupported_message_error"),
+		"The message type cannot be streamed over Server-Sent Events.",
+		"type", messageType,
+	)
+}
+
+func SSEInvalidLastEventIDError(ctx context.Context, lastEventID string, cause error) *derr.ErrorResponse {
+	return derr.HTTPBadRequestError(ctx, cause, derr.C("sse_invalid_last_event_id_error"),
+		"The last event id is not valid.",
+		"last_event_id", lastEventID,
+		"reason", cause.Error(),
+	)
+}
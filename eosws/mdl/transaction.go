@@ -15,6 +15,7 @@
 package mdl
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -416,6 +417,21 @@ func ToV1ActionTraceRaw(parentAction *pbcodec.ActionTrace, actions []*pbcodec.Ac
 		}
 	}
 
+	// Return values are only reported by nodes running deep mind version 14 and up
+	if len(parentAction.ReturnValue) > 0 {
+		rawTrace, err = sjson.SetBytes(rawTrace, "return_value", hex.EncodeToString(parentAction.ReturnValue))
+		if err != nil {
+			return nil, fmt.Errorf("set return value: %w", err)
+		}
+	}
+
+	if parentAction.JsonReturnValue != "" {
+		rawTrace, err = sjson.SetRawBytes(rawTrace, "json_return_value", []byte(parentAction.JsonReturnValue))
+		if err != nil {
+			return nil, fmt.Errorf("set json return value: %w", err)
+		}
+	}
+
 	return rawTrace, nil
 }
 
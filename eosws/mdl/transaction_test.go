@@ -110,6 +110,25 @@ func TestToActionTraceRaw(t *testing.T) {
 	}
 }
 
+func TestToActionTraceRaw_ReturnValue(t *testing.T) {
+	actionTrace := &pbcodec.ActionTrace{
+		Receiver:        "eosio",
+		Receipt:         &pbcodec.ActionReceipt{Receiver: "eosio", GlobalSequence: 1},
+		Action:          &pbcodec.Action{Account: "eosio", Name: "getbalance"},
+		ReturnValue:     []byte{0x01, 0x02},
+		JsonReturnValue: `{"amount":"1.0000 EOS"}`,
+	}
+
+	actual, err := ToV1ActionTraceRaw(actionTrace, []*pbcodec.ActionTrace{actionTrace}, true)
+	require.NoError(t, err)
+
+	var trace map[string]interface{}
+	require.NoError(t, json.Unmarshal(actual, &trace))
+
+	assert.Equal(t, "0102", trace["return_value"])
+	assert.Equal(t, map[string]interface{}{"amount": "1.0000 EOS"}, trace["json_return_value"])
+}
+
 var jsonpbMarshaler = &jsonpb.Marshaler{
 	Indent: "  ",
 }
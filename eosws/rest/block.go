@@ -22,6 +22,8 @@ import (
 	"github.com/streamingfast/derr"
 	"github.com/zhongshuwen/histnew/eosws"
 	"github.com/zhongshuwen/histnew/eosws/mdl"
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+	"github.com/zhongshuwen/histnew/trxdb"
 	"github.com/streamingfast/validator"
 	"github.com/gorilla/mux"
 	"github.com/streamingfast/dmetering"
@@ -53,12 +55,30 @@ func GetBlocksHandler(db eosws.DB) http.Handler {
 		skip, _ := strconv.Atoi(r.FormValue("skip"))
 		limit, _ := strconv.Atoi(r.FormValue("limit"))
 
-		dbBlocks, err := db.ListBlocks(r.Context(), uint32(skip), limit)
+		var dbBlocks []*pbcodec.BlockWithRefs
+		var err error
+		if mode, ranged := blockRangeMode(r); ranged {
+			// `skip` is the highest block num listed, at most `limit` blocks are listed from the
+			// `limit` heights below it, forked blocks counting in the `limit` when included
+			highBlockNum := uint32(skip)
+			lowBlockNum := uint32(0)
+			if highBlockNum >= uint32(limit) {
+				lowBlockNum = highBlockNum - uint32(limit) + 1
+			}
+
+			dbBlocks, err = db.ListBlocksInRange(r.Context(), lowBlockNum, highBlockNum, mode)
+		} else {
+			dbBlocks, err = db.ListBlocks(r.Context(), uint32(skip), limit)
+		}
 		if err != nil {
 			eosws.WriteError(w, r, derr.Wrap(err, "failed to get blocks"))
 			return
 		}
 
+		if len(dbBlocks) > limit {
+			dbBlocks = dbBlocks[:limit]
+		}
+
 		var blockSummaries []*mdl.BlockSummary
 		for _, block := range dbBlocks {
 			blkSummary, err := mdl.ToV1BlockSummary(block)
@@ -90,6 +110,23 @@ func GetBlocksHandler(db eosws.DB) http.Handler {
 	})
 }
 
+// blockRangeMode returns the mode of the block range requested by the `canonical`,
+// `irreversible_only` or `include_forks` query parameters. Without any of them, `ranged`
+// is false and the blocks are listed like they always were, forked blocks counting in
+// the `limit`.
+func blockRangeMode(r *http.Request) (mode trxdb.BlockRangeMode, ranged bool) {
+	switch {
+	case r.FormValue("irreversible_only") == "true":
+		return trxdb.BlockRangeIrreversibleOnly, true
+	case r.FormValue("include_forks") == "true":
+		return trxdb.BlockRangeAllForks, true
+	case r.FormValue("canonical") == "true":
+		return trxdb.BlockRangeCanonical, true
+	}
+
+	return mode, false
+}
+
 func GetBlockHandler(db eosws.DB) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
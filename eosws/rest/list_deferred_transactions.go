@@ -0,0 +1,111 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/streamingfast/derr"
+	"github.com/streamingfast/dmetering"
+	"github.com/streamingfast/validator"
+	"github.com/zhongshuwen/histnew/eosws"
+	zsw "github.com/zhongshuwen/zswchain-go"
+)
+
+// ListDeferredTransactionsBySenderHandler lists the deferred transactions
+// scheduled by the account `account`, most recent first.
+func ListDeferredTransactionsBySenderHandler(db eosws.DB) http.Handler {
+	return listTransactionsByIndexHandler(
+		"/v0/transactions/deferred/by_sender/{account}",
+		"account",
+		func(value string) string {
+			if !validator.IsValidName(value) {
+				return "The account field must be a valid EOS name"
+			}
+			return ""
+		},
+		db.ListDeferredTransactionsForSender,
+	)
+}
+
+// ListPendingDeferredTransactionsHandler lists the deferred transactions still
+// waiting to execute at block `at_block_num`, the last written block when not
+// specified, the ones expiring first coming first.
+func ListPendingDeferredTransactionsHandler(db eosws.DB) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		errors := eosws.ValidatePendingDeferredTransactionsRequest(r)
+		if len(errors) > 0 {
+			eosws.WriteError(w, r, derr.RequestValidationError(ctx, errors))
+			//////////////////////////////////////////////////////////////////////
+			// Billable event on REST API endpoint
+			// WARNING: Ingress / Egress bytess is taken care by the middleware
+			//////////////////////////////////////////////////////////////////////
+			dmetering.EmitWithContext(dmetering.Event{
+				Source:         "eosws",
+				Kind:           "REST API",
+				Method:         "/v0/transactions/deferred/pending",
+				RequestsCount:  1,
+				ResponsesCount: 1,
+			}, ctx)
+			//////////////////////////////////////////////////////////////////////
+			return
+		}
+
+		var atBlockNum uint32
+		if value := r.FormValue("at_block_num"); value != "" {
+			num, _ := strconv.ParseUint(value, 10, 32)
+			atBlockNum = uint32(num)
+		} else {
+			blockID, err := db.GetLastWrittenBlockID(ctx)
+			if err != nil {
+				eosws.WriteError(w, r, derr.Wrap(err, "failed to get last written block"))
+				return
+			}
+			atBlockNum = zsw.BlockNum(blockID)
+		}
+
+		cursor, _ := parseCursor(r.FormValue("cursor"))
+		limit, _ := strconv.Atoi(r.FormValue("limit"))
+
+		dbTransactionList, err := db.ListPendingDeferredTransactions(ctx, atBlockNum, cursor, limit)
+		if err != nil {
+			eosws.WriteError(w, r, derr.Wrap(err, "failed to get pending deferred transactions"))
+			return
+		}
+
+		eosws.WriteJSON(w, r, dbTransactionList)
+
+		count := int64(len(dbTransactionList.Transactions))
+		if count == 0 {
+			count = 1
+		}
+
+		//////////////////////////////////////////////////////////////////////
+		// Billable event on REST API endpoint
+		// WARNING: Ingress / Egress bytess is taken care by the middleware
+		//////////////////////////////////////////////////////////////////////
+		dmetering.EmitWithContext(dmetering.Event{
+			Source:         "eosws",
+			Kind:           "REST API",
+			Method:         "/v0/transactions/deferred/pending",
+			RequestsCount:  1,
+			ResponsesCount: count,
+		}, ctx)
+		//////////////////////////////////////////////////////////////////////
+	})
+}
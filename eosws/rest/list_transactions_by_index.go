@@ -0,0 +1,125 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/streamingfast/derr"
+	"github.com/streamingfast/dmetering"
+	"github.com/zhongshuwen/histnew/eosws"
+	"github.com/zhongshuwen/histnew/eosws/mdl"
+	"github.com/zhongshuwen/zswchain-go/ecc"
+)
+
+// ListTransactionsBySignerKeyHandler lists the transactions signed by the
+// public key `key`, most recent first.
+func ListTransactionsBySignerKeyHandler(db eosws.DB) http.Handler {
+	return listTransactionsByIndexHandler(
+		"/v0/transactions/by_signer_key/{key}",
+		"key",
+		func(value string) string {
+			if _, err := ecc.NewPublicKey(value); err != nil {
+				return "The key field must be a valid public key"
+			}
+			return ""
+		},
+		db.ListTransactionsForSignerKey,
+	)
+}
+
+// ListTransactionsByActionDataHashHandler lists the transactions containing an
+// action whose raw data has the sha256 `hash`, most recent first.
+func ListTransactionsByActionDataHashHandler(db eosws.DB) http.Handler {
+	return listTransactionsByIndexHandler(
+		"/v0/transactions/by_action_data_hash/{hash}",
+		"hash",
+		func(value string) string {
+			if decoded, err := hex.DecodeString(value); err != nil || len(decoded) != 32 {
+				return "The hash field must be a 64 characters hexadecimal sha256"
+			}
+			return ""
+		},
+		db.ListTransactionsForActionDataHash,
+	)
+}
+
+type listTransactionsByIndexFunc func(ctx context.Context, value string, startKey string, limit int) (*mdl.TransactionList, error)
+
+func listTransactionsByIndexHandler(method string, pathVariable string, validateValue func(value string) string, list listTransactionsByIndexFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		value := mux.Vars(r)[pathVariable]
+
+		errors := eosws.ValidateListRequest(r)
+		if message := validateValue(value); message != "" {
+			if errors == nil {
+				errors = url.Values{}
+			}
+			errors[pathVariable] = []string{message}
+		}
+
+		if len(errors) > 0 {
+			eosws.WriteError(w, r, derr.RequestValidationError(ctx, errors))
+			//////////////////////////////////////////////////////////////////////
+			// Billable event on REST API endpoint
+			// WARNING: Ingress / Egress bytess is taken care by the middleware
+			//////////////////////////////////////////////////////////////////////
+			dmetering.EmitWithContext(dmetering.Event{
+				Source:         "eosws",
+				Kind:           "REST API",
+				Method:         method,
+				RequestsCount:  1,
+				ResponsesCount: 1,
+			}, ctx)
+			//////////////////////////////////////////////////////////////////////
+			return
+		}
+
+		cursor, _ := parseCursor(r.FormValue("cursor"))
+		limit, _ := strconv.Atoi(r.FormValue("limit"))
+
+		dbTransactionList, err := list(ctx, value, cursor, limit)
+		if err != nil {
+			eosws.WriteError(w, r, derr.Wrap(err, "failed to get transactions"))
+			return
+		}
+
+		eosws.WriteJSON(w, r, dbTransactionList)
+
+		count := int64(len(dbTransactionList.Transactions))
+		if count == 0 {
+			count = 1
+		}
+
+		//////////////////////////////////////////////////////////////////////
+		// Billable event on REST API endpoint
+		// WARNING: Ingress / Egress bytess is taken care by the middleware
+		//////////////////////////////////////////////////////////////////////
+		dmetering.EmitWithContext(dmetering.Event{
+			Source:         "eosws",
+			Kind:           "REST API",
+			Method:         method,
+			RequestsCount:  1,
+			ResponsesCount: count,
+		}, ctx)
+		//////////////////////////////////////////////////////////////////////
+	})
+}
@@ -0,0 +1,251 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/streamingfast/logging"
+	"github.com/zhongshuwen/histnew/eosws"
+	pbabicodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/abicodec/v1"
+	zsw "github.com/zhongshuwen/zswchain-go"
+	"github.com/zhongshuwen/zswchain-go/zswerr"
+	"go.uber.org/zap"
+)
+
+// TransactionSimulator executes transactions against a nodeos instance that does not
+// broadcast them, like a read-only node without peers, and returns the resulting trace
+// with the action data decoded through abicodec and the database operations, when the
+// node reports them, decoded with the ABIs known by statedb.
+type TransactionSimulator struct {
+	API            *zsw.API
+	abiCodecClient pbabicodec.DecoderClient
+	abiGetter      eosws.ABIGetter
+}
+
+func NewTransactionSimulator(API *zsw.API, abiCodecClient pbabicodec.DecoderClient, abiGetter eosws.ABIGetter) *TransactionSimulator {
+	return &TransactionSimulator{
+		API:            API,
+		abiCodecClient: abiCodecClient,
+		abiGetter:      abiGetter,
+	}
+}
+
+func (s *TransactionSimulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlogger := logging.Logger(ctx, zlog)
+
+	eosws.TrackUserEvent(ctx, "rest_request",
+		"method", r.Method,
+		"host", r.Host,
+		"path", r.URL.Path,
+		"query", r.URL.Query(),
+	)
+
+	incomingContent, err := ioutil.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		zlogger.Warn("simulateTrx: ioutil.ReadAll", zap.Error(err))
+		return
+	}
+
+	var tx *zsw.PackedTransaction
+	if err := json.Unmarshal(incomingContent, &tx); err != nil {
+		if isValidJSON(incomingContent) {
+			writeDetailedAPIError(err, "Internal Service Error", 3010010, "packed_transaction_type_exception", "Invalid packed transaction", "Invalid packed transaction", w)
+			return
+		}
+		writeDetailedAPIError(err, "Internal Service Error", 4, "parse_error_exception", "Parse Error", err.Error(), w)
+		return
+	}
+
+	timedoutContext, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	response, err := s.API.PushTransactionRaw(timedoutContext, tx)
+	if err != nil {
+		if apiErr, ok := err.(zsw.APIError); ok {
+			zlogger.Info("simulate transaction API error", logFieldsFromAPIErr(apiErr)...)
+			if apiErrCnt, err := json.Marshal(apiErr); err == nil {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(apiErr.Code)
+				w.Write(apiErrCnt)
+				return
+			}
+		}
+
+		checkHTTPError(err, "cannot simulate transaction on Nodeos API", zswerr.ErrUnhandledException, w)
+		return
+	}
+
+	out, err := s.enrich(ctx, response)
+	if checkHTTPError(err, "cannot decode simulated transaction", zswerr.ErrUnhandledException, w) {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("content-length", fmt.Sprintf("%d", len(out)))
+	w.Write(out)
+}
+
+// enrich decodes the action data and database operations of the `processed` trace found
+// in the push response. What cannot be decoded is left as is, the failure being logged.
+func (s *TransactionSimulator) enrich(ctx context.Context, response json.RawMessage) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(response))
+	decoder.UseNumber()
+
+	var resp map[string]interface{}
+	if err := decoder.Decode(&resp); err != nil {
+		return nil, fmt.Errorf("invalid push response: %w", err)
+	}
+
+	processed, ok := resp["processed"].(map[string]interface{})
+	if !ok {
+		return response, nil
+	}
+
+	var blockNum uint32
+	if num, ok := processed["block_num"].(json.Number); ok {
+		if value, err := num.Int64(); err == nil {
+			blockNum = uint32(value)
+		}
+	}
+
+	s.decodeActionTraces(ctx, processed["action_traces"], blockNum)
+	s.decodeDBOps(ctx, processed["db_ops"])
+
+	return json.Marshal(resp)
+}
+
+func (s *TransactionSimulator) decodeActionTraces(ctx context.Context, traces interface{}, blockNum uint32) {
+	list, ok := traces.([]interface{})
+	if !ok {
+		return
+	}
+
+	for _, element := range list {
+		trace, ok := element.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if act, ok := trace["act"].(map[string]interface{}); ok {
+			s.decodeAction(ctx, act, blockNum)
+		}
+
+		// Legacy traces nest the inline actions instead of flattening them
+		s.decodeActionTraces(ctx, trace["inline_traces"], blockNum)
+	}
+}
+
+func (s *TransactionSimulator) decodeAction(ctx context.Context, act map[string]interface{}, blockNum uint32) {
+	hexData, ok := act["hex_data"].(string)
+	if !ok {
+		// Without an ABI, nodeos renders the data in hexadecimal
+		if hexData, ok = act["data"].(string); !ok {
+			return
+		}
+	}
+
+	if _, decoded := act["data"].(map[string]interface{}); decoded {
+		return
+	}
+
+	account, _ := act["account"].(string)
+	name, _ := act["name"].(string)
+
+	payload, err := hex.DecodeString(hexData)
+	if err != nil {
+		zlog.Debug("invalid action hex data", zap.String("account", account), zap.String("action", name), zap.Error(err))
+		return
+	}
+
+	resp, err := s.abiCodecClient.DecodeAction(ctx, &pbabicodec.DecodeActionRequest{
+		Account:    account,
+		Action:     name,
+		AtBlockNum: blockNum,
+		Payload:    payload,
+	})
+	if err != nil {
+		zlog.Info("failed to decode simulated action data", zap.String("account", account), zap.String("action", name), zap.Uint32("block_num", blockNum), zap.Error(err))
+		return
+	}
+
+	act["hex_data"] = hexData
+	act["data"] = json.RawMessage(resp.JsonPayload)
+}
+
+// decodeDBOps decodes database operations reported like `dfuse.zswhq.codec.v1.DBOp`,
+// adding `old_json` and `new_json` next to `old_data` and `new_data`.
+func (s *TransactionSimulator) decodeDBOps(ctx context.Context, dbOps interface{}) {
+	list, ok := dbOps.([]interface{})
+	if !ok {
+		return
+	}
+
+	abis := map[string]*zsw.ABI{}
+	for _, element := range list {
+		dbOp, ok := element.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		code, _ := dbOp["code"].(string)
+		table, _ := dbOp["table_name"].(string)
+		if code == "" || table == "" {
+			continue
+		}
+
+		abi, found := abis[code]
+		if !found {
+			var err error
+			if abi, err = s.abiGetter.GetABI(ctx, 0, zsw.AccountName(code)); err != nil {
+				zlog.Info("failed to get ABI of simulated db op", zap.String("code", code), zap.Error(err))
+			}
+			abis[code] = abi
+		}
+
+		if abi == nil {
+			continue
+		}
+
+		for _, field := range []string{"old", "new"} {
+			hexData, ok := dbOp[field+"_data"].(string)
+			if !ok || hexData == "" {
+				continue
+			}
+
+			data, err := hex.DecodeString(hexData)
+			if err != nil {
+				continue
+			}
+
+			rowData, err := abi.DecodeTableRow(zsw.TableName(table), data)
+			if err != nil {
+				zlog.Info("failed to decode simulated db op", zap.String("code", code), zap.String("table", table), zap.Error(err))
+				continue
+			}
+
+			dbOp[field+"_json"] = json.RawMessage(rowData)
+		}
+	}
+}
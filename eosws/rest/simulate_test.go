@@ -0,0 +1,109 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pbabicodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/abicodec/v1"
+	zsw "github.com/zhongshuwen/zswchain-go"
+	"google.golang.org/grpc"
+)
+
+func TestTransactionSimulator(t *testing.T) {
+	cases := []struct {
+		name           string
+		nodeosStatus   int
+		nodeosResponse string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:         "decoded action data and db ops",
+			nodeosStatus: 200,
+			nodeosResponse: `{"transaction_id":"abc","processed":{"id":"abc","block_num":10,"receipt":{"cpu_usage_us":120},"action_traces":[
+				{"act":{"account":"token","name":"transfer","data":"096e65772e76616c7565"}},
+				{"act":{"account":"token","name":"issue","data":{"to":"bob"},"hex_data":"00"}}
+			],"db_ops":[
+				{"code":"token","scope":"token","table_name":"table_name_1","primary_key":"key","new_data":"096e65772e76616c7565"}
+			]}}`,
+			expectedStatus: 200,
+			expectedBody: `{"processed":{"action_traces":[
+				{"act":{"account":"token","data":{"decoded":"transfer@10"},"hex_data":"096e65772e76616c7565","name":"transfer"}},
+				{"act":{"account":"token","data":{"to":"bob"},"hex_data":"00","name":"issue"}}
+			],"block_num":10,"db_ops":[
+				{"code":"token","new_data":"096e65772e76616c7565","new_json":{"struct_1_field_1":"new.value"},"primary_key":"key","scope":"token","table_name":"table_name_1"}
+			],"id":"abc","receipt":{"cpu_usage_us":120}},"transaction_id":"abc"}`,
+		},
+		{
+			name:           "nodeos error",
+			nodeosStatus:   500,
+			nodeosResponse: `{"code":500,"message":"Internal Service Error","error":{"code":3050003,"name":"eosio_assert_message_exception","what":"eosio_assert_message assertion failure","details":[]}}`,
+			expectedStatus: 500,
+			expectedBody:   `{"code":500,"message":"Internal Service Error","error":{"code":3050003,"name":"eosio_assert_message_exception","what":"eosio_assert_message assertion failure","details":[]}}`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			nodeos := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/v1/chain/push_transaction", r.URL.Path)
+				w.WriteHeader(c.nodeosStatus)
+				w.Write([]byte(c.nodeosResponse))
+			}))
+			defer nodeos.Close()
+
+			abi, err := zsw.NewABI(strings.NewReader(`{"version":"zswhq::abi/1.0","structs":[{"name":"struct_name_1","fields":[{"name":"struct_1_field_1","type":"string"}]}],"tables":[{"name":"table_name_1","index_type":"i64","key_names":["key_name_1"],"key_types":["string"],"type":"struct_name_1"}]}`))
+			require.NoError(t, err)
+
+			simulator := NewTransactionSimulator(zsw.New(nodeos.URL), &testDecoderClient{}, &testABIGetter{abi: abi})
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest("POST", "/v1/chain/simulate_transaction", strings.NewReader(`{"signatures":[],"compression":"none","packed_context_free_data":"","packed_trx":"00"}`))
+			simulator.ServeHTTP(recorder, request)
+
+			assert.Equal(t, c.expectedStatus, recorder.Code)
+			assert.JSONEq(t, c.expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+type testDecoderClient struct {
+	pbabicodec.DecoderClient
+}
+
+func (c *testDecoderClient) DecodeAction(ctx context.Context, in *pbabicodec.DecodeActionRequest, opts ...grpc.CallOption) (*pbabicodec.Response, error) {
+	if hex.EncodeToString(in.Payload) != "096e65772e76616c7565" {
+		return nil, fmt.Errorf("unexpected payload")
+	}
+
+	return &pbabicodec.Response{JsonPayload: fmt.Sprintf(`{"decoded":"%s@%d"}`, in.Action, in.AtBlockNum)}, nil
+}
+
+type testABIGetter struct {
+	abi *zsw.ABI
+}
+
+func (g *testABIGetter) GetABI(ctx context.Context, blockNum uint32, account zsw.AccountName) (*zsw.ABI, error) {
+	return g.abi, nil
+}
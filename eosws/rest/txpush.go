@@ -43,14 +43,18 @@ import (
 /////// PUSHER ROUTER
 
 type TxPushRouter struct {
-	dumbAPIProxy           http.Handler
-	pushTransactionHandler http.Handler
+	dumbAPIProxy               http.Handler
+	pushTransactionHandler     http.Handler
+	simulateTransactionHandler http.Handler
 }
 
-func NewTxPushRouter(dumbAPIProxy http.Handler, pushTransactionHandler http.Handler) *TxPushRouter {
+// NewTxPushRouter routes the chain API requests, `simulateTransactionHandler`
+// can be nil when transaction simulation is not enabled.
+func NewTxPushRouter(dumbAPIProxy http.Handler, pushTransactionHandler http.Handler, simulateTransactionHandler http.Handler) *TxPushRouter {
 	return &TxPushRouter{
-		dumbAPIProxy:           dumbAPIProxy,
-		pushTransactionHandler: pushTransactionHandler,
+		dumbAPIProxy:               dumbAPIProxy,
+		pushTransactionHandler:     pushTransactionHandler,
+		simulateTransactionHandler: simulateTransactionHandler,
 	}
 }
 
@@ -66,6 +70,11 @@ func (t *TxPushRouter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			t.pushTransactionHandler.ServeHTTP(w, r)
 			return
 		}
+	case "/v1/chain/simulate_transaction":
+		if t.simulateTransactionHandler != nil {
+			t.simulateTransactionHandler.ServeHTTP(w, r)
+			return
+		}
 		//case "/v1/chain/get_info":
 		//return
 	}
@@ -199,6 +208,7 @@ func (t *TxPusher) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	})
 
 	var trxTraceFoundChan <-chan *pbcodec.TransactionTrace
+	var inclusionChan <-chan *inclusionEvent
 	var shutdownFunc func(error)
 	expirationDelay := time.Minute * 2 //baseline for inblock inclusion
 	normalizedGuarantee := guarantee
@@ -220,8 +230,10 @@ func (t *TxPusher) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	case "irreversible":
 		expirationDelay += 6 * time.Minute
 		trxTraceFoundChan, shutdownFunc = awaitTransactionIrreversible(ctx, trxID, liveSourceFactory)
+	case "resilient":
+		inclusionChan, shutdownFunc = awaitTransactionResilient(ctx, trxID, liveSourceFactory)
 	default:
-		msg := "unknown value for X-Eos-Push-Guarantee. Please use 'irreversible', 'in-block', 'handoff:1', 'handoffs:2', 'handoffs:3'"
+		msg := "unknown value for X-Eos-Push-Guarantee. Please use 'irreversible', 'resilient', 'in-block', 'handoff:1', 'handoffs:2', 'handoffs:3'"
 		checkHTTPError(fmt.Errorf(msg), msg, zswerr.ErrUnhandledException, w)
 		return
 	}
@@ -273,6 +285,11 @@ func (t *TxPusher) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if normalizedGuarantee == "resilient" {
+		t.serveResilient(ctx, w, r, tx, trxID, inclusionChan)
+		return
+	}
+
 	zlog.Debug("waiting for trx to appear in a block", zap.String("hexTrxID", trxID), zap.Float64("minutes", expirationDelay.Minutes()), zap.String("guarantee", guarantee))
 
 	resend := 0
@@ -333,24 +350,9 @@ func (t *TxPusher) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		case trxTrace := <-trxTraceFoundChan:
 			blockID := trxTrace.ProducerBlockId
 
-			var processed json.RawMessage
-			if os.Getenv("EOSWS_PUSH_V1_OUTPUT") == "true" {
-				v1tr, err := mdl.ToV1TransactionTrace(trxTrace)
-				if checkHTTPError(err, "cannot marshal response", zswerr.ErrUnhandledException, w) {
-					return
-				}
-				out, err := json.Marshal(v1tr)
-				if checkHTTPError(err, "cannot marshal response", zswerr.ErrUnhandledException, w) {
-					return
-				}
-				processed = out
-			} else {
-				eosTrace := codec.TransactionTraceToEOS(trxTrace)
-				out, err := json.Marshal(eosTrace)
-				if checkHTTPError(err, "cannot marshal response", zswerr.ErrUnhandledException, w) {
-					return
-				}
-				processed = out
+			processed, err := marshalProcessed(trxTrace)
+			if checkHTTPError(err, "cannot marshal response", zswerr.ErrUnhandledException, w) {
+				return
 			}
 
 			resp := &PushResponse{
@@ -373,6 +375,20 @@ func (t *TxPusher) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// marshalProcessed renders the transaction trace the way the chain API does, or
+// in the dfuse format when `EOSWS_PUSH_V1_OUTPUT` is set
+func marshalProcessed(trxTrace *pbcodec.TransactionTrace) (json.RawMessage, error) {
+	if os.Getenv("EOSWS_PUSH_V1_OUTPUT") == "true" {
+		v1tr, err := mdl.ToV1TransactionTrace(trxTrace)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(v1tr)
+	}
+
+	return json.Marshal(codec.TransactionTraceToEOS(trxTrace))
+}
+
 func writeDetailedAPIError(err error, msg string, errorCode int, errorName, errorWhat, detailMessage string, w http.ResponseWriter) {
 	fields := []zap.Field{
 		zap.Error(err),
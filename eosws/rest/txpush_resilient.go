@@ -0,0 +1,356 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/bstream/forkable"
+	"github.com/zhongshuwen/histnew/eosws/metrics"
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+	zsw "github.com/zhongshuwen/zswchain-go"
+	"github.com/zhongshuwen/zswchain-go/zswerr"
+	"go.uber.org/zap"
+)
+
+// Progress events of a transaction pushed with the `resilient` guarantee. The
+// last event of a push is always one of `irreversible`, `expired` or `failed`.
+const (
+	PushEventPushed       = "pushed"
+	PushEventIncluded     = "included"
+	PushEventForkedOut    = "forked_out"
+	PushEventRePushed     = "re_pushed"
+	PushEventReIncluded   = "re_included"
+	PushEventIrreversible = "irreversible"
+	PushEventExpired      = "expired"
+	PushEventFailed       = "failed"
+)
+
+type PushEvent struct {
+	Type          string          `json:"type"`
+	TransactionID string          `json:"transaction_id"`
+	BlockID       string          `json:"block_id,omitempty"`
+	BlockNum      uint32          `json:"block_num,omitempty"`
+	Attempt       int             `json:"attempt,omitempty"`
+	Processed     json.RawMessage `json:"processed,omitempty"`
+	Error         json.RawMessage `json:"error,omitempty"`
+}
+
+func (e *PushEvent) isFinal() bool {
+	return e.Type == PushEventIrreversible || e.Type == PushEventExpired || e.Type == PushEventFailed
+}
+
+// resilientIrreversibleDelay is how long we keep waiting for an included transaction
+// to become irreversible once it has expired, it cannot be forked out for good before.
+const resilientIrreversibleDelay = 6 * time.Minute
+
+// serveResilient follows a transaction pushed successfully until it becomes irreversible,
+// re-pushing it each time a fork removes it until it expires. The progress events are
+// streamed to clients accepting `text/event-stream`, others only get the final outcome.
+func (t *TxPusher) serveResilient(ctx context.Context, w http.ResponseWriter, r *http.Request, tx *zsw.PackedTransaction, trxID string, inclusions <-chan *inclusionEvent) {
+	useLegacyPush := r.URL.EscapedPath() == "/v1/chain/push_transaction"
+
+	expiration := time.Now().Add(2 * time.Minute)
+	if signedTx, err := tx.Unpack(); err == nil {
+		expiration = signedTx.Expiration.Time
+	} else {
+		zlog.Info("unable to unpack transaction, using default expiration", zap.String("trx_id", trxID), zap.Error(err))
+	}
+
+	var events *eventStreamWriter
+	if strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+		events = newEventStreamWriter(w)
+	}
+
+	onEvent := func(event *PushEvent) {
+		zlog.Debug("resilient push event", zap.String("trx_id", trxID), zap.String("type", event.Type), zap.String("block_id", event.BlockID))
+		if events == nil {
+			return
+		}
+
+		if err := events.write(event.Type, event); err != nil {
+			zlog.Info("unable to write push event", zap.String("trx_id", trxID), zap.Error(err))
+		}
+	}
+
+	onEvent(&PushEvent{Type: PushEventPushed, TransactionID: trxID})
+
+	push := &resilientPush{
+		trxID: trxID,
+		push: func(ctx context.Context) error {
+			return t.tryPush(t.randomAPI(), ctx, tx, trxID, useLegacyPush)
+		},
+		inclusions:        inclusions,
+		expiration:        expiration,
+		irreversibleDelay: resilientIrreversibleDelay,
+		resendInterval:    8 * time.Second,
+		onEvent:           onEvent,
+	}
+
+	final := push.run(ctx)
+	switch final.Type {
+	case PushEventIrreversible:
+		metrics.SucceededPushTrxCount.Inc("resilient")
+	case PushEventExpired:
+		metrics.TimedOutPushTrxCount.Inc("resilient")
+	}
+
+	if events != nil {
+		onEvent(final)
+		return
+	}
+
+	switch final.Type {
+	case PushEventIrreversible:
+		out, err := json.Marshal(&PushResponse{
+			BlockID:       final.BlockID,
+			BlockNum:      final.BlockNum,
+			Processed:     final.Processed,
+			TransactionID: trxID,
+		})
+		if checkHTTPError(err, "cannot marshal response", zswerr.ErrUnhandledException, w) {
+			return
+		}
+
+		w.Header().Set("content-length", fmt.Sprintf("%d", len(out)))
+		w.Write(out)
+
+	case PushEventExpired:
+		msg := fmt.Sprintf("transaction %q expired before being irreversibly included in a block", trxID)
+		checkHTTPError(errors.New(msg), msg, zswerr.ErrTimeoutException, w)
+
+	default:
+		var apiErr zsw.APIError
+		if len(final.Error) != 0 && json.Unmarshal(final.Error, &apiErr) == nil && apiErr.Code != 0 {
+			w.WriteHeader(apiErr.Code)
+			w.Write(final.Error)
+			return
+		}
+
+		msg := fmt.Sprintf("unable to irreversibly include transaction %q: %s", trxID, string(final.Error))
+		checkHTTPError(errors.New(msg), msg, zswerr.ErrUnhandledException, w)
+	}
+}
+
+// resilientPush tracks the inclusions of a pushed transaction, re-pushing it through
+// `push` when a fork removes it, up until its expiration.
+type resilientPush struct {
+	trxID             string
+	push              func(ctx context.Context) error
+	inclusions        <-chan *inclusionEvent
+	expiration        time.Time
+	irreversibleDelay time.Duration
+	resendInterval    time.Duration
+	onEvent           func(event *PushEvent)
+}
+
+// run returns the final event of the push, after having sent all the others to `onEvent`
+func (p *resilientPush) run(ctx context.Context) *PushEvent {
+	var included *inclusionEvent
+	seenInclusion := false
+	expired := false
+	rePushes := 0
+
+	deadline := time.NewTimer(time.Until(p.expiration))
+	defer deadline.Stop()
+
+	resend := time.NewTicker(p.resendInterval)
+	defer resend.Stop()
+
+	// repush returns a final event when the transaction cannot be pushed anymore
+	repush := func() *PushEvent {
+		err := p.push(ctx)
+		if err == nil {
+			return nil
+		}
+
+		apiErr, ok := err.(zsw.APIError)
+		if !ok {
+			zlog.Debug("unable to re-push transaction, will retry", zap.String("trx_id", p.trxID), zap.Error(err))
+			return nil
+		}
+
+		switch {
+		case isExpiredError(apiErr):
+			expired = true
+			if included == nil {
+				return &PushEvent{Type: PushEventExpired, TransactionID: p.trxID}
+			}
+			return nil
+		case isDuplicateError(apiErr), isRetryable(apiErr):
+			return nil
+		}
+
+		zlog.Info("push transaction API error after earlier success", append(logFieldsFromAPIErr(apiErr), zap.String("trx_id", p.trxID))...)
+		errCnt, _ := json.Marshal(apiErr)
+		return &PushEvent{Type: PushEventFailed, TransactionID: p.trxID, Error: errCnt}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return &PushEvent{Type: PushEventFailed, TransactionID: p.trxID, Error: errorJSON(ctx.Err())}
+
+		case <-resend.C:
+			if included != nil || expired {
+				continue
+			}
+
+			if final := repush(); final != nil {
+				return final
+			}
+
+		case <-deadline.C:
+			if included == nil {
+				return &PushEvent{Type: PushEventExpired, TransactionID: p.trxID}
+			}
+
+			if expired {
+				return &PushEvent{Type: PushEventFailed, TransactionID: p.trxID, Error: errorJSON(fmt.Errorf("too long waiting for block %s to become irreversible", included.blockID))}
+			}
+
+			expired = true
+			deadline.Reset(p.irreversibleDelay)
+
+		case inclusion := <-p.inclusions:
+			event := &PushEvent{TransactionID: p.trxID, BlockID: inclusion.blockID, BlockNum: zsw.BlockNum(inclusion.blockID)}
+
+			switch inclusion.step {
+			case forkable.StepNew, forkable.StepRedo:
+				event.Type = PushEventIncluded
+				if seenInclusion {
+					event.Type = PushEventReIncluded
+				}
+				included = inclusion
+				seenInclusion = true
+				p.onEvent(event)
+
+			case forkable.StepUndo:
+				if included == nil || included.blockID != inclusion.blockID {
+					continue
+				}
+
+				included = nil
+				event.Type = PushEventForkedOut
+				p.onEvent(event)
+
+				if expired {
+					continue
+				}
+
+				rePushes++
+				if final := repush(); final != nil {
+					return final
+				}
+				p.onEvent(&PushEvent{Type: PushEventRePushed, TransactionID: p.trxID, Attempt: rePushes})
+
+			case forkable.StepIrreversible:
+				processed, err := marshalProcessed(inclusion.trace)
+				if err != nil {
+					return &PushEvent{Type: PushEventFailed, TransactionID: p.trxID, Error: errorJSON(err)}
+				}
+
+				event.Type = PushEventIrreversible
+				event.Processed = processed
+				return event
+			}
+		}
+	}
+}
+
+func errorJSON(err error) json.RawMessage {
+	out, _ := json.Marshal(err.Error())
+	return out
+}
+
+type inclusionEvent struct {
+	step    forkable.StepType
+	blockID string
+	trace   *pbcodec.TransactionTrace
+}
+
+var runningResilient int64
+
+// awaitTransactionResilient starts a fork aware pipeline sending back every new, undo, redo
+// and irreversible step of the blocks containing the transaction `trxID`.
+func awaitTransactionResilient(ctx context.Context, trxID string, sourceFactory bstream.SourceFactory) (<-chan *inclusionEvent, func(error)) {
+	atomic.AddInt64(&runningResilient, 1)
+	zlog.Info("waiting for trx to appear in an irreversible block, following forks", zap.String("trxID", trxID), zap.Int64("count", atomic.LoadInt64(&runningResilient)))
+
+	inclusions := make(chan *inclusionEvent)
+
+	handler := bstream.HandlerFunc(func(block *bstream.Block, obj interface{}) error {
+		blk := block.ToNative().(*pbcodec.Block)
+		trxTrace := traceExecutedInBlock(trxID, blk)
+		if trxTrace == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+		case inclusions <- &inclusionEvent{step: obj.(*forkable.ForkableObject).Step, blockID: block.ID(), trace: trxTrace}:
+		}
+		return nil
+	})
+
+	forkableHandler := forkable.New(handler, forkable.WithLogger(zlog), forkable.WithFilters(forkable.StepNew|forkable.StepUndo|forkable.StepRedo|forkable.StepIrreversible))
+	source := sourceFactory(forkableHandler)
+	source.OnTerminating(func(e error) {
+		atomic.AddInt64(&runningResilient, -1)
+	})
+
+	go source.Run()
+	return inclusions, source.Shutdown
+}
+
+// eventStreamWriter writes server-sent events, flushing each one right away
+type eventStreamWriter struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+}
+
+func newEventStreamWriter(w http.ResponseWriter) *eventStreamWriter {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	flusher, _ := w.(http.Flusher)
+	return &eventStreamWriter{w: w, flusher: flusher}
+}
+
+func (s *eventStreamWriter) write(event string, data interface{}) error {
+	cnt, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(s.w, "event: %s\ndata: %s\n\n", event, cnt); err != nil {
+		return err
+	}
+
+	if s.flusher != nil {
+		s.flusher.Flush()
+	}
+	return nil
+}
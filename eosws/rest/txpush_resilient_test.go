@@ -0,0 +1,174 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/streamingfast/bstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zhongshuwen/histnew/codec"
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+	zsw "github.com/zhongshuwen/zswchain-go"
+)
+
+func Test_ResilientPush(t *testing.T) {
+	cases := []struct {
+		name           string
+		blocks         []*bstream.Block
+		apiStatus      int
+		apiResponse    string
+		expiration     time.Duration
+		expectedEvents []string
+		expectedFinal  string
+		expectedBlock  string
+		expectedPushes int32
+	}{
+		{
+			name: "re-pushed after fork then irreversible",
+			blocks: []*bstream.Block{
+				resilientTestBlock(t, "00000001a", "00000000a", 1, "a"),
+				resilientTestBlock(t, "00000002a", "00000001a", 1, "beefbeef"),
+				resilientTestBlock(t, "00000002b", "00000001a", 1, "x"),
+				resilientTestBlock(t, "00000003b", "00000002b", 1, "y"),
+				resilientTestBlock(t, "00000004b", "00000003b", 1, "beefbeef"),
+				resilientTestBlock(t, "00000005b", "00000004b", 4, "z"),
+			},
+			apiResponse:    `{"transaction_id":"beefbeef"}`,
+			expiration:     time.Minute,
+			expectedEvents: []string{PushEventIncluded, PushEventForkedOut, PushEventRePushed, PushEventReIncluded},
+			expectedFinal:  PushEventIrreversible,
+			expectedBlock:  "00000004b",
+			expectedPushes: 1,
+		},
+		{
+			name: "expired after fork",
+			blocks: []*bstream.Block{
+				resilientTestBlock(t, "00000001a", "00000000a", 1, "a"),
+				resilientTestBlock(t, "00000002a", "00000001a", 1, "beefbeef"),
+				resilientTestBlock(t, "00000002b", "00000001a", 1, "x"),
+				resilientTestBlock(t, "00000003b", "00000002b", 1, "y"),
+			},
+			apiStatus:      500,
+			apiResponse:    `{"code":500,"message":"Internal Service Error","error":{"code":3040005,"name":"expired_tx_exception","what":"Expired Transaction","details":[]}}`,
+			expiration:     time.Minute,
+			expectedEvents: []string{PushEventIncluded, PushEventForkedOut},
+			expectedFinal:  PushEventExpired,
+			expectedPushes: 1,
+		},
+		{
+			name: "never included",
+			blocks: []*bstream.Block{
+				resilientTestBlock(t, "00000001a", "00000000a", 1, "a"),
+			},
+			apiResponse:    `{"transaction_id":"beefbeef"}`,
+			expiration:     50 * time.Millisecond,
+			expectedFinal:  PushEventExpired,
+			expectedPushes: 0,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var pushes int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&pushes, 1)
+				if c.apiStatus != 0 {
+					w.WriteHeader(c.apiStatus)
+				}
+				w.Write([]byte(c.apiResponse))
+			}))
+			defer server.Close()
+
+			pusher := NewTxPusher(zsw.New(server.URL), nil, nil, 0, nil)
+
+			sourceFactory := bstream.SourceFactory(func(h bstream.Handler) bstream.Source {
+				return bstream.NewMockSource(c.blocks, h)
+			})
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			inclusions, shutdown := awaitTransactionResilient(ctx, "beefbeef", sourceFactory)
+			defer shutdown(nil)
+
+			var events []string
+			push := &resilientPush{
+				trxID: "beefbeef",
+				push: func(ctx context.Context) error {
+					return pusher.tryPush(pusher.API, ctx, &zsw.PackedTransaction{}, "beefbeef", false)
+				},
+				inclusions:        inclusions,
+				expiration:        time.Now().Add(c.expiration),
+				irreversibleDelay: time.Minute,
+				resendInterval:    time.Hour,
+				onEvent: func(event *PushEvent) {
+					events = append(events, event.Type)
+				},
+			}
+
+			final := push.run(ctx)
+			assert.Equal(t, c.expectedEvents, events)
+			assert.Equal(t, c.expectedFinal, final.Type)
+			assert.True(t, final.isFinal())
+			assert.Equal(t, c.expectedBlock, final.BlockID)
+			assert.Equal(t, c.expectedPushes, atomic.LoadInt32(&pushes))
+			if c.expectedFinal == PushEventIrreversible {
+				assert.NotEmpty(t, final.Processed)
+			}
+		})
+	}
+}
+
+func Test_EventStreamWriter(t *testing.T) {
+	recorder := httptest.NewRecorder()
+
+	events := newEventStreamWriter(recorder)
+	require.NoError(t, events.write(PushEventPushed, &PushEvent{Type: PushEventPushed, TransactionID: "abc"}))
+
+	assert.Equal(t, "text/event-stream", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, "event: pushed\ndata: {\"type\":\"pushed\",\"transaction_id\":\"abc\"}\n\n", recorder.Body.String())
+	assert.True(t, recorder.Flushed)
+}
+
+func resilientTestBlock(t *testing.T, id, previousID string, libNum uint32, trxID string) *bstream.Block {
+	pbblock := &pbcodec.Block{
+		Id:     id,
+		Number: zsw.BlockNum(id),
+		Header: &pbcodec.BlockHeader{
+			Previous:  previousID,
+			Producer:  "producer",
+			Timestamp: &timestamp.Timestamp{},
+		},
+		DposIrreversibleBlocknum: libNum,
+		UnfilteredTransactionTraces: []*pbcodec.TransactionTrace{
+			{
+				Id: trxID,
+			},
+		},
+	}
+
+	block, err := codec.BlockFromProto(pbblock)
+	require.NoError(t, err)
+
+	return block
+}
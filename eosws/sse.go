@@ -0,0 +1,273 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eosws
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/streamingfast/dauth/authenticator"
+	"github.com/streamingfast/derr"
+	"github.com/streamingfast/logging"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+)
+
+// sseStreamTypes are the messages that can be streamed over Server-Sent Events, mapped
+// to whether their stream can be resumed from the `Last-Event-ID` of the client. The
+// others are re-issued as is when the client reconnects.
+var sseStreamTypes = map[string]bool{
+	"get_action_traces":         true,
+	"get_actions":               true,
+	"get_table_rows":            true,
+	"get_transaction_lifecycle": false,
+	"get_head_info":             false,
+}
+
+var errSSETransportClosed = errors.New("sse transport closed")
+
+// SSEHandler streams the response of a single `/v1/stream` message, passed JSON encoded
+// in the `message` query parameter, as Server-Sent Events. Each event is named after the
+// outgoing message type and carries the same JSON payload as over the WebSocket.
+//
+// Events carrying a block number have an ID of the form `<block_num>:<documents>`, where
+// `documents` is the count of documents of that block already sent. Reconnecting with it
+// as `Last-Event-ID` (or the `last_event_id` query parameter) resumes the stream at that
+// block, skipping the documents the client already received. The connection is closed
+// after an `error` event.
+func (s *WebsocketHandler) SSEHandler() http.Handler {
+	return http.HandlerFunc(s.serveSSE)
+}
+
+func (s *WebsocketHandler) serveSSE(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	credentials := authenticator.GetCredentials(ctx)
+
+	zlogger := logging.Logger(ctx, zlog).With(zap.String("sse_conn_id", shortIDGenerator.MustGenerate()))
+	zlogger.Debug("handling Server-Sent Events stream request")
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		derr.WriteError(ctx, w, "unable to stream Server-Sent Events", SSEStreamingUnsupportedError(ctx))
+		return
+	}
+
+	rawMsg := []byte(r.URL.Query().Get("message"))
+	msgType := gjson.GetBytes(rawMsg, "type").String()
+	resumable, supported := sseStreamTypes[msgType]
+	if !supported {
+		derr.WriteError(ctx, w, "unable to stream Server-Sent Events", SSEUnsupportedMessageError(ctx, msgType))
+		return
+	}
+
+	transport := newSSETransport(w, flusher)
+	if lastEventID := sseLastEventID(r); lastEventID != "" && resumable {
+		cursor, err := parseSSECursor(lastEventID)
+		if err != nil {
+			derr.WriteError(ctx, w, "unable to resume Server-Sent Events", SSEInvalidLastEventIDError(ctx, lastEventID, err))
+			return
+		}
+
+		rawMsg, err = resumeSSEMessage(rawMsg, cursor)
+		if err != nil {
+			derr.WriteError(ctx, w, "unable to resume Server-Sent Events", WSInvalidJSONMessageError(ctx, err))
+			return
+		}
+
+		zlogger.Debug("resuming stream", zap.Uint64("block_num", cursor.blockNum), zap.Uint64("documents", cursor.documents))
+		transport.skip = cursor
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	s.incConnectionsCounter()
+	defer s.decConnectionsCounter()
+
+	childCtx := logging.WithLogger(ctx, zlogger)
+
+	TrackUserEvent(childCtx, "sse_conn_start", "connection_count", s.connections)
+
+	conn := NewWSConn(s, transport, credentials, s.filesourceBlockRateLimit, childCtx)
+	go conn.handleHeartbeats()
+	conn.handleMessage(rawMsg)
+
+	select {
+	case <-ctx.Done():
+		zlogger.Debug("context done", zap.Error(ctx.Err()))
+	case <-conn.Terminating():
+		zlogger.Debug("connection done", zap.Error(conn.Err()))
+	case <-transport.errored:
+		zlogger.Debug("error emitted, closing stream")
+	}
+
+	conn.Shutdown(nil)
+}
+
+func sseLastEventID(r *http.Request) string {
+	if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+		return lastEventID
+	}
+
+	return r.URL.Query().Get("last_event_id")
+}
+
+// resumeSSEMessage rewrites the message so its stream starts at the cursor's block,
+// without fetching the initial state the client already received.
+func resumeSSEMessage(rawMsg []byte, cursor *sseCursor) ([]byte, error) {
+	var msg map[string]interface{}
+	if err := json.Unmarshal(rawMsg, &msg); err != nil {
+		return nil, err
+	}
+
+	msg["start_block"] = cursor.blockNum
+	delete(msg, "fetch")
+
+	return json.Marshal(msg)
+}
+
+type sseCursor struct {
+	blockNum  uint64
+	documents uint64
+}
+
+func parseSSECursor(in string) (*sseCursor, error) {
+	parts := strings.Split(in, ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("expected <block_num>:<documents>, got %q", in)
+	}
+
+	blockNum, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil || blockNum == 0 {
+		return nil, fmt.Errorf("invalid block num %q", parts[0])
+	}
+
+	documents, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid documents count %q", parts[1])
+	}
+
+	return &sseCursor{blockNum: blockNum, documents: documents}, nil
+}
+
+func (c sseCursor) String() string {
+	return fmt.Sprintf("%d:%d", c.blockNum, c.documents)
+}
+
+type sseTransport struct {
+	writer  io.Writer
+	flusher http.Flusher
+	errored chan struct{}
+
+	cursor sseCursor
+	skip   *sseCursor // documents the client received before resuming
+
+	lock   sync.Mutex
+	closed bool
+}
+
+func newSSETransport(writer io.Writer, flusher http.Flusher) *sseTransport {
+	return &sseTransport{
+		writer:  writer,
+		flusher: flusher,
+		errored: make(chan struct{}),
+	}
+}
+
+func (t *sseTransport) WriteMessage(msgType string, msgBytes []byte) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.closed {
+		return errSSETransportClosed
+	}
+
+	id, send := t.nextEventID(msgType, msgBytes)
+	if !send {
+		return nil
+	}
+
+	var event bytes.Buffer
+	if id != "" {
+		event.WriteString("id: " + id + "\n")
+	}
+	event.WriteString("event: " + msgType + "\n")
+	event.WriteString("data: ")
+	event.Write(msgBytes)
+	event.WriteString("\n\n")
+
+	if _, err := t.writer.Write(event.Bytes()); err != nil {
+		return err
+	}
+	t.flusher.Flush()
+
+	if msgType == "error" {
+		t.closed = true
+		close(t.errored)
+	}
+
+	return nil
+}
+
+// nextEventID advances the cursor with the message, returning the event ID to send it
+// with and whether the client did not already receive it before resuming.
+func (t *sseTransport) nextEventID(msgType string, msgBytes []byte) (id string, send bool) {
+	blockNum := gjson.GetBytes(msgBytes, "data.block_num").Uint()
+	if blockNum == 0 {
+		return "", true
+	}
+
+	if msgType == "progress" {
+		// All documents of the block were sent, resuming starts at the next one
+		t.cursor = sseCursor{blockNum: blockNum + 1}
+		return t.cursor.String(), true
+	}
+
+	if blockNum != t.cursor.blockNum {
+		t.cursor = sseCursor{blockNum: blockNum}
+	}
+	t.cursor.documents++
+
+	if t.skip != nil {
+		if t.cursor.blockNum == t.skip.blockNum && t.cursor.documents <= t.skip.documents {
+			return "", false
+		}
+
+		if t.cursor.blockNum > t.skip.blockNum {
+			t.skip = nil
+		}
+	}
+
+	return t.cursor.String(), true
+}
+
+func (t *sseTransport) Close() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.closed = true
+	return nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eosws
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSETransport_WriteMessage(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	transport := newSSETransport(recorder, recorder)
+
+	require.NoError(t, transport.WriteMessage("listening", []byte(`{"type":"listening","data":{"next_block":10}}`)))
+	require.NoError(t, transport.WriteMessage("action_trace", []byte(`{"type":"action_trace","data":{"block_num":10}}`)))
+	require.NoError(t, transport.WriteMessage("action_trace", []byte(`{"type":"action_trace","data":{"block_num":10}}`)))
+	require.NoError(t, transport.WriteMessage("progress", []byte(`{"type":"progress","data":{"block_num":10}}`)))
+	require.NoError(t, transport.WriteMessage("action_trace", []byte(`{"type":"action_trace","data":{"block_num":12}}`)))
+
+	assert.Equal(t, ""+
+		"event: listening\ndata: {\"type\":\"listening\",\"data\":{\"next_block\":10}}\n\n"+
+		"id: 10:1\nevent: action_trace\ndata: {\"type\":\"action_trace\",\"data\":{\"block_num\":10}}\n\n"+
+		"id: 10:2\nevent: action_trace\ndata: {\"type\":\"action_trace\",\"data\":{\"block_num\":10}}\n\n"+
+		"id: 11:0\nevent: progress\ndata: {\"type\":\"progress\",\"data\":{\"block_num\":10}}\n\n"+
+		"id: 12:1\nevent: action_trace\ndata: {\"type\":\"action_trace\",\"data\":{\"block_num\":12}}\n\n",
+		recorder.Body.String(),
+	)
+}
+
+func TestSSETransport_Resume(t *testing.T) {
+	cursor, err := parseSSECursor("10:2")
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	transport := newSSETransport(recorder, recorder)
+	transport.skip = cursor
+
+	for _, blockNum := range []string{"10", "10", "10", "11"} {
+		require.NoError(t, transport.WriteMessage("table_delta", []byte(`{"data":{"block_num":`+blockNum+`}}`)))
+	}
+
+	assert.Equal(t, ""+
+		"id: 10:3\nevent: table_delta\ndata: {\"data\":{\"block_num\":10}}\n\n"+
+		"id: 11:1\nevent: table_delta\ndata: {\"data\":{\"block_num\":11}}\n\n",
+		recorder.Body.String(),
+	)
+}
+
+func TestSSETransport_ClosesOnError(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	transport := newSSETransport(recorder, recorder)
+
+	require.NoError(t, transport.WriteMessage("error", []byte(`{"type":"error"}`)))
+
+	select {
+	case <-transport.errored:
+	default:
+		t.Fatal("expected transport to be errored")
+	}
+
+	assert.Equal(t, errSSETransportClosed, transport.WriteMessage("ping", []byte(`{"type":"ping"}`)))
+}
+
+func TestResumeSSEMessage(t *testing.T) {
+	out, err := resumeSSEMessage([]byte(`{"type":"get_table_rows","fetch":true,"listen":true,"start_block":-10}`), &sseCursor{blockNum: 10, documents: 2})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"type":"get_table_rows","listen":true,"start_block":10}`, string(out))
+}
+
+func TestParseSSECursor(t *testing.T) {
+	tests := []struct {
+		in          string
+		expected    *sseCursor
+		expectedErr bool
+	}{
+		{"10:2", &sseCursor{blockNum: 10, documents: 2}, false},
+		{"10:0", &sseCursor{blockNum: 10}, false},
+		{"0:1", nil, true},
+		{"10", nil, true},
+		{"a:1", nil, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			cursor, err := parseSSECursor(test.in)
+			if test.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, cursor)
+		})
+	}
+}
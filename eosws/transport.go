@@ -0,0 +1,46 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eosws
+
+import (
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Transport carries the outgoing messages of a `WSConn` to the client. Writes are
+// serialized by the connection, so implementations do not need to be safe for
+// concurrent writes, `Close` can however be called concurrently with them.
+type Transport interface {
+	WriteMessage(msgType string, msgBytes []byte) error
+	Close() error
+}
+
+type websocketTransport struct {
+	conn *websocket.Conn
+}
+
+func NewWebsocketTransport(conn *websocket.Conn) Transport {
+	return &websocketTransport{conn: conn}
+}
+
+func (t *websocketTransport) WriteMessage(msgType string, msgBytes []byte) error {
+	_ = t.conn.SetWriteDeadline(time.Now().Add(1 * time.Minute))
+	return t.conn.WriteMessage(websocket.TextMessage, msgBytes)
+}
+
+func (t *websocketTransport) Close() error {
+	return t.conn.Close()
+}
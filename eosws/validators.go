@@ -36,8 +36,11 @@ func init() {
 
 func ValidateBlocksRequest(r *http.Request) url.Values {
 	return validator.ValidateQueryParams(r, validator.Rules{
-		"skip":  []string{"numeric"},
-		"limit": []string{"required", "numeric_between:1,100"},
+		"skip":              []string{"numeric"},
+		"limit":             []string{"required", "numeric_between:1,100"},
+		"canonical":         []string{"in:true,false"},
+		"irreversible_only": []string{"in:true,false"},
+		"include_forks":     []string{"in:true,false"},
 	})
 }
 
@@ -48,6 +51,14 @@ func ValidateListRequest(r *http.Request) url.Values {
 	})
 }
 
+func ValidatePendingDeferredTransactionsRequest(r *http.Request) url.Values {
+	return validator.ValidateQueryParams(r, validator.Rules{
+		"at_block_num": []string{"eos.blockNum", fmt.Sprintf("numeric_between:1,%d", math.MaxUint32)},
+		"limit":        []string{"required", "numeric_between:1,100"},
+		"cursor":       []string{"eosws.cursor"},
+	})
+}
+
 func validateSearchTransactionsRequest(r *http.Request) url.Values {
 	return validator.ValidateQueryParams(r, validator.Rules{
 		"q":               []string{"required", "min:5"},
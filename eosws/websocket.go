@@ -46,7 +46,8 @@ type WebsocketHandler struct {
 	stateClient        pbstatedb.StateClient
 	irreversibleFinder IrreversibleFinder
 
-	maxStreamCount int
+	maxStreamCount           int
+	filesourceBlockRateLimit time.Duration
 }
 
 var hostname string
@@ -104,6 +105,8 @@ func NewWebsocketHandler(
 		headInfoHub:        headInfoHub,
 		irreversibleFinder: irrFinder,
 		maxStreamCount:     maxStreamCount,
+
+		filesourceBlockRateLimit: filesourceBlockRateLimit,
 	}
 
 	s.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -133,8 +136,8 @@ func NewWebsocketHandler(
 
 		TrackUserEvent(childCtx, "ws_conn_start", "connection_count", s.connections)
 
-		conn := NewWSConn(s, c, credentials, filesourceBlockRateLimit, childCtx)
-		go conn.handleWSIncoming()
+		conn := NewWSConn(s, NewWebsocketTransport(c), credentials, filesourceBlockRateLimit, childCtx)
+		go conn.handleWSIncoming(c)
 
 		go conn.handleHeartbeats()
 		select {
@@ -143,7 +146,7 @@ func NewWebsocketHandler(
 		case <-conn.Terminating():
 			zlog.Info("connection done", zap.Error(ctx.Err()))
 		}
-		_ = conn.conn.Close()
+		_ = conn.transport.Close()
 	})
 
 	return s
@@ -42,7 +42,7 @@ type WSConn struct {
 	*shutter.Shutter
 
 	*WebsocketHandler
-	conn *websocket.Conn
+	transport Transport
 
 	//pipelines         map[string]afterburner.Pipeline
 	listenerCancelers map[string]func() error
@@ -55,12 +55,12 @@ type WSConn struct {
 	filesourceBlockRateLimit time.Duration
 }
 
-func NewWSConn(wshand *WebsocketHandler, conn *websocket.Conn, creds authenticator.Credentials, filesourceBlockRateLimit time.Duration, ctx context.Context) *WSConn {
+func NewWSConn(wshand *WebsocketHandler, transport Transport, creds authenticator.Credentials, filesourceBlockRateLimit time.Duration, ctx context.Context) *WSConn {
 	// Each WS conn will have its own SubscribablePipeline ? Hooked into the main pipeline
 	// of the process, let's, for now, simply create a Joiner per socket
 	ws := &WSConn{
 		WebsocketHandler:         wshand,
-		conn:                     conn,
+		transport:                transport,
 		creds:                    creds,
 		listenerCancelers:        make(map[string]func() error),
 		Context:                  ctx,
@@ -69,7 +69,7 @@ func NewWSConn(wshand *WebsocketHandler, conn *websocket.Conn, creds authenticat
 
 	ws.Shutter = shutter.New()
 	ws.Shutter.OnTerminating(func(e error) {
-		_ = ws.conn.Close()
+		_ = ws.transport.Close()
 		ws.ShutdownAllListeners()
 
 		TrackUserEvent(ws.Context, "ws_conn_close", "error", ws.Err())
@@ -115,6 +115,22 @@ func (ws *WSConn) RegisterListener(ctx context.Context, reqID string, canceler f
 		return WSStreamAlreadyExistError(ws.Context, reqID)
 	}
 
+	if acquirer, ok := ws.creds.(authStreamAcquirer); ok {
+		release, err := acquirer.AcquireStream(func(err error) {
+			// Called while metering a document, possibly from the stream itself
+			go ws.shutdownListenerOverQuota(reqID, err)
+		})
+		if err != nil {
+			return AuthQuotaExceededError(ws.Context, err)
+		}
+
+		streamCanceler := canceler
+		canceler = func() error {
+			defer release()
+			return streamCanceler()
+		}
+	}
+
 	ws.listenerCancelers[reqID] = canceler
 
 	zlogger.Debug("added listener cancelers", zap.Int("new_count", len(ws.listenerCancelers)))
@@ -144,6 +160,17 @@ func (ws *WSConn) ShutdownListener(ctx context.Context, reqID string) error {
 	return nil
 }
 
+// shutdownListenerOverQuota closes the stream `reqID` once the credentials exhausted
+// their documents quota while it was running.
+func (ws *WSConn) shutdownListenerOverQuota(reqID string, cause error) {
+	if err := ws.ShutdownListener(ws.Context, reqID); err != nil {
+		zlog.Debug("unable to shut down listener over quota", zap.String("req_id", reqID), zap.Error(err))
+		return
+	}
+
+	ws.EmitError(ws.Context, reqID, AuthQuotaExceededError(ws.Context, cause))
+}
+
 func (ws *WSConn) ShutdownAllListeners() {
 	ws.listenersLock.Lock()
 	defer ws.listenersLock.Unlock()
@@ -158,10 +185,10 @@ func (ws *WSConn) ShutdownAllListeners() {
 	}
 }
 
-func (ws *WSConn) handleWSIncoming() {
+func (ws *WSConn) handleWSIncoming(conn *websocket.Conn) {
 	for {
 		// msgType ignored, no distinction between binary and text messages
-		msgType, rawmsg, err := ws.conn.ReadMessage()
+		msgType, rawmsg, err := conn.ReadMessage()
 		if err != nil {
 			if !ws.IsTerminating() { // not our concern if it is already shut down...
 				ws.Shutdown(err)
@@ -303,6 +330,9 @@ func (ws *WSConn) handleMessage(rawMsg []byte) {
 	case *wsmsg.GetAccount:
 		ws.onAccount(childCtx, msg)
 
+	case *wsmsg.GetAccountResources:
+		ws.onGetAccountResources(childCtx, msg)
+
 	}
 }
 
@@ -336,8 +366,7 @@ func (ws *WSConn) Emit(ctx context.Context, msg wsmsg.OutgoingMessager) {
 	//////////////////////////////////////////////////////////////////////
 
 	ws.emitLock.Lock()
-	_ = ws.conn.SetWriteDeadline(time.Now().Add(1 * time.Minute))
-	if err := ws.conn.WriteMessage(websocket.TextMessage, msgBytes); err != nil {
+	if err := ws.transport.WriteMessage(msgType, msgBytes); err != nil {
 		zlogger.Info("unable to write message back to client", zap.Error(err))
 		ws.Shutdown(err)
 	}
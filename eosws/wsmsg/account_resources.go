@@ -0,0 +1,121 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wsmsg
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	v1 "github.com/invisible-train-40/eosws-go/mdl/v1"
+	"github.com/streamingfast/bstream/forkable"
+	"github.com/streamingfast/validator"
+)
+
+func init() {
+	RegisterIncomingMessage("get_account_resources", GetAccountResources{})
+	RegisterOutgoingMessage("account_resources_snapshot", AccountResourcesSnapshot{})
+	RegisterOutgoingMessage("account_resources_delta", AccountResourcesDelta{})
+}
+
+// INCOMING
+type GetAccountResourcesData struct {
+	Account string `json:"account"`
+}
+
+type GetAccountResources struct {
+	CommonIn
+	Data GetAccountResourcesData `json:"data"`
+}
+
+func (m *GetAccountResources) Validate(ctx context.Context) error {
+	if !m.Listen && !m.Fetch {
+		return fmt.Errorf("one of 'listen' or 'fetch' required (both supported)")
+	}
+
+	if m.IrreversibleOnly {
+		return fmt.Errorf("'irreversible_only' is not supported")
+	}
+
+	if m.Data.Account == "" {
+		return fmt.Errorf("'data.account' is required")
+	}
+
+	if err := validator.EOSNameRule("data.account", "", "", m.Data.Account); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// OUTGOING
+
+// AccountResourceLimits are the resources allocated to an account, the
+// weights being the staked amounts in the smallest unit of the core token.
+type AccountResourceLimits struct {
+	NetWeight int64 `json:"net_weight"`
+	CPUWeight int64 `json:"cpu_weight"`
+	RAMBytes  int64 `json:"ram_bytes"`
+	Pending   bool  `json:"pending,omitempty"`
+}
+
+// AccountResourceUsage is the resources consumed by an account, the NET and
+// CPU usages being the averaged consumption over the usage window.
+type AccountResourceUsage struct {
+	NetUsage uint64 `json:"net_usage"`
+	CPUUsage uint64 `json:"cpu_usage"`
+	RAMUsage uint64 `json:"ram_usage"`
+}
+
+type AccountResourcesSnapshot struct {
+	CommonOut
+	Data struct {
+		Account  string `json:"account"`
+		BlockNum uint32 `json:"block_num"`
+		// Resources is the account's row of the system contract `userres` table, nil if the account has none
+		Resources json.RawMessage `json:"resources"`
+	} `json:"data"`
+}
+
+func NewAccountResourcesSnapshot(account string, blockNum uint32, resources json.RawMessage) *AccountResourcesSnapshot {
+	out := &AccountResourcesSnapshot{}
+	out.Data.Account = account
+	out.Data.BlockNum = blockNum
+	out.Data.Resources = resources
+	return out
+}
+
+type AccountResourcesDelta struct {
+	CommonOut
+	Data struct {
+		Account  string                 `json:"account"`
+		BlockNum uint32                 `json:"block_num"`
+		Step     string                 `json:"step"`
+		RAMOps   []*v1.RAMOp            `json:"ram_ops,omitempty"`
+		Limits   *AccountResourceLimits `json:"limits,omitempty"`
+		Usage    *AccountResourceUsage  `json:"usage,omitempty"`
+	} `json:"data"`
+}
+
+func NewAccountResourcesDelta(account string, blockNum uint32, step forkable.StepType, ramOps []*v1.RAMOp, limits *AccountResourceLimits, usage *AccountResourceUsage) *AccountResourcesDelta {
+	out := &AccountResourcesDelta{}
+	out.Data.Account = account
+	out.Data.BlockNum = blockNum
+	out.Data.Step = step.String()
+	out.Data.RAMOps = ramOps
+	out.Data.Limits = limits
+	out.Data.Usage = usage
+	return out
+}
@@ -5,6 +5,7 @@ go 1.16
 require (
 	contrib.go.opencensus.io/exporter/stackdriver v0.13.10
 	github.com/GeertJohan/go.rice v1.0.2
+	github.com/RoaringBitmap/roaring v0.4.23
 	github.com/ShinyTrinkets/overseer v0.3.0
 	github.com/acarl005/stripansi v0.0.0-20180116102854-5a71ef0e047d
 	github.com/andreyvit/diff v0.0.0-20170406064948-c7f18ee00883
@@ -13,6 +14,7 @@ require (
 	github.com/auth0/go-jwt-middleware v0.0.0-20190805220309-36081240882b
 	github.com/blevesearch/bleve v1.0.14
 	github.com/bmizerany/assert v0.0.0-20160611221934-b7ed37b82869 // indirect
+	github.com/bradfitz/gomemcache v0.0.0-20190913173617-a41fca850d0b
 	github.com/coreos/etcd v3.3.25+incompatible // indirect
 	github.com/coreos/go-systemd v0.0.0-20191104093116-d3cd4ed1dbcf // indirect
 	github.com/daaku/go.zipexe v1.0.1 // indirect
@@ -30,6 +32,7 @@ require (
 	github.com/gorilla/mux v1.8.0
 	github.com/gorilla/websocket v1.4.2
 	github.com/graph-gophers/graphql-go v0.0.0-20191115155744-f33e81362277
+	github.com/hashicorp/golang-lru v0.5.3
 	github.com/invisible-train-40/client-go v0.1.2
 	github.com/invisible-train-40/eosio-boot v0.1.0
 	github.com/invisible-train-40/eosws-go v0.1.0
@@ -82,6 +85,7 @@ require (
 	github.com/tidwall/gjson v1.14.0
 	github.com/tidwall/sjson v1.0.4
 	github.com/urfave/negroni v1.0.0 // indirect
+	github.com/xitongsys/parquet-go v1.5.1
 	github.com/zhongshuwen/zswchain-go v1.12.11
 	go.opencensus.io v0.23.0
 	go.uber.org/atomic v1.9.0
@@ -24,6 +24,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type DecodeErrorCode int32
+
+const (
+	DecodeErrorCode_DECODEERRORCODE_NONE            DecodeErrorCode = 0
+	DecodeErrorCode_DECODEERRORCODE_ABI_NOT_FOUND   DecodeErrorCode = 1
+	DecodeErrorCode_DECODEERRORCODE_INVALID_PAYLOAD DecodeErrorCode = 2
+)
+
+var DecodeErrorCode_name = map[int32]string{
+	0: "DECODEERRORCODE_NONE",
+	1: "DECODEERRORCODE_ABI_NOT_FOUND",
+	2: "DECODEERRORCODE_INVALID_PAYLOAD",
+}
+
+var DecodeErrorCode_value = map[string]int32{
+	"DECODEERRORCODE_NONE":            0,
+	"DECODEERRORCODE_ABI_NOT_FOUND":   1,
+	"DECODEERRORCODE_INVALID_PAYLOAD": 2,
+}
+
+func (x DecodeErrorCode) String() string {
+	return proto.EnumName(DecodeErrorCode_name, int32(x))
+}
+
+func (DecodeErrorCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6174012c24e1a081, []int{0}
+}
+
 type DecodeTableRequest struct {
 	Account    string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	Table      string `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
@@ -246,191 +274,1427 @@ func (m *Response) GetJsonPayload() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*DecodeTableRequest)(nil), "dfuse.zswhq.abicodec.v1.DecodeTableRequest")
-	proto.RegisterType((*DecodeActionRequest)(nil), "dfuse.zswhq.abicodec.v1.DecodeActionRequest")
-	proto.RegisterType((*GetAbiRequest)(nil), "dfuse.zswhq.abicodec.v1.GetAbiRequest")
-	proto.RegisterType((*Response)(nil), "dfuse.zswhq.abicodec.v1.Response")
+type ListAbiVersionsRequest struct {
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	WithAbi              bool     `protobuf:"varint,2,opt,name=withAbi,proto3" json:"withAbi,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("dfuse/zswhq/abicodec/v1/abicodec.proto", fileDescriptor_6174012c24e1a081)
+func (m *ListAbiVersionsRequest) Reset()         { *m = ListAbiVersionsRequest{} }
+func (m *ListAbiVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAbiVersionsRequest) ProtoMessage()    {}
+func (*ListAbiVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6174012c24e1a081, []int{4}
 }
 
-var fileDescriptor_6174012c24e1a081 = []byte{
-	// 338 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x4b, 0xfb, 0x40,
-	0x10, 0xc5, 0x49, 0xff, 0xff, 0xb6, 0x3a, 0x6d, 0x2f, 0xab, 0x68, 0xf0, 0x20, 0xb1, 0x87, 0x52,
-	0x50, 0x37, 0x54, 0x8f, 0x9e, 0x5a, 0x94, 0xe2, 0xa5, 0x48, 0xf4, 0x24, 0x88, 0xec, 0x6e, 0x57,
-	0x5d, 0x6d, 0x33, 0xb1, 0xbb, 0x29, 0x78, 0x11, 0xfc, 0x22, 0x7e, 0x56, 0xc9, 0x26, 0x0d, 0x29,
-	0x12, 0x52, 0xf0, 0xb6, 0x6f, 0xf6, 0xc7, 0xcc, 0x63, 0x1e, 0x03, 0xbd, 0xe9, 0x53, 0xac, 0xa5,
-	0x2f, 0x51, 0x2b, 0xf4, 0x19, 0x57, 0x02, 0xa7, 0x52, 0xf8, 0xcb, 0x41, 0xfe, 0xa6, 0xd1, 0x02,
-	0x0d, 0x92, 0x7d, 0xcb, 0x51, 0xcb, 0xd1, 0xfc, 0x6f, 0x39, 0xe8, 0x7e, 0x02, 0xb9, 0x94, 0x89,
-	0xba, 0x63, 0x7c, 0x26, 0x03, 0xf9, 0x1e, 0x4b, 0x6d, 0x88, 0x0b, 0x4d, 0x26, 0x04, 0xc6, 0xa1,
-	0x71, 0x1d, 0xcf, 0xe9, 0x6f, 0x07, 0x2b, 0x49, 0x76, 0xa1, 0x6e, 0x12, 0xd2, 0xfd, 0x67, 0xeb,
-	0xa9, 0x20, 0x87, 0x00, 0xcc, 0x8c, 0x66, 0x28, 0xde, 0x26, 0xf1, 0xdc, 0xfd, 0xef, 0x39, 0xfd,
-	0x4e, 0x50, 0xa8, 0x24, 0xfd, 0x22, 0xf6, 0x31, 0x43, 0x36, 0x75, 0xeb, 0x9e, 0xd3, 0x6f, 0x07,
-	0x2b, 0xd9, 0xfd, 0x72, 0x60, 0x27, 0x35, 0x30, 0x14, 0x46, 0x61, 0x58, 0xed, 0x60, 0x0f, 0x1a,
-	0xcc, 0xa2, 0x99, 0x85, 0x4c, 0xfd, 0xc1, 0xc3, 0x35, 0x74, 0xc6, 0xd2, 0x0c, 0xb9, 0xaa, 0x1e,
-	0x5e, 0x31, 0xa4, 0x3b, 0x81, 0xad, 0x40, 0xea, 0x08, 0x43, 0x2d, 0x89, 0x07, 0x2d, 0xc6, 0x55,
-	0x0e, 0x3b, 0x16, 0x2e, 0x96, 0x12, 0xe2, 0x55, 0x63, 0x78, 0x93, 0xd9, 0xaa, 0xd9, 0x59, 0xc5,
-	0xd2, 0xd9, 0x77, 0x0d, 0x9a, 0xe9, 0x7a, 0x16, 0xe4, 0x01, 0x5a, 0x85, 0xa8, 0xc8, 0x31, 0x2d,
-	0xc9, 0x94, 0xfe, 0x0e, 0xf4, 0xe0, 0xa8, 0x14, 0xce, 0xed, 0x3e, 0x42, 0xbb, 0x18, 0x04, 0x39,
-	0xa9, 0xe8, 0xbf, 0x96, 0xd7, 0x26, 0x03, 0x6e, 0xa1, 0x91, 0xae, 0x99, 0xf4, 0x4a, 0xe1, 0xb5,
-	0x1c, 0x36, 0x68, 0x3a, 0x1a, 0xdf, 0x5f, 0x3d, 0x2b, 0xf3, 0x12, 0x73, 0x2a, 0x70, 0xee, 0x5b,
-	0xfc, 0x54, 0x61, 0xf6, 0x48, 0xcf, 0x22, 0xe2, 0x7e, 0xc9, 0x95, 0x5c, 0x44, 0x7c, 0xa5, 0x78,
-	0xc3, 0x1e, 0xca, 0xf9, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7b, 0xcc, 0x11, 0x2c, 0x52, 0x03,
-	0x00, 0x00,
+func (m *ListAbiVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAbiVersionsRequest.Unmarshal(m, b)
+}
+func (m *ListAbiVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAbiVersionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAbiVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAbiVersionsRequest.Merge(m, src)
+}
+func (m *ListAbiVersionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAbiVersionsRequest.Size(m)
+}
+func (m *ListAbiVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAbiVersionsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+var xxx_messageInfo_ListAbiVersionsRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func (m *ListAbiVersionsRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
 
-// DecoderClient is the client API for Decoder service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DecoderClient interface {
-	DecodeTable(ctx context.Context, in *DecodeTableRequest, opts ...grpc.CallOption) (*Response, error)
-	DecodeAction(ctx context.Context, in *DecodeActionRequest, opts ...grpc.CallOption) (*Response, error)
-	GetAbi(ctx context.Context, in *GetAbiRequest, opts ...grpc.CallOption) (*Response, error)
+func (m *ListAbiVersionsRequest) GetWithAbi() bool {
+	if m != nil {
+		return m.WithAbi
+	}
+	return false
 }
 
-type decoderClient struct {
-	cc grpc.ClientConnInterface
+type ListAbiVersionsResponse struct {
+	Versions             []*AbiVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func NewDecoderClient(cc grpc.ClientConnInterface) DecoderClient {
-	return &decoderClient{cc}
+func (m *ListAbiVersionsResponse) Reset()         { *m = ListAbiVersionsResponse{} }
+func (m *ListAbiVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAbiVersionsResponse) ProtoMessage()    {}
+func (*ListAbiVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6174012c24e1a081, []int{5}
 }
 
-func (c *decoderClient) DecodeTable(ctx context.Context, in *DecodeTableRequest, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/dfuse.zswhq.abicodec.v1.Decoder/DecodeTable", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListAbiVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAbiVersionsResponse.Unmarshal(m, b)
+}
+func (m *ListAbiVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAbiVersionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListAbiVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAbiVersionsResponse.Merge(m, src)
+}
+func (m *ListAbiVersionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAbiVersionsResponse.Size(m)
+}
+func (m *ListAbiVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAbiVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAbiVersionsResponse proto.InternalMessageInfo
+
+func (m *ListAbiVersionsResponse) GetVersions() []*AbiVersion {
+	if m != nil {
+		return m.Versions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *decoderClient) DecodeAction(ctx context.Context, in *DecodeActionRequest, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/dfuse.zswhq.abicodec.v1.Decoder/DecodeAction", in, out, opts...)
-	if err != nil {
-		return nil, err
+type AbiVersion struct {
+	StartBlockNum uint32 `protobuf:"varint,1,opt,name=startBlockNum,proto3" json:"startBlockNum,omitempty"`
+	EndBlockNum   uint32 `protobuf:"varint,2,opt,name=endBlockNum,proto3" json:"endBlockNum,omitempty"`
+	// ID of the transaction holding the `setabi` action. Empty for ABIs cached before transaction
+	// IDs were tracked, deleting the abicodec cache so it's synced again from the blocks fills them.
+	TrxId                string   `protobuf:"bytes,3,opt,name=trxId,proto3" json:"trxId,omitempty"`
+	JsonAbi              string   `protobuf:"bytes,4,opt,name=jsonAbi,proto3" json:"jsonAbi,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AbiVersion) Reset()         { *m = AbiVersion{} }
+func (m *AbiVersion) String() string { return proto.CompactTextString(m) }
+func (*AbiVersion) ProtoMessage()    {}
+func (*AbiVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6174012c24e1a081, []int{6}
+}
+
+func (m *AbiVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbiVersion.Unmarshal(m, b)
+}
+func (m *AbiVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbiVersion.Marshal(b, m, deterministic)
+}
+func (m *AbiVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbiVersion.Merge(m, src)
+}
+func (m *AbiVersion) XXX_Size() int {
+	return xxx_messageInfo_AbiVersion.Size(m)
+}
+func (m *AbiVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbiVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbiVersion proto.InternalMessageInfo
+
+func (m *AbiVersion) GetStartBlockNum() uint32 {
+	if m != nil {
+		return m.StartBlockNum
 	}
-	return out, nil
+	return 0
 }
 
-func (c *decoderClient) GetAbi(ctx context.Context, in *GetAbiRequest, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/dfuse.zswhq.abicodec.v1.Decoder/GetAbi", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AbiVersion) GetEndBlockNum() uint32 {
+	if m != nil {
+		return m.EndBlockNum
 	}
-	return out, nil
+	return 0
 }
 
-// DecoderServer is the server API for Decoder service.
-type DecoderServer interface {
-	DecodeTable(context.Context, *DecodeTableRequest) (*Response, error)
-	DecodeAction(context.Context, *DecodeActionRequest) (*Response, error)
-	GetAbi(context.Context, *GetAbiRequest) (*Response, error)
+func (m *AbiVersion) GetTrxId() string {
+	if m != nil {
+		return m.TrxId
+	}
+	return ""
 }
 
-// UnimplementedDecoderServer can be embedded to have forward compatible implementations.
-type UnimplementedDecoderServer struct {
+func (m *AbiVersion) GetJsonAbi() string {
+	if m != nil {
+		return m.JsonAbi
+	}
+	return ""
 }
 
-func (*UnimplementedDecoderServer) DecodeTable(ctx context.Context, req *DecodeTableRequest) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DecodeTable not implemented")
+type DiffAbisRequest struct {
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	FromBlockNum         uint32   `protobuf:"varint,2,opt,name=fromBlockNum,proto3" json:"fromBlockNum,omitempty"`
+	ToBlockNum           uint32   `protobuf:"varint,3,opt,name=toBlockNum,proto3" json:"toBlockNum,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedDecoderServer) DecodeAction(ctx context.Context, req *DecodeActionRequest) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DecodeAction not implemented")
+
+func (m *DiffAbisRequest) Reset()         { *m = DiffAbisRequest{} }
+func (m *DiffAbisRequest) String() string { return proto.CompactTextString(m) }
+func (*DiffAbisRequest) ProtoMessage()    {}
+func (*DiffAbisRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6174012c24e1a081, []int{7}
 }
-func (*UnimplementedDecoderServer) GetAbi(ctx context.Context, req *GetAbiRequest) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAbi not implemented")
+
+func (m *DiffAbisRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffAbisRequest.Unmarshal(m, b)
+}
+func (m *DiffAbisRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffAbisRequest.Marshal(b, m, deterministic)
+}
+func (m *DiffAbisRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffAbisRequest.Merge(m, src)
+}
+func (m *DiffAbisRequest) XXX_Size() int {
+	return xxx_messageInfo_DiffAbisRequest.Size(m)
+}
+func (m *DiffAbisRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffAbisRequest.DiscardUnknown(m)
 }
 
-func RegisterDecoderServer(s *grpc.Server, srv DecoderServer) {
-	s.RegisterService(&_Decoder_serviceDesc, srv)
+var xxx_messageInfo_DiffAbisRequest proto.InternalMessageInfo
+
+func (m *DiffAbisRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
 }
 
-func _Decoder_DecodeTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DecodeTableRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *DiffAbisRequest) GetFromBlockNum() uint32 {
+	if m != nil {
+		return m.FromBlockNum
 	}
-	if interceptor == nil {
-		return srv.(DecoderServer).DecodeTable(ctx, in)
+	return 0
+}
+
+func (m *DiffAbisRequest) GetToBlockNum() uint32 {
+	if m != nil {
+		return m.ToBlockNum
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dfuse.zswhq.abicodec.v1.Decoder/DecodeTable",
+	return 0
+}
+
+type DiffAbisResponse struct {
+	FromAbiBlockNum      uint32          `protobuf:"varint,1,opt,name=fromAbiBlockNum,proto3" json:"fromAbiBlockNum,omitempty"`
+	ToAbiBlockNum        uint32          `protobuf:"varint,2,opt,name=toAbiBlockNum,proto3" json:"toAbiBlockNum,omitempty"`
+	AddedActions         []string        `protobuf:"bytes,3,rep,name=addedActions,proto3" json:"addedActions,omitempty"`
+	RemovedActions       []string        `protobuf:"bytes,4,rep,name=removedActions,proto3" json:"removedActions,omitempty"`
+	ChangedActions       []*TypeChange   `protobuf:"bytes,5,rep,name=changedActions,proto3" json:"changedActions,omitempty"`
+	AddedTables          []string        `protobuf:"bytes,6,rep,name=addedTables,proto3" json:"addedTables,omitempty"`
+	RemovedTables        []string        `protobuf:"bytes,7,rep,name=removedTables,proto3" json:"removedTables,omitempty"`
+	ChangedTables        []*TypeChange   `protobuf:"bytes,8,rep,name=changedTables,proto3" json:"changedTables,omitempty"`
+	AddedStructs         []string        `protobuf:"bytes,9,rep,name=addedStructs,proto3" json:"addedStructs,omitempty"`
+	RemovedStructs       []string        `protobuf:"bytes,10,rep,name=removedStructs,proto3" json:"removedStructs,omitempty"`
+	ChangedStructs       []*StructChange `protobuf:"bytes,11,rep,name=changedStructs,proto3" json:"changedStructs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DiffAbisResponse) Reset()         { *m = DiffAbisResponse{} }
+func (m *DiffAbisResponse) String() string { return proto.CompactTextString(m) }
+func (*DiffAbisResponse) ProtoMessage()    {}
+func (*DiffAbisResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6174012c24e1a081, []int{8}
+}
+
+func (m *DiffAbisResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffAbisResponse.Unmarshal(m, b)
+}
+func (m *DiffAbisResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffAbisResponse.Marshal(b, m, deterministic)
+}
+func (m *DiffAbisResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffAbisResponse.Merge(m, src)
+}
+func (m *DiffAbisResponse) XXX_Size() int {
+	return xxx_messageInfo_DiffAbisResponse.Size(m)
+}
+func (m *DiffAbisResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffAbisResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffAbisResponse proto.InternalMessageInfo
+
+func (m *DiffAbisResponse) GetFromAbiBlockNum() uint32 {
+	if m != nil {
+		return m.FromAbiBlockNum
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DecoderServer).DecodeTable(ctx, req.(*DecodeTableRequest))
+	return 0
+}
+
+func (m *DiffAbisResponse) GetToAbiBlockNum() uint32 {
+	if m != nil {
+		return m.ToAbiBlockNum
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Decoder_DecodeAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DecodeActionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *DiffAbisResponse) GetAddedActions() []string {
+	if m != nil {
+		return m.AddedActions
 	}
-	if interceptor == nil {
-		return srv.(DecoderServer).DecodeAction(ctx, in)
+	return nil
+}
+
+func (m *DiffAbisResponse) GetRemovedActions() []string {
+	if m != nil {
+		return m.RemovedActions
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dfuse.zswhq.abicodec.v1.Decoder/DecodeAction",
+	return nil
+}
+
+func (m *DiffAbisResponse) GetChangedActions() []*TypeChange {
+	if m != nil {
+		return m.ChangedActions
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DecoderServer).DecodeAction(ctx, req.(*DecodeActionRequest))
+	return nil
+}
+
+func (m *DiffAbisResponse) GetAddedTables() []string {
+	if m != nil {
+		return m.AddedTables
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Decoder_GetAbi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAbiRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *DiffAbisResponse) GetRemovedTables() []string {
+	if m != nil {
+		return m.RemovedTables
 	}
-	if interceptor == nil {
-		return srv.(DecoderServer).GetAbi(ctx, in)
+	return nil
+}
+
+func (m *DiffAbisResponse) GetChangedTables() []*TypeChange {
+	if m != nil {
+		return m.ChangedTables
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dfuse.zswhq.abicodec.v1.Decoder/GetAbi",
+	return nil
+}
+
+func (m *DiffAbisResponse) GetAddedStructs() []string {
+	if m != nil {
+		return m.AddedStructs
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DecoderServer).GetAbi(ctx, req.(*GetAbiRequest))
+	return nil
+}
+
+func (m *DiffAbisResponse) GetRemovedStructs() []string {
+	if m != nil {
+		return m.RemovedStructs
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Decoder_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dfuse.zswhq.abicodec.v1.Decoder",
-	HandlerType: (*DecoderServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "DecodeTable",
-			Handler:    _Decoder_DecodeTable_Handler,
-		},
-		{
-			MethodName: "DecodeAction",
-			Handler:    _Decoder_DecodeAction_Handler,
-		},
-		{
-			MethodName: "GetAbi",
-			Handler:    _Decoder_GetAbi_Handler,
+func (m *DiffAbisResponse) GetChangedStructs() []*StructChange {
+	if m != nil {
+		return m.ChangedStructs
+	}
+	return nil
+}
+
+type TypeChange struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	FromType             string   `protobuf:"bytes,2,opt,name=fromType,proto3" json:"fromType,omitempty"`
+	ToType               string   `protobuf:"bytes,3,opt,name=toType,proto3" json:"toType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TypeChange) Reset()         { *m = TypeChange{} }
+func (m *TypeChange) String() string { return proto.CompactTextString(m) }
+func (*TypeChange) ProtoMessage()    {}
+func (*TypeChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6174012c24e1a081, []int{9}
+}
+
+func (m *TypeChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TypeChange.Unmarshal(m, b)
+}
+func (m *TypeChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TypeChange.Marshal(b, m, deterministic)
+}
+func (m *TypeChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TypeChange.Merge(m, src)
+}
+func (m *TypeChange) XXX_Size() int {
+	return xxx_messageInfo_TypeChange.Size(m)
+}
+func (m *TypeChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_TypeChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TypeChange proto.InternalMessageInfo
+
+func (m *TypeChange) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TypeChange) GetFromType() string {
+	if m != nil {
+		return m.FromType
+	}
+	return ""
+}
+
+func (m *TypeChange) GetToType() string {
+	if m != nil {
+		return m.ToType
+	}
+	return ""
+}
+
+type StructChange struct {
+	Name                 string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	FromBase             string        `protobuf:"bytes,2,opt,name=fromBase,proto3" json:"fromBase,omitempty"`
+	ToBase               string        `protobuf:"bytes,3,opt,name=toBase,proto3" json:"toBase,omitempty"`
+	AddedFields          []*FieldDef   `protobuf:"bytes,4,rep,name=addedFields,proto3" json:"addedFields,omitempty"`
+	RemovedFields        []*FieldDef   `protobuf:"bytes,5,rep,name=removedFields,proto3" json:"removedFields,omitempty"`
+	ChangedFields        []*TypeChange `protobuf:"bytes,6,rep,name=changedFields,proto3" json:"changedFields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *StructChange) Reset()         { *m = StructChange{} }
+func (m *StructChange) String() string { return proto.CompactTextString(m) }
+func (*StructChange) ProtoMessage()    {}
+func (*StructChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6174012c24e1a081, []int{10}
+}
+
+func (m *StructChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StructChange.Unmarshal(m, b)
+}
+func (m *StructChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StructChange.Marshal(b, m, deterministic)
+}
+func (m *StructChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StructChange.Merge(m, src)
+}
+func (m *StructChange) XXX_Size() int {
+	return xxx_messageInfo_StructChange.Size(m)
+}
+func (m *StructChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_StructChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StructChange proto.InternalMessageInfo
+
+func (m *StructChange) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StructChange) GetFromBase() string {
+	if m != nil {
+		return m.FromBase
+	}
+	return ""
+}
+
+func (m *StructChange) GetToBase() string {
+	if m != nil {
+		return m.ToBase
+	}
+	return ""
+}
+
+func (m *StructChange) GetAddedFields() []*FieldDef {
+	if m != nil {
+		return m.AddedFields
+	}
+	return nil
+}
+
+func (m *StructChange) GetRemovedFields() []*FieldDef {
+	if m != nil {
+		return m.RemovedFields
+	}
+	return nil
+}
+
+func (m *StructChange) GetChangedFields() []*TypeChange {
+	if m != nil {
+		return m.ChangedFields
+	}
+	return nil
+}
+
+type FieldDef struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldDef) Reset()         { *m = FieldDef{} }
+func (m *FieldDef) String() string { return proto.CompactTextString(m) }
+func (*FieldDef) ProtoMessage()    {}
+func (*FieldDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6174012c24e1a081, []int{11}
+}
+
+func (m *FieldDef) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldDef.Unmarshal(m, b)
+}
+func (m *FieldDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldDef.Marshal(b, m, deterministic)
+}
+func (m *FieldDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldDef.Merge(m, src)
+}
+func (m *FieldDef) XXX_Size() int {
+	return xxx_messageInfo_FieldDef.Size(m)
+}
+func (m *FieldDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldDef proto.InternalMessageInfo
+
+func (m *FieldDef) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FieldDef) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+type DecodeActionsBatchRequest struct {
+	Groups               []*DecodeActionsGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DecodeActionsBatchRequest) Reset()         { *m = DecodeActionsBatchRequest{} }
+func (m *DecodeActionsBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*DecodeActionsBatchRequest) ProtoMessage()    {}
+func (*DecodeActionsBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6174012c24e1a081, []int{12}
+}
+
+func (m *DecodeActionsBatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecodeActionsBatchRequest.Unmarshal(m, b)
+}
+func (m *DecodeActionsBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecodeActionsBatchRequest.Marshal(b, m, deterministic)
+}
+func (m *DecodeActionsBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodeActionsBatchRequest.Merge(m, src)
+}
+func (m *DecodeActionsBatchRequest) XXX_Size() int {
+	return xxx_messageInfo_DecodeActionsBatchRequest.Size(m)
+}
+func (m *DecodeActionsBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodeActionsBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodeActionsBatchRequest proto.InternalMessageInfo
+
+func (m *DecodeActionsBatchRequest) GetGroups() []*DecodeActionsGroup {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+type DecodeActionsGroup struct {
+	Account              string           `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	AtBlockNum           uint32           `protobuf:"varint,2,opt,name=atBlockNum,proto3" json:"atBlockNum,omitempty"`
+	Actions              []*ActionPayload `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DecodeActionsGroup) Reset()         { *m = DecodeActionsGroup{} }
+func (m *DecodeActionsGroup) String() string { return proto.CompactTextString(m) }
+func (*DecodeActionsGroup) ProtoMessage()    {}
+func (*DecodeActionsGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6174012c24e1a081, []int{13}
+}
+
+func (m *DecodeActionsGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecodeActionsGroup.Unmarshal(m, b)
+}
+func (m *DecodeActionsGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecodeActionsGroup.Marshal(b, m, deterministic)
+}
+func (m *DecodeActionsGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodeActionsGroup.Merge(m, src)
+}
+func (m *DecodeActionsGroup) XXX_Size() int {
+	return xxx_messageInfo_DecodeActionsGroup.Size(m)
+}
+func (m *DecodeActionsGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodeActionsGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodeActionsGroup proto.InternalMessageInfo
+
+func (m *DecodeActionsGroup) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *DecodeActionsGroup) GetAtBlockNum() uint32 {
+	if m != nil {
+		return m.AtBlockNum
+	}
+	return 0
+}
+
+func (m *DecodeActionsGroup) GetActions() []*ActionPayload {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+type ActionPayload struct {
+	Action               string   `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Payload              []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActionPayload) Reset()         { *m = ActionPayload{} }
+func (m *ActionPayload) String() string { return proto.CompactTextString(m) }
+func (*ActionPayload) ProtoMessage()    {}
+func (*ActionPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6174012c24e1a081, []int{14}
+}
+
+func (m *ActionPayload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionPayload.Unmarshal(m, b)
+}
+func (m *ActionPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionPayload.Marshal(b, m, deterministic)
+}
+func (m *ActionPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionPayload.Merge(m, src)
+}
+func (m *ActionPayload) XXX_Size() int {
+	return xxx_messageInfo_ActionPayload.Size(m)
+}
+func (m *ActionPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionPayload proto.InternalMessageInfo
+
+func (m *ActionPayload) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ActionPayload) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type DecodeTablesBatchRequest struct {
+	Groups               []*DecodeTablesGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DecodeTablesBatchRequest) Reset()         { *m = DecodeTablesBatchRequest{} }
+func (m *DecodeTablesBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*DecodeTablesBatchRequest) ProtoMessage()    {}
+func (*DecodeTablesBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6174012c24e1a081, []int{15}
+}
+
+func (m *DecodeTablesBatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecodeTablesBatchRequest.Unmarshal(m, b)
+}
+func (m *DecodeTablesBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecodeTablesBatchRequest.Marshal(b, m, deterministic)
+}
+func (m *DecodeTablesBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodeTablesBatchRequest.Merge(m, src)
+}
+func (m *DecodeTablesBatchRequest) XXX_Size() int {
+	return xxx_messageInfo_DecodeTablesBatchRequest.Size(m)
+}
+func (m *DecodeTablesBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodeTablesBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodeTablesBatchRequest proto.InternalMessageInfo
+
+func (m *DecodeTablesBatchRequest) GetGroups() []*DecodeTablesGroup {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+type DecodeTablesGroup struct {
+	Account              string          `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	AtBlockNum           uint32          `protobuf:"varint,2,opt,name=atBlockNum,proto3" json:"atBlockNum,omitempty"`
+	Rows                 []*TablePayload `protobuf:"bytes,3,rep,name=rows,proto3" json:"rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DecodeTablesGroup) Reset()         { *m = DecodeTablesGroup{} }
+func (m *DecodeTablesGroup) String() string { return proto.CompactTextString(m) }
+func (*DecodeTablesGroup) ProtoMessage()    {}
+func (*DecodeTablesGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6174012c24e1a081, []int{16}
+}
+
+func (m *DecodeTablesGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecodeTablesGroup.Unmarshal(m, b)
+}
+func (m *DecodeTablesGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecodeTablesGroup.Marshal(b, m, deterministic)
+}
+func (m *DecodeTablesGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodeTablesGroup.Merge(m, src)
+}
+func (m *DecodeTablesGroup) XXX_Size() int {
+	return xxx_messageInfo_DecodeTablesGroup.Size(m)
+}
+func (m *DecodeTablesGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodeTablesGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodeTablesGroup proto.InternalMessageInfo
+
+func (m *DecodeTablesGroup) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *DecodeTablesGroup) GetAtBlockNum() uint32 {
+	if m != nil {
+		return m.AtBlockNum
+	}
+	return 0
+}
+
+func (m *DecodeTablesGroup) GetRows() []*TablePayload {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
+type TablePayload struct {
+	Table                string   `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	Payload              []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TablePayload) Reset()         { *m = TablePayload{} }
+func (m *TablePayload) String() string { return proto.CompactTextString(m) }
+func (*TablePayload) ProtoMessage()    {}
+func (*TablePayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6174012c24e1a081, []int{17}
+}
+
+func (m *TablePayload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TablePayload.Unmarshal(m, b)
+}
+func (m *TablePayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TablePayload.Marshal(b, m, deterministic)
+}
+func (m *TablePayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TablePayload.Merge(m, src)
+}
+func (m *TablePayload) XXX_Size() int {
+	return xxx_messageInfo_TablePayload.Size(m)
+}
+func (m *TablePayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_TablePayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TablePayload proto.InternalMessageInfo
+
+func (m *TablePayload) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *TablePayload) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type DecodeBatchResponse struct {
+	Results              []*DecodeResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DecodeBatchResponse) Reset()         { *m = DecodeBatchResponse{} }
+func (m *DecodeBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*DecodeBatchResponse) ProtoMessage()    {}
+func (*DecodeBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6174012c24e1a081, []int{18}
+}
+
+func (m *DecodeBatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecodeBatchResponse.Unmarshal(m, b)
+}
+func (m *DecodeBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecodeBatchResponse.Marshal(b, m, deterministic)
+}
+func (m *DecodeBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodeBatchResponse.Merge(m, src)
+}
+func (m *DecodeBatchResponse) XXX_Size() int {
+	return xxx_messageInfo_DecodeBatchResponse.Size(m)
+}
+func (m *DecodeBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodeBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodeBatchResponse proto.InternalMessageInfo
+
+func (m *DecodeBatchResponse) GetResults() []*DecodeResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type DecodeResult struct {
+	AbiBlockNum          uint32          `protobuf:"varint,1,opt,name=abiBlockNum,proto3" json:"abiBlockNum,omitempty"`
+	JsonPayload          string          `protobuf:"bytes,2,opt,name=jsonPayload,proto3" json:"jsonPayload,omitempty"`
+	ErrorCode            DecodeErrorCode `protobuf:"varint,3,opt,name=errorCode,proto3,enum=dfuse.eosio.abicodec.v1.DecodeErrorCode" json:"errorCode,omitempty"`
+	ErrorMessage         string          `protobuf:"bytes,4,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DecodeResult) Reset()         { *m = DecodeResult{} }
+func (m *DecodeResult) String() string { return proto.CompactTextString(m) }
+func (*DecodeResult) ProtoMessage()    {}
+func (*DecodeResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6174012c24e1a081, []int{19}
+}
+
+func (m *DecodeResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecodeResult.Unmarshal(m, b)
+}
+func (m *DecodeResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecodeResult.Marshal(b, m, deterministic)
+}
+func (m *DecodeResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodeResult.Merge(m, src)
+}
+func (m *DecodeResult) XXX_Size() int {
+	return xxx_messageInfo_DecodeResult.Size(m)
+}
+func (m *DecodeResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodeResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodeResult proto.InternalMessageInfo
+
+func (m *DecodeResult) GetAbiBlockNum() uint32 {
+	if m != nil {
+		return m.AbiBlockNum
+	}
+	return 0
+}
+
+func (m *DecodeResult) GetJsonPayload() string {
+	if m != nil {
+		return m.JsonPayload
+	}
+	return ""
+}
+
+func (m *DecodeResult) GetErrorCode() DecodeErrorCode {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return DecodeErrorCode_DECODEERRORCODE_NONE
+}
+
+func (m *DecodeResult) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("dfuse.zswhq.abicodec.v1.DecodeErrorCode", DecodeErrorCode_name, DecodeErrorCode_value)
+	proto.RegisterType((*DecodeTableRequest)(nil), "dfuse.zswhq.abicodec.v1.DecodeTableRequest")
+	proto.RegisterType((*DecodeActionRequest)(nil), "dfuse.zswhq.abicodec.v1.DecodeActionRequest")
+	proto.RegisterType((*GetAbiRequest)(nil), "dfuse.zswhq.abicodec.v1.GetAbiRequest")
+	proto.RegisterType((*Response)(nil), "dfuse.zswhq.abicodec.v1.Response")
+	proto.RegisterType((*ListAbiVersionsRequest)(nil), "dfuse.zswhq.abicodec.v1.ListAbiVersionsRequest")
+	proto.RegisterType((*ListAbiVersionsResponse)(nil), "dfuse.zswhq.abicodec.v1.ListAbiVersionsResponse")
+	proto.RegisterType((*AbiVersion)(nil), "dfuse.zswhq.abicodec.v1.AbiVersion")
+	proto.RegisterType((*DiffAbisRequest)(nil), "dfuse.zswhq.abicodec.v1.DiffAbisRequest")
+	proto.RegisterType((*DiffAbisResponse)(nil), "dfuse.zswhq.abicodec.v1.DiffAbisResponse")
+	proto.RegisterType((*TypeChange)(nil), "dfuse.zswhq.abicodec.v1.TypeChange")
+	proto.RegisterType((*StructChange)(nil), "dfuse.zswhq.abicodec.v1.StructChange")
+	proto.RegisterType((*FieldDef)(nil), "dfuse.zswhq.abicodec.v1.FieldDef")
+	proto.RegisterType((*DecodeActionsBatchRequest)(nil), "dfuse.zswhq.abicodec.v1.DecodeActionsBatchRequest")
+	proto.RegisterType((*DecodeActionsGroup)(nil), "dfuse.zswhq.abicodec.v1.DecodeActionsGroup")
+	proto.RegisterType((*ActionPayload)(nil), "dfuse.zswhq.abicodec.v1.ActionPayload")
+	proto.RegisterType((*DecodeTablesBatchRequest)(nil), "dfuse.zswhq.abicodec.v1.DecodeTablesBatchRequest")
+	proto.RegisterType((*DecodeTablesGroup)(nil), "dfuse.zswhq.abicodec.v1.DecodeTablesGroup")
+	proto.RegisterType((*TablePayload)(nil), "dfuse.zswhq.abicodec.v1.TablePayload")
+	proto.RegisterType((*DecodeBatchResponse)(nil), "dfuse.zswhq.abicodec.v1.DecodeBatchResponse")
+	proto.RegisterType((*DecodeResult)(nil), "dfuse.zswhq.abicodec.v1.DecodeResult")
+}
+
+func init() {
+	proto.RegisterFile("dfuse/zswhq/abicodec/v1/abicodec.proto", fileDescriptor_6174012c24e1a081)
+}
+
+var fileDescriptor_6174012c24e1a081 = []byte{
+	// 1116 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x5f, 0x6f, 0x1b, 0x45,
+	0x10, 0xe7, 0x1c, 0xc7, 0x76, 0xc6, 0x49, 0x13, 0x96, 0xaa, 0x3d, 0x22, 0x01, 0xee, 0x95, 0x46,
+	0xa6, 0x05, 0xbb, 0x31, 0x4f, 0x08, 0x09, 0xb0, 0x63, 0x27, 0xb2, 0x48, 0xed, 0xea, 0x12, 0x22,
+	0x51, 0x09, 0xc2, 0xd9, 0xb7, 0x49, 0x0e, 0x6c, 0xaf, 0xb9, 0x5d, 0xa7, 0xf4, 0x05, 0x09, 0x89,
+	0x07, 0x1e, 0xf9, 0x1a, 0x3c, 0xf1, 0x0d, 0x90, 0xf8, 0x66, 0x68, 0xf7, 0x76, 0xef, 0x76, 0xcf,
+	0xbd, 0xfa, 0xa2, 0xf0, 0x76, 0x33, 0xfb, 0x9b, 0x3f, 0xfb, 0xdb, 0xd9, 0xd9, 0x39, 0xd8, 0xf3,
+	0x2f, 0x16, 0x14, 0x37, 0x31, 0xa1, 0x01, 0x69, 0x7a, 0xa3, 0x60, 0x4c, 0x7c, 0x3c, 0x6e, 0x5e,
+	0xef, 0xc7, 0xdf, 0x8d, 0x79, 0x48, 0x18, 0x41, 0xf7, 0x05, 0xae, 0x21, 0x70, 0x8d, 0x78, 0xed,
+	0x7a, 0xdf, 0xf9, 0x15, 0x50, 0x17, 0x73, 0xe9, 0xd4, 0x1b, 0x4d, 0xb0, 0x8b, 0x7f, 0x5e, 0x60,
+	0xca, 0x90, 0x0d, 0x65, 0x6f, 0x3c, 0x26, 0x8b, 0x19, 0xb3, 0xad, 0x9a, 0x55, 0xdf, 0x70, 0x95,
+	0x88, 0xee, 0xc2, 0x3a, 0xe3, 0x48, 0x7b, 0x4d, 0xe8, 0x23, 0x01, 0xbd, 0x0f, 0xe0, 0xb1, 0xce,
+	0x84, 0x8c, 0x7f, 0x1a, 0x2c, 0xa6, 0x76, 0xb1, 0x66, 0xd5, 0xb7, 0x5c, 0x4d, 0xc3, 0xfd, 0xcd,
+	0xbd, 0x57, 0x13, 0xe2, 0xf9, 0xf6, 0x7a, 0xcd, 0xaa, 0x6f, 0xba, 0x4a, 0x74, 0x7e, 0xb3, 0xe0,
+	0x9d, 0x28, 0x81, 0xf6, 0x98, 0x05, 0x64, 0xb6, 0x3a, 0x83, 0x7b, 0x50, 0xf2, 0x04, 0x54, 0xa6,
+	0x20, 0xa5, 0x5b, 0xe4, 0xd0, 0x87, 0xad, 0x23, 0xcc, 0xda, 0xa3, 0x60, 0x75, 0xf0, 0x15, 0x41,
+	0x9c, 0x01, 0x54, 0x5c, 0x4c, 0xe7, 0x64, 0x46, 0x31, 0xaa, 0x41, 0xd5, 0x1b, 0x05, 0x31, 0xd8,
+	0x12, 0x60, 0x5d, 0xc5, 0x11, 0x3f, 0x52, 0x32, 0x7b, 0x2e, 0xd3, 0x2a, 0x88, 0x58, 0xba, 0xca,
+	0x39, 0x86, 0x7b, 0xc7, 0x01, 0xe5, 0xb9, 0x9d, 0xe1, 0x90, 0x06, 0x64, 0x46, 0x57, 0xe7, 0x68,
+	0x43, 0xf9, 0x65, 0xc0, 0xae, 0xda, 0xa3, 0x40, 0x78, 0xac, 0xb8, 0x4a, 0x74, 0x5e, 0xc0, 0xfd,
+	0x25, 0x6f, 0x32, 0xd9, 0x2f, 0xa1, 0x72, 0x2d, 0x75, 0xb6, 0x55, 0x5b, 0xab, 0x57, 0x5b, 0x0f,
+	0x1b, 0x19, 0x35, 0xd3, 0x48, 0xec, 0xdd, 0xd8, 0xc8, 0xf9, 0xdd, 0x02, 0x48, 0x16, 0xd0, 0x87,
+	0xb0, 0x45, 0x99, 0x17, 0xb2, 0xd4, 0xf6, 0x4d, 0x25, 0x27, 0x00, 0xcf, 0xfc, 0x18, 0x53, 0x88,
+	0x28, 0xd2, 0x54, 0xa2, 0xde, 0xc2, 0x5f, 0xfa, 0x7e, 0x5c, 0x6f, 0x5c, 0xe0, 0x5b, 0xe4, 0x2c,
+	0xf1, 0x2d, 0x16, 0xa3, 0xcd, 0x4b, 0xd1, 0x21, 0xb0, 0xdd, 0x0d, 0x2e, 0x2e, 0xda, 0xa3, 0x20,
+	0x07, 0x53, 0x0e, 0x6c, 0x5e, 0x84, 0x64, 0x9a, 0x8a, 0x6f, 0xe8, 0xf8, 0x89, 0x33, 0x12, 0x23,
+	0xd6, 0xa2, 0x13, 0x4f, 0x34, 0xce, 0x5f, 0x45, 0xd8, 0x49, 0x22, 0x4a, 0x36, 0xeb, 0xb0, 0xcd,
+	0x9d, 0xb4, 0x97, 0x8e, 0x3f, 0xad, 0xe6, 0x3c, 0x31, 0xa2, 0xe3, 0xa2, 0x1c, 0x4c, 0x25, 0x4f,
+	0xd4, 0xf3, 0x7d, 0xec, 0x47, 0x77, 0x84, 0xda, 0x6b, 0xb5, 0xb5, 0xfa, 0x86, 0x6b, 0xe8, 0xd0,
+	0x1e, 0xdc, 0x09, 0xf1, 0x94, 0x5c, 0x27, 0xa8, 0xa2, 0x40, 0xa5, 0xb4, 0xe8, 0x6b, 0xb8, 0x33,
+	0xbe, 0xf2, 0x66, 0x97, 0x09, 0x6e, 0x7d, 0xc5, 0x79, 0x9f, 0xbe, 0x9a, 0xe3, 0x03, 0x61, 0xe2,
+	0xa6, 0x4c, 0x45, 0x8d, 0xf3, 0x24, 0x44, 0xf7, 0xa0, 0x76, 0x49, 0x44, 0xd4, 0x55, 0x7c, 0x83,
+	0x32, 0x01, 0x89, 0x29, 0x0b, 0x8c, 0xa9, 0x44, 0x7d, 0xd8, 0x92, 0x9e, 0x25, 0xaa, 0x92, 0x3f,
+	0x27, 0xd3, 0x32, 0xe6, 0xea, 0x84, 0x85, 0x8b, 0x31, 0xa3, 0xf6, 0x86, 0xc6, 0x95, 0xd4, 0x69,
+	0x5c, 0x29, 0x14, 0x18, 0x5c, 0x29, 0xdc, 0xb3, 0x98, 0x2b, 0x85, 0xab, 0x8a, 0xbc, 0x1e, 0x65,
+	0xe6, 0x15, 0xe1, 0x52, 0x6c, 0x49, 0x63, 0xe7, 0x14, 0x20, 0xc9, 0x1b, 0x21, 0x28, 0xce, 0xbc,
+	0x29, 0x96, 0x45, 0x29, 0xbe, 0xd1, 0x2e, 0x54, 0x78, 0x85, 0x70, 0x94, 0x6c, 0x07, 0xb1, 0xcc,
+	0x1b, 0x1f, 0x23, 0x62, 0x45, 0x36, 0xbe, 0x48, 0x72, 0xfe, 0x2e, 0xc0, 0xa6, 0x1e, 0xf6, 0x4d,
+	0x8e, 0x3b, 0x1e, 0x35, 0x1c, 0x73, 0x39, 0x72, 0x2c, 0x56, 0x62, 0xc7, 0x42, 0x7f, 0x20, 0x0f,
+	0xf7, 0x30, 0xc0, 0x13, 0x3f, 0x2a, 0xa7, 0x6a, 0xeb, 0x41, 0xe6, 0xd6, 0x05, 0xac, 0x8b, 0x2f,
+	0x5c, 0xdd, 0x0a, 0x1d, 0xc5, 0xe7, 0x2f, 0xdd, 0xac, 0xe7, 0x75, 0x63, 0xda, 0x69, 0x25, 0x22,
+	0x1d, 0x95, 0x6e, 0x5e, 0x22, 0x91, 0xa5, 0xd3, 0x82, 0x8a, 0x8a, 0xf2, 0x5a, 0xb2, 0x10, 0x14,
+	0x59, 0x72, 0x02, 0xe2, 0xdb, 0xf9, 0x01, 0xde, 0xd5, 0xdf, 0x29, 0xda, 0xf1, 0xd8, 0xf8, 0x4a,
+	0xb5, 0x98, 0x03, 0x28, 0x5d, 0x86, 0x64, 0x31, 0x57, 0xbd, 0xf3, 0x49, 0x66, 0x52, 0x86, 0x8f,
+	0x23, 0x6e, 0xe3, 0x4a, 0x53, 0xe7, 0x4f, 0x0b, 0xd0, 0xf2, 0x72, 0xee, 0xc7, 0xa8, 0xb0, 0xf4,
+	0xe2, 0x7d, 0xc5, 0x2d, 0x93, 0x86, 0x51, 0x6d, 0xed, 0x65, 0xb7, 0x74, 0x81, 0x93, 0xaf, 0x8e,
+	0xab, 0xcc, 0x9c, 0x36, 0x6c, 0x19, 0x2b, 0xda, 0xe3, 0x6b, 0x19, 0x8f, 0xaf, 0xf6, 0xb8, 0x16,
+	0xcc, 0xc7, 0xf5, 0x7b, 0xb0, 0xb5, 0x01, 0xc3, 0xa4, 0xad, 0x93, 0xa2, 0xed, 0xf1, 0x0a, 0xda,
+	0x22, 0x17, 0x26, 0x6b, 0x7f, 0x58, 0xf0, 0xf6, 0xd2, 0xea, 0x2d, 0x48, 0xfb, 0x0c, 0x8a, 0x21,
+	0x79, 0xa9, 0x18, 0xcb, 0xbe, 0xe8, 0x22, 0x9a, 0x22, 0x4c, 0x98, 0x38, 0x5f, 0xc0, 0xa6, 0xae,
+	0x4d, 0x66, 0x25, 0x4b, 0x9f, 0x95, 0xb2, 0xa9, 0x3a, 0x53, 0xa3, 0x90, 0x24, 0x29, 0x7e, 0x9a,
+	0xcb, 0x21, 0xa6, 0x8b, 0x09, 0x53, 0x34, 0x3d, 0x5a, 0x41, 0x93, 0x2b, 0xd0, 0xae, 0xb2, 0x72,
+	0xfe, 0xb1, 0x60, 0x53, 0x5f, 0xf9, 0x3f, 0x26, 0x13, 0x74, 0x08, 0x1b, 0x38, 0x0c, 0x49, 0x78,
+	0x40, 0xfc, 0xa8, 0x6f, 0xdc, 0x69, 0xd5, 0x57, 0xe4, 0xd5, 0x53, 0x78, 0x37, 0x31, 0xe5, 0xed,
+	0x5a, 0x08, 0xcf, 0x30, 0xa5, 0xde, 0x25, 0x96, 0xef, 0xb9, 0xa1, 0x7b, 0x4c, 0x61, 0x3b, 0xe5,
+	0x01, 0xd9, 0x70, 0xb7, 0xdb, 0x3b, 0x18, 0x76, 0x7b, 0x3d, 0xd7, 0x1d, 0xba, 0xfc, 0xe3, 0x7c,
+	0x30, 0x1c, 0xf4, 0x76, 0xde, 0x42, 0x0f, 0xe0, 0xbd, 0xf4, 0x4a, 0xbb, 0xd3, 0x3f, 0x1f, 0x0c,
+	0x4f, 0xcf, 0x0f, 0x87, 0xdf, 0x0c, 0xba, 0x3b, 0x16, 0x7a, 0x08, 0x1f, 0xa4, 0x21, 0xfd, 0xc1,
+	0x59, 0xfb, 0xb8, 0xdf, 0x3d, 0x7f, 0xde, 0xfe, 0xf6, 0x78, 0xd8, 0xee, 0xee, 0x14, 0x5a, 0xff,
+	0x96, 0xa1, 0x1c, 0x45, 0x0d, 0xd1, 0x77, 0x50, 0xd5, 0x6a, 0x0c, 0x3d, 0xc9, 0x53, 0xa7, 0xb2,
+	0xc8, 0x77, 0xb3, 0x3b, 0x5d, 0x7c, 0xc2, 0xe7, 0xea, 0x7c, 0xa2, 0xcb, 0x86, 0x3e, 0xce, 0xd5,
+	0x3e, 0x6e, 0x10, 0xe0, 0x04, 0x4a, 0xd1, 0x84, 0x8b, 0xb2, 0x5b, 0x80, 0x31, 0x02, 0xe7, 0x71,
+	0xca, 0x60, 0x3b, 0x35, 0x4d, 0xa2, 0x66, 0xa6, 0xd5, 0xeb, 0xa7, 0xd8, 0xdd, 0xa7, 0xf9, 0x0d,
+	0x62, 0xae, 0x2a, 0x6a, 0xdc, 0x42, 0x6f, 0x28, 0x38, 0x73, 0x06, 0xdc, 0xfd, 0x28, 0x07, 0x52,
+	0x06, 0xb8, 0x4e, 0x75, 0x61, 0x71, 0x19, 0x51, 0x2b, 0x5f, 0x47, 0xd7, 0xdb, 0xdb, 0xee, 0xaa,
+	0x63, 0x34, 0xaf, 0x39, 0x33, 0xfb, 0x58, 0x14, 0x76, 0x3f, 0x57, 0x47, 0xbc, 0x45, 0xd4, 0x99,
+	0xf9, 0xfb, 0x45, 0x4f, 0x58, 0x88, 0xbd, 0xe9, 0x0d, 0x2b, 0x30, 0x5f, 0x43, 0xaa, 0x5b, 0x4f,
+	0x2d, 0x34, 0x31, 0xfe, 0x37, 0x55, 0xb8, 0x1b, 0x5d, 0xa8, 0xfc, 0xd1, 0x3a, 0x47, 0x2f, 0x7a,
+	0x97, 0x01, 0xbb, 0x5a, 0x8c, 0x1a, 0x63, 0x32, 0x6d, 0x0a, 0xb3, 0x4f, 0x02, 0x22, 0x3f, 0xa2,
+	0x9f, 0xe6, 0xf9, 0xa8, 0x99, 0xf1, 0x0f, 0xfd, 0xf9, 0x7c, 0xa4, 0xa4, 0x51, 0x49, 0xfc, 0x46,
+	0x7f, 0xfa, 0xdf, 0x00, 0x13, 0xa0, 0x7a, 0x28, 0x70, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// DecoderClient is the client API for Decoder service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DecoderClient interface {
+	DecodeTable(ctx context.Context, in *DecodeTableRequest, opts ...grpc.CallOption) (*Response, error)
+	DecodeAction(ctx context.Context, in *DecodeActionRequest, opts ...grpc.CallOption) (*Response, error)
+	GetAbi(ctx context.Context, in *GetAbiRequest, opts ...grpc.CallOption) (*Response, error)
+	ListAbiVersions(ctx context.Context, in *ListAbiVersionsRequest, opts ...grpc.CallOption) (*ListAbiVersionsResponse, error)
+	DiffAbis(ctx context.Context, in *DiffAbisRequest, opts ...grpc.CallOption) (*DiffAbisResponse, error)
+	DecodeActionsBatch(ctx context.Context, in *DecodeActionsBatchRequest, opts ...grpc.CallOption) (*DecodeBatchResponse, error)
+	DecodeTablesBatch(ctx context.Context, in *DecodeTablesBatchRequest, opts ...grpc.CallOption) (*DecodeBatchResponse, error)
+	DecodeActionsStream(ctx context.Context, opts ...grpc.CallOption) (Decoder_DecodeActionsStreamClient, error)
+	DecodeTablesStream(ctx context.Context, opts ...grpc.CallOption) (Decoder_DecodeTablesStreamClient, error)
+}
+
+type decoderClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDecoderClient(cc grpc.ClientConnInterface) DecoderClient {
+	return &decoderClient{cc}
+}
+
+func (c *decoderClient) DecodeTable(ctx context.Context, in *DecodeTableRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/dfuse.zswhq.abicodec.v1.Decoder/DecodeTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decoderClient) DecodeAction(ctx context.Context, in *DecodeActionRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/dfuse.zswhq.abicodec.v1.Decoder/DecodeAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decoderClient) GetAbi(ctx context.Context, in *GetAbiRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/dfuse.zswhq.abicodec.v1.Decoder/GetAbi", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decoderClient) ListAbiVersions(ctx context.Context, in *ListAbiVersionsRequest, opts ...grpc.CallOption) (*ListAbiVersionsResponse, error) {
+	out := new(ListAbiVersionsResponse)
+	err := c.cc.Invoke(ctx, "/dfuse.zswhq.abicodec.v1.Decoder/ListAbiVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decoderClient) DiffAbis(ctx context.Context, in *DiffAbisRequest, opts ...grpc.CallOption) (*DiffAbisResponse, error) {
+	out := new(DiffAbisResponse)
+	err := c.cc.Invoke(ctx, "/dfuse.zswhq.abicodec.v1.Decoder/DiffAbis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decoderClient) DecodeActionsBatch(ctx context.Context, in *DecodeActionsBatchRequest, opts ...grpc.CallOption) (*DecodeBatchResponse, error) {
+	out := new(DecodeBatchResponse)
+	err := c.cc.Invoke(ctx, "/dfuse.zswhq.abicodec.v1.Decoder/DecodeActionsBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decoderClient) DecodeTablesBatch(ctx context.Context, in *DecodeTablesBatchRequest, opts ...grpc.CallOption) (*DecodeBatchResponse, error) {
+	out := new(DecodeBatchResponse)
+	err := c.cc.Invoke(ctx, "/dfuse.zswhq.abicodec.v1.Decoder/DecodeTablesBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decoderClient) DecodeActionsStream(ctx context.Context, opts ...grpc.CallOption) (Decoder_DecodeActionsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Decoder_serviceDesc.Streams[0], "/dfuse.zswhq.abicodec.v1.Decoder/DecodeActionsStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &decoderDecodeActionsStreamClient{stream}
+	return x, nil
+}
+
+type Decoder_DecodeActionsStreamClient interface {
+	Send(*DecodeActionRequest) error
+	Recv() (*DecodeResult, error)
+	grpc.ClientStream
+}
+
+type decoderDecodeActionsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *decoderDecodeActionsStreamClient) Send(m *DecodeActionRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *decoderDecodeActionsStreamClient) Recv() (*DecodeResult, error) {
+	m := new(DecodeResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *decoderClient) DecodeTablesStream(ctx context.Context, opts ...grpc.CallOption) (Decoder_DecodeTablesStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Decoder_serviceDesc.Streams[1], "/dfuse.zswhq.abicodec.v1.Decoder/DecodeTablesStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &decoderDecodeTablesStreamClient{stream}
+	return x, nil
+}
+
+type Decoder_DecodeTablesStreamClient interface {
+	Send(*DecodeTableRequest) error
+	Recv() (*DecodeResult, error)
+	grpc.ClientStream
+}
+
+type decoderDecodeTablesStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *decoderDecodeTablesStreamClient) Send(m *DecodeTableRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *decoderDecodeTablesStreamClient) Recv() (*DecodeResult, error) {
+	m := new(DecodeResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DecoderServer is the server API for Decoder service.
+type DecoderServer interface {
+	DecodeTable(context.Context, *DecodeTableRequest) (*Response, error)
+	DecodeAction(context.Context, *DecodeActionRequest) (*Response, error)
+	GetAbi(context.Context, *GetAbiRequest) (*Response, error)
+	ListAbiVersions(context.Context, *ListAbiVersionsRequest) (*ListAbiVersionsResponse, error)
+	DiffAbis(context.Context, *DiffAbisRequest) (*DiffAbisResponse, error)
+	DecodeActionsBatch(context.Context, *DecodeActionsBatchRequest) (*DecodeBatchResponse, error)
+	DecodeTablesBatch(context.Context, *DecodeTablesBatchRequest) (*DecodeBatchResponse, error)
+	DecodeActionsStream(Decoder_DecodeActionsStreamServer) error
+	DecodeTablesStream(Decoder_DecodeTablesStreamServer) error
+}
+
+// UnimplementedDecoderServer can be embedded to have forward compatible implementations.
+type UnimplementedDecoderServer struct {
+}
+
+func (*UnimplementedDecoderServer) DecodeTable(ctx context.Context, req *DecodeTableRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeTable not implemented")
+}
+func (*UnimplementedDecoderServer) DecodeAction(ctx context.Context, req *DecodeActionRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeAction not implemented")
+}
+func (*UnimplementedDecoderServer) GetAbi(ctx context.Context, req *GetAbiRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAbi not implemented")
+}
+func (*UnimplementedDecoderServer) ListAbiVersions(ctx context.Context, req *ListAbiVersionsRequest) (*ListAbiVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAbiVersions not implemented")
+}
+func (*UnimplementedDecoderServer) DiffAbis(ctx context.Context, req *DiffAbisRequest) (*DiffAbisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffAbis not implemented")
+}
+func (*UnimplementedDecoderServer) DecodeActionsBatch(ctx context.Context, req *DecodeActionsBatchRequest) (*DecodeBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeActionsBatch not implemented")
+}
+func (*UnimplementedDecoderServer) DecodeTablesBatch(ctx context.Context, req *DecodeTablesBatchRequest) (*DecodeBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeTablesBatch not implemented")
+}
+func (*UnimplementedDecoderServer) DecodeActionsStream(srv Decoder_DecodeActionsStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method DecodeActionsStream not implemented")
+}
+func (*UnimplementedDecoderServer) DecodeTablesStream(srv Decoder_DecodeTablesStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method DecodeTablesStream not implemented")
+}
+
+func RegisterDecoderServer(s *grpc.Server, srv DecoderServer) {
+	s.RegisterService(&_Decoder_serviceDesc, srv)
+}
+
+func _Decoder_DecodeTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecoderServer).DecodeTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dfuse.zswhq.abicodec.v1.Decoder/DecodeTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecoderServer).DecodeTable(ctx, req.(*DecodeTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Decoder_DecodeAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecoderServer).DecodeAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dfuse.zswhq.abicodec.v1.Decoder/DecodeAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecoderServer).DecodeAction(ctx, req.(*DecodeActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Decoder_GetAbi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAbiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecoderServer).GetAbi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dfuse.zswhq.abicodec.v1.Decoder/GetAbi",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecoderServer).GetAbi(ctx, req.(*GetAbiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Decoder_ListAbiVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAbiVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecoderServer).ListAbiVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dfuse.zswhq.abicodec.v1.Decoder/ListAbiVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecoderServer).ListAbiVersions(ctx, req.(*ListAbiVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Decoder_DiffAbis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffAbisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecoderServer).DiffAbis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dfuse.zswhq.abicodec.v1.Decoder/DiffAbis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecoderServer).DiffAbis(ctx, req.(*DiffAbisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Decoder_DecodeActionsBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeActionsBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecoderServer).DecodeActionsBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dfuse.zswhq.abicodec.v1.Decoder/DecodeActionsBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecoderServer).DecodeActionsBatch(ctx, req.(*DecodeActionsBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Decoder_DecodeTablesBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeTablesBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecoderServer).DecodeTablesBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dfuse.zswhq.abicodec.v1.Decoder/DecodeTablesBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecoderServer).DecodeTablesBatch(ctx, req.(*DecodeTablesBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Decoder_DecodeActionsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DecoderServer).DecodeActionsStream(&decoderDecodeActionsStreamServer{stream})
+}
+
+type Decoder_DecodeActionsStreamServer interface {
+	Send(*DecodeResult) error
+	Recv() (*DecodeActionRequest, error)
+	grpc.ServerStream
+}
+
+type decoderDecodeActionsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *decoderDecodeActionsStreamServer) Send(m *DecodeResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *decoderDecodeActionsStreamServer) Recv() (*DecodeActionRequest, error) {
+	m := new(DecodeActionRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Decoder_DecodeTablesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DecoderServer).DecodeTablesStream(&decoderDecodeTablesStreamServer{stream})
+}
+
+type Decoder_DecodeTablesStreamServer interface {
+	Send(*DecodeResult) error
+	Recv() (*DecodeTableRequest, error)
+	grpc.ServerStream
+}
+
+type decoderDecodeTablesStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *decoderDecodeTablesStreamServer) Send(m *DecodeResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *decoderDecodeTablesStreamServer) Recv() (*DecodeTableRequest, error) {
+	m := new(DecodeTableRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _Decoder_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dfuse.zswhq.abicodec.v1.Decoder",
+	HandlerType: (*DecoderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DecodeTable",
+			Handler:    _Decoder_DecodeTable_Handler,
+		},
+		{
+			MethodName: "DecodeAction",
+			Handler:    _Decoder_DecodeAction_Handler,
+		},
+		{
+			MethodName: "GetAbi",
+			Handler:    _Decoder_GetAbi_Handler,
+		},
+		{
+			MethodName: "ListAbiVersions",
+			Handler:    _Decoder_ListAbiVersions_Handler,
+		},
+		{
+			MethodName: "DiffAbis",
+			Handler:    _Decoder_DiffAbis_Handler,
+		},
+		{
+			MethodName: "DecodeActionsBatch",
+			Handler:    _Decoder_DecodeActionsBatch_Handler,
+		},
+		{
+			MethodName: "DecodeTablesBatch",
+			Handler:    _Decoder_DecodeTablesBatch_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DecodeActionsStream",
+			Handler:       _Decoder_DecodeActionsStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DecodeTablesStream",
+			Handler:       _Decoder_DecodeTablesStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "dfuse/zswhq/abicodec/v1/abicodec.proto",
 }
@@ -3066,7 +3066,13 @@ type ActionTrace struct {
 	// of an action.
 	//
 	// See https://github.com/EOSIO/eos/pull/8327
-	ReturnValue                            []byte     `protobuf:"bytes,41,opt,name=return_value,json=returnValue,proto3" json:"return_value,omitempty"`
+	ReturnValue []byte `protobuf:"bytes,41,opt,name=return_value,json=returnValue,proto3" json:"return_value,omitempty"`
+	// JsonReturnValue is the JSON representation of `return_value`, decoded against the ABI of the
+	// receiver using the result type reported by deep mind for the action. Empty when the return
+	// value could not be decoded.
+	//
+	// This was added in deep mind version 14 and will *not* be populated on older versions.
+	JsonReturnValue                        string     `protobuf:"bytes,42,opt,name=json_return_value,json=jsonReturnValue,proto3" json:"json_return_value,omitempty"`
 	Exception                              *Exception `protobuf:"bytes,15,opt,name=exception,proto3" json:"exception,omitempty"`
 	ErrorCode                              uint64     `protobuf:"varint,20,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
 	ActionOrdinal                          uint32     `protobuf:"varint,16,opt,name=action_ordinal,json=actionOrdinal,proto3" json:"action_ordinal,omitempty"`
@@ -3202,6 +3208,13 @@ func (m *ActionTrace) GetReturnValue() []byte {
 	return nil
 }
 
+func (m *ActionTrace) GetJsonReturnValue() string {
+	if m != nil {
+		return m.JsonReturnValue
+	}
+	return ""
+}
+
 func (m *ActionTrace) GetException() *Exception {
 	if m != nil {
 		return m.Exception
@@ -6000,398 +6013,397 @@ func init() {
 }
 
 var fileDescriptor_3286b8d338e80dff = []byte{
-	// 6284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7c, 0x4d, 0x70, 0x1b, 0xc9,
-	0x75, 0xb0, 0x00, 0xe2, 0xf7, 0x01, 0x24, 0x87, 0x2d, 0xfe, 0x80, 0x94, 0x28, 0x51, 0xb3, 0xbb,
-	0x5a, 0xae, 0x76, 0x45, 0xad, 0xb8, 0xbb, 0xb6, 0xd7, 0xdf, 0xae, 0xb5, 0x20, 0x00, 0x89, 0x58,
-	0x92, 0x00, 0xab, 0x01, 0x49, 0x2b, 0x7f, 0xde, 0x4c, 0x0d, 0x67, 0x9a, 0xe4, 0xac, 0x80, 0x99,
-	0xf1, 0xcc, 0x80, 0x22, 0x5d, 0x29, 0x57, 0x25, 0xbe, 0x38, 0x55, 0xf6, 0x25, 0x97, 0x54, 0x25,
-	0x87, 0xa4, 0x52, 0xbe, 0xfa, 0x10, 0x57, 0x0e, 0x89, 0x53, 0xb9, 0xe4, 0x94, 0x6b, 0x2a, 0xa7,
-	0x1c, 0x92, 0x54, 0xe5, 0x90, 0x94, 0x8f, 0x39, 0xe5, 0x9a, 0xea, 0x9f, 0xf9, 0x03, 0x06, 0x20,
-	0x21, 0xab, 0x92, 0x9c, 0x88, 0x7e, 0xfd, 0xde, 0xeb, 0xbf, 0xd7, 0xef, 0xb7, 0x87, 0xb0, 0xa1,
-	0x1f, 0x0f, 0x5c, 0xf2, 0x80, 0x58, 0xae, 0x61, 0x3d, 0xd0, 0x2c, 0x9d, 0x68, 0x0f, 0xce, 0x1e,
-	0xf2, 0x1f, 0x5b, 0xb6, 0x63, 0x79, 0x16, 0x5a, 0x64, 0x18, 0x5b, 0x0c, 0x63, 0x8b, 0x77, 0x9c,
-	0x3d, 0x5c, 0xbb, 0x7d, 0x62, 0x59, 0x27, 0x3d, 0xf2, 0x80, 0xe1, 0x1c, 0x0d, 0x8e, 0x1f, 0x78,
-	0x46, 0x9f, 0xb8, 0x9e, 0xda, 0xb7, 0x39, 0x99, 0xfc, 0x97, 0xcb, 0x90, 0xdd, 0xe9, 0x59, 0xda,
-	0x4b, 0x34, 0x07, 0x69, 0x43, 0xaf, 0xa4, 0x36, 0x52, 0x9b, 0x45, 0x9c, 0x36, 0x74, 0xb4, 0x0c,
-	0x39, 0x73, 0xd0, 0x3f, 0x22, 0x4e, 0x25, 0xbd, 0x91, 0xda, 0x9c, 0xc5, 0xa2, 0x85, 0x2a, 0x90,
-	0x3f, 0x23, 0x8e, 0x6b, 0x58, 0x66, 0x65, 0x86, 0x75, 0xf8, 0x4d, 0xf4, 0x29, 0xe4, 0x4e, 0x89,
-	0xaa, 0x13, 0xa7, 0x92, 0xd9, 0x48, 0x6d, 0x96, 0xb6, 0xef, 0x6c, 0x25, 0xcd, 0x69, 0x8b, 0x0d,
-	0xb7, 0xcb, 0x10, 0xb1, 0x20, 0x40, 0xf7, 0x01, 0xd9, 0x8e, 0xa5, 0x0f, 0x34, 0xe2, 0x28, 0xae,
-	0x71, 0x62, 0xaa, 0xde, 0xc0, 0x21, 0x95, 0x2c, 0x9b, 0xcc, 0x82, 0xdf, 0xd3, 0xf1, 0x3b, 0xd0,
-	0x97, 0x20, 0x1d, 0x51, 0x2e, 0x0a, 0x39, 0xf7, 0x88, 0x49, 0x07, 0x77, 0x2b, 0xf9, 0x8d, 0x99,
-	0xcd, 0xd2, 0xf6, 0xed, 0xe4, 0x31, 0x1b, 0x3e, 0x1e, 0x9e, 0x67, 0x84, 0x41, 0xdb, 0x45, 0x07,
-	0xf0, 0x96, 0x6e, 0x5b, 0xae, 0x62, 0x3b, 0x96, 0x6d, 0xb9, 0x44, 0x57, 0x0c, 0xc7, 0x21, 0x6c,
-	0x49, 0x47, 0x3d, 0xa2, 0x30, 0x6c, 0x73, 0xd0, 0xaf, 0x14, 0xd8, 0x5a, 0x37, 0x28, 0xea, 0xa1,
-	0xc0, 0x6c, 0x46, 0x10, 0x77, 0x04, 0x1e, 0xfa, 0x0c, 0xd6, 0x18, 0xbb, 0x64, 0x2e, 0x45, 0xc6,
-	0xa5, 0x42, 0x31, 0x12, 0xa9, 0x0f, 0xc5, 0xc2, 0x1c, 0xcb, 0xf2, 0x94, 0x3e, 0x71, 0x5e, 0xf6,
-	0x48, 0xa5, 0xc4, 0x36, 0xf3, 0x9d, 0x09, 0x9b, 0x89, 0x2d, 0xcb, 0x3b, 0x60, 0xc8, 0x62, 0x79,
-	0x4e, 0x00, 0x40, 0x27, 0xb0, 0x1a, 0xec, 0xac, 0x67, 0x29, 0x3d, 0xd5, 0xf5, 0x14, 0x01, 0xd0,
-	0x2b, 0x65, 0xb6, 0x67, 0x1f, 0x24, 0xb3, 0x3e, 0x14, 0x64, 0x5d, 0x6b, 0x5f, 0x75, 0x3d, 0xd1,
-	0xd2, 0xf1, 0xb2, 0x9d, 0x08, 0x47, 0x26, 0xdc, 0x1c, 0x19, 0xc8, 0xe8, 0xdb, 0x3d, 0x83, 0x6d,
-	0xe9, 0x51, 0x65, 0x96, 0x8d, 0xb5, 0x75, 0x95, 0xb1, 0x9a, 0x9c, 0xac, 0x89, 0x77, 0x70, 0xc5,
-	0x4e, 0xec, 0x71, 0x8e, 0xd0, 0x5b, 0x30, 0xab, 0x59, 0xe6, 0xb1, 0xe1, 0xf4, 0x15, 0xcd, 0x1a,
-	0x98, 0x5e, 0x65, 0x7e, 0x63, 0x66, 0x73, 0x16, 0x97, 0x05, 0xb0, 0x46, 0x61, 0xe8, 0x2b, 0x90,
-	0x6c, 0x62, 0xea, 0x86, 0x79, 0xa2, 0xb8, 0xda, 0x29, 0xd1, 0x07, 0x3d, 0x52, 0x91, 0xd8, 0x7e,
-	0xde, 0x1f, 0x33, 0x11, 0x8e, 0xed, 0xcf, 0xa7, 0x23, 0x88, 0xf0, 0xbc, 0x60, 0xe3, 0x03, 0x90,
-	0x05, 0x37, 0x54, 0xcd, 0x33, 0xce, 0x54, 0x8f, 0xe8, 0x0a, 0xbb, 0x4b, 0x9a, 0xd5, 0x53, 0x8e,
-	0x09, 0x13, 0x50, 0xb7, 0xb2, 0xc0, 0x06, 0x79, 0x90, 0x3c, 0x48, 0xd5, 0x27, 0x3c, 0x14, 0x74,
-	0x8f, 0x05, 0x19, 0x5e, 0x55, 0xc7, 0x75, 0xa1, 0x9b, 0x50, 0x3c, 0x53, 0x7b, 0x86, 0x4e, 0x3b,
-	0x2b, 0x68, 0x23, 0xb5, 0x59, 0xc0, 0x21, 0x00, 0x7d, 0x0e, 0xe0, 0xf4, 0x8c, 0xbe, 0xe1, 0x29,
-	0x96, 0xed, 0x56, 0xae, 0xb3, 0xbd, 0xbe, 0x95, 0x3c, 0x3a, 0x66, 0x78, 0x6d, 0x1b, 0x17, 0x1d,
-	0xf1, 0xcb, 0x45, 0x2a, 0xac, 0x0c, 0xcc, 0x63, 0xa3, 0xe7, 0x11, 0x87, 0xe8, 0x8a, 0xe7, 0xa8,
-	0xa6, 0x4b, 0x67, 0x42, 0xef, 0x55, 0x8e, 0xf1, 0xda, 0x4c, 0xe6, 0xd5, 0x0d, 0x31, 0x31, 0xd1,
-	0x88, 0x61, 0x7b, 0x78, 0x39, 0x64, 0x14, 0xe9, 0x75, 0xd1, 0xd7, 0xb0, 0x94, 0x3c, 0xc0, 0x83,
-	0x29, 0x07, 0x58, 0x4c, 0x64, 0xff, 0x05, 0xdc, 0x4c, 0x5e, 0x81, 0x90, 0x8e, 0x65, 0x76, 0xf3,
-	0xd6, 0x12, 0x27, 0xc7, 0x65, 0xe5, 0x33, 0x58, 0x9b, 0x40, 0xff, 0x21, 0xbf, 0xb9, 0x63, 0xa9,
-	0xbf, 0x81, 0xb7, 0x22, 0xe3, 0x33, 0xc1, 0xd7, 0x0c, 0x2f, 0xc6, 0x88, 0x9e, 0xcc, 0x22, 0x5b,
-	0xec, 0x8d, 0x71, 0x8b, 0x3d, 0x6f, 0xdb, 0x78, 0x23, 0xe4, 0xd3, 0x14, 0x6c, 0x22, 0xa3, 0xd1,
-	0xd3, 0x3a, 0x86, 0x3b, 0x97, 0x8f, 0xf4, 0xf0, 0xf2, 0x91, 0x6e, 0x5d, 0x32, 0xce, 0x37, 0xb0,
-	0x3e, 0x66, 0x4f, 0x3d, 0x47, 0xd5, 0x88, 0x5b, 0x59, 0x62, 0x63, 0xdc, 0xbd, 0xf4, 0xe8, 0xba,
-	0x14, 0x1d, 0xdf, 0x48, 0xdc, 0x7c, 0xd6, 0x47, 0xd7, 0x74, 0x63, 0xd2, 0x48, 0x5b, 0x53, 0x8d,
-	0xb4, 0x3a, 0x7e, 0x9c, 0x3d, 0x90, 0x27, 0xad, 0x49, 0x9c, 0xf6, 0x0a, 0x3b, 0xed, 0xdb, 0xe3,
-	0x27, 0xcc, 0x0f, 0xfd, 0x09, 0x6c, 0x5c, 0xca, 0xea, 0x7d, 0xc6, 0x6a, 0x7d, 0x32, 0x23, 0x0c,
-	0x77, 0x23, 0xb3, 0x22, 0xe7, 0x44, 0x1b, 0x50, 0xbd, 0x62, 0x98, 0xf6, 0xc0, 0x53, 0x62, 0x72,
-	0x58, 0x61, 0xec, 0x22, 0x6b, 0x68, 0x08, 0xe4, 0x26, 0xc5, 0xad, 0x46, 0x24, 0xb2, 0x05, 0x6f,
-	0x5f, 0x89, 0xe3, 0x07, 0xdc, 0xb2, 0x5d, 0xca, 0x6f, 0xcc, 0x1c, 0x3d, 0xcb, 0x53, 0x7b, 0x71,
-	0x8e, 0xab, 0xe3, 0xe6, 0xd8, 0xa5, 0xb8, 0x97, 0xce, 0x31, 0x81, 0xe3, 0xfd, 0xe4, 0x39, 0x8e,
-	0xf0, 0xbb, 0x07, 0x0b, 0xdc, 0x31, 0xa0, 0x4e, 0x04, 0xd5, 0xfa, 0x2f, 0xc9, 0x45, 0x65, 0x8e,
-	0xb9, 0x11, 0xdc, 0x32, 0x76, 0x38, 0x7c, 0x8f, 0x5c, 0xa0, 0x2e, 0x20, 0xa6, 0x6d, 0x49, 0x60,
-	0x1a, 0x94, 0xb3, 0x87, 0x15, 0x60, 0x8a, 0xfb, 0xee, 0x64, 0x33, 0x15, 0x98, 0x05, 0x89, 0x73,
-	0xf0, 0xdb, 0xcf, 0x1e, 0x22, 0x17, 0x36, 0x98, 0x56, 0x56, 0xe2, 0xf3, 0x50, 0x07, 0xde, 0xa9,
-	0xe5, 0x18, 0xde, 0x85, 0x72, 0xb6, 0x5d, 0xb9, 0xc5, 0xc6, 0x78, 0x7f, 0x82, 0x45, 0x17, 0xd3,
-	0xac, 0xfa, 0x54, 0xf8, 0x26, 0x63, 0x9a, 0xd8, 0xf7, 0x6c, 0x1b, 0x7d, 0x9d, 0xb0, 0x94, 0xed,
-	0xca, 0xed, 0x49, 0x36, 0xc8, 0x5f, 0x4a, 0xc0, 0x66, 0xec, 0x9a, 0xb6, 0xd1, 0xfb, 0xb0, 0xc0,
-	0x77, 0x9e, 0xad, 0xc4, 0x66, 0x26, 0xb8, 0xb2, 0xc9, 0x4c, 0x90, 0x14, 0x74, 0x54, 0x39, 0x1c,
-	0x55, 0x61, 0x3d, 0x44, 0x36, 0x4c, 0xad, 0x37, 0xd0, 0x89, 0xc2, 0x21, 0x0a, 0x39, 0xb7, 0x9d,
-	0xca, 0x7b, 0xec, 0x38, 0xd6, 0x02, 0xa4, 0x26, 0xc7, 0x79, 0xcc, 0xda, 0x8d, 0x73, 0xdb, 0x89,
-	0xb3, 0x20, 0xe7, 0xa3, 0x2c, 0xee, 0x0d, 0xb1, 0x68, 0x9c, 0x0f, 0xb3, 0xf8, 0x1a, 0x3e, 0x08,
-	0x59, 0xb8, 0x17, 0xae, 0x47, 0xfa, 0x42, 0xa2, 0xdc, 0xc4, 0x49, 0x6d, 0x33, 0x8e, 0xef, 0x06,
-	0x34, 0x1d, 0x46, 0xc2, 0x45, 0xcb, 0x1d, 0x99, 0xa1, 0xfc, 0xd3, 0x19, 0x98, 0x65, 0x87, 0xf1,
-	0xdc, 0xf0, 0x4e, 0x31, 0x39, 0x76, 0x47, 0xdc, 0xe7, 0x87, 0x90, 0x65, 0x12, 0xc0, 0xbc, 0xe7,
-	0xb1, 0x7a, 0x98, 0xbb, 0x6f, 0x1c, 0x13, 0xa9, 0xb0, 0x9a, 0xa8, 0xcd, 0x1d, 0x72, 0xec, 0x32,
-	0x5f, 0x7b, 0xac, 0x17, 0x18, 0xb3, 0x92, 0xc7, 0x2e, 0x5e, 0x31, 0x46, 0x15, 0x3a, 0x9b, 0xe5,
-	0x21, 0x48, 0x23, 0x9c, 0x33, 0xd3, 0x70, 0x9e, 0xf7, 0x86, 0x38, 0xfe, 0x7f, 0x58, 0x1e, 0xd5,
-	0x7c, 0x8c, 0x6f, 0x76, 0x1a, 0xbe, 0x8b, 0xde, 0xb0, 0x0e, 0xa7, 0xcc, 0x65, 0x28, 0x47, 0xfd,
-	0xe8, 0x4a, 0x8e, 0xc9, 0x5c, 0x0c, 0x26, 0xbf, 0x07, 0xf3, 0xc3, 0xab, 0x5c, 0x86, 0xdc, 0xa9,
-	0xea, 0x9e, 0x12, 0xb7, 0x92, 0xda, 0x98, 0xd9, 0x2c, 0x63, 0xd1, 0x92, 0x77, 0x61, 0x75, 0xac,
-	0xeb, 0x45, 0x85, 0x7c, 0xd4, 0x8d, 0xe3, 0xf4, 0x92, 0x3d, 0x84, 0x2c, 0xff, 0x24, 0x0d, 0x2b,
-	0x63, 0x5c, 0x45, 0xb4, 0x09, 0x52, 0x70, 0x0b, 0x7b, 0xc6, 0x91, 0x42, 0xfd, 0xfe, 0x14, 0xd3,
-	0x5f, 0x73, 0x3e, 0x7c, 0xdf, 0x38, 0x6a, 0x0d, 0xfa, 0xd4, 0x85, 0x0d, 0x30, 0xe9, 0x14, 0x99,
-	0xac, 0x94, 0x71, 0xd9, 0x07, 0xee, 0xaa, 0xee, 0x29, 0x7a, 0x02, 0xa5, 0xa8, 0x7e, 0x9a, 0x99,
-	0x4a, 0x3f, 0x81, 0x1b, 0x6a, 0xa6, 0xc3, 0x28, 0xa3, 0x6d, 0x71, 0xec, 0x53, 0x6b, 0x87, 0x90,
-	0xe3, 0xb6, 0xdc, 0x07, 0x69, 0x64, 0xf5, 0x91, 0xf0, 0x30, 0x15, 0x0f, 0x0f, 0x1f, 0x41, 0xd1,
-	0x77, 0xe6, 0xdd, 0x4a, 0x9a, 0xd9, 0xf3, 0x3b, 0x93, 0x47, 0xdf, 0x23, 0x17, 0x38, 0xa4, 0x91,
-	0x7f, 0x00, 0xa5, 0x48, 0x0f, 0xba, 0x03, 0x65, 0x55, 0x63, 0xe6, 0x41, 0x31, 0xd5, 0x3e, 0x11,
-	0x77, 0xaf, 0x24, 0x60, 0x2d, 0xb5, 0x4f, 0x92, 0xcd, 0x41, 0x3a, 0xd1, 0x1c, 0xc8, 0xbf, 0x0b,
-	0xab, 0x63, 0x57, 0x3d, 0x61, 0x55, 0x8d, 0xd1, 0x55, 0xbd, 0x7b, 0xc5, 0x3d, 0x8d, 0xae, 0xed,
-	0x4f, 0x52, 0xb0, 0x30, 0x82, 0x70, 0x95, 0x25, 0x6a, 0xb0, 0x32, 0xc6, 0xd2, 0x08, 0xcd, 0x33,
-	0x95, 0x99, 0x59, 0x3a, 0x4a, 0x02, 0xcb, 0x1a, 0x2c, 0x25, 0xe2, 0xa3, 0x47, 0x90, 0x3e, 0xfb,
-	0x90, 0x4d, 0x6b, 0x6c, 0x44, 0x95, 0x6c, 0xb3, 0x3e, 0xdc, 0xbd, 0x86, 0xd3, 0x67, 0x1f, 0xee,
-	0x14, 0x21, 0x7f, 0xa6, 0x3a, 0x86, 0x6a, 0x7a, 0x72, 0x0f, 0x56, 0xc6, 0xe0, 0xd2, 0xd8, 0xc7,
-	0x3b, 0x75, 0x88, 0x7b, 0x6a, 0xf5, 0x74, 0x71, 0x00, 0x21, 0x00, 0x7d, 0x04, 0x99, 0x97, 0xe4,
-	0xc2, 0xdf, 0xfd, 0x31, 0x19, 0x80, 0x3d, 0x72, 0xf1, 0x9c, 0x18, 0x27, 0xa7, 0x1e, 0x66, 0xc8,
-	0x72, 0x07, 0xe6, 0x87, 0x62, 0x67, 0xb4, 0x0e, 0x60, 0x5a, 0xba, 0xef, 0xb7, 0x89, 0x61, 0x28,
-	0x84, 0xfb, 0x16, 0xec, 0x30, 0x98, 0x91, 0xa5, 0x30, 0x3e, 0x5c, 0x99, 0x1e, 0x06, 0x85, 0xb5,
-	0x28, 0x48, 0xd6, 0x60, 0x39, 0x39, 0x6a, 0x46, 0x08, 0x32, 0x91, 0x13, 0x64, 0xbf, 0xd1, 0x27,
-	0xb0, 0xc2, 0xa2, 0x64, 0x7e, 0x7e, 0xe6, 0xa0, 0x1f, 0x06, 0xe6, 0x3c, 0xe5, 0xb2, 0x48, 0xbb,
-	0xd9, 0x2c, 0x5b, 0x83, 0xbe, 0xcf, 0x4a, 0x26, 0x50, 0x19, 0x17, 0x2e, 0xbf, 0xc9, 0x61, 0x7e,
-	0x95, 0x06, 0x34, 0x1a, 0x7d, 0x09, 0x3b, 0x97, 0x09, 0xec, 0xdc, 0x22, 0x64, 0x0d, 0x53, 0x27,
-	0xe7, 0x4c, 0x37, 0x67, 0x30, 0x6f, 0xa0, 0x47, 0x90, 0x73, 0x3d, 0xd5, 0x1b, 0xb8, 0x6c, 0x26,
-	0x73, 0xe3, 0xae, 0x44, 0x84, 0x7f, 0x87, 0xa1, 0x63, 0x41, 0x46, 0x27, 0xad, 0xd9, 0x03, 0x65,
-	0xe0, 0xaa, 0x27, 0x44, 0xe9, 0x1b, 0x9a, 0x63, 0x29, 0x2e, 0xd1, 0x2c, 0x53, 0x77, 0xfd, 0x49,
-	0x6b, 0xf6, 0xe0, 0x29, 0xed, 0x3d, 0xa0, 0x9d, 0x1d, 0xde, 0x87, 0xee, 0xc2, 0xbc, 0x49, 0x3c,
-	0x41, 0xf6, 0xca, 0x72, 0x74, 0x57, 0x24, 0xa9, 0x66, 0x4d, 0xe2, 0x31, 0xf4, 0xe7, 0x14, 0x88,
-	0x9e, 0x01, 0xb2, 0x55, 0xed, 0x65, 0xdc, 0x6d, 0x17, 0x16, 0x6b, 0xdc, 0xf5, 0x65, 0xf8, 0xd1,
-	0x1d, 0x59, 0xb0, 0x87, 0x41, 0xf2, 0xdf, 0xd2, 0x6b, 0x3c, 0x0c, 0x45, 0xb7, 0x00, 0x82, 0xa4,
-	0x16, 0xb7, 0x29, 0x45, 0x1c, 0x81, 0xa0, 0x0d, 0x28, 0x69, 0x56, 0xdf, 0x76, 0x88, 0xcb, 0x34,
-	0x0c, 0x5f, 0x60, 0x14, 0x84, 0xbe, 0x0d, 0x15, 0x31, 0x5f, 0xcd, 0x32, 0x3d, 0x72, 0xee, 0x29,
-	0xc7, 0x0e, 0x21, 0x8a, 0xae, 0x7a, 0x2a, 0x5b, 0x60, 0x19, 0x2f, 0xf1, 0xfe, 0x1a, 0xef, 0x7e,
-	0xec, 0x10, 0x52, 0x57, 0x3d, 0x95, 0x25, 0xd6, 0x46, 0x17, 0x9a, 0x61, 0x24, 0x09, 0xf3, 0xff,
-	0xab, 0x19, 0x28, 0x45, 0xf2, 0x73, 0xe8, 0x3b, 0x50, 0x0c, 0x32, 0x86, 0xc2, 0xf4, 0xac, 0x6d,
-	0xf1, 0x9c, 0xe2, 0x96, 0x9f, 0x53, 0xdc, 0xea, 0xfa, 0x18, 0x38, 0x44, 0x46, 0x6b, 0x50, 0xf0,
-	0xb5, 0x9b, 0x90, 0x96, 0xa0, 0x4d, 0xaf, 0xb3, 0xc8, 0xd2, 0x10, 0x9d, 0x6d, 0xfa, 0x2c, 0x0e,
-	0x01, 0x9c, 0x92, 0x9c, 0x19, 0xd6, 0xc0, 0x65, 0x42, 0xc5, 0x28, 0x79, 0x9b, 0x1a, 0xe9, 0xa8,
-	0xb7, 0xd1, 0x77, 0x2c, 0xcb, 0xab, 0xe4, 0xd9, 0x6a, 0xa2, 0x8e, 0xcd, 0x01, 0x85, 0xfb, 0x17,
-	0x36, 0xc0, 0x2b, 0x30, 0xbc, 0x52, 0x14, 0xe5, 0xbd, 0x88, 0xad, 0xf6, 0x15, 0x3c, 0xcf, 0xd1,
-	0xcd, 0x07, 0x76, 0x4e, 0x28, 0xfa, 0x7d, 0x58, 0xe0, 0xc9, 0xca, 0x68, 0xd2, 0xb1, 0x74, 0xb5,
-	0xa4, 0xa3, 0xc4, 0x29, 0x23, 0x59, 0xc7, 0x43, 0x90, 0x4c, 0xf2, 0x4a, 0x09, 0x0c, 0xc0, 0xf4,
-	0xa1, 0xc7, 0x9c, 0x49, 0x5e, 0xf9, 0x40, 0xf7, 0xd9, 0x43, 0xf9, 0x0f, 0x00, 0xa4, 0xc8, 0x51,
-	0x36, 0xce, 0x88, 0xe9, 0x8d, 0x78, 0xa5, 0xab, 0x50, 0xe0, 0x6a, 0xc0, 0xd0, 0x85, 0x1d, 0xcc,
-	0xb3, 0x76, 0x53, 0x47, 0x37, 0xa0, 0x18, 0x68, 0x08, 0x71, 0x69, 0x38, 0x2e, 0xf5, 0x54, 0x86,
-	0x1d, 0xb1, 0xcc, 0xa8, 0x23, 0x86, 0x08, 0x2c, 0x18, 0xa6, 0x47, 0x1c, 0x93, 0x06, 0x6f, 0xba,
-	0x6e, 0x44, 0xae, 0xd4, 0xb7, 0x2e, 0xbd, 0xfe, 0x6c, 0xba, 0x5b, 0x55, 0x5d, 0xa7, 0x81, 0x27,
-	0x67, 0xd2, 0xbb, 0xd8, 0xbd, 0x86, 0x25, 0x9f, 0x65, 0x55, 0x70, 0x44, 0x5f, 0x42, 0x21, 0xe0,
-	0x9e, 0x63, 0xdc, 0x3f, 0x98, 0x86, 0xfb, 0xee, 0x35, 0x1c, 0xd0, 0xa3, 0x36, 0x14, 0x79, 0xd4,
-	0x49, 0x99, 0xe5, 0x27, 0x39, 0x44, 0x23, 0xcc, 0xfc, 0x10, 0x74, 0xf7, 0x1a, 0x0e, 0x79, 0x20,
-	0x05, 0xe6, 0x75, 0xcf, 0x39, 0xf7, 0xc3, 0x30, 0xc3, 0x3c, 0x61, 0x52, 0x57, 0xda, 0xfe, 0xf8,
-	0x8a, 0x6c, 0xeb, 0x9e, 0x73, 0xee, 0x1f, 0x31, 0xe5, 0x3d, 0xa7, 0x87, 0x00, 0xc3, 0x3c, 0x41,
-	0x47, 0xb0, 0xc0, 0x06, 0xd0, 0x54, 0x53, 0x23, 0xbd, 0x9e, 0xea, 0xf9, 0x12, 0x5b, 0xda, 0xfe,
-	0x68, 0x8a, 0x21, 0x6a, 0x8c, 0x9c, 0x8d, 0x20, 0xe9, 0x41, 0x9b, 0xb3, 0x5b, 0xfb, 0x01, 0xcc,
-	0x0f, 0x1d, 0x04, 0x6a, 0x42, 0x29, 0xaa, 0x3f, 0x52, 0x93, 0x14, 0x25, 0xb5, 0xdf, 0x71, 0x45,
-	0x19, 0xa5, 0x5d, 0xfb, 0xa7, 0x14, 0x64, 0x19, 0x7b, 0xb4, 0x03, 0x79, 0x87, 0x5b, 0x15, 0xc1,
-	0xf0, 0xea, 0x39, 0x40, 0x9f, 0x70, 0x78, 0x62, 0xe9, 0xd7, 0x9f, 0x18, 0xaa, 0x42, 0xc9, 0x1e,
-	0x1c, 0xf5, 0x0c, 0x4d, 0x61, 0xde, 0x04, 0xd7, 0x76, 0x1b, 0x63, 0x6e, 0x23, 0x43, 0xdc, 0x23,
-	0x17, 0x2e, 0x06, 0x3b, 0xf8, 0xbd, 0xf6, 0xf3, 0x14, 0x14, 0x7c, 0xc1, 0x40, 0x9f, 0x41, 0x96,
-	0x45, 0x43, 0x62, 0x71, 0x57, 0xcd, 0x5d, 0x71, 0x22, 0x54, 0x83, 0xd2, 0x51, 0xa8, 0x88, 0xc5,
-	0xc2, 0xae, 0x50, 0x51, 0x89, 0x52, 0xad, 0xfd, 0x71, 0x0a, 0x66, 0x63, 0x12, 0x85, 0xbe, 0x07,
-	0xa0, 0x39, 0x84, 0x25, 0xad, 0x8f, 0x2e, 0xc4, 0xcc, 0xc6, 0xab, 0xaf, 0x3a, 0xcf, 0x13, 0x16,
-	0x05, 0xc9, 0xce, 0xc5, 0x1b, 0xdc, 0xef, 0xb5, 0x43, 0x28, 0x47, 0x45, 0x11, 0x7d, 0x01, 0x25,
-	0x4d, 0xfc, 0x9e, 0x62, 0x6e, 0xe0, 0xd3, 0xec, 0x5c, 0xec, 0xe4, 0x21, 0x4b, 0xa8, 0x88, 0xcb,
-	0xf7, 0x01, 0xc2, 0x13, 0x42, 0xb7, 0xe3, 0x07, 0x2b, 0xec, 0x6f, 0x78, 0x6c, 0xf2, 0x2f, 0x72,
-	0xb0, 0x18, 0x99, 0xe6, 0xbe, 0x71, 0x4c, 0xb4, 0x0b, 0xad, 0x47, 0x46, 0xd4, 0xe7, 0x33, 0x40,
-	0x51, 0xf3, 0x23, 0x5c, 0x9c, 0xf4, 0x74, 0x2e, 0x4e, 0xd4, 0x82, 0x71, 0x10, 0x7a, 0x01, 0xd7,
-	0xe3, 0x61, 0x39, 0xbf, 0x15, 0x6f, 0x4f, 0x79, 0x2b, 0x90, 0x37, 0xea, 0xaf, 0x0d, 0x1d, 0x18,
-	0xfc, 0x16, 0x17, 0x64, 0x68, 0x1f, 0xaf, 0x0f, 0xef, 0x23, 0x6a, 0xc3, 0x7c, 0xa0, 0x0a, 0x79,
-	0x26, 0x40, 0x14, 0xaf, 0xae, 0x2a, 0xfb, 0x73, 0x01, 0x39, 0x6b, 0xa3, 0xe7, 0xb0, 0x1c, 0x32,
-	0xe4, 0xd6, 0x49, 0x54, 0x18, 0xcb, 0x57, 0xbd, 0x0f, 0x8b, 0x01, 0x83, 0xa8, 0x5f, 0x13, 0xbf,
-	0x06, 0x8b, 0x53, 0x5f, 0x83, 0x21, 0x59, 0x5d, 0x9a, 0x5a, 0x56, 0xd1, 0x47, 0xb0, 0xc4, 0xd8,
-	0xd1, 0x95, 0xc5, 0x4c, 0xeb, 0x1d, 0x66, 0x5a, 0x17, 0xfd, 0xce, 0x68, 0x99, 0x10, 0x7d, 0x12,
-	0xdd, 0x8f, 0x18, 0x95, 0xcc, 0xa8, 0x96, 0x82, 0xde, 0x18, 0xd9, 0xa7, 0x50, 0xe1, 0x23, 0x27,
-	0x0c, 0xf7, 0x16, 0x23, 0x5c, 0x89, 0xf4, 0x47, 0x49, 0xbf, 0xcc, 0x14, 0x66, 0xa5, 0xeb, 0x5f,
-	0x66, 0x0a, 0xcb, 0xd2, 0x1d, 0xf9, 0x17, 0x29, 0x58, 0x18, 0x11, 0x11, 0xaa, 0xa8, 0x46, 0x4d,
-	0xc3, 0x9d, 0xcb, 0x65, 0x36, 0x26, 0x5a, 0x71, 0x0f, 0x39, 0x3d, 0xe2, 0x21, 0xdf, 0x83, 0x85,
-	0x24, 0xc7, 0x97, 0x06, 0x60, 0xf3, 0x5a, 0xdc, 0xe5, 0x95, 0xff, 0x28, 0x0d, 0xa5, 0xe8, 0x04,
-	0x1f, 0x05, 0x65, 0xe9, 0x89, 0x66, 0x2b, 0x42, 0x32, 0x54, 0x9c, 0x6e, 0xc1, 0x62, 0x6c, 0x70,
-	0xbf, 0x70, 0xc5, 0xe3, 0xcd, 0x9b, 0xe3, 0x6b, 0x7c, 0x96, 0x89, 0x51, 0x64, 0x76, 0x22, 0x9b,
-	0x88, 0xbe, 0x05, 0x79, 0x9f, 0xc5, 0xcc, 0x15, 0x58, 0xf8, 0xc8, 0xe8, 0x11, 0x40, 0xc4, 0xf5,
-	0xcc, 0x5c, 0xcd, 0xf5, 0x8c, 0x90, 0xc8, 0x7f, 0x98, 0x86, 0x85, 0x91, 0x65, 0xa2, 0xef, 0x52,
-	0xb6, 0xb6, 0xe1, 0xa8, 0x91, 0xf3, 0x9b, 0xe4, 0xe4, 0x47, 0xb0, 0x91, 0x0c, 0xb3, 0x0e, 0x39,
-	0x0e, 0x43, 0x4b, 0x3f, 0x76, 0x71, 0xc8, 0xb1, 0x1f, 0x50, 0xa2, 0x4d, 0x90, 0x42, 0x1c, 0xdb,
-	0x21, 0xc7, 0xc6, 0xb9, 0xf0, 0x2f, 0xe7, 0x7c, 0xb4, 0x43, 0x06, 0x45, 0xf7, 0xe1, 0x7a, 0x5f,
-	0x3d, 0x57, 0x86, 0x23, 0xb8, 0x0c, 0x43, 0x96, 0xfa, 0xea, 0x79, 0x2b, 0x16, 0xc4, 0xbd, 0x0b,
-	0x14, 0xa6, 0x44, 0xe2, 0x44, 0x57, 0x44, 0x13, 0xb3, 0x7d, 0xf5, 0xbc, 0xe6, 0xc7, 0x87, 0x2e,
-	0x75, 0x6d, 0x75, 0xd2, 0x53, 0x2f, 0x68, 0x08, 0xc9, 0x7c, 0xc6, 0x59, 0x5c, 0x60, 0x80, 0x0e,
-	0xd1, 0xe4, 0xbf, 0x2b, 0xc6, 0xfc, 0x66, 0xae, 0x78, 0x86, 0x15, 0x7f, 0xcc, 0x39, 0x4e, 0xb3,
-	0x48, 0x37, 0x74, 0x8e, 0x83, 0x10, 0x78, 0x2d, 0x1a, 0x02, 0x7f, 0x0a, 0xc0, 0x49, 0x68, 0x4c,
-	0x74, 0x95, 0xd8, 0x89, 0x61, 0xd3, 0x36, 0x95, 0xf6, 0xa0, 0x94, 0x1e, 0xb8, 0xeb, 0x3c, 0x88,
-	0x9a, 0xf7, 0x3b, 0x76, 0x84, 0xdb, 0xbe, 0x1b, 0x3a, 0x51, 0xdc, 0xd7, 0xde, 0xba, 0xaa, 0xb9,
-	0x10, 0x52, 0x1e, 0xb8, 0x52, 0x15, 0xc8, 0x93, 0x9e, 0x6a, 0xbb, 0x44, 0x67, 0x7b, 0x34, 0x83,
-	0xfd, 0x26, 0x5d, 0x7d, 0x70, 0x26, 0xcc, 0x4d, 0xce, 0xe0, 0x82, 0x1f, 0x4f, 0xd3, 0x60, 0xce,
-	0x0f, 0x95, 0x74, 0xe6, 0xec, 0x16, 0x70, 0x08, 0x40, 0x8f, 0x61, 0x36, 0x5e, 0xc8, 0x2b, 0x4e,
-	0x4a, 0xfc, 0x55, 0x23, 0xb6, 0xa0, 0x1c, 0x2b, 0xdb, 0x61, 0x58, 0x38, 0x56, 0x0d, 0xaa, 0x6e,
-	0x99, 0xfb, 0xcb, 0x8d, 0x0b, 0x4c, 0x65, 0x5c, 0xe6, 0x39, 0x03, 0xea, 0x73, 0xf0, 0x43, 0xfe,
-	0x9c, 0x7a, 0xff, 0x1a, 0xb1, 0x99, 0xdc, 0xcf, 0x4f, 0x56, 0xe1, 0x02, 0x0d, 0x87, 0x14, 0x68,
-	0x1d, 0x80, 0x38, 0x8e, 0xe5, 0x28, 0x14, 0x8d, 0xbd, 0x2a, 0xc8, 0xe0, 0x22, 0x83, 0xd4, 0x2c,
-	0x9d, 0xa0, 0x87, 0x90, 0xd3, 0x8f, 0x58, 0x25, 0x76, 0x81, 0x2d, 0x79, 0x2d, 0x99, 0x75, 0x7d,
-	0xa7, 0x6d, 0xe3, 0xac, 0x7e, 0xd4, 0xb6, 0x5d, 0x4a, 0xf2, 0xf2, 0x8c, 0x91, 0xdc, 0x9a, 0x44,
-	0xb2, 0xf7, 0x8c, 0x92, 0xbc, 0x3c, 0xa3, 0x24, 0xdf, 0x86, 0x02, 0xdb, 0x10, 0x4a, 0x84, 0x26,
-	0x29, 0x13, 0x61, 0x82, 0xf2, 0x14, 0x9b, 0x12, 0x7e, 0x01, 0x25, 0x91, 0xe5, 0x8e, 0xbc, 0x18,
-	0x18, 0xb3, 0x7c, 0x91, 0xf6, 0xa6, 0x16, 0xec, 0xd8, 0xff, 0xc9, 0x86, 0xb6, 0x89, 0xd3, 0x8f,
-	0x94, 0xb5, 0x6f, 0x8e, 0x7b, 0x53, 0xe1, 0xf4, 0xe9, 0xd0, 0x36, 0xfb, 0xeb, 0xa2, 0x8f, 0x21,
-	0xef, 0xa8, 0x9c, 0x6e, 0x69, 0x52, 0x91, 0x1a, 0x57, 0x0f, 0xda, 0x36, 0xce, 0x39, 0x2a, 0xa3,
-	0xea, 0x00, 0xa2, 0x54, 0x9a, 0xe5, 0x38, 0x24, 0xac, 0x72, 0x2f, 0x33, 0x06, 0xef, 0x8c, 0x65,
-	0x50, 0x0b, 0xd0, 0xdb, 0x36, 0x96, 0x1c, 0xb5, 0x1f, 0x05, 0xb8, 0x43, 0xcf, 0x26, 0x56, 0xa6,
-	0x7d, 0x36, 0xf1, 0x5d, 0x28, 0x7a, 0xea, 0x51, 0x8f, 0x6f, 0x61, 0x85, 0x51, 0xaf, 0x8f, 0x91,
-	0x46, 0x8a, 0xd6, 0xb6, 0x71, 0xc1, 0xe3, 0x3f, 0x5c, 0xb4, 0x07, 0xb3, 0x81, 0x03, 0xe0, 0x39,
-	0x84, 0x54, 0x56, 0x27, 0x95, 0xb8, 0x6b, 0x02, 0xf5, 0x71, 0x4f, 0xf5, 0x5a, 0x96, 0x4e, 0x70,
-	0xd9, 0x27, 0xee, 0x3a, 0x84, 0xc8, 0xbf, 0x4e, 0x41, 0x65, 0xdc, 0x0d, 0xff, 0xbf, 0x9e, 0x8c,
-	0x93, 0xff, 0x26, 0x05, 0x39, 0x7e, 0xf3, 0xa9, 0x0e, 0x12, 0xc9, 0x6d, 0xa1, 0x7c, 0xfd, 0x66,
-	0x90, 0xd9, 0x4c, 0x47, 0x32, 0x9b, 0x7b, 0x30, 0x2b, 0xb2, 0xdd, 0x3f, 0xe2, 0xc6, 0x6b, 0x66,
-	0x92, 0x34, 0x50, 0x31, 0x34, 0x58, 0x3a, 0x6d, 0x9f, 0x9c, 0x91, 0x1e, 0x8e, 0xd3, 0x52, 0x25,
-	0xf7, 0x8d, 0x6b, 0x99, 0xdc, 0xb5, 0x10, 0x19, 0x2b, 0x0a, 0x60, 0x69, 0xb4, 0x55, 0x28, 0x38,
-	0xea, 0x2b, 0xde, 0x97, 0x65, 0x69, 0xa4, 0xbc, 0xa3, 0xbe, 0x62, 0xee, 0xc6, 0x2f, 0x0b, 0x50,
-	0x8a, 0xe8, 0x2d, 0xb4, 0x06, 0x05, 0xa6, 0x51, 0xcf, 0x88, 0xc3, 0xbc, 0xdf, 0x22, 0x0e, 0xda,
-	0xe8, 0xf3, 0xe1, 0x88, 0xf7, 0xad, 0x89, 0x96, 0x7f, 0x38, 0xd8, 0xfd, 0x18, 0x72, 0xb1, 0xb8,
-	0x6b, 0xb2, 0xdf, 0x20, 0x70, 0xd1, 0x1d, 0x28, 0x47, 0xdd, 0x17, 0x76, 0x06, 0x05, 0x5c, 0x8a,
-	0x38, 0x26, 0x51, 0xd5, 0x9f, 0x89, 0xab, 0xfe, 0x0a, 0xe4, 0x35, 0xcb, 0x74, 0xad, 0x9e, 0xff,
-	0x1a, 0xcf, 0x6f, 0xa2, 0x77, 0x60, 0x2e, 0x1a, 0xb3, 0x18, 0xba, 0x48, 0xd6, 0xcd, 0x46, 0xa0,
-	0xc3, 0x69, 0xa5, 0xfc, 0x90, 0xe5, 0x4c, 0x34, 0x74, 0x85, 0x64, 0x43, 0x17, 0xb7, 0xa7, 0xc5,
-	0x69, 0xec, 0x29, 0x86, 0xeb, 0x7e, 0x19, 0x45, 0x37, 0xdc, 0x97, 0x8a, 0x4e, 0x7a, 0x9e, 0xea,
-	0x56, 0x36, 0x99, 0xb4, 0xc8, 0xe3, 0x36, 0x91, 0x11, 0xd4, 0x29, 0x2a, 0x5e, 0x10, 0xe4, 0x75,
-	0xc3, 0x7d, 0xc9, 0x20, 0x4c, 0x1d, 0xf9, 0x3c, 0xa9, 0x5a, 0x12, 0x2c, 0x61, 0x92, 0x00, 0x0a,
-	0x96, 0xb8, 0x7a, 0xc0, 0xb9, 0x4a, 0x82, 0x01, 0x56, 0xfb, 0x82, 0xe9, 0x1d, 0x28, 0x3b, 0xc4,
-	0x1b, 0x38, 0xa6, 0x72, 0xa6, 0xf6, 0x06, 0x84, 0x95, 0xca, 0xcb, 0xd4, 0x9b, 0xa2, 0xb0, 0x67,
-	0x14, 0xf4, 0x66, 0x8d, 0xd6, 0xe2, 0xb0, 0xd1, 0x7a, 0x07, 0xe6, 0xfc, 0x27, 0x44, 0x8e, 0x6e,
-	0x98, 0x6a, 0x8f, 0xd9, 0xb5, 0x59, 0x2c, 0x8c, 0x78, 0x9b, 0x03, 0xd1, 0xc7, 0xb0, 0xcc, 0xd4,
-	0x8f, 0xe5, 0x28, 0x43, 0xe8, 0x0b, 0x42, 0x1f, 0xf0, 0xde, 0x6a, 0x8c, 0xea, 0xfb, 0x70, 0x4f,
-	0xeb, 0x59, 0x2e, 0x71, 0x3d, 0x65, 0x60, 0x9a, 0x96, 0x67, 0x1c, 0x1b, 0x44, 0x57, 0x68, 0xe0,
-	0xe1, 0x26, 0x70, 0x42, 0x8c, 0xd3, 0x5d, 0x41, 0xf1, 0x34, 0x20, 0xa8, 0x0a, 0xfc, 0x38, 0xef,
-	0x77, 0xa3, 0xa1, 0x27, 0xf7, 0xc6, 0xae, 0x73, 0x1f, 0x33, 0x0c, 0x89, 0x98, 0x5b, 0x16, 0x7b,
-	0xcb, 0xd0, 0x57, 0x3d, 0xea, 0xa9, 0xb0, 0x07, 0x19, 0xd1, 0xb7, 0x0c, 0x07, 0x1c, 0x8e, 0x30,
-	0xdc, 0x1d, 0x41, 0x8e, 0xbf, 0x26, 0x10, 0x8f, 0x08, 0xd8, 0x5b, 0x8b, 0x02, 0x96, 0x87, 0x39,
-	0x44, 0x9f, 0x11, 0xf0, 0xe7, 0x03, 0xf2, 0x9f, 0xa7, 0x61, 0x36, 0x76, 0xbf, 0x63, 0x1a, 0x23,
-	0x35, 0xa4, 0x31, 0x96, 0x21, 0xa7, 0x1b, 0x27, 0xc4, 0xf5, 0x84, 0xe2, 0x13, 0x2d, 0xba, 0xde,
-	0x93, 0x9e, 0x75, 0xa4, 0xf6, 0x14, 0x97, 0xfc, 0x70, 0x40, 0x4c, 0x8d, 0xdf, 0xeb, 0x0c, 0x9e,
-	0xe3, 0xe0, 0x8e, 0x80, 0xa2, 0x27, 0x5c, 0x47, 0x86, 0x68, 0x99, 0x89, 0x52, 0x3f, 0xf0, 0x4e,
-	0x7d, 0x52, 0x5c, 0x56, 0x23, 0x2d, 0xf4, 0x16, 0x75, 0xf5, 0xb5, 0xb3, 0x90, 0x51, 0x96, 0x8d,
-	0x57, 0xa6, 0xc0, 0x28, 0x12, 0xe5, 0x15, 0x22, 0xf1, 0xaa, 0x50, 0x99, 0x02, 0x03, 0xa4, 0x3b,
-	0x50, 0x56, 0x8f, 0x8c, 0x10, 0x87, 0x6b, 0x85, 0x92, 0x7a, 0x64, 0xf8, 0x28, 0xf2, 0x01, 0x94,
-	0xa3, 0x53, 0xb9, 0x4a, 0x21, 0x74, 0x0d, 0x0a, 0x01, 0x47, 0xe1, 0xa1, 0xfb, 0x6d, 0xb9, 0x0a,
-	0xf3, 0x43, 0x97, 0x6f, 0x82, 0xa5, 0x59, 0x84, 0x2c, 0xbb, 0xcd, 0x8c, 0xcb, 0x0c, 0xe6, 0x0d,
-	0xf9, 0x7b, 0x50, 0x8e, 0xaa, 0x84, 0xa9, 0xe9, 0x3f, 0x82, 0x62, 0x10, 0x94, 0x51, 0x63, 0xe6,
-	0x5d, 0xd8, 0x44, 0xd4, 0x18, 0xd9, 0x6f, 0x0a, 0x63, 0xe6, 0x85, 0xbf, 0x01, 0x60, 0xbf, 0xe5,
-	0x9f, 0xa5, 0x21, 0xcb, 0xfc, 0x36, 0x54, 0x83, 0xa2, 0x65, 0x93, 0x48, 0x8c, 0x36, 0x37, 0xfe,
-	0x65, 0xc5, 0x79, 0xdb, 0xde, 0x6a, 0xfb, 0xc8, 0x38, 0xa4, 0x4b, 0xb4, 0xa1, 0xa3, 0x6a, 0x7c,
-	0x26, 0x49, 0x8d, 0x0f, 0xa5, 0x91, 0x32, 0xaf, 0x9f, 0x46, 0x92, 0xbf, 0x03, 0xc5, 0x60, 0x76,
-	0x68, 0x09, 0x16, 0xda, 0x87, 0x0d, 0x5c, 0xed, 0x36, 0xdb, 0x2d, 0xe5, 0x69, 0x6b, 0xaf, 0xd5,
-	0x7e, 0xde, 0x92, 0xae, 0xa1, 0x45, 0x90, 0x42, 0x70, 0x0d, 0x37, 0xaa, 0xdd, 0x86, 0x94, 0x92,
-	0x7f, 0x39, 0x03, 0x19, 0xea, 0x2f, 0xa3, 0x9d, 0xd1, 0xdd, 0x78, 0x7b, 0xbc, 0x7b, 0x9d, 0xbc,
-	0x19, 0x61, 0x75, 0x88, 0xab, 0x0b, 0x11, 0xb9, 0x8a, 0x15, 0x33, 0x5d, 0x81, 0x20, 0xc3, 0xd4,
-	0x24, 0xdf, 0x11, 0xf6, 0x9b, 0x9e, 0xae, 0xab, 0x59, 0x36, 0x11, 0x2e, 0x02, 0x6f, 0x50, 0xb5,
-	0xca, 0x1d, 0x41, 0xb6, 0xbf, 0xdc, 0x52, 0x72, 0xd7, 0x90, 0xc9, 0xe6, 0x6d, 0x28, 0xd9, 0x8e,
-	0xd1, 0x57, 0x9d, 0x0b, 0xf6, 0x02, 0x81, 0x1b, 0x4a, 0x10, 0xa0, 0x3d, 0x72, 0x41, 0xad, 0xa4,
-	0xd5, 0xd3, 0x15, 0x5b, 0xbd, 0x20, 0x0e, 0xbb, 0x0f, 0x45, 0x5c, 0xb0, 0x7a, 0xfa, 0x21, 0x6d,
-	0xf3, 0xf0, 0xeb, 0x95, 0xe8, 0xe4, 0xd6, 0xb1, 0x60, 0x92, 0x57, 0xbc, 0x73, 0x15, 0x28, 0x22,
-	0xf7, 0x4c, 0x8a, 0xdc, 0x33, 0xb1, 0x7a, 0xba, 0xef, 0xb4, 0x50, 0x3a, 0xd6, 0x05, 0xbc, 0xcb,
-	0x24, 0xdc, 0x69, 0xd1, 0xa7, 0x3d, 0x83, 0x66, 0xab, 0xd3, 0xc0, 0x5d, 0x29, 0x15, 0x87, 0x3e,
-	0x3d, 0xac, 0xd3, 0x93, 0x49, 0xc7, 0xa1, 0xb8, 0x71, 0xd0, 0x7e, 0xd6, 0x90, 0x66, 0xe4, 0xdf,
-	0xa4, 0x21, 0x43, 0x83, 0x95, 0x29, 0xce, 0x8b, 0xa2, 0xbf, 0xd1, 0xf3, 0x92, 0x60, 0x86, 0x6e,
-	0x39, 0xaf, 0x78, 0xd2, 0x9f, 0xf1, 0xbd, 0xce, 0x4e, 0xda, 0xeb, 0xdc, 0x84, 0xbd, 0xce, 0x8f,
-	0xdf, 0xeb, 0xc2, 0xff, 0xc6, 0x5e, 0xff, 0x67, 0x19, 0xb2, 0x2c, 0x60, 0x9a, 0x42, 0x55, 0x30,
-	0xfc, 0xd7, 0xde, 0xed, 0x45, 0xc8, 0xf2, 0x6d, 0xe2, 0xdb, 0xcd, 0x1b, 0xa1, 0xf6, 0xcb, 0x44,
-	0xb4, 0x1f, 0x85, 0xf2, 0xec, 0x01, 0x37, 0x1a, 0xbc, 0x41, 0x67, 0x4a, 0xef, 0x8b, 0x6b, 0xab,
-	0xc2, 0x52, 0x5c, 0x32, 0xd3, 0x96, 0x8f, 0x8c, 0x43, 0x3a, 0xf4, 0xdd, 0xc0, 0x29, 0xce, 0x33,
-	0x0e, 0xf2, 0x24, 0x0e, 0x43, 0xae, 0xf1, 0x3a, 0xc0, 0xc0, 0x34, 0x7e, 0x38, 0x20, 0xec, 0x5a,
-	0xf2, 0xab, 0x55, 0xe4, 0x90, 0x3d, 0x72, 0x21, 0xff, 0x7e, 0xfe, 0x0a, 0x47, 0xb7, 0x06, 0xcb,
-	0xc3, 0xaa, 0x4a, 0xe9, 0x56, 0x77, 0xf6, 0x1b, 0x52, 0x0a, 0xdd, 0x82, 0xb5, 0xb0, 0xaf, 0xde,
-	0x78, 0xdc, 0xc0, 0xb8, 0x51, 0x57, 0xba, 0xf8, 0x2b, 0xa5, 0x5a, 0xaf, 0x4b, 0x69, 0x74, 0x07,
-	0xd6, 0xc7, 0xf4, 0xd7, 0xaa, 0xad, 0x5a, 0x63, 0x5f, 0x9a, 0x99, 0x80, 0x72, 0xf8, 0xb4, 0xb3,
-	0xdb, 0xa8, 0x4b, 0x19, 0xf4, 0x1e, 0xbc, 0x33, 0x06, 0x05, 0x57, 0x0f, 0x94, 0x5a, 0x1b, 0xe3,
-	0x46, 0x8d, 0xf6, 0x49, 0x59, 0x24, 0xc3, 0xad, 0x71, 0xa8, 0x4c, 0x90, 0xea, 0x52, 0x0e, 0x55,
-	0x60, 0x31, 0x8a, 0xb3, 0xdf, 0xe8, 0x36, 0xaa, 0x4f, 0xbb, 0xbb, 0x52, 0x1e, 0x2d, 0x03, 0x0a,
-	0x7b, 0xf6, 0x9b, 0xad, 0x3d, 0x06, 0x2f, 0xc4, 0x29, 0x5a, 0x8d, 0xe7, 0xd5, 0x5a, 0xad, 0xfd,
-	0xb4, 0xd5, 0x95, 0x8a, 0xe8, 0x36, 0xdc, 0x08, 0x7b, 0x0e, 0x71, 0xf3, 0xa0, 0x8a, 0x5f, 0x28,
-	0xcd, 0x56, 0xbd, 0xc1, 0x77, 0x00, 0xe2, 0x13, 0x8a, 0x23, 0x08, 0xd1, 0x2e, 0x4d, 0xc2, 0x11,
-	0x97, 0xa2, 0x8c, 0x3e, 0x84, 0x0f, 0x26, 0xe3, 0xd0, 0xf1, 0xe8, 0xdc, 0x94, 0xc3, 0xea, 0x8b,
-	0x06, 0x96, 0x66, 0xd1, 0x47, 0xf0, 0xe0, 0x12, 0x0a, 0x3e, 0x01, 0xa5, 0xbd, 0x5f, 0x17, 0x44,
-	0x73, 0xf1, 0xc3, 0x16, 0xfd, 0xfc, 0xb0, 0xe7, 0xe3, 0x27, 0xd5, 0x69, 0xd4, 0xda, 0xad, 0x7a,
-	0x7c, 0xb5, 0x12, 0x7a, 0x1b, 0x36, 0xc6, 0xa3, 0x88, 0xf5, 0x2e, 0xa0, 0x6d, 0xd8, 0x1a, 0x8f,
-	0x95, 0xb8, 0x1a, 0x84, 0x3e, 0x81, 0x87, 0x97, 0xd2, 0x8c, 0xac, 0xe7, 0x7a, 0x5c, 0x97, 0x74,
-	0x1a, 0xdd, 0xea, 0x4e, 0x53, 0x5a, 0x8c, 0x4b, 0x7a, 0xa7, 0xd1, 0xad, 0xb5, 0xeb, 0x0d, 0x69,
-	0x29, 0x7e, 0xcc, 0x4f, 0x5b, 0x81, 0x00, 0x2c, 0xc7, 0x8f, 0x99, 0x8f, 0x46, 0x7b, 0x7c, 0xcb,
-	0xbd, 0x32, 0x16, 0x41, 0x9c, 0x5f, 0x65, 0x58, 0xe8, 0x0e, 0x71, 0xa3, 0x56, 0xed, 0x36, 0xea,
-	0xd2, 0xaa, 0xfc, 0xd3, 0x34, 0x14, 0x83, 0x8b, 0x4f, 0xa7, 0xd6, 0xaa, 0x1e, 0x34, 0x3a, 0x87,
-	0xd5, 0x5a, 0x23, 0x72, 0x09, 0x17, 0x60, 0x36, 0x04, 0xd3, 0x45, 0xa4, 0xe2, 0x98, 0xbe, 0x44,
-	0xa6, 0x11, 0x82, 0xb9, 0x08, 0x98, 0x4e, 0x7f, 0x06, 0xad, 0xc0, 0xf5, 0x38, 0x8c, 0x09, 0xb7,
-	0x94, 0x89, 0x23, 0xb3, 0x5d, 0xc8, 0x52, 0x11, 0x08, 0x61, 0xd1, 0x2b, 0x24, 0xe5, 0xd0, 0x3a,
-	0xac, 0x86, 0x7d, 0x43, 0xa7, 0x20, 0xe5, 0xd1, 0x75, 0x98, 0x0f, 0xbb, 0xb9, 0xd8, 0x14, 0xe2,
-	0x83, 0x33, 0xa0, 0x82, 0xdb, 0xcf, 0xa5, 0x22, 0x92, 0xa0, 0x1c, 0x76, 0xec, 0x3d, 0x93, 0x40,
-	0xfe, 0x79, 0x98, 0x28, 0x41, 0x30, 0x57, 0xad, 0x0d, 0x69, 0xa2, 0x39, 0x00, 0x01, 0xa3, 0xd2,
-	0x96, 0xa2, 0x9b, 0x22, 0xda, 0x42, 0x9b, 0xa4, 0xe9, 0xa6, 0xf8, 0xa0, 0x50, 0x2d, 0xcc, 0xa0,
-	0x79, 0x28, 0x09, 0x30, 0x55, 0x2a, 0x52, 0x26, 0x42, 0x2a, 0xa4, 0x32, 0x1b, 0x01, 0x89, 0x43,
-	0xcb, 0xc9, 0xbf, 0x97, 0x82, 0xf9, 0xa1, 0x1c, 0x1b, 0x8f, 0x00, 0x82, 0x14, 0x5d, 0x90, 0x44,
-	0x2f, 0x87, 0xc0, 0xa6, 0x3e, 0xa4, 0x77, 0xd3, 0x43, 0x7a, 0x77, 0x1a, 0xcb, 0x42, 0xc3, 0xa9,
-	0xbc, 0x48, 0xae, 0xa1, 0xc6, 0xa8, 0xe5, 0x7b, 0x77, 0x62, 0x3a, 0xee, 0x0d, 0xdb, 0x3e, 0xdf,
-	0xff, 0xc8, 0x24, 0xf9, 0x8b, 0xd9, 0xf1, 0xfe, 0x62, 0x6e, 0xc8, 0x5f, 0x94, 0x5b, 0x6f, 0xc6,
-	0x65, 0x10, 0x67, 0x97, 0x96, 0xff, 0x31, 0x03, 0x39, 0x9e, 0x00, 0x46, 0xf5, 0xd1, 0x3d, 0xba,
-	0x3b, 0x29, 0x63, 0xfc, 0xda, 0x5b, 0xb4, 0x0c, 0x39, 0x97, 0x98, 0x7a, 0xb0, 0x47, 0xa2, 0x45,
-	0x3d, 0x2c, 0xfe, 0x2b, 0x2c, 0x6a, 0x14, 0x38, 0xa0, 0xa9, 0x87, 0xfb, 0x9a, 0x8d, 0xee, 0xeb,
-	0x1d, 0x28, 0xb3, 0x2a, 0xb3, 0x4b, 0xc3, 0x6f, 0xd5, 0x13, 0xfb, 0x55, 0x0a, 0x60, 0x55, 0x8f,
-	0x7a, 0xd8, 0xbc, 0xc4, 0x33, 0x30, 0x3d, 0xa3, 0x27, 0x5c, 0x68, 0x60, 0xa0, 0xa7, 0x14, 0x42,
-	0xe5, 0x32, 0xac, 0x5b, 0x51, 0x26, 0xdc, 0xda, 0x97, 0x43, 0x60, 0xd5, 0x4b, 0x08, 0x86, 0x8a,
-	0x57, 0x08, 0x86, 0x7e, 0x8b, 0x9a, 0xba, 0xfc, 0xd7, 0xa9, 0xd7, 0x8d, 0x86, 0xd0, 0x2a, 0x2c,
-	0x45, 0x0c, 0xd8, 0xd3, 0x4e, 0xa0, 0x6e, 0x87, 0x5c, 0xc4, 0xc7, 0xd5, 0xe6, 0x7e, 0xa3, 0x2e,
-	0xcd, 0x0c, 0xb1, 0xe1, 0x2a, 0x21, 0x83, 0x6e, 0xc0, 0x4a, 0x08, 0x3d, 0x68, 0xd7, 0x9b, 0x8f,
-	0x5f, 0xf8, 0x9d, 0xd9, 0xe4, 0x4e, 0x3e, 0x4a, 0x4e, 0xfe, 0x4d, 0x8a, 0xc5, 0xb4, 0x42, 0xb0,
-	0xb6, 0x61, 0xc9, 0xb5, 0x06, 0x8e, 0x46, 0x94, 0xa1, 0x2d, 0xe4, 0x0a, 0xe0, 0x3a, 0xef, 0xec,
-	0x8e, 0x4f, 0x0e, 0x0e, 0x97, 0xd5, 0xa2, 0x6f, 0xd5, 0x66, 0xe2, 0x6f, 0xd5, 0xe2, 0xb9, 0xc0,
-	0xcc, 0x34, 0xb9, 0xc0, 0x4f, 0x20, 0x2f, 0x0a, 0x26, 0xa2, 0x5e, 0x36, 0xb9, 0x5e, 0x92, 0xe3,
-	0xf5, 0x12, 0xf9, 0xdf, 0x53, 0x50, 0x0c, 0xca, 0x20, 0xf4, 0xa2, 0xbf, 0x34, 0x4c, 0x7f, 0x69,
-	0xec, 0xf7, 0x55, 0xae, 0xc4, 0x3b, 0x30, 0xe7, 0xd7, 0x5c, 0x44, 0x52, 0x47, 0xc4, 0xda, 0x02,
-	0x5a, 0xe7, 0xb9, 0x9d, 0x6f, 0x43, 0x5e, 0x00, 0xc4, 0xd2, 0xd6, 0x27, 0x96, 0x65, 0xb0, 0x8f,
-	0x2d, 0xef, 0x40, 0x66, 0x8f, 0x4e, 0x45, 0x82, 0xf2, 0x5e, 0xb3, 0x55, 0x8f, 0x48, 0xd0, 0x12,
-	0x2c, 0x30, 0xc8, 0x21, 0xa6, 0xb6, 0xb0, 0xdb, 0x7c, 0xc6, 0x45, 0x68, 0x01, 0x66, 0x19, 0x38,
-	0x00, 0xa5, 0xe5, 0x1f, 0x81, 0x34, 0x5c, 0x6b, 0x40, 0x1f, 0xc2, 0xe2, 0x50, 0xba, 0x8f, 0x2f,
-	0x91, 0x2e, 0x3f, 0x8b, 0x51, 0x2c, 0xd9, 0xc7, 0x57, 0xfa, 0x71, 0xf4, 0xa1, 0x42, 0xc2, 0xb6,
-	0x84, 0xaf, 0x32, 0x22, 0x54, 0xf2, 0x3f, 0xa7, 0x21, 0xc7, 0x8b, 0x45, 0x53, 0xa8, 0x29, 0x4e,
-	0xf0, 0xda, 0x6a, 0xaa, 0xca, 0x63, 0x3a, 0x9b, 0x38, 0x7d, 0xf1, 0x54, 0xef, 0xee, 0x65, 0xe5,
-	0x83, 0xf6, 0xd1, 0x37, 0x44, 0xf3, 0x58, 0xec, 0x47, 0x81, 0x94, 0x05, 0x8b, 0x19, 0x29, 0x8b,
-	0xe2, 0x74, 0x2c, 0x68, 0x68, 0x49, 0x9c, 0xfe, 0xff, 0x50, 0x8c, 0xf8, 0x1f, 0x29, 0x90, 0x86,
-	0xe7, 0x20, 0x4a, 0xdd, 0xc0, 0x2e, 0x9f, 0x28, 0x75, 0xdb, 0xaa, 0x43, 0x4c, 0x8f, 0xde, 0xbb,
-	0x12, 0xbf, 0x93, 0x1c, 0xc0, 0xf5, 0xb3, 0xf5, 0xca, 0x0c, 0xf2, 0x94, 0xbc, 0x91, 0x98, 0x57,
-	0xfa, 0x1c, 0xca, 0xec, 0xd5, 0xf9, 0xc0, 0xe6, 0x5f, 0x2c, 0x5f, 0x5e, 0x00, 0x2f, 0x51, 0xfc,
-	0xa7, 0xb6, 0xff, 0x3d, 0x73, 0x31, 0xfc, 0x90, 0x21, 0x33, 0x29, 0xcd, 0x1d, 0xf9, 0x9c, 0x22,
-	0xa0, 0x90, 0x7f, 0x0c, 0x10, 0x2e, 0x34, 0xf1, 0x55, 0xfc, 0x32, 0xe4, 0xf8, 0xaa, 0xfc, 0xc4,
-	0x2a, 0x6f, 0xa1, 0x3a, 0xcc, 0x3a, 0xe4, 0x87, 0x03, 0xc3, 0xa1, 0xa6, 0x66, 0xe0, 0x9d, 0x8a,
-	0x89, 0x5f, 0x3a, 0x78, 0xd9, 0xa7, 0xa2, 0x20, 0xf9, 0x5f, 0x53, 0x50, 0x0c, 0xbf, 0x92, 0x78,
-	0xf3, 0x9f, 0x2f, 0xa0, 0x27, 0x50, 0x10, 0xf9, 0x46, 0xff, 0x11, 0xc9, 0xfb, 0x57, 0xaa, 0x7a,
-	0x09, 0x26, 0x01, 0x31, 0xfa, 0x16, 0x64, 0x5f, 0xa9, 0x86, 0xe7, 0xbf, 0x27, 0x19, 0xf3, 0xde,
-	0xf1, 0xb9, 0x6a, 0x78, 0x82, 0x94, 0xa3, 0xcb, 0x3b, 0x50, 0x0c, 0xe6, 0x44, 0xdd, 0x99, 0xf0,
-	0x65, 0x98, 0xd8, 0xe6, 0x62, 0xf0, 0x30, 0x8c, 0xee, 0xf5, 0x2b, 0x86, 0xe8, 0xff, 0x2b, 0x09,
-	0xde, 0x92, 0x9f, 0xc0, 0xfc, 0xd0, 0xf4, 0xa8, 0x80, 0xa9, 0x9a, 0x67, 0x05, 0x02, 0xc6, 0x1a,
-	0xe8, 0x16, 0x80, 0x1d, 0x20, 0x8a, 0x03, 0x8b, 0x40, 0xe4, 0x33, 0x58, 0x4a, 0x5c, 0x27, 0x6a,
-	0xc4, 0x08, 0x53, 0x93, 0xbe, 0x48, 0x1b, 0x2e, 0x0f, 0x46, 0x08, 0xc7, 0x2e, 0xe0, 0x11, 0x40,
-	0xb8, 0x33, 0xd4, 0x60, 0xd1, 0xbd, 0x61, 0xaf, 0x4c, 0xc4, 0x57, 0x42, 0xb4, 0xdd, 0x21, 0xda,
-	0x58, 0x06, 0x7f, 0x3f, 0x03, 0x05, 0xbf, 0xb0, 0x8c, 0x1e, 0x8f, 0xea, 0xbc, 0xcd, 0xc9, 0xb5,
-	0xe8, 0x64, 0xad, 0xf7, 0x29, 0x64, 0x5d, 0x4f, 0xf5, 0xc8, 0xe4, 0x47, 0xa3, 0x9c, 0x47, 0x87,
-	0x22, 0xee, 0x5e, 0xc3, 0x9c, 0x02, 0x7d, 0x06, 0x39, 0xf6, 0x10, 0xff, 0x44, 0x88, 0xbd, 0x3c,
-	0x89, 0xb6, 0xc6, 0x30, 0x77, 0xaf, 0x61, 0x41, 0x83, 0x30, 0xcc, 0xf9, 0x59, 0x7a, 0x86, 0xe0,
-	0x7f, 0x5b, 0xf8, 0xde, 0x24, 0x2e, 0x22, 0xab, 0xbe, 0xcf, 0x08, 0x76, 0xaf, 0xe1, 0x59, 0x35,
-	0x0a, 0x40, 0x6d, 0xf0, 0x01, 0x4a, 0x98, 0x41, 0x2a, 0x4d, 0xde, 0x18, 0xc1, 0x92, 0x95, 0x98,
-	0x77, 0xaf, 0x61, 0xbf, 0x74, 0xc0, 0xda, 0x6f, 0xca, 0xb7, 0xf6, 0x55, 0xed, 0x4e, 0x8e, 0xfb,
-	0x02, 0xf2, 0x7f, 0xcd, 0x40, 0x29, 0xb2, 0xa7, 0xe8, 0x6b, 0x58, 0x51, 0xcf, 0x88, 0xa3, 0x9e,
-	0x10, 0xff, 0x79, 0x54, 0xf0, 0x84, 0x66, 0xe2, 0x83, 0x60, 0x36, 0xcb, 0xaa, 0xa6, 0x0d, 0xfa,
-	0x83, 0x1e, 0x35, 0xab, 0x78, 0x51, 0xb0, 0xe1, 0x0f, 0xaa, 0xfc, 0x67, 0x37, 0x23, 0xec, 0x83,
-	0x0a, 0xbd, 0x38, 0xf6, 0xd7, 0x62, 0xef, 0x3f, 0x9a, 0x62, 0x95, 0x59, 0xf1, 0x8f, 0x33, 0xc2,
-	0x79, 0xf3, 0x0a, 0x93, 0xff, 0xaf, 0x30, 0x82, 0xa9, 0x44, 0x70, 0xc3, 0x49, 0x64, 0x62, 0xb8,
-	0x01, 0xdf, 0x4d, 0x90, 0xf8, 0xe7, 0xdd, 0x94, 0xab, 0xb8, 0x12, 0x3c, 0x27, 0x38, 0xc7, 0xe0,
-	0x2d, 0xe2, 0xdf, 0xa6, 0x00, 0x93, 0xf2, 0x14, 0x98, 0xb9, 0x08, 0x66, 0xcd, 0x1e, 0x08, 0xcc,
-	0xbb, 0x30, 0xcf, 0x31, 0x1d, 0xb5, 0xaf, 0x1c, 0x5d, 0x78, 0xc4, 0x15, 0x25, 0xa5, 0x59, 0x06,
-	0xc6, 0x6a, 0x7f, 0x87, 0x02, 0xe9, 0x3c, 0xcf, 0x0c, 0xc7, 0x1b, 0x88, 0xd1, 0xd9, 0x59, 0x31,
-	0x9b, 0x9f, 0xc1, 0xf3, 0xa2, 0xa3, 0x45, 0xb8, 0xdc, 0x45, 0x71, 0xe9, 0xf8, 0x1c, 0xb7, 0x18,
-	0xc3, 0xad, 0xd9, 0x03, 0x86, 0x2b, 0xff, 0x4b, 0x1a, 0xca, 0xd1, 0x1b, 0x81, 0x7e, 0x07, 0x16,
-	0x03, 0x22, 0xc5, 0x56, 0x1d, 0xb5, 0x4f, 0x3c, 0xe2, 0xb8, 0xe2, 0xdc, 0xc7, 0x7c, 0xae, 0xd0,
-	0xa0, 0xe6, 0xcf, 0xd0, 0x18, 0xcb, 0xc3, 0x80, 0x06, 0x23, 0x4d, 0x0c, 0x13, 0xc2, 0x28, 0xff,
-	0x60, 0x01, 0x51, 0xfe, 0xe9, 0xd7, 0xe1, 0x6f, 0x8a, 0x25, 0x47, 0xf8, 0x3f, 0x86, 0x0d, 0xff,
-	0xce, 0x85, 0xef, 0x3e, 0x7c, 0x69, 0x7b, 0x65, 0x98, 0xba, 0xf5, 0x4a, 0xbc, 0xe4, 0xb8, 0x29,
-	0xf0, 0xfc, 0xf3, 0xad, 0x72, 0xa4, 0xe7, 0x0c, 0x27, 0xca, 0x27, 0x7c, 0x08, 0x32, 0xc4, 0x27,
-	0x13, 0xe3, 0xe3, 0xcb, 0x54, 0x8c, 0x8f, 0xfc, 0x67, 0x29, 0xb8, 0x9e, 0xa0, 0x2c, 0xc6, 0x78,
-	0x23, 0x15, 0xc8, 0x0b, 0xa9, 0x63, 0x1b, 0x52, 0xc0, 0x7e, 0x93, 0x7d, 0xe0, 0x17, 0x8a, 0xdd,
-	0x0c, 0x4b, 0x23, 0x14, 0xcd, 0x40, 0xe2, 0xd6, 0x01, 0x22, 0xb2, 0xc6, 0xb3, 0x0c, 0x45, 0x2d,
-	0x10, 0xb3, 0x1b, 0x50, 0x0c, 0x05, 0x2c, 0xcb, 0x7a, 0x0b, 0x8e, 0x90, 0x2d, 0xf9, 0x1f, 0x52,
-	0x80, 0x46, 0x95, 0xcf, 0x98, 0x19, 0xd6, 0xa2, 0xef, 0xe9, 0xa6, 0xbb, 0xad, 0xe1, 0xbb, 0xbb,
-	0x1a, 0x14, 0xc3, 0xdb, 0x36, 0x33, 0x1d, 0x13, 0xff, 0xb9, 0x8e, 0xbf, 0xa6, 0xe8, 0x95, 0xa5,
-	0x6b, 0xe2, 0x9a, 0xb2, 0x07, 0xd2, 0x30, 0x29, 0xf5, 0xa8, 0x99, 0x5b, 0xe7, 0x57, 0xe9, 0xb9,
-	0x9d, 0x63, 0xae, 0x9b, 0x5f, 0x8a, 0x5f, 0x85, 0x02, 0x7b, 0xbd, 0xa0, 0x08, 0x87, 0x3b, 0x83,
-	0xf3, 0xac, 0xdd, 0x38, 0x47, 0x6b, 0x50, 0xd0, 0x2c, 0xd3, 0x1d, 0xf4, 0x85, 0x43, 0x98, 0xc1,
-	0x41, 0x5b, 0xfe, 0x49, 0x1a, 0x96, 0x93, 0x65, 0x94, 0x5a, 0x4f, 0x4f, 0x75, 0x4e, 0x08, 0x2f,
-	0xb5, 0x66, 0xb0, 0x68, 0x21, 0x09, 0x66, 0xfa, 0xaa, 0x3f, 0x08, 0xfd, 0xc9, 0xcf, 0xde, 0x31,
-	0xac, 0xe0, 0xa9, 0x91, 0xdf, 0xa4, 0xb1, 0x57, 0x5f, 0x3d, 0x57, 0xfa, 0x83, 0x9e, 0x67, 0xd8,
-	0x3d, 0x43, 0x7c, 0x95, 0xc6, 0x9f, 0x8a, 0x1e, 0x04, 0x40, 0xf4, 0x05, 0xfb, 0xaf, 0x42, 0x9e,
-	0xa3, 0x6a, 0x9e, 0xe2, 0x50, 0x1b, 0x9a, 0x9d, 0xf4, 0xf9, 0x3e, 0xa6, 0x56, 0x84, 0xfd, 0xcb,
-	0x21, 0x46, 0x81, 0xb9, 0x09, 0x2d, 0x91, 0x73, 0x5b, 0x35, 0x75, 0x4e, 0x9f, 0xbb, 0x9c, 0x1e,
-	0x38, 0x3e, 0xa5, 0x96, 0x9f, 0x40, 0x96, 0x01, 0xa9, 0xcf, 0x68, 0x0e, 0xfa, 0xd4, 0x4e, 0x09,
-	0x67, 0x28, 0x83, 0x43, 0x00, 0xda, 0x80, 0x92, 0x4e, 0x4c, 0xab, 0x6f, 0x98, 0xac, 0x9f, 0xef,
-	0x40, 0x14, 0x24, 0xff, 0x45, 0x86, 0x06, 0xe7, 0xfe, 0xb3, 0x0f, 0x3f, 0x33, 0xc5, 0x23, 0x36,
-	0x9e, 0x99, 0x4a, 0xf2, 0xda, 0x2b, 0x90, 0xef, 0x13, 0x37, 0x10, 0xa9, 0x22, 0xf6, 0x9b, 0xe8,
-	0x0b, 0xe6, 0x54, 0x68, 0x2f, 0x85, 0x9f, 0x78, 0xef, 0x92, 0x37, 0x27, 0x5b, 0xfb, 0xd6, 0xc9,
-	0x01, 0x27, 0xc5, 0x9c, 0x70, 0xed, 0xc7, 0x00, 0x21, 0x10, 0xd5, 0xd9, 0xc3, 0x22, 0x8f, 0x9c,
-	0xfb, 0x4f, 0xa1, 0xae, 0xc2, 0x51, 0x7c, 0xdd, 0x88, 0x7d, 0x52, 0x2a, 0x19, 0xc7, 0x96, 0xd3,
-	0x57, 0x03, 0x2f, 0x9e, 0xb7, 0x82, 0x62, 0x7a, 0x26, 0x2c, 0xa6, 0xaf, 0xfd, 0x69, 0x9a, 0x4d,
-	0x40, 0xf0, 0xa0, 0x57, 0xb3, 0x47, 0x1d, 0x3d, 0xff, 0x6a, 0xb2, 0x06, 0x25, 0x3c, 0x36, 0x7a,
-	0xc1, 0xa6, 0xd0, 0xdf, 0x14, 0xd6, 0x33, 0x4c, 0xbe, 0x23, 0x59, 0xcc, 0x7e, 0xd3, 0x81, 0xfb,
-	0xc4, 0x3b, 0xb5, 0xfc, 0x14, 0x96, 0x68, 0x51, 0x09, 0x3f, 0xb5, 0x5c, 0x2f, 0x52, 0x06, 0x0e,
-	0xda, 0xe8, 0x36, 0x94, 0xa8, 0xd7, 0xaf, 0xea, 0xd1, 0xac, 0x1f, 0x70, 0x10, 0x2b, 0x13, 0xc7,
-	0xbe, 0xb6, 0xcc, 0x4f, 0xf3, 0xb5, 0x65, 0x64, 0x37, 0x0b, 0xaf, 0xbd, 0x9b, 0xf2, 0x6f, 0xd2,
-	0x90, 0x17, 0x49, 0x85, 0x84, 0x5c, 0x45, 0x2a, 0x29, 0x57, 0x41, 0x60, 0xc5, 0x1d, 0xb0, 0x40,
-	0xd2, 0x38, 0x23, 0x8a, 0x43, 0x5c, 0xcf, 0x31, 0x82, 0xe7, 0xf1, 0x13, 0xac, 0x51, 0x27, 0x20,
-	0xc2, 0x11, 0x1a, 0xbc, 0xec, 0x26, 0xc2, 0xd1, 0x7d, 0x40, 0x3a, 0x71, 0x35, 0xc7, 0x60, 0x93,
-	0x8f, 0x67, 0x4f, 0x16, 0x22, 0x3d, 0x62, 0x56, 0x32, 0x94, 0x75, 0x42, 0xb5, 0x3e, 0x31, 0x35,
-	0x83, 0xf0, 0xd8, 0xa6, 0x88, 0x63, 0x30, 0xb4, 0x0d, 0x4b, 0xc3, 0xff, 0xef, 0x41, 0x61, 0x8f,
-	0x32, 0xf8, 0xb1, 0x5d, 0x1f, 0xfa, 0x9f, 0x0f, 0xdd, 0x0b, 0x9b, 0xa0, 0x26, 0xcc, 0xba, 0x36,
-	0xd1, 0x8c, 0x63, 0x43, 0x53, 0xc5, 0x07, 0x88, 0x33, 0xe3, 0x5f, 0xf1, 0x75, 0xa2, 0xa8, 0x38,
-	0x4e, 0x29, 0xff, 0x2a, 0x05, 0xcb, 0xc9, 0x9b, 0xc0, 0x5e, 0xe3, 0x99, 0xea, 0x51, 0x8f, 0xf0,
-	0x60, 0xb1, 0x80, 0xfd, 0x26, 0xfa, 0x04, 0x96, 0x6d, 0x87, 0x88, 0xff, 0x11, 0xc6, 0xbf, 0x14,
-	0xe2, 0x41, 0xa7, 0xb0, 0x74, 0x4b, 0xb1, 0x5e, 0x2c, 0x3a, 0xd1, 0x13, 0xd8, 0x20, 0xaa, 0xd3,
-	0x33, 0x88, 0xeb, 0x29, 0x6a, 0xaf, 0x67, 0xbd, 0xa2, 0xb1, 0x6d, 0xc8, 0x24, 0x78, 0xa0, 0x5e,
-	0xc4, 0xeb, 0x3e, 0x5e, 0x95, 0xa3, 0x55, 0x03, 0x2c, 0x2a, 0x76, 0xf2, 0xa7, 0x30, 0x1b, 0x5b,
-	0x54, 0x62, 0x64, 0xbd, 0x08, 0x59, 0xfe, 0x7a, 0x8d, 0xdf, 0x21, 0xde, 0x90, 0xff, 0x2d, 0x05,
-	0x48, 0x98, 0x46, 0x3f, 0xbf, 0x84, 0xc9, 0xf1, 0x84, 0x67, 0x34, 0x15, 0xc8, 0x8b, 0xc4, 0x92,
-	0xff, 0xa5, 0xaa, 0x68, 0x8e, 0x7e, 0xa9, 0x3a, 0x2e, 0x6b, 0x98, 0x99, 0x94, 0x35, 0xcc, 0x4e,
-	0x93, 0x35, 0xbc, 0xda, 0x63, 0xc7, 0x7b, 0xbf, 0x4e, 0x01, 0xe2, 0xff, 0x55, 0x40, 0x7c, 0x41,
-	0x63, 0xf4, 0x68, 0xfc, 0x7f, 0x03, 0x56, 0x76, 0xf6, 0xdb, 0xb5, 0x3d, 0xdc, 0x78, 0xd6, 0xc0,
-	0x9d, 0xe6, 0x4e, 0x73, 0xbf, 0xd9, 0x7d, 0xa1, 0xb4, 0xda, 0xad, 0x86, 0x74, 0x0d, 0xdd, 0x81,
-	0xf5, 0x84, 0x4e, 0xbf, 0xc5, 0xca, 0xc8, 0x6f, 0xc1, 0xed, 0x04, 0x94, 0x26, 0x8e, 0x20, 0xa5,
-	0xd1, 0x4d, 0xa8, 0x24, 0x20, 0x75, 0xba, 0xd5, 0xfd, 0x06, 0x2f, 0x23, 0x27, 0xf4, 0x1e, 0x54,
-	0x5f, 0xec, 0x34, 0x38, 0x4a, 0xe6, 0xde, 0xcf, 0xe2, 0x5f, 0x87, 0x88, 0x4f, 0xd3, 0xd6, 0x60,
-	0xb9, 0x8b, 0xab, 0xad, 0x0e, 0xaf, 0xfd, 0x74, 0xba, 0xd5, 0xee, 0xd3, 0x8e, 0x3f, 0xf5, 0x5b,
-	0xb0, 0x36, 0xda, 0xd7, 0xf8, 0xaa, 0x51, 0x7b, 0xda, 0x6d, 0xd4, 0x79, 0xf9, 0x7b, 0xb4, 0xbf,
-	0xd3, 0x7e, 0xdc, 0x7d, 0x5c, 0x6d, 0xee, 0x4b, 0xe9, 0xe4, 0xfe, 0xdd, 0x2a, 0xae, 0xb3, 0xfe,
-	0x19, 0xb4, 0x0e, 0xab, 0xa3, 0xfd, 0xf5, 0xc6, 0x7e, 0xf5, 0x05, 0xab, 0x7b, 0x27, 0x76, 0x37,
-	0xbe, 0x3a, 0x6c, 0xe2, 0x46, 0x5d, 0xca, 0x26, 0x77, 0xfb, 0x31, 0x5e, 0x2e, 0x79, 0x70, 0x9e,
-	0xf8, 0x6e, 0xd4, 0xa5, 0xfc, 0x4e, 0xf5, 0xfb, 0x8f, 0x4e, 0x0c, 0xef, 0x74, 0x70, 0xb4, 0xa5,
-	0x59, 0xfd, 0x07, 0xec, 0x82, 0xdf, 0x37, 0x2c, 0xf1, 0x83, 0xff, 0x23, 0x4e, 0xfb, 0xe8, 0x41,
-	0xd2, 0xff, 0xe5, 0xfc, 0x7f, 0xf6, 0x11, 0xfb, 0x79, 0x94, 0x63, 0x42, 0xf5, 0xd1, 0x7f, 0x07,
-	0x00, 0x00, 0xff, 0xff, 0x63, 0xf8, 0xe8, 0xb5, 0xbe, 0x53, 0x00, 0x00,
+	// 6266 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7c, 0x4b, 0x70, 0x23, 0xc7,
+	0x79, 0xf0, 0xe2, 0x0d, 0x7c, 0x00, 0x49, 0xb0, 0x97, 0x0f, 0x90, 0xfb, 0xe2, 0x8e, 0xa4, 0x15,
+	0xb5, 0x92, 0xb8, 0x5a, 0x4a, 0xb2, 0x2d, 0xff, 0x92, 0x57, 0x20, 0x80, 0x15, 0x29, 0x92, 0x20,
+	0xab, 0xc9, 0xdd, 0xd5, 0xfa, 0xb7, 0x32, 0x35, 0x9c, 0x69, 0x92, 0xa3, 0x05, 0x66, 0xc6, 0x33,
+	0x03, 0x2e, 0xe9, 0x4a, 0xb9, 0x2a, 0xc9, 0xc5, 0xa9, 0xb2, 0x2f, 0xb9, 0xa4, 0x2a, 0x39, 0x24,
+	0x95, 0xf2, 0x35, 0x87, 0xb8, 0x72, 0x48, 0x9c, 0xca, 0x25, 0xa7, 0x5c, 0x53, 0x39, 0xf9, 0x90,
+	0xa4, 0x2a, 0x87, 0xa4, 0x7c, 0xcc, 0x29, 0xd7, 0x54, 0xbf, 0xe6, 0x85, 0x01, 0x48, 0xac, 0x37,
+	0x8f, 0x13, 0xd1, 0x5f, 0x7f, 0xdf, 0xd7, 0xaf, 0xaf, 0xbf, 0x67, 0x0f, 0x61, 0xc5, 0x38, 0x1e,
+	0x78, 0xe4, 0x01, 0xb1, 0x3d, 0xd3, 0x7e, 0xa0, 0xdb, 0x06, 0xd1, 0x1f, 0x9c, 0x3d, 0xe4, 0x3f,
+	0xd6, 0x1c, 0xd7, 0xf6, 0x6d, 0x34, 0xc7, 0x30, 0xd6, 0x18, 0xc6, 0x1a, 0xef, 0x38, 0x7b, 0xb8,
+	0x7c, 0xe7, 0xc4, 0xb6, 0x4f, 0x7a, 0xe4, 0x01, 0xc3, 0x39, 0x1a, 0x1c, 0x3f, 0xf0, 0xcd, 0x3e,
+	0xf1, 0x7c, 0xad, 0xef, 0x70, 0x32, 0xe5, 0x2f, 0x17, 0xa0, 0xb0, 0xd1, 0xb3, 0xf5, 0x17, 0x68,
+	0x1a, 0xb2, 0xa6, 0xd1, 0xc8, 0xac, 0x64, 0x56, 0x2b, 0x38, 0x6b, 0x1a, 0x68, 0x01, 0x8a, 0xd6,
+	0xa0, 0x7f, 0x44, 0xdc, 0x46, 0x76, 0x25, 0xb3, 0x3a, 0x85, 0x45, 0x0b, 0x35, 0xa0, 0x74, 0x46,
+	0x5c, 0xcf, 0xb4, 0xad, 0x46, 0x8e, 0x75, 0xc8, 0x26, 0xfa, 0x04, 0x8a, 0xa7, 0x44, 0x33, 0x88,
+	0xdb, 0xc8, 0xaf, 0x64, 0x56, 0xab, 0xeb, 0x77, 0xd7, 0xd2, 0xe6, 0xb4, 0xc6, 0x86, 0xdb, 0x64,
+	0x88, 0x58, 0x10, 0xa0, 0xf7, 0x01, 0x39, 0xae, 0x6d, 0x0c, 0x74, 0xe2, 0xaa, 0x9e, 0x79, 0x62,
+	0x69, 0xfe, 0xc0, 0x25, 0x8d, 0x02, 0x9b, 0xcc, 0xac, 0xec, 0x39, 0x90, 0x1d, 0xe8, 0x4b, 0xa8,
+	0x1f, 0x51, 0x2e, 0x2a, 0x39, 0xf7, 0x89, 0x45, 0x07, 0xf7, 0x1a, 0xa5, 0x95, 0xdc, 0x6a, 0x75,
+	0xfd, 0x4e, 0xfa, 0x98, 0x1d, 0x89, 0x87, 0x67, 0x18, 0x61, 0xd0, 0xf6, 0xd0, 0x2e, 0xbc, 0x61,
+	0x38, 0xb6, 0xa7, 0x3a, 0xae, 0xed, 0xd8, 0x1e, 0x31, 0x54, 0xd3, 0x75, 0x09, 0x5b, 0xd2, 0x51,
+	0x8f, 0xa8, 0x0c, 0xdb, 0x1a, 0xf4, 0x1b, 0x65, 0xb6, 0xd6, 0x15, 0x8a, 0xba, 0x2f, 0x30, 0xb7,
+	0x22, 0x88, 0x1b, 0x02, 0x0f, 0x7d, 0x0a, 0xcb, 0x8c, 0x5d, 0x3a, 0x97, 0x0a, 0xe3, 0xd2, 0xa0,
+	0x18, 0xa9, 0xd4, 0xfb, 0x62, 0x61, 0xae, 0x6d, 0xfb, 0x6a, 0x9f, 0xb8, 0x2f, 0x7a, 0xa4, 0x51,
+	0x65, 0x9b, 0xf9, 0xd6, 0x98, 0xcd, 0xc4, 0xb6, 0xed, 0xef, 0x32, 0x64, 0x3c, 0x13, 0x90, 0x73,
+	0x00, 0x3a, 0x81, 0xa5, 0x60, 0x67, 0x7d, 0x5b, 0xed, 0x69, 0x9e, 0xaf, 0x0a, 0x80, 0xd1, 0xa8,
+	0xb1, 0x3d, 0x7b, 0x2f, 0x9d, 0xf5, 0xbe, 0x20, 0x3b, 0xb4, 0x77, 0x34, 0xcf, 0x17, 0x2d, 0x03,
+	0x2f, 0x38, 0xa9, 0x70, 0x64, 0xc1, 0xcd, 0xa1, 0x81, 0xcc, 0xbe, 0xd3, 0x33, 0xd9, 0x96, 0x1e,
+	0x35, 0xa6, 0xd8, 0x58, 0x6b, 0x57, 0x19, 0x6b, 0x8b, 0x93, 0x6d, 0xe1, 0x0d, 0xdc, 0x70, 0x52,
+	0x7b, 0xdc, 0x23, 0xf4, 0x06, 0x4c, 0xe9, 0xb6, 0x75, 0x6c, 0xba, 0x7d, 0x55, 0xb7, 0x07, 0x96,
+	0xdf, 0x98, 0x59, 0xc9, 0xad, 0x4e, 0xe1, 0x9a, 0x00, 0xb6, 0x28, 0x0c, 0x7d, 0x05, 0x75, 0x87,
+	0x58, 0x86, 0x69, 0x9d, 0xa8, 0x9e, 0x7e, 0x4a, 0x8c, 0x41, 0x8f, 0x34, 0xea, 0x6c, 0x3f, 0xdf,
+	0x1f, 0x31, 0x11, 0x8e, 0x2d, 0xe7, 0x73, 0x20, 0x88, 0xf0, 0x8c, 0x60, 0x23, 0x01, 0xc8, 0x86,
+	0x1b, 0x9a, 0xee, 0x9b, 0x67, 0x9a, 0x4f, 0x0c, 0x95, 0xdd, 0x25, 0xdd, 0xee, 0xa9, 0xc7, 0x84,
+	0x09, 0xa8, 0xd7, 0x98, 0x65, 0x83, 0x3c, 0x48, 0x1f, 0xa4, 0x29, 0x09, 0xf7, 0x05, 0xdd, 0x63,
+	0x41, 0x86, 0x97, 0xb4, 0x51, 0x5d, 0xe8, 0x26, 0x54, 0xce, 0xb4, 0x9e, 0x69, 0xd0, 0xce, 0x06,
+	0x5a, 0xc9, 0xac, 0x96, 0x71, 0x08, 0x40, 0x9f, 0x01, 0xb8, 0x3d, 0xb3, 0x6f, 0xfa, 0xaa, 0xed,
+	0x78, 0x8d, 0xeb, 0x6c, 0xaf, 0x6f, 0xa7, 0x8f, 0x8e, 0x19, 0xde, 0x9e, 0x83, 0x2b, 0xae, 0xf8,
+	0xe5, 0x21, 0x0d, 0x16, 0x07, 0xd6, 0xb1, 0xd9, 0xf3, 0x89, 0x4b, 0x0c, 0xd5, 0x77, 0x35, 0xcb,
+	0xa3, 0x33, 0xa1, 0xf7, 0xaa, 0xc8, 0x78, 0xad, 0xa6, 0xf3, 0x3a, 0x0c, 0x31, 0x31, 0xd1, 0x89,
+	0xe9, 0xf8, 0x78, 0x21, 0x64, 0x14, 0xe9, 0xf5, 0xd0, 0xd7, 0x30, 0x9f, 0x3e, 0xc0, 0x83, 0x09,
+	0x07, 0x98, 0x4b, 0x65, 0xff, 0x39, 0xdc, 0x4c, 0x5f, 0x81, 0x90, 0x8e, 0x05, 0x76, 0xf3, 0x96,
+	0x53, 0x27, 0xc7, 0x65, 0xe5, 0x53, 0x58, 0x1e, 0x43, 0xff, 0x01, 0xbf, 0xb9, 0x23, 0xa9, 0xbf,
+	0x81, 0x37, 0x22, 0xe3, 0x33, 0xc1, 0xd7, 0x4d, 0x3f, 0xc6, 0x88, 0x9e, 0xcc, 0x1c, 0x5b, 0xec,
+	0x8d, 0x51, 0x8b, 0x3d, 0xdf, 0x73, 0xf0, 0x4a, 0xc8, 0x67, 0x4b, 0xb0, 0x89, 0x8c, 0x46, 0x4f,
+	0xeb, 0x18, 0xee, 0x5e, 0x3e, 0xd2, 0xc3, 0xcb, 0x47, 0xba, 0x7d, 0xc9, 0x38, 0xdf, 0xc0, 0xad,
+	0x11, 0x7b, 0xea, 0xbb, 0x9a, 0x4e, 0xbc, 0xc6, 0x3c, 0x1b, 0xe3, 0xde, 0xa5, 0x47, 0x77, 0x48,
+	0xd1, 0xf1, 0x8d, 0xd4, 0xcd, 0x67, 0x7d, 0x74, 0x4d, 0x37, 0xc6, 0x8d, 0xb4, 0x36, 0xd1, 0x48,
+	0x4b, 0xa3, 0xc7, 0xd9, 0x06, 0x65, 0xdc, 0x9a, 0xc4, 0x69, 0x2f, 0xb2, 0xd3, 0xbe, 0x33, 0x7a,
+	0xc2, 0xfc, 0xd0, 0xbf, 0x80, 0x95, 0x4b, 0x59, 0xbd, 0xcb, 0x58, 0xdd, 0x1a, 0xcf, 0x08, 0xc3,
+	0xbd, 0xc8, 0xac, 0xc8, 0x39, 0xd1, 0x07, 0x54, 0xaf, 0x98, 0x96, 0x33, 0xf0, 0xd5, 0x98, 0x1c,
+	0x36, 0x18, 0xbb, 0xc8, 0x1a, 0x3a, 0x02, 0x79, 0x8b, 0xe2, 0x36, 0x23, 0x12, 0xd9, 0x85, 0x37,
+	0xaf, 0xc4, 0xf1, 0x3d, 0x6e, 0xd9, 0x2e, 0xe5, 0x37, 0x62, 0x8e, 0xbe, 0xed, 0x6b, 0xbd, 0x38,
+	0xc7, 0xa5, 0x51, 0x73, 0x3c, 0xa4, 0xb8, 0x97, 0xce, 0x31, 0x85, 0xe3, 0xfb, 0xe9, 0x73, 0x1c,
+	0xe2, 0x77, 0x1f, 0x66, 0xb9, 0x63, 0x40, 0x9d, 0x08, 0xaa, 0xf5, 0x5f, 0x90, 0x8b, 0xc6, 0x34,
+	0x73, 0x23, 0xb8, 0x65, 0x3c, 0xe0, 0xf0, 0x6d, 0x72, 0x81, 0x0e, 0x01, 0x31, 0x6d, 0x4b, 0x02,
+	0xd3, 0xa0, 0x9e, 0x3d, 0x6c, 0xc0, 0x4a, 0x66, 0xb4, 0xa0, 0x0d, 0x99, 0x85, 0x3a, 0xe7, 0x20,
+	0xdb, 0x4f, 0x1f, 0x22, 0x0f, 0x56, 0x98, 0x56, 0x56, 0xe3, 0xf3, 0xd0, 0x06, 0xfe, 0xa9, 0xed,
+	0x9a, 0xfe, 0x85, 0x7a, 0xb6, 0xde, 0xb8, 0xcd, 0xc6, 0x78, 0x77, 0x8c, 0x45, 0x17, 0xd3, 0x6c,
+	0x4a, 0x2a, 0x7c, 0x93, 0x31, 0x4d, 0xed, 0x7b, 0xba, 0x8e, 0xbe, 0x4e, 0x59, 0xca, 0x7a, 0xe3,
+	0xce, 0x38, 0x1b, 0x24, 0x97, 0x12, 0xb0, 0x19, 0xb9, 0xa6, 0x75, 0xf4, 0x2e, 0xcc, 0xf2, 0x9d,
+	0x67, 0x2b, 0x71, 0x98, 0x09, 0x6e, 0xac, 0x32, 0x13, 0x54, 0x0f, 0x3a, 0x9a, 0x1c, 0x8e, 0x9a,
+	0x70, 0x2b, 0x44, 0x36, 0x2d, 0xbd, 0x37, 0x30, 0x88, 0xca, 0x21, 0x2a, 0x39, 0x77, 0xdc, 0xc6,
+	0x3b, 0xec, 0x38, 0x96, 0x03, 0xa4, 0x2d, 0x8e, 0xf3, 0x98, 0xb5, 0x3b, 0xe7, 0x8e, 0x1b, 0x67,
+	0x41, 0xce, 0x87, 0x59, 0xdc, 0x4f, 0xb0, 0xe8, 0x9c, 0x27, 0x59, 0x7c, 0x0d, 0xef, 0x85, 0x2c,
+	0xbc, 0x0b, 0xcf, 0x27, 0x7d, 0x21, 0x51, 0x5e, 0xea, 0xa4, 0xd6, 0x19, 0xc7, 0xb7, 0x03, 0x9a,
+	0x03, 0x46, 0xc2, 0x45, 0xcb, 0x1b, 0x9a, 0xa1, 0xf2, 0x93, 0x1c, 0x4c, 0xb1, 0xc3, 0x78, 0x66,
+	0xfa, 0xa7, 0x98, 0x1c, 0x7b, 0x43, 0xee, 0xf3, 0x43, 0x28, 0x30, 0x09, 0x60, 0xde, 0xf3, 0x48,
+	0x3d, 0xcc, 0x78, 0x60, 0x8e, 0x89, 0x34, 0x58, 0x4a, 0xd5, 0xe6, 0x2e, 0x39, 0xf6, 0x1a, 0xb9,
+	0x71, 0x5e, 0x60, 0xcc, 0x4a, 0x1e, 0x7b, 0x78, 0xd1, 0x1c, 0x56, 0xe8, 0x6c, 0x96, 0xfb, 0x50,
+	0x1f, 0xe2, 0x9c, 0x9f, 0x84, 0xf3, 0x8c, 0x9f, 0xe0, 0xf8, 0xff, 0x61, 0x61, 0x58, 0xf3, 0x31,
+	0xbe, 0x85, 0x49, 0xf8, 0xce, 0xf9, 0x49, 0x1d, 0x4e, 0x99, 0x2b, 0x50, 0x8b, 0xfa, 0xd1, 0x8d,
+	0x22, 0x93, 0xb9, 0x18, 0x4c, 0x79, 0x07, 0x66, 0x92, 0xab, 0x5c, 0x80, 0xe2, 0xa9, 0xe6, 0x9d,
+	0x12, 0xaf, 0x91, 0x59, 0xc9, 0xad, 0xd6, 0xb0, 0x68, 0x29, 0x9b, 0xb0, 0x34, 0xd2, 0xf5, 0xa2,
+	0x42, 0x3e, 0xec, 0xc6, 0x71, 0xfa, 0xba, 0x93, 0x40, 0x56, 0x7e, 0x2f, 0x0b, 0x8b, 0x23, 0x5c,
+	0x45, 0xb4, 0x0a, 0xf5, 0xe0, 0x16, 0xf6, 0xcc, 0x23, 0x95, 0xfa, 0xfd, 0x19, 0xa6, 0xbf, 0xa6,
+	0x25, 0x7c, 0xc7, 0x3c, 0xea, 0x0e, 0xfa, 0xd4, 0x85, 0x0d, 0x30, 0xe9, 0x14, 0x99, 0xac, 0xd4,
+	0x70, 0x4d, 0x02, 0x37, 0x35, 0xef, 0x14, 0x7d, 0x01, 0xd5, 0xa8, 0x7e, 0xca, 0x4d, 0xa4, 0x9f,
+	0xc0, 0x0b, 0x35, 0xd3, 0x7e, 0x94, 0xd1, 0x7a, 0x23, 0xff, 0x6a, 0xda, 0x21, 0xe4, 0xb8, 0xae,
+	0xf4, 0xa1, 0x3e, 0xb4, 0xfa, 0x48, 0x78, 0x98, 0x89, 0x87, 0x87, 0x8f, 0xa0, 0x22, 0x9d, 0x79,
+	0xaf, 0x91, 0x5d, 0xc9, 0x8d, 0x8e, 0x10, 0x25, 0xd3, 0x6d, 0x72, 0x81, 0x43, 0x1a, 0xe5, 0x07,
+	0x50, 0x8d, 0xf4, 0xa0, 0xbb, 0x50, 0xd3, 0x74, 0x66, 0x1e, 0x54, 0x4b, 0xeb, 0x13, 0x71, 0xf7,
+	0xaa, 0x02, 0xd6, 0xd5, 0xfa, 0x24, 0xdd, 0x1c, 0x64, 0x53, 0xcd, 0x81, 0xf2, 0xdb, 0xb0, 0x34,
+	0x72, 0xd5, 0x63, 0x56, 0xd5, 0x19, 0x5e, 0xd5, 0xdb, 0x57, 0xdc, 0xd3, 0xe8, 0xda, 0xfe, 0x38,
+	0x03, 0xb3, 0x43, 0x08, 0x57, 0x59, 0xa2, 0x0e, 0x8b, 0x23, 0x2c, 0x4d, 0x23, 0x3b, 0xb9, 0x99,
+	0x99, 0x3f, 0x4a, 0x03, 0x2b, 0x3a, 0xcc, 0xa7, 0xe2, 0xa3, 0x47, 0x90, 0x3d, 0xfb, 0xa0, 0x91,
+	0x19, 0x17, 0x51, 0xa5, 0xdb, 0xac, 0x0f, 0x36, 0xaf, 0xe1, 0xec, 0xd9, 0x07, 0x1b, 0x15, 0x28,
+	0x9d, 0x69, 0xae, 0xa9, 0x59, 0xbe, 0xd2, 0x83, 0xc5, 0x11, 0xb8, 0x34, 0xf6, 0xf1, 0x4f, 0x5d,
+	0xe2, 0x9d, 0xda, 0x3d, 0x43, 0x1c, 0x40, 0x08, 0x40, 0x1f, 0x42, 0xfe, 0x05, 0xb9, 0x90, 0xbb,
+	0x3f, 0x22, 0x03, 0xb0, 0x4d, 0x2e, 0x9e, 0x11, 0xf3, 0xe4, 0xd4, 0xc7, 0x0c, 0x59, 0x39, 0x80,
+	0x99, 0x44, 0xec, 0x8c, 0x6e, 0x01, 0x58, 0xb6, 0x21, 0xfd, 0x36, 0x31, 0x0c, 0x85, 0x70, 0xdf,
+	0x82, 0x1d, 0x06, 0x33, 0xb2, 0x14, 0xc6, 0x87, 0xab, 0xe1, 0x2a, 0x87, 0x75, 0x29, 0x48, 0xd1,
+	0x61, 0x21, 0x3d, 0x6a, 0x46, 0x08, 0xf2, 0x91, 0x13, 0x64, 0xbf, 0xd1, 0xc7, 0xb0, 0xc8, 0xa2,
+	0x64, 0x7e, 0x7e, 0xd6, 0xa0, 0x1f, 0x06, 0xe6, 0x3c, 0xe5, 0x32, 0x47, 0xbb, 0xd9, 0x2c, 0xbb,
+	0x83, 0xbe, 0x64, 0xa5, 0x10, 0x68, 0x8c, 0x0a, 0x97, 0x5f, 0xe7, 0x30, 0xbf, 0xc8, 0x02, 0x1a,
+	0x8e, 0xbe, 0x84, 0x9d, 0xcb, 0x07, 0x76, 0x6e, 0x0e, 0x0a, 0xa6, 0x65, 0x90, 0x73, 0xa6, 0x9b,
+	0xf3, 0x98, 0x37, 0xd0, 0x23, 0x28, 0x7a, 0xbe, 0xe6, 0x0f, 0x3c, 0x36, 0x93, 0xe9, 0x51, 0x57,
+	0x22, 0xc2, 0xff, 0x80, 0xa1, 0x63, 0x41, 0x46, 0x27, 0xad, 0x3b, 0x03, 0x75, 0xe0, 0x69, 0x27,
+	0x44, 0xed, 0x9b, 0xba, 0x6b, 0xab, 0x1e, 0xd1, 0x6d, 0xcb, 0xf0, 0xe4, 0xa4, 0x75, 0x67, 0xf0,
+	0x84, 0xf6, 0xee, 0xd2, 0xce, 0x03, 0xde, 0x87, 0xee, 0xc1, 0x8c, 0x45, 0x7c, 0x41, 0xf6, 0xd2,
+	0x76, 0x0d, 0x4f, 0x24, 0xa9, 0xa6, 0x2c, 0xe2, 0x33, 0xf4, 0x67, 0x14, 0x88, 0x9e, 0x02, 0x72,
+	0x34, 0xfd, 0x45, 0xdc, 0x6d, 0x17, 0x16, 0x6b, 0xd4, 0xf5, 0x65, 0xf8, 0xd1, 0x1d, 0x99, 0x75,
+	0x92, 0x20, 0xe5, 0x6f, 0xe9, 0x35, 0x4e, 0x42, 0xd1, 0x6d, 0x80, 0x20, 0xa9, 0xc5, 0x6d, 0x4a,
+	0x05, 0x47, 0x20, 0x68, 0x05, 0xaa, 0xba, 0xdd, 0x77, 0x5c, 0xe2, 0x31, 0x0d, 0xc3, 0x17, 0x18,
+	0x05, 0xa1, 0x6f, 0x43, 0x43, 0xcc, 0x57, 0xb7, 0x2d, 0x9f, 0x9c, 0xfb, 0xea, 0xb1, 0x4b, 0x88,
+	0x6a, 0x68, 0xbe, 0xc6, 0x16, 0x58, 0xc3, 0xf3, 0xbc, 0xbf, 0xc5, 0xbb, 0x1f, 0xbb, 0x84, 0xb4,
+	0x35, 0x5f, 0x63, 0x89, 0xb5, 0xe1, 0x85, 0xe6, 0x19, 0x49, 0xca, 0xfc, 0xff, 0x2a, 0x07, 0xd5,
+	0x48, 0x7e, 0x0e, 0x7d, 0x07, 0x2a, 0x41, 0xc6, 0x50, 0x98, 0x9e, 0xe5, 0x35, 0x9e, 0x53, 0x5c,
+	0x93, 0x39, 0xc5, 0xb5, 0x43, 0x89, 0x81, 0x43, 0x64, 0xb4, 0x0c, 0x65, 0xa9, 0xdd, 0x84, 0xb4,
+	0x04, 0x6d, 0x7a, 0x9d, 0x45, 0x96, 0x86, 0x18, 0x6c, 0xd3, 0xa7, 0x70, 0x08, 0xe0, 0x94, 0xe4,
+	0xcc, 0xb4, 0x07, 0x5e, 0xa3, 0x28, 0x29, 0x79, 0x9b, 0x1a, 0xe9, 0xa8, 0xb7, 0xd1, 0x77, 0x6d,
+	0xdb, 0x6f, 0x94, 0xd8, 0x6a, 0xa2, 0x8e, 0xcd, 0x2e, 0x85, 0xcb, 0x0b, 0x1b, 0xe0, 0x95, 0x57,
+	0x32, 0xf2, 0xc2, 0x4a, 0x94, 0x77, 0x22, 0xb6, 0x5a, 0x2a, 0x78, 0x9e, 0xa3, 0x9b, 0x09, 0xec,
+	0x1c, 0x07, 0xa3, 0x1d, 0x98, 0xe5, 0xc9, 0xca, 0x68, 0xd2, 0xb1, 0x7a, 0xb5, 0xa4, 0x63, 0x9d,
+	0x53, 0x46, 0xb2, 0x8e, 0xfb, 0x50, 0xb7, 0xc8, 0x4b, 0x35, 0x30, 0x00, 0x93, 0x87, 0x1e, 0xd3,
+	0x16, 0x79, 0x29, 0x81, 0xde, 0xd3, 0x87, 0xca, 0xef, 0x03, 0xd4, 0x23, 0x47, 0xd9, 0x39, 0x23,
+	0x96, 0x3f, 0xe4, 0x95, 0x2e, 0x41, 0x99, 0xab, 0x01, 0xd3, 0x10, 0x76, 0xb0, 0xc4, 0xda, 0x5b,
+	0x06, 0xba, 0x01, 0x95, 0x40, 0x43, 0x88, 0x4b, 0xc3, 0x71, 0xa9, 0xa7, 0x92, 0x74, 0xc4, 0xf2,
+	0xc3, 0x8e, 0x18, 0x22, 0x30, 0x6b, 0x5a, 0x3e, 0x71, 0x2d, 0x1a, 0xbc, 0x19, 0x86, 0x19, 0xb9,
+	0x52, 0xdf, 0xba, 0xf4, 0xfa, 0xb3, 0xe9, 0xae, 0x35, 0x0d, 0x83, 0x06, 0x9e, 0x9c, 0x49, 0xef,
+	0x62, 0xf3, 0x1a, 0xae, 0x4b, 0x96, 0x4d, 0xc1, 0x11, 0x7d, 0x09, 0xe5, 0x80, 0x7b, 0x71, 0x25,
+	0x33, 0x3a, 0x7f, 0x99, 0xce, 0x7d, 0xf3, 0x1a, 0x0e, 0xe8, 0xd1, 0x1e, 0x54, 0x78, 0xd4, 0x49,
+	0x99, 0x95, 0xc6, 0x39, 0x44, 0x43, 0xcc, 0x64, 0x08, 0xba, 0x79, 0x0d, 0x87, 0x3c, 0x90, 0x0a,
+	0x33, 0x86, 0xef, 0x9e, 0xcb, 0x30, 0xcc, 0xb4, 0x4e, 0x98, 0xd4, 0x55, 0xd7, 0x3f, 0xba, 0x22,
+	0xdb, 0xb6, 0xef, 0x9e, 0xcb, 0x23, 0xa6, 0xbc, 0xa7, 0x8d, 0x10, 0x60, 0x5a, 0x27, 0xe8, 0x08,
+	0x66, 0xd9, 0x00, 0xba, 0x66, 0xe9, 0xa4, 0xd7, 0xd3, 0x7c, 0x29, 0xb1, 0xd5, 0xf5, 0x0f, 0x27,
+	0x18, 0xa2, 0xc5, 0xc8, 0xd9, 0x08, 0x75, 0x23, 0x68, 0x73, 0x76, 0xcb, 0x3f, 0x80, 0x99, 0xc4,
+	0x41, 0xa0, 0x2d, 0xa8, 0x46, 0xf5, 0x47, 0x66, 0x9c, 0xa2, 0xa4, 0xf6, 0x3b, 0xae, 0x28, 0xa3,
+	0xb4, 0xcb, 0xbf, 0xca, 0x40, 0x81, 0xb1, 0x47, 0x1b, 0x50, 0x72, 0xb9, 0x55, 0x11, 0x0c, 0xaf,
+	0x9e, 0x03, 0x94, 0x84, 0xc9, 0x89, 0x65, 0x5f, 0x7d, 0x62, 0xa8, 0x09, 0x55, 0x67, 0x70, 0xd4,
+	0x33, 0x75, 0x95, 0x79, 0x13, 0x5c, 0xdb, 0xad, 0x8c, 0xb8, 0x8d, 0x0c, 0x71, 0x9b, 0x5c, 0x78,
+	0x18, 0x9c, 0xe0, 0xf7, 0xf2, 0xcf, 0x32, 0x50, 0x96, 0x82, 0x81, 0x3e, 0x85, 0x02, 0x8b, 0x86,
+	0x1a, 0x99, 0x71, 0xf7, 0x7a, 0x28, 0x77, 0xc5, 0x89, 0x50, 0x0b, 0xaa, 0x47, 0xa1, 0x22, 0x16,
+	0x0b, 0xbb, 0x42, 0x45, 0x25, 0x4a, 0xb5, 0xfc, 0x47, 0x19, 0x98, 0x8a, 0x49, 0x14, 0xfa, 0x1e,
+	0x80, 0xee, 0x12, 0x96, 0xb4, 0x3e, 0xba, 0x10, 0x33, 0x1b, 0xad, 0xbe, 0xda, 0x3c, 0x4f, 0x58,
+	0x11, 0x24, 0x1b, 0x17, 0xaf, 0x71, 0xbf, 0x97, 0xf7, 0xa1, 0x16, 0x15, 0x45, 0xf4, 0x39, 0x54,
+	0x75, 0xf1, 0x7b, 0x82, 0xb9, 0x81, 0xa4, 0xd9, 0xb8, 0xd8, 0x28, 0x41, 0x81, 0x50, 0x11, 0x57,
+	0xde, 0x07, 0x08, 0x4f, 0x08, 0xdd, 0x89, 0x1f, 0xac, 0xb0, 0xbf, 0xe1, 0xb1, 0x29, 0x3f, 0x2f,
+	0xc2, 0x5c, 0x64, 0x9a, 0x3b, 0xe6, 0x31, 0xd1, 0x2f, 0xf4, 0x1e, 0x19, 0x52, 0x9f, 0x4f, 0x01,
+	0x45, 0xcd, 0x8f, 0x70, 0x71, 0xb2, 0x93, 0xb9, 0x38, 0xb3, 0x7e, 0x12, 0x84, 0x9e, 0xc3, 0xf5,
+	0x78, 0x58, 0xce, 0x6f, 0xc5, 0x9b, 0x13, 0xde, 0x0a, 0xe4, 0x0f, 0xc1, 0x92, 0x07, 0x06, 0xbf,
+	0xc1, 0x05, 0x49, 0xec, 0xe3, 0xf5, 0xe4, 0x3e, 0xa2, 0x3d, 0x98, 0x09, 0x54, 0x21, 0xcf, 0x04,
+	0x34, 0xaa, 0x13, 0xc9, 0xfe, 0x74, 0x40, 0xce, 0xda, 0xe8, 0x19, 0x2c, 0x84, 0x0c, 0xb9, 0x75,
+	0x12, 0x15, 0xc6, 0xda, 0x55, 0xef, 0xc3, 0x5c, 0xc0, 0x20, 0x02, 0x4d, 0x5c, 0x83, 0xb9, 0x89,
+	0xaf, 0x41, 0x42, 0x56, 0xe7, 0x27, 0x96, 0x55, 0xf4, 0x21, 0xcc, 0x33, 0x76, 0x74, 0x65, 0x31,
+	0xd3, 0x7a, 0x97, 0x99, 0xd6, 0x39, 0xd9, 0x19, 0x2d, 0x13, 0xa2, 0x8f, 0xa3, 0xfb, 0x11, 0xa3,
+	0x52, 0x18, 0xd5, 0x7c, 0xd0, 0x1b, 0x23, 0xfb, 0x04, 0x1a, 0x7c, 0xe4, 0x94, 0xe1, 0xde, 0x60,
+	0x84, 0x8b, 0x91, 0xfe, 0x28, 0xe9, 0x97, 0xf9, 0xf2, 0x54, 0xfd, 0xfa, 0x97, 0xf9, 0xf2, 0x42,
+	0xfd, 0xae, 0xf2, 0xf3, 0x0c, 0xcc, 0x0e, 0x89, 0x08, 0x55, 0x54, 0xc3, 0xa6, 0xe1, 0xee, 0xe5,
+	0x32, 0x5b, 0xf5, 0x47, 0x7a, 0xc8, 0xd9, 0x21, 0x0f, 0xf9, 0x3e, 0xcc, 0xa6, 0x39, 0xbe, 0x34,
+	0x00, 0x9b, 0xd1, 0xe3, 0x2e, 0xaf, 0xf2, 0x87, 0x59, 0xa8, 0x46, 0x27, 0xf8, 0x28, 0x28, 0x4b,
+	0x8f, 0x35, 0x5b, 0x11, 0x92, 0x44, 0x71, 0xba, 0x0b, 0x73, 0xb1, 0xc1, 0x65, 0xe1, 0x8a, 0xc7,
+	0x9b, 0x37, 0x47, 0xd7, 0xf8, 0x6c, 0x0b, 0xa3, 0xc8, 0xec, 0x38, 0xc8, 0x43, 0xdf, 0x82, 0x92,
+	0x64, 0x91, 0xbb, 0x02, 0x0b, 0x89, 0x8c, 0x1e, 0x01, 0x44, 0x5c, 0xcf, 0xfc, 0xd5, 0x5c, 0xcf,
+	0x08, 0x89, 0xf2, 0x07, 0x59, 0x98, 0x1d, 0x5a, 0x26, 0xfa, 0x2e, 0x65, 0xeb, 0x98, 0xae, 0x16,
+	0x39, 0xbf, 0x71, 0x4e, 0x7e, 0x04, 0x1b, 0x29, 0x30, 0xe5, 0x92, 0xe3, 0x30, 0xb4, 0x94, 0xb1,
+	0x8b, 0x4b, 0x8e, 0x65, 0x40, 0x49, 0xf3, 0x61, 0x21, 0x8e, 0xe3, 0x92, 0x63, 0xf3, 0x5c, 0xf8,
+	0x97, 0xd3, 0x12, 0x6d, 0x9f, 0x41, 0xd1, 0xfb, 0x70, 0xbd, 0xaf, 0x9d, 0xab, 0xc9, 0x08, 0x2e,
+	0xcf, 0x90, 0xeb, 0x7d, 0xed, 0xbc, 0x1b, 0x0b, 0xe2, 0xde, 0x06, 0x0a, 0x53, 0x23, 0x71, 0xa2,
+	0x27, 0xa2, 0x89, 0xa9, 0xbe, 0x76, 0xde, 0x92, 0xf1, 0xa1, 0x47, 0x5d, 0x5b, 0x83, 0xf4, 0xb4,
+	0x0b, 0x1a, 0x42, 0x32, 0x9f, 0x71, 0x0a, 0x97, 0x19, 0xe0, 0x80, 0xe8, 0xca, 0xdf, 0x55, 0x62,
+	0x7e, 0x33, 0x57, 0x3c, 0x49, 0xc5, 0x1f, 0x73, 0x8e, 0xb3, 0x2c, 0xd2, 0x0d, 0x9d, 0xe3, 0x20,
+	0x04, 0x5e, 0x8e, 0x86, 0xc0, 0x9f, 0x00, 0x70, 0x12, 0x1a, 0x13, 0x5d, 0x25, 0x76, 0x62, 0xd8,
+	0xb4, 0x4d, 0xa5, 0x3d, 0x28, 0xa5, 0x07, 0xee, 0x3a, 0x0f, 0xa2, 0x66, 0x64, 0xc7, 0x86, 0x70,
+	0xdb, 0x37, 0x43, 0x27, 0x8a, 0xfb, 0xda, 0x6b, 0x57, 0x35, 0x17, 0x42, 0xca, 0x25, 0x39, 0xcd,
+	0x71, 0x91, 0x9e, 0xe6, 0x78, 0xc4, 0x60, 0x7b, 0x94, 0xc3, 0xb2, 0x49, 0x57, 0x1f, 0x9c, 0x09,
+	0x73, 0x93, 0xf3, 0xb8, 0x2c, 0xe3, 0x69, 0x1a, 0xcc, 0xc9, 0x50, 0xc9, 0x60, 0xce, 0x6e, 0x19,
+	0x87, 0x00, 0xf4, 0x18, 0xa6, 0xe2, 0x85, 0xbc, 0xca, 0xb8, 0xc4, 0x5f, 0x33, 0x62, 0x0b, 0x6a,
+	0xb1, 0xb2, 0x1d, 0x86, 0xd9, 0x63, 0xcd, 0xa4, 0xea, 0x96, 0xb9, 0xbf, 0xdc, 0xb8, 0xc0, 0x44,
+	0xc6, 0x65, 0x86, 0x33, 0xa0, 0x3e, 0x07, 0x3f, 0xe4, 0xcf, 0xa8, 0xf7, 0xaf, 0x13, 0x87, 0xc9,
+	0xfd, 0xcc, 0x78, 0x15, 0x2e, 0xd0, 0x70, 0x48, 0x41, 0xd3, 0x45, 0xc4, 0x75, 0x6d, 0x57, 0xa5,
+	0x68, 0xec, 0x55, 0x41, 0x1e, 0x57, 0x18, 0xa4, 0x65, 0x1b, 0x04, 0x3d, 0x84, 0xa2, 0x71, 0xc4,
+	0x2a, 0xb1, 0xb3, 0x6c, 0xc9, 0xcb, 0xe9, 0xac, 0xdb, 0x1b, 0x7b, 0x0e, 0x2e, 0x18, 0x47, 0xb4,
+	0xde, 0xfa, 0x10, 0x8a, 0x2f, 0xce, 0x18, 0xc9, 0xed, 0x71, 0x24, 0xdb, 0x4f, 0x29, 0xc9, 0x8b,
+	0x33, 0x4a, 0xf2, 0x6d, 0x28, 0xb3, 0x0d, 0xa1, 0x44, 0x68, 0x9c, 0x32, 0x11, 0x26, 0xa8, 0x44,
+	0xb1, 0x29, 0xe1, 0xe7, 0x50, 0x15, 0x59, 0xee, 0xc8, 0x8b, 0x81, 0x11, 0xcb, 0x17, 0x69, 0x6f,
+	0x6a, 0xc1, 0x8e, 0xe5, 0x4f, 0x36, 0xb4, 0x43, 0xdc, 0x7e, 0xa4, 0xac, 0x7d, 0x73, 0xd4, 0x9b,
+	0x0a, 0xb7, 0x4f, 0x87, 0x76, 0xd8, 0x5f, 0x0f, 0x7d, 0x04, 0x25, 0x57, 0xe3, 0x74, 0xf3, 0xe3,
+	0x8a, 0xd4, 0xb8, 0xb9, 0xbb, 0xe7, 0xe0, 0xa2, 0xab, 0x31, 0xaa, 0x03, 0x40, 0x94, 0x4a, 0xb7,
+	0x5d, 0x97, 0x84, 0x55, 0xee, 0x85, 0x95, 0xdc, 0xe8, 0x22, 0x03, 0x6e, 0xee, 0xb6, 0x02, 0xf4,
+	0x3d, 0x07, 0xd7, 0x5d, 0xad, 0x1f, 0x05, 0x78, 0x89, 0x67, 0x13, 0x8b, 0x93, 0x3e, 0x9b, 0xf8,
+	0x2e, 0x54, 0x7c, 0x8d, 0x3e, 0xf0, 0xa1, 0xd4, 0x0d, 0x46, 0x7d, 0x6b, 0x84, 0x34, 0x52, 0xb4,
+	0x3d, 0x07, 0x97, 0x7d, 0xfe, 0x83, 0x16, 0xa2, 0xa7, 0x02, 0x07, 0xc0, 0x77, 0x09, 0x69, 0x2c,
+	0x8d, 0x2b, 0x71, 0xb7, 0x04, 0xea, 0xe3, 0x9e, 0xe6, 0xd3, 0x5c, 0x23, 0xae, 0x49, 0xe2, 0x43,
+	0x97, 0x10, 0xe5, 0x97, 0x19, 0x68, 0x8c, 0xba, 0xe1, 0xff, 0xd7, 0x93, 0x71, 0xca, 0xdf, 0x64,
+	0xa0, 0xc8, 0x6f, 0x3e, 0xd5, 0x41, 0x22, 0xb9, 0x2d, 0x94, 0xaf, 0x6c, 0x06, 0x99, 0xcd, 0x6c,
+	0x24, 0xb3, 0xb9, 0x0d, 0x53, 0x22, 0xdb, 0xfd, 0x23, 0x6e, 0xbc, 0x72, 0xe3, 0xa4, 0x81, 0x8a,
+	0xa1, 0xc9, 0xd2, 0x69, 0x3b, 0xe4, 0x8c, 0xf4, 0x70, 0x9c, 0x96, 0x2a, 0xb9, 0x6f, 0x3c, 0xdb,
+	0xe2, 0xae, 0x85, 0xc8, 0x58, 0x51, 0x00, 0x4b, 0xa3, 0x2d, 0x41, 0xd9, 0xd5, 0x5e, 0xf2, 0xbe,
+	0x02, 0x4b, 0x23, 0x95, 0x5c, 0xed, 0x25, 0x73, 0x37, 0x7e, 0x55, 0x86, 0x6a, 0x44, 0x6f, 0xd1,
+	0xf4, 0x15, 0xd3, 0xa8, 0x67, 0xc4, 0x65, 0xde, 0x6f, 0x05, 0x07, 0x6d, 0xf4, 0x59, 0x32, 0xe2,
+	0x7d, 0x63, 0xac, 0xe5, 0x4f, 0x06, 0xbb, 0x1f, 0x41, 0x31, 0x16, 0x77, 0x8d, 0xf7, 0x1b, 0x04,
+	0x2e, 0x4d, 0x83, 0x45, 0xdd, 0x17, 0x76, 0x06, 0x65, 0x5c, 0x15, 0x30, 0xea, 0x98, 0x44, 0x55,
+	0x7f, 0x3e, 0xae, 0xfa, 0x1b, 0x50, 0xd2, 0x6d, 0xcb, 0xb3, 0x7b, 0xf2, 0x35, 0x9e, 0x6c, 0xa2,
+	0xb7, 0x60, 0x3a, 0x1a, 0xb3, 0x98, 0x86, 0x48, 0xd6, 0x4d, 0x45, 0xa0, 0xc9, 0xb4, 0x52, 0x29,
+	0x61, 0x39, 0x53, 0x0d, 0x5d, 0x39, 0xdd, 0xd0, 0xc5, 0xed, 0x69, 0x65, 0x12, 0x7b, 0x8a, 0xe1,
+	0xba, 0x2c, 0xa3, 0x18, 0xa6, 0xf7, 0x42, 0x35, 0x48, 0xcf, 0xd7, 0xbc, 0xc6, 0x2a, 0x93, 0x16,
+	0x65, 0xd4, 0x26, 0x32, 0x82, 0x36, 0x45, 0xc5, 0xb3, 0x82, 0xbc, 0x6d, 0x7a, 0x2f, 0x18, 0x84,
+	0xa9, 0x23, 0xc9, 0x93, 0xaa, 0x25, 0xc1, 0x12, 0xc6, 0x09, 0xa0, 0x60, 0x89, 0x9b, 0xbb, 0x9c,
+	0x6b, 0x5d, 0x30, 0xc0, 0x5a, 0x5f, 0x30, 0xbd, 0x0b, 0x35, 0x97, 0xf8, 0x03, 0xd7, 0x52, 0xcf,
+	0xb4, 0xde, 0x80, 0xb0, 0x52, 0x79, 0x0d, 0x57, 0x39, 0xec, 0x29, 0x05, 0xd1, 0x2d, 0x63, 0x62,
+	0x1a, 0xc3, 0xe3, 0xf5, 0xf0, 0x19, 0xda, 0x81, 0x23, 0xb8, 0xaf, 0xd5, 0xc0, 0xcd, 0x25, 0x0d,
+	0xdc, 0x5b, 0x30, 0x2d, 0xce, 0xde, 0x76, 0x0d, 0xd3, 0xd2, 0x7a, 0xcc, 0x06, 0x4e, 0x61, 0x61,
+	0xf0, 0xf7, 0x38, 0x10, 0x7d, 0x04, 0x0b, 0x4c, 0x55, 0xd9, 0xae, 0x9a, 0x40, 0x9f, 0x15, 0xba,
+	0x83, 0xf7, 0x36, 0x63, 0x54, 0xdf, 0x87, 0xfb, 0x7a, 0xcf, 0xf6, 0x88, 0xe7, 0xab, 0x03, 0xcb,
+	0xb2, 0x7d, 0xf3, 0x98, 0xbe, 0x23, 0xa4, 0x41, 0x8a, 0x97, 0xc2, 0x09, 0x31, 0x4e, 0xf7, 0x04,
+	0xc5, 0x93, 0x80, 0xa0, 0x29, 0xf0, 0xe3, 0xbc, 0xdf, 0x8e, 0x86, 0xa9, 0xdc, 0x73, 0xbb, 0xce,
+	0xfd, 0xd1, 0x00, 0xbc, 0x45, 0xa1, 0xf1, 0x77, 0x0f, 0x7d, 0xcd, 0xa7, 0x5e, 0x4d, 0xe3, 0x76,
+	0xe2, 0xdd, 0xc3, 0x2e, 0x87, 0xd3, 0xe7, 0x31, 0x43, 0xc8, 0xf1, 0x97, 0x07, 0xe2, 0xc1, 0x01,
+	0x7b, 0x97, 0x51, 0xc6, 0x4a, 0x92, 0x43, 0xf4, 0xc9, 0x01, 0x7f, 0x6a, 0xa0, 0xfc, 0x59, 0x16,
+	0xa6, 0x62, 0xba, 0x20, 0xa6, 0x5d, 0x32, 0x09, 0xed, 0xb2, 0x00, 0x45, 0xc3, 0x3c, 0x21, 0x9e,
+	0x2f, 0x94, 0xa4, 0x68, 0xd1, 0xf5, 0x9e, 0xf4, 0xec, 0x23, 0xad, 0xa7, 0x7a, 0xe4, 0x87, 0x03,
+	0x62, 0xe9, 0x5c, 0x07, 0xe4, 0xf1, 0x34, 0x07, 0x1f, 0x08, 0x28, 0xfa, 0x82, 0xeb, 0xd3, 0x10,
+	0x2d, 0x3f, 0xf6, 0x86, 0x0c, 0xfc, 0x53, 0x49, 0x8a, 0x6b, 0x5a, 0xa4, 0x45, 0x0b, 0xdb, 0x2e,
+	0xd1, 0xcf, 0x42, 0x46, 0x05, 0x36, 0x5e, 0x8d, 0x02, 0xa3, 0x48, 0x94, 0x57, 0x88, 0xc4, 0x2b,
+	0x48, 0x35, 0x0a, 0x0c, 0x90, 0x68, 0x0e, 0xff, 0xc8, 0x0c, 0x71, 0xb8, 0x06, 0xa9, 0x6a, 0x47,
+	0xa6, 0x44, 0x51, 0x76, 0xa1, 0x16, 0x9d, 0xca, 0x55, 0x8a, 0xa6, 0xcb, 0x50, 0x0e, 0x38, 0x0a,
+	0x6f, 0x5e, 0xb6, 0x95, 0x26, 0xcc, 0x24, 0x2e, 0xea, 0x18, 0xab, 0x34, 0x07, 0x05, 0x76, 0xf3,
+	0x19, 0x97, 0x1c, 0xe6, 0x0d, 0xe5, 0x7b, 0x50, 0x8b, 0xaa, 0x8f, 0x89, 0xe9, 0x3f, 0x84, 0x4a,
+	0x10, 0xc0, 0x51, 0xc3, 0xe7, 0x5f, 0x38, 0x44, 0xd4, 0x23, 0xd9, 0x6f, 0x0a, 0x33, 0x34, 0x41,
+	0x55, 0xc3, 0xec, 0xb7, 0xf2, 0xd3, 0x2c, 0x14, 0x98, 0x8f, 0x87, 0x5a, 0x50, 0xb1, 0x1d, 0x12,
+	0x89, 0xe7, 0xa6, 0x47, 0xbf, 0xc2, 0x38, 0xdf, 0x73, 0xd6, 0xf6, 0x24, 0x32, 0x0e, 0xe9, 0x52,
+	0xed, 0xed, 0xb0, 0xca, 0xcf, 0xa5, 0xa9, 0xfc, 0x44, 0xca, 0x29, 0xff, 0xea, 0x29, 0x27, 0xe5,
+	0x3b, 0x50, 0x09, 0x66, 0x87, 0xe6, 0x61, 0x76, 0x6f, 0xbf, 0x83, 0x9b, 0x87, 0x5b, 0x7b, 0x5d,
+	0xf5, 0x49, 0x77, 0xbb, 0xbb, 0xf7, 0xac, 0x5b, 0xbf, 0x86, 0xe6, 0xa0, 0x1e, 0x82, 0x5b, 0xb8,
+	0xd3, 0x3c, 0xec, 0xd4, 0x33, 0xca, 0x9f, 0xe7, 0x20, 0x4f, 0x7d, 0x6b, 0xb4, 0x31, 0xbc, 0x1b,
+	0x6f, 0x8e, 0x76, 0xc5, 0xd3, 0x37, 0x23, 0xac, 0x24, 0x71, 0x75, 0x21, 0xa2, 0x5c, 0xb1, 0x62,
+	0x0a, 0xa2, 0xfb, 0xc5, 0xd4, 0x24, 0xdf, 0x11, 0xf6, 0x9b, 0x9e, 0xae, 0xa7, 0xdb, 0x0e, 0x11,
+	0xee, 0x04, 0x6f, 0x50, 0xb5, 0xca, 0x9d, 0x46, 0xb6, 0xbf, 0xdc, 0xaa, 0x72, 0x37, 0x92, 0xc9,
+	0x26, 0xcd, 0xb2, 0xb9, 0x66, 0x5f, 0x73, 0x2f, 0xd8, 0x6b, 0x05, 0x6e, 0x54, 0x41, 0x80, 0xe8,
+	0xbb, 0x87, 0x1b, 0x50, 0xb1, 0x7b, 0x86, 0xea, 0x68, 0x17, 0xc4, 0x65, 0xf7, 0xa1, 0x82, 0xcb,
+	0x76, 0xcf, 0xd8, 0xa7, 0x6d, 0x1e, 0xaa, 0xbd, 0x14, 0x9d, 0xdc, 0x92, 0x96, 0x69, 0xa1, 0x88,
+	0x75, 0x2e, 0x01, 0x45, 0xe4, 0x5e, 0x4c, 0x85, 0x7b, 0x31, 0x76, 0xcf, 0x90, 0x0e, 0x0e, 0xa5,
+	0x63, 0x5d, 0xc0, 0xbb, 0x2c, 0xc2, 0x1d, 0x1c, 0x63, 0xd2, 0x33, 0xd8, 0xea, 0x1e, 0x74, 0xf0,
+	0x61, 0x3d, 0x13, 0x87, 0x3e, 0xd9, 0x6f, 0xd3, 0x93, 0xc9, 0xc6, 0xa1, 0xb8, 0xb3, 0xbb, 0xf7,
+	0xb4, 0x53, 0xcf, 0x29, 0xbf, 0xce, 0x42, 0x7e, 0xfb, 0xe9, 0x44, 0xe7, 0xb5, 0xfd, 0xf4, 0x35,
+	0x9f, 0x57, 0x1d, 0x72, 0x74, 0xcb, 0x79, 0x75, 0x94, 0xfe, 0x8c, 0xef, 0x75, 0x61, 0xdc, 0x5e,
+	0x17, 0xc7, 0xec, 0x75, 0x69, 0xf4, 0x5e, 0x97, 0xff, 0x37, 0xf6, 0xfa, 0x3f, 0x6a, 0x50, 0x60,
+	0xc1, 0xd5, 0x04, 0xaa, 0x82, 0xe1, 0xbf, 0xf2, 0x6e, 0xcf, 0x41, 0x81, 0x6f, 0x13, 0xdf, 0x6e,
+	0xde, 0x08, 0xb5, 0x5f, 0x3e, 0xa2, 0xfd, 0x28, 0x94, 0x67, 0x1a, 0xb8, 0xd1, 0xe0, 0x0d, 0x3a,
+	0x53, 0x7a, 0x5f, 0x3c, 0x47, 0x13, 0x96, 0xe2, 0x92, 0x99, 0x76, 0x25, 0x32, 0x0e, 0xe9, 0xd0,
+	0x77, 0x03, 0x07, 0xba, 0xc4, 0x38, 0x28, 0xe3, 0x38, 0x24, 0xdc, 0xe8, 0x5b, 0x00, 0x03, 0xcb,
+	0xfc, 0xe1, 0x80, 0xb0, 0x6b, 0xc9, 0xaf, 0x56, 0x85, 0x43, 0xe8, 0xf3, 0xa1, 0xdf, 0x2d, 0x5d,
+	0xe1, 0xe8, 0x96, 0x61, 0x21, 0xa9, 0xaa, 0xd4, 0xc3, 0xe6, 0xc6, 0x4e, 0xa7, 0x9e, 0x41, 0xb7,
+	0x61, 0x39, 0xec, 0x6b, 0x77, 0x1e, 0x77, 0x30, 0xee, 0xb4, 0xd5, 0x43, 0xfc, 0x95, 0xda, 0x6c,
+	0xb7, 0xeb, 0x59, 0x74, 0x17, 0x6e, 0x8d, 0xe8, 0x6f, 0x35, 0xbb, 0xad, 0xce, 0x4e, 0x3d, 0x37,
+	0x06, 0x65, 0xff, 0xc9, 0xc1, 0x66, 0xa7, 0x5d, 0xcf, 0xa3, 0x77, 0xe0, 0xad, 0x11, 0x28, 0xb8,
+	0xb9, 0xab, 0xb6, 0xf6, 0x30, 0xee, 0xb4, 0x68, 0x5f, 0xbd, 0x80, 0x14, 0xb8, 0x3d, 0x0a, 0x95,
+	0x09, 0x52, 0xbb, 0x5e, 0x44, 0x0d, 0x98, 0x8b, 0xe2, 0xec, 0x74, 0x0e, 0x3b, 0xcd, 0x27, 0x87,
+	0x9b, 0xf5, 0x12, 0x5a, 0x00, 0x14, 0xf6, 0xec, 0x6c, 0x75, 0xb7, 0x19, 0xbc, 0x1c, 0xa7, 0xe8,
+	0x76, 0x9e, 0x35, 0x5b, 0xad, 0xbd, 0x27, 0xdd, 0xc3, 0x7a, 0x05, 0xdd, 0x81, 0x1b, 0x61, 0xcf,
+	0x3e, 0xde, 0xda, 0x6d, 0xe2, 0xe7, 0xea, 0x56, 0xb7, 0xdd, 0xe1, 0x3b, 0x00, 0xf1, 0x09, 0xc5,
+	0x11, 0x84, 0x68, 0x57, 0xc7, 0xe1, 0x88, 0x4b, 0x51, 0x43, 0x1f, 0xc0, 0x7b, 0xe3, 0x71, 0xe8,
+	0x78, 0x74, 0x6e, 0xea, 0x7e, 0xf3, 0x79, 0x07, 0xd7, 0xa7, 0xd0, 0x87, 0xf0, 0xe0, 0x12, 0x0a,
+	0x3e, 0x01, 0x75, 0x6f, 0xa7, 0x2d, 0x88, 0xa6, 0xe3, 0x87, 0x2d, 0xfa, 0xf9, 0x61, 0xcf, 0xc4,
+	0x4f, 0xea, 0xa0, 0xd3, 0xda, 0xeb, 0xb6, 0xe3, 0xab, 0xad, 0xa3, 0x37, 0x61, 0x65, 0x34, 0x8a,
+	0x58, 0xef, 0x2c, 0x5a, 0x87, 0xb5, 0xd1, 0x58, 0xa9, 0xab, 0x41, 0xe8, 0x63, 0x78, 0x78, 0x29,
+	0xcd, 0xd0, 0x7a, 0xae, 0xc7, 0x75, 0xc9, 0x41, 0xe7, 0xb0, 0xb9, 0xb1, 0x55, 0x9f, 0x8b, 0x4b,
+	0xfa, 0x41, 0xe7, 0xb0, 0xb5, 0xd7, 0xee, 0xd4, 0xe7, 0xe3, 0xc7, 0xfc, 0xa4, 0x1b, 0x08, 0xc0,
+	0x42, 0xfc, 0x98, 0xf9, 0x68, 0xb4, 0x47, 0x5a, 0xee, 0xc5, 0x91, 0x08, 0xe2, 0xfc, 0x1a, 0x49,
+	0xa1, 0xdb, 0xc7, 0x9d, 0x56, 0xf3, 0xb0, 0xd3, 0xae, 0x2f, 0x29, 0x3f, 0xc9, 0x42, 0x25, 0xb8,
+	0xf8, 0x74, 0x6a, 0xdd, 0xe6, 0x6e, 0xe7, 0x60, 0xbf, 0xd9, 0xea, 0x44, 0x2e, 0xe1, 0x2c, 0x4c,
+	0x85, 0x60, 0xba, 0x88, 0x4c, 0x1c, 0x53, 0x4a, 0x64, 0x16, 0x21, 0x98, 0x8e, 0x80, 0xe9, 0xf4,
+	0x73, 0x68, 0x11, 0xae, 0xc7, 0x61, 0x4c, 0xb8, 0xeb, 0xf9, 0x38, 0x32, 0xdb, 0x85, 0x02, 0x15,
+	0x81, 0x10, 0x16, 0xbd, 0x42, 0xf5, 0x22, 0xba, 0x05, 0x4b, 0x61, 0x5f, 0xe2, 0x14, 0xea, 0x25,
+	0x74, 0x1d, 0x66, 0xc2, 0x6e, 0x2e, 0x36, 0xe5, 0xf8, 0xe0, 0x0c, 0xa8, 0xe2, 0xbd, 0x67, 0xf5,
+	0x0a, 0xaa, 0x43, 0x2d, 0xec, 0xd8, 0x7e, 0x5a, 0x07, 0xe5, 0x67, 0x61, 0x52, 0x05, 0xc1, 0x74,
+	0xb3, 0x95, 0xd0, 0x44, 0xd3, 0x00, 0x02, 0x46, 0xa5, 0x2d, 0x43, 0x37, 0x45, 0xb4, 0x85, 0x36,
+	0xc9, 0xd2, 0x4d, 0x91, 0xa0, 0x50, 0x2d, 0xe4, 0xd0, 0x0c, 0x54, 0x05, 0x98, 0x2a, 0x95, 0x7a,
+	0x3e, 0x42, 0x2a, 0xa4, 0xb2, 0x10, 0x01, 0x89, 0x43, 0x2b, 0x2a, 0xbf, 0x93, 0x81, 0x99, 0x44,
+	0x3e, 0x8e, 0x47, 0x00, 0xb2, 0xad, 0x06, 0x09, 0xf7, 0x5a, 0x08, 0xdc, 0x32, 0x12, 0x7a, 0x37,
+	0x9b, 0xd0, 0xbb, 0x93, 0x58, 0x16, 0x1a, 0x4e, 0x95, 0x44, 0x22, 0x8e, 0xbe, 0xdb, 0x4c, 0x5a,
+	0xbe, 0xb7, 0xc7, 0xa6, 0xee, 0x5e, 0xb3, 0xed, 0x93, 0xfe, 0x47, 0x3e, 0xcd, 0x5f, 0x2c, 0x8c,
+	0xf6, 0x17, 0x8b, 0x09, 0x7f, 0x51, 0xe9, 0xbe, 0x1e, 0x97, 0x41, 0x9c, 0x5d, 0x56, 0xf9, 0xc7,
+	0x3c, 0x14, 0x79, 0xb2, 0x18, 0xb5, 0x87, 0xf7, 0xe8, 0xde, 0xb8, 0xec, 0xf2, 0x2b, 0x6f, 0xd1,
+	0x02, 0x14, 0x3d, 0x62, 0x19, 0xc1, 0x1e, 0x89, 0x16, 0xf5, 0xb0, 0xf8, 0xaf, 0xb0, 0x00, 0x52,
+	0xe6, 0x80, 0x2d, 0x23, 0xdc, 0xd7, 0x42, 0x74, 0x5f, 0xef, 0x42, 0x8d, 0x55, 0xa4, 0x3d, 0x1a,
+	0x7e, 0x6b, 0xbe, 0xd8, 0xaf, 0x6a, 0x00, 0x6b, 0xfa, 0xd4, 0xc3, 0xe6, 0xe5, 0xa0, 0x81, 0xe5,
+	0x9b, 0x3d, 0xe1, 0x42, 0x03, 0x03, 0x3d, 0xa1, 0x10, 0x2a, 0x97, 0x61, 0x8d, 0x8b, 0x32, 0xe1,
+	0xd6, 0xbe, 0x16, 0x02, 0x9b, 0x7e, 0x4a, 0x30, 0x54, 0xb9, 0x42, 0x30, 0xf4, 0x1b, 0xd4, 0xdf,
+	0x95, 0xbf, 0xce, 0xbc, 0x6a, 0x34, 0x84, 0x96, 0x60, 0x3e, 0x84, 0xd2, 0x7b, 0x2b, 0xbb, 0x12,
+	0x2e, 0xe2, 0xe3, 0xe6, 0xd6, 0x4e, 0xa7, 0x5d, 0xcf, 0x25, 0xd8, 0x70, 0x95, 0x90, 0x47, 0x37,
+	0x60, 0x31, 0x84, 0xee, 0xee, 0xb5, 0xb7, 0x1e, 0x3f, 0x97, 0x9d, 0x85, 0xf4, 0x4e, 0x3e, 0x4a,
+	0x51, 0xf9, 0x75, 0x86, 0xc5, 0xb4, 0x42, 0xb0, 0xd6, 0x61, 0xde, 0xb3, 0x07, 0xae, 0x4e, 0xd4,
+	0xc4, 0x16, 0x72, 0x05, 0x70, 0x9d, 0x77, 0x1e, 0x8e, 0x4e, 0x24, 0x26, 0x4b, 0x70, 0xd1, 0x77,
+	0x6d, 0xb9, 0xf8, 0xbb, 0xb6, 0x78, 0xde, 0x30, 0x3f, 0x49, 0xde, 0xf0, 0x63, 0x28, 0x89, 0xe2,
+	0x4a, 0xa3, 0x30, 0x2e, 0xe1, 0xca, 0x57, 0x85, 0x8b, 0xbc, 0xb6, 0xa2, 0xfc, 0x5b, 0x06, 0x2a,
+	0x41, 0xc9, 0x84, 0x5e, 0xf4, 0x17, 0xa6, 0x25, 0x97, 0xc6, 0x7e, 0x5f, 0xe5, 0x4a, 0xbc, 0x05,
+	0xd3, 0xb2, 0x3e, 0x23, 0x92, 0x3a, 0x22, 0xd6, 0x16, 0xd0, 0x36, 0x03, 0xa2, 0x6f, 0x43, 0x49,
+	0x00, 0xc4, 0xd2, 0x6e, 0x8d, 0x2d, 0xe1, 0x60, 0x89, 0xad, 0x6c, 0x40, 0x7e, 0x9b, 0x4e, 0xa5,
+	0x0e, 0xb5, 0xed, 0xad, 0x6e, 0x3b, 0x22, 0x41, 0xf3, 0x30, 0xcb, 0x20, 0xfb, 0x98, 0xda, 0xc2,
+	0xc3, 0xad, 0xa7, 0x5c, 0x84, 0x66, 0x61, 0x8a, 0x81, 0x03, 0x50, 0x56, 0xf9, 0x11, 0xd4, 0x93,
+	0x75, 0x09, 0xf4, 0x01, 0xcc, 0x25, 0xd2, 0x7d, 0x7c, 0x89, 0x74, 0xf9, 0x05, 0x8c, 0x62, 0xc9,
+	0x3e, 0xbe, 0xd2, 0x8f, 0xa2, 0x8f, 0x1a, 0x52, 0xb6, 0x25, 0x7c, 0xc1, 0x11, 0xa1, 0x52, 0xfe,
+	0x29, 0x0b, 0x45, 0x5e, 0x58, 0x9a, 0x40, 0x4d, 0x71, 0x82, 0x57, 0x56, 0x53, 0x4d, 0x1e, 0xd3,
+	0xd1, 0x3a, 0x96, 0x78, 0xd6, 0x77, 0xef, 0xb2, 0x52, 0xc3, 0xde, 0xd1, 0x37, 0x44, 0xf7, 0x59,
+	0xec, 0x47, 0x81, 0xa8, 0xc9, 0x63, 0x3f, 0xc6, 0xa2, 0x32, 0x19, 0x0b, 0x1a, 0x5a, 0x12, 0xb7,
+	0xff, 0x3f, 0x14, 0x23, 0xfe, 0x7b, 0x06, 0xea, 0xc9, 0x39, 0x88, 0xb2, 0x38, 0xb0, 0xcb, 0x27,
+	0xca, 0xe2, 0x8e, 0xe6, 0x12, 0xcb, 0xa7, 0xf7, 0xae, 0xca, 0xef, 0x24, 0x07, 0x70, 0xfd, 0x6c,
+	0xbf, 0xb4, 0x82, 0x3c, 0x25, 0x6f, 0xa4, 0xe6, 0x95, 0x3e, 0x83, 0x1a, 0x7b, 0xa1, 0x3e, 0x70,
+	0xf8, 0xd7, 0xcd, 0x97, 0x17, 0xcb, 0xab, 0x14, 0xff, 0x89, 0x23, 0xbf, 0x7d, 0xae, 0x84, 0x1f,
+	0x3d, 0xe4, 0xc7, 0xa5, 0xb9, 0x23, 0x9f, 0x5e, 0x04, 0x14, 0xca, 0x8f, 0x01, 0xc2, 0x85, 0xa6,
+	0xbe, 0xa0, 0x5f, 0x80, 0x22, 0x5f, 0x95, 0x4c, 0xac, 0xf2, 0x16, 0x6a, 0xd3, 0x34, 0xe7, 0x0f,
+	0x07, 0x26, 0xfd, 0x7a, 0x91, 0xf2, 0x6b, 0xe4, 0xae, 0x36, 0x78, 0x4d, 0x52, 0x51, 0x90, 0xf2,
+	0x2f, 0x19, 0xa8, 0x04, 0x7d, 0xff, 0x0d, 0x9f, 0x3a, 0xa0, 0x2f, 0xa0, 0x2c, 0xf2, 0x8d, 0xf2,
+	0xc1, 0xc9, 0xbb, 0x57, 0xaa, 0x90, 0x09, 0x26, 0x01, 0x31, 0xfa, 0x16, 0x14, 0x5e, 0x6a, 0xa6,
+	0x2f, 0xdf, 0x9e, 0x8c, 0x78, 0x1b, 0xf9, 0x4c, 0x33, 0x7d, 0x41, 0xca, 0xd1, 0x95, 0x0d, 0xa8,
+	0x04, 0x73, 0xa2, 0xee, 0x4c, 0xf8, 0x8a, 0x4c, 0x6c, 0x73, 0x25, 0x78, 0x44, 0x46, 0xf7, 0xfa,
+	0x25, 0x43, 0x94, 0xff, 0x76, 0x82, 0xb7, 0x94, 0x2f, 0x60, 0x26, 0x31, 0x3d, 0x2a, 0x60, 0x9a,
+	0xee, 0xdb, 0x81, 0x80, 0xb1, 0x06, 0x7d, 0x4a, 0xe4, 0x04, 0x88, 0xe2, 0xc0, 0x22, 0x10, 0xe5,
+	0x0c, 0xe6, 0x53, 0xd7, 0x89, 0x3a, 0x31, 0xc2, 0xcc, 0xb8, 0xaf, 0xd7, 0x12, 0x0c, 0xa2, 0xfc,
+	0x47, 0x2e, 0xe0, 0x11, 0x40, 0xb8, 0x33, 0xd4, 0x60, 0xd1, 0xbd, 0x61, 0x2f, 0x52, 0xc4, 0x17,
+	0x45, 0xb4, 0x7d, 0x40, 0xf4, 0x91, 0x0c, 0xfe, 0x3e, 0x07, 0x65, 0x59, 0x84, 0x46, 0x8f, 0x87,
+	0x75, 0xde, 0xea, 0xf8, 0xba, 0x75, 0xba, 0xd6, 0xfb, 0x04, 0x0a, 0x9e, 0xaf, 0xf9, 0x64, 0xfc,
+	0x03, 0x53, 0xce, 0x83, 0x56, 0x85, 0xc9, 0xe6, 0x35, 0xcc, 0x29, 0xd0, 0xa7, 0x50, 0x64, 0x8f,
+	0xf6, 0x4f, 0x84, 0xd8, 0x2b, 0xe3, 0x68, 0x5b, 0x0c, 0x73, 0xf3, 0x1a, 0x16, 0x34, 0x08, 0xc3,
+	0xb4, 0x90, 0x2b, 0x95, 0x21, 0xc8, 0xef, 0x10, 0xdf, 0x19, 0xc7, 0x45, 0x64, 0xd5, 0x77, 0x18,
+	0xc1, 0xe6, 0x35, 0x5a, 0x6a, 0x8a, 0x00, 0xd0, 0x1e, 0x48, 0x80, 0x1a, 0x66, 0x90, 0xaa, 0xeb,
+	0xab, 0x57, 0x60, 0xc9, 0xca, 0xd1, 0x9b, 0xd7, 0x70, 0x4d, 0x8b, 0xb4, 0x95, 0xee, 0xeb, 0x55,
+	0xb5, 0x1b, 0x45, 0xee, 0x0b, 0x28, 0xff, 0x99, 0x83, 0x6a, 0x64, 0x4f, 0xd1, 0xd7, 0xb0, 0xa8,
+	0x9d, 0x11, 0x97, 0xd6, 0xc9, 0x85, 0x8f, 0x13, 0x3c, 0xb7, 0x19, 0xfb, 0x78, 0x98, 0xcd, 0xb2,
+	0xa9, 0xeb, 0x83, 0xfe, 0xa0, 0x47, 0xcd, 0x2a, 0x9e, 0x13, 0x6c, 0xf8, 0xe3, 0x2b, 0xf9, 0x44,
+	0x67, 0x88, 0x7d, 0x50, 0xcd, 0x6f, 0x64, 0x5f, 0x9d, 0xbd, 0x7c, 0x60, 0xc5, 0xaa, 0xb8, 0xe2,
+	0x9f, 0x6c, 0x84, 0xf3, 0xe6, 0x15, 0x26, 0xf9, 0x6f, 0x33, 0x82, 0xa9, 0x44, 0x70, 0xc3, 0x49,
+	0xe4, 0x63, 0xb8, 0x01, 0xdf, 0x55, 0xa8, 0xf3, 0x4f, 0xc1, 0x29, 0x57, 0x71, 0x25, 0x78, 0x4e,
+	0x70, 0x9a, 0xc1, 0xbb, 0x44, 0xde, 0xa6, 0x00, 0x93, 0xf2, 0x14, 0x98, 0xc5, 0x08, 0x66, 0xcb,
+	0x19, 0x08, 0xcc, 0x7b, 0x30, 0xc3, 0x31, 0x69, 0xd1, 0xf6, 0xe8, 0xc2, 0x27, 0x9e, 0x28, 0x29,
+	0x4d, 0x31, 0x30, 0xd6, 0xfa, 0x1b, 0x14, 0x48, 0xe7, 0x79, 0x66, 0xba, 0xfe, 0x40, 0x8c, 0xce,
+	0xce, 0x8a, 0xd9, 0xfc, 0x3c, 0x9e, 0x11, 0x1d, 0x5d, 0xc2, 0xe5, 0x2e, 0x8a, 0x4b, 0xc7, 0xe7,
+	0xb8, 0x95, 0x18, 0x6e, 0xcb, 0x19, 0x30, 0x5c, 0xe5, 0x9f, 0xb3, 0x50, 0x8b, 0xde, 0x08, 0xf4,
+	0x5b, 0x30, 0x17, 0x10, 0xa9, 0x8e, 0xe6, 0x6a, 0x7d, 0xe2, 0xd3, 0x4f, 0x09, 0x33, 0xe3, 0x3e,
+	0x6d, 0xe8, 0x50, 0xf3, 0x67, 0xea, 0x8c, 0xe5, 0x7e, 0x40, 0x83, 0x91, 0xee, 0x0c, 0x12, 0x30,
+	0xca, 0x3f, 0x58, 0x40, 0x94, 0x7f, 0xf6, 0x55, 0xf8, 0x5b, 0xc4, 0x4f, 0xc0, 0xd0, 0x63, 0x58,
+	0x91, 0x77, 0x2e, 0x7c, 0x23, 0x22, 0xa5, 0xed, 0xa5, 0x69, 0x19, 0xf6, 0x4b, 0xf1, 0xea, 0xe3,
+	0xa6, 0xc0, 0x93, 0xe7, 0xdb, 0xe4, 0x48, 0xcf, 0x18, 0x4e, 0x94, 0x4f, 0xf8, 0x68, 0x24, 0xc1,
+	0x27, 0x1f, 0xe3, 0x23, 0x65, 0x2a, 0xc6, 0x47, 0xf9, 0xd3, 0x0c, 0x5c, 0x4f, 0x51, 0x16, 0x23,
+	0xbc, 0x91, 0x06, 0x94, 0x84, 0xd4, 0xb1, 0x0d, 0x29, 0x63, 0xd9, 0x64, 0x1f, 0x03, 0x86, 0x62,
+	0x97, 0x63, 0x69, 0x04, 0xfa, 0x0a, 0x2e, 0xb4, 0x62, 0x11, 0x59, 0xe3, 0x59, 0x86, 0x8a, 0x1e,
+	0x88, 0xd9, 0x0d, 0xa8, 0x84, 0x02, 0x56, 0x60, 0xbd, 0x65, 0x57, 0xc8, 0x96, 0xf2, 0x0f, 0x19,
+	0x40, 0xc3, 0xca, 0x67, 0xc4, 0x0c, 0x5b, 0xd1, 0xb7, 0x77, 0x93, 0xdd, 0xd6, 0xf0, 0x8d, 0x5e,
+	0x0b, 0x2a, 0xe1, 0x6d, 0xcb, 0x4d, 0xc6, 0x44, 0x3e, 0xed, 0x91, 0x6b, 0x8a, 0x5e, 0x59, 0xba,
+	0x26, 0xae, 0x29, 0x7b, 0x50, 0x4f, 0x92, 0x52, 0x8f, 0x9a, 0xb9, 0x75, 0xb2, 0x4a, 0xcf, 0xed,
+	0x1c, 0x73, 0xdd, 0x64, 0x29, 0x7e, 0x09, 0xca, 0xec, 0x05, 0x83, 0x2a, 0x1c, 0xee, 0x3c, 0x2e,
+	0xb1, 0x76, 0xe7, 0x9c, 0xd6, 0x68, 0x75, 0xdb, 0xf2, 0x06, 0x7d, 0xe1, 0x10, 0xe6, 0x71, 0xd0,
+	0xa6, 0x9f, 0x5f, 0x2f, 0xa4, 0xcb, 0x28, 0xb5, 0x9e, 0xbe, 0xe6, 0x9e, 0x10, 0x5e, 0x6a, 0xcd,
+	0x63, 0xd1, 0xa2, 0xb5, 0x9d, 0xbe, 0x26, 0x07, 0xa1, 0x3f, 0xf9, 0xd9, 0xbb, 0xa6, 0x1d, 0x3c,
+	0x4b, 0x92, 0x4d, 0x1a, 0x7b, 0xd1, 0x87, 0xa5, 0xfd, 0x41, 0xcf, 0x37, 0xe9, 0xa7, 0x95, 0x6e,
+	0x23, 0x1f, 0x3c, 0x2b, 0xdd, 0x0d, 0x80, 0xe8, 0x73, 0xf6, 0x1f, 0x88, 0x7c, 0x57, 0xd3, 0xe9,
+	0x1b, 0x10, 0x5f, 0x9a, 0x9b, 0x51, 0xaf, 0xd9, 0xa8, 0x15, 0xc1, 0x35, 0x49, 0x81, 0xb9, 0x09,
+	0xad, 0x92, 0x73, 0x47, 0xb3, 0x0c, 0x4e, 0x5f, 0xbc, 0x9c, 0x1e, 0x38, 0x3e, 0xa5, 0x56, 0xbe,
+	0x80, 0x02, 0x03, 0x52, 0x9f, 0xd1, 0x1a, 0xf4, 0xa9, 0x9d, 0x12, 0xce, 0x50, 0x1e, 0x87, 0x00,
+	0xfa, 0x75, 0xa1, 0x41, 0x2c, 0xbb, 0x6f, 0x5a, 0xac, 0x9f, 0xef, 0x40, 0x14, 0xa4, 0xfc, 0x45,
+	0x9e, 0x06, 0xe7, 0xf2, 0xd9, 0x87, 0xcc, 0x4c, 0xf1, 0x88, 0x8d, 0xfd, 0x4e, 0xf5, 0xda, 0x1b,
+	0x50, 0xea, 0x13, 0x2f, 0x10, 0xa9, 0x0a, 0x96, 0x4d, 0xf4, 0x39, 0x73, 0x2a, 0xf4, 0x17, 0xc2,
+	0x4f, 0xbc, 0x7f, 0xc9, 0x9b, 0x93, 0xb5, 0x1d, 0xfb, 0x64, 0x97, 0x93, 0x62, 0x4e, 0xb8, 0xfc,
+	0x63, 0x80, 0x10, 0x88, 0xda, 0x50, 0x12, 0xaf, 0x95, 0x84, 0x5a, 0xbc, 0x0a, 0x47, 0xf1, 0x25,
+	0x24, 0x96, 0xa4, 0x54, 0x32, 0x8e, 0x6d, 0xb7, 0xaf, 0x05, 0x5e, 0x3c, 0x6f, 0x05, 0xc5, 0xf4,
+	0x7c, 0x58, 0x4c, 0x5f, 0xfe, 0x93, 0x2c, 0x40, 0xc8, 0x83, 0x5e, 0xcd, 0x1e, 0x75, 0xf4, 0xe4,
+	0xd5, 0x64, 0x0d, 0x4a, 0x78, 0x6c, 0xf6, 0x82, 0x4d, 0xa1, 0xbf, 0x29, 0xac, 0x67, 0x5a, 0x7c,
+	0x47, 0x0a, 0x98, 0xfd, 0xa6, 0x03, 0xf7, 0x89, 0x7f, 0x6a, 0xcb, 0x14, 0x96, 0x68, 0x51, 0x09,
+	0x3f, 0xb5, 0x3d, 0x3f, 0x52, 0x06, 0x0e, 0xda, 0x34, 0x47, 0x45, 0xbd, 0x7e, 0xcd, 0x88, 0x66,
+	0xfd, 0x80, 0x83, 0x58, 0x99, 0x38, 0xf6, 0x65, 0x66, 0x69, 0x92, 0x2f, 0x33, 0x23, 0xbb, 0x59,
+	0x7e, 0xe5, 0xdd, 0xa4, 0xf5, 0xda, 0x92, 0x48, 0x2a, 0xa4, 0xe4, 0x2a, 0x32, 0x69, 0xb9, 0x0a,
+	0x02, 0x8b, 0xde, 0x80, 0x05, 0x92, 0xf4, 0x23, 0x6a, 0x97, 0x78, 0xbe, 0x6b, 0x06, 0x4f, 0xe9,
+	0xc7, 0x58, 0xa3, 0x83, 0x80, 0x08, 0x47, 0x68, 0xf0, 0x82, 0x97, 0x0a, 0xa7, 0x9f, 0xbc, 0x1a,
+	0xc4, 0xd3, 0x5d, 0x93, 0x4d, 0x3e, 0x9e, 0x3d, 0x99, 0x8d, 0xf4, 0x88, 0x59, 0x29, 0x50, 0x33,
+	0x08, 0xd5, 0xfa, 0xc4, 0xd2, 0x4d, 0xc2, 0x63, 0x9b, 0x0a, 0x8e, 0xc1, 0x68, 0xbe, 0x2a, 0xf9,
+	0xbf, 0x21, 0x54, 0xf6, 0x28, 0x83, 0x1f, 0xdb, 0xf5, 0xc4, 0xff, 0x87, 0x38, 0xa4, 0x6f, 0x34,
+	0xb6, 0x60, 0xca, 0x73, 0x88, 0x6e, 0x1e, 0x9b, 0xba, 0x26, 0x3e, 0x56, 0xcc, 0x8d, 0x7e, 0xf1,
+	0x77, 0x10, 0x45, 0xc5, 0x71, 0x4a, 0xe5, 0x17, 0x19, 0x58, 0x48, 0xdf, 0x04, 0x7a, 0x09, 0x89,
+	0x45, 0x73, 0xc1, 0x3c, 0x58, 0x2c, 0x63, 0xd9, 0xa4, 0xdf, 0x8a, 0x38, 0x2e, 0x11, 0xff, 0x4f,
+	0x8c, 0x7f, 0x55, 0xc4, 0x83, 0x4e, 0x61, 0xe9, 0xe6, 0x63, 0xbd, 0x58, 0x74, 0xd2, 0x7f, 0x69,
+	0x44, 0x34, 0xb7, 0x67, 0x12, 0xcf, 0x57, 0xb5, 0x5e, 0xcf, 0x7e, 0x49, 0x63, 0xdb, 0x90, 0x49,
+	0xf0, 0x98, 0xbd, 0x82, 0x6f, 0x49, 0xbc, 0x26, 0x47, 0x6b, 0x06, 0x58, 0x54, 0xec, 0x94, 0x4f,
+	0x60, 0x2a, 0xb6, 0xa8, 0xd4, 0xc8, 0x7a, 0x0e, 0x0a, 0xfc, 0x05, 0x1b, 0xbf, 0x43, 0xbc, 0xa1,
+	0xfc, 0x6b, 0x06, 0x90, 0x30, 0x8d, 0x32, 0xbf, 0x84, 0xc9, 0xf1, 0x98, 0x67, 0x34, 0xf4, 0x95,
+	0x22, 0x4f, 0x2c, 0xc9, 0xaf, 0x5a, 0x45, 0x73, 0xf8, 0xab, 0xd6, 0x51, 0x59, 0xc3, 0xfc, 0xb8,
+	0xac, 0x61, 0x61, 0x92, 0xac, 0xe1, 0xd5, 0x1e, 0x46, 0xde, 0xff, 0x65, 0x06, 0x10, 0xff, 0x0f,
+	0x04, 0xe2, 0x6b, 0x1b, 0xb3, 0x47, 0xe3, 0xff, 0x1b, 0xb0, 0xb8, 0xb1, 0xb3, 0xd7, 0xda, 0xc6,
+	0x9d, 0xa7, 0x1d, 0x7c, 0xb0, 0xb5, 0xb1, 0xb5, 0xb3, 0x75, 0xf8, 0x5c, 0xed, 0xee, 0x75, 0x3b,
+	0xf5, 0x6b, 0xb4, 0x6c, 0x98, 0xd2, 0x29, 0x5b, 0xac, 0x8c, 0xfc, 0x06, 0xdc, 0x49, 0x41, 0xd9,
+	0xc2, 0x11, 0xa4, 0x2c, 0xba, 0x09, 0x8d, 0x14, 0xa4, 0x83, 0xc3, 0xe6, 0x4e, 0x87, 0x97, 0x91,
+	0x53, 0x7a, 0x77, 0x9b, 0xcf, 0x37, 0x3a, 0x1c, 0x25, 0x7f, 0xff, 0xa7, 0xf1, 0x2f, 0x49, 0xc4,
+	0x67, 0x6c, 0xcb, 0xb0, 0x70, 0x88, 0x9b, 0xdd, 0x03, 0x5e, 0xfb, 0x39, 0x38, 0x6c, 0x1e, 0x3e,
+	0x39, 0x90, 0x53, 0xbf, 0x0d, 0xcb, 0xc3, 0x7d, 0x9d, 0xaf, 0x3a, 0xad, 0x27, 0xb4, 0x74, 0x97,
+	0x49, 0xef, 0x3f, 0xd8, 0x7b, 0x7c, 0x48, 0x53, 0xd2, 0xf5, 0x6c, 0x7a, 0xff, 0x66, 0x13, 0xb7,
+	0x59, 0x7f, 0x8e, 0x96, 0xd3, 0x86, 0xfb, 0xdb, 0x9d, 0x9d, 0xe6, 0x73, 0x56, 0xf7, 0x4e, 0xed,
+	0xee, 0x7c, 0xb5, 0xbf, 0x85, 0x3b, 0xed, 0x7a, 0x21, 0xbd, 0x5b, 0xc6, 0x78, 0xc5, 0xf4, 0xc1,
+	0x79, 0xe2, 0xbb, 0xd3, 0xae, 0x97, 0x36, 0x9a, 0xdf, 0x7f, 0x74, 0x62, 0xfa, 0xa7, 0x83, 0xa3,
+	0x35, 0xdd, 0xee, 0x3f, 0x60, 0x17, 0xfc, 0x7d, 0xd3, 0x16, 0x3f, 0xf8, 0x3f, 0xed, 0x74, 0x8e,
+	0x1e, 0xa4, 0xfd, 0x0f, 0xcf, 0xff, 0xe7, 0x1c, 0xb1, 0x9f, 0x47, 0x45, 0x26, 0x54, 0x1f, 0xfe,
+	0xd7, 0x00, 0x40, 0x4d, 0x36, 0xe0, 0xea, 0x53, 0x00, 0x00,
 }
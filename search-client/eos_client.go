@@ -41,7 +41,18 @@ func NewEOSClient(cc *grpc.ClientConn, dbReader trxdb.DBReader) *EOSClient {
 }
 
 func (e *EOSClient) StreamMatches(callerCtx context.Context, req *pbsearch.RouterRequest) (EOSStreamMatchesClient, error) {
-	hammer := dhammer.NewHammer(30, 20, e.hammerBatchProcessor)
+	return e.StreamMatchesWithProjection(callerCtx, req, nil)
+}
+
+// StreamMatchesWithProjection works like `StreamMatches` but prunes each hydrated
+// transaction trace according to `projection` before handing it to the consumer.
+// When the trxdb driver implements `trxdb.ProjectedTracesReader`, irreversible
+// traces are pruned while being read, otherwise right after their retrieval. A
+// `nil` projection keeps the full transaction trace.
+func (e *EOSClient) StreamMatchesWithProjection(callerCtx context.Context, req *pbsearch.RouterRequest, projection *Projection) (EOSStreamMatchesClient, error) {
+	hammer := dhammer.NewHammer(30, 20, func(ctx context.Context, items []interface{}) ([]interface{}, error) {
+		return e.hammerBatchProcessor(ctx, items, projection)
+	})
 	hammer.Start(callerCtx)
 
 	go e.StreamSearchToHammer(callerCtx, hammer, req)
@@ -57,16 +68,29 @@ func (e *EOSClient) StreamMatches(callerCtx context.Context, req *pbsearch.Route
 	return esm, nil
 }
 
-func (e *EOSClient) hammerBatchProcessor(ctx context.Context, items []interface{}) (out []interface{}, err error) {
+func (e *EOSClient) hammerBatchProcessor(ctx context.Context, items []interface{}, projection *Projection) (out []interface{}, err error) {
 	zlogger := logging.Logger(ctx, zlog)
 	zlogger.Debug("processing hammer batch", zap.Int("item_count", len(items)))
 
 	prefixes, prefixToIndex := searchclient.GatherTransactionPrefixesToFetch(items, isIrreversibleEOSMatch)
 
+	projectedReader, projectedOnRead := e.dbReader.(trxdb.ProjectedTracesReader)
+	projectedOnRead = projectedOnRead && projection != nil
+
 	var rows [][]*pbcodec.TransactionEvent
 	if len(prefixes) > 0 {
-		zlogger.Debug("performing retrieval of transaction traces", zap.Int("prefix_count", len(prefixes)))
-		rows, err = e.dbReader.GetTransactionTracesBatch(ctx, prefixes)
+		zlogger.Debug("performing retrieval of transaction traces", zap.Int("prefix_count", len(prefixes)), zap.Bool("projected", projectedOnRead))
+		if projectedOnRead {
+			var actionIndexes [][]uint32
+			actionIndexes, err = gatherActionIndexes(items, prefixes)
+			if err != nil {
+				return nil, err
+			}
+
+			rows, err = projectedReader.GetProjectedTransactionTracesBatch(ctx, prefixes, actionIndexes, projection)
+		} else {
+			rows, err = e.dbReader.GetTransactionTracesBatch(ctx, prefixes)
+		}
 		if err != nil {
 			return nil, fmt.Errorf("unable to fetch transaction traces batch: %w", err)
 		}
@@ -74,7 +98,7 @@ func (e *EOSClient) hammerBatchProcessor(ctx context.Context, items []interface{
 
 	for _, v := range items {
 		m := v.(*searchclient.MatchOrError)
-		resp, err := processEOSHammerItem(ctx, m, rows, prefixToIndex)
+		resp, err := processEOSHammerItem(ctx, m, rows, prefixToIndex, projection, projectedOnRead)
 		if err != nil {
 			return out, err
 		}
@@ -85,21 +109,47 @@ func (e *EOSClient) hammerBatchProcessor(ctx context.Context, items []interface{
 	return out, nil
 }
 
-func processEOSHammerItem(ctx context.Context, m *searchclient.MatchOrError, rows [][]*pbcodec.TransactionEvent, rowMap map[string]int) (*EOSSearchMatch, error) {
+// gatherActionIndexes returns the matching action indexes of each of the `prefixes`
+// gathered by `searchclient.GatherTransactionPrefixesToFetch` out of `items`.
+func gatherActionIndexes(items []interface{}, prefixes []string) (out [][]uint32, err error) {
+	out = make([][]uint32, len(prefixes))
+	for _, v := range items {
+		m := v.(*searchclient.MatchOrError)
+		if m.Err != nil {
+			break
+		}
+
+		eosMatch, err := toEOSMatch(m.Match)
+		if err != nil {
+			return nil, err
+		}
+
+		if eosMatch.Block != nil {
+			continue
+		}
+
+		for i, prefix := range prefixes {
+			if prefix == m.Match.TrxIdPrefix {
+				out[i] = eosMatch.ActionIndexes
+			}
+		}
+	}
+
+	return out, nil
+}
+
+func processEOSHammerItem(ctx context.Context, m *searchclient.MatchOrError, rows [][]*pbcodec.TransactionEvent, rowMap map[string]int, projection *Projection, projectedOnRead bool) (*EOSSearchMatch, error) {
 	if m.Err != nil {
 		return nil, m.Err
 	}
 
 	trxIDPrefix := m.Match.TrxIdPrefix
 
-	var eosMatchAny ptypes.DynamicAny
-	err := ptypes.UnmarshalAny(m.Match.GetChainSpecific(), &eosMatchAny)
+	eosMatch, err := toEOSMatch(m.Match)
 	if err != nil {
 		return nil, err
 	}
 
-	eosMatch := eosMatchAny.Message.(*pbsearchzsw.Match)
-
 	var blockID string
 	var blockHeader *pbcodec.BlockHeader
 	var trace *pbcodec.TransactionTrace
@@ -133,7 +183,17 @@ func processEOSHammerItem(ctx context.Context, m *searchclient.MatchOrError, row
 	}
 
 	var matchingActions []*pbcodec.ActionTrace
-	if trace != nil {
+	switch {
+	case trace == nil:
+	case projection != nil && projectedOnRead && eosMatch.Block == nil:
+		// Already projected by the trxdb read, which always keeps the receipt header
+		if !projection.Receipts {
+			trace.Receipt = nil
+		}
+		matchingActions = trace.ActionTraces
+	case projection != nil:
+		trace, matchingActions = projection.Apply(trace, eosMatch.ActionIndexes)
+	default:
 		matchingActions = make([]*pbcodec.ActionTrace, len(eosMatch.ActionIndexes))
 		for i, callIndex := range eosMatch.ActionIndexes {
 			matchingActions[i] = trace.ActionTraces[callIndex]
@@ -149,6 +209,16 @@ func processEOSHammerItem(ctx context.Context, m *searchclient.MatchOrError, row
 	}, nil
 }
 
+func toEOSMatch(match *pbsearch.SearchMatch) (*pbsearchzsw.Match, error) {
+	var eosMatchAny ptypes.DynamicAny
+	err := ptypes.UnmarshalAny(match.GetChainSpecific(), &eosMatchAny)
+	if err != nil {
+		return nil, err
+	}
+
+	return eosMatchAny.Message.(*pbsearchzsw.Match), nil
+}
+
 func isIrreversibleEOSMatch(match *pbsearch.SearchMatch) bool {
 	// This sucks really hard. This was before a simple check if a variable was nil, now, it requires
 	// a full decoding of the any message to the correct type. This is probably a performance hit here
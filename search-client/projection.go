@@ -0,0 +1,9 @@
+package searchclient
+
+import (
+	"github.com/zhongshuwen/histnew/trxdb"
+)
+
+// Projection selects which parts of a matched transaction are kept, see
+// `trxdb.TraceProjection`.
+type Projection = trxdb.TraceProjection
@@ -0,0 +1,107 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package archive wraps the `github.com/streamingfast/search/app/archive`
+// application so it consults per-shard term summaries (see `search/summary`)
+// before querying bleve.
+package archive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/streamingfast/search"
+	"github.com/streamingfast/search/app/archive"
+	"github.com/streamingfast/search/archive/roarcache"
+	"github.com/zhongshuwen/histnew/search/summary"
+)
+
+// emptyResultsCacheTTL is the one used by the archive app for its memcache
+// empty results cache.
+const emptyResultsCacheTTL = 30 * 24 * time.Hour
+
+type Config struct {
+	archive.Config
+
+	QueryFactory           search.BleveQueryFactory // Factory of the served queries, required when term summaries are enabled
+	EnableTermSummaries    bool                     // Skip shards whose term summary proves they cannot match the query
+	TermSummariesPollEvery time.Duration            // How often new term summaries are fetched from the indexes store
+}
+
+type Modules = archive.Modules
+
+// New creates the archive app. When term summaries are enabled, the summaries
+// are served as the app's empty results cache through a local memcache server
+// (chained to the configured memcache, if any) and the queries built by
+// `QueryFactory` are recorded so the cache can evaluate them.
+func New(config *Config, modules *Modules) (*archive.App, error) {
+	if !config.EnableTermSummaries {
+		if config.QueryFactory != nil {
+			search.GetBleveQueryFactory = config.QueryFactory
+		}
+		return archive.New(&config.Config, modules), nil
+	}
+
+	if config.QueryFactory == nil {
+		return nil, fmt.Errorf("a query factory is required when term summaries are enabled")
+	}
+
+	zlog.Info("setting up term summaries cache")
+	summaryStore, err := summary.NewStore(config.IndexesStoreURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed setting up summaries store: %w", err)
+	}
+
+	var next roarcache.Cache
+	if config.EnableEmptyResultsCache {
+		next = roarcache.NewMemcache(config.MemcacheAddr, emptyResultsCacheTTL, config.ShardSize)
+	}
+
+	summaryCache := summary.NewCache(summaryStore, config.ShardSize, next)
+	if err := summaryCache.Load(context.Background()); err != nil {
+		return nil, fmt.Errorf("loading term summaries: %w", err)
+	}
+
+	server, err := summary.NewMemcacheServer("127.0.0.1:0", config.ShardSize, summaryCache)
+	if err != nil {
+		return nil, fmt.Errorf("starting term summaries cache server: %w", err)
+	}
+
+	queryFactory := config.QueryFactory
+	search.GetBleveQueryFactory = func(rawQuery string) *search.BleveQuery {
+		bquery := queryFactory(rawQuery)
+		bquery.Validator = summaryCache.WrapValidator(bquery.Validator)
+		return bquery
+	}
+
+	archiveConfig := config.Config
+	archiveConfig.EnableEmptyResultsCache = true
+	archiveConfig.MemcacheAddr = server.Addr()
+	app := archive.New(&archiveConfig, modules)
+
+	pollCtx, cancelPoll := context.WithCancel(context.Background())
+	app.OnTerminating(func(_ error) {
+		cancelPoll()
+		server.Close()
+	})
+	go summaryCache.PollEvery(pollCtx, config.TermSummariesPollEvery)
+	go func() {
+		if err := server.Serve(); err != nil && !app.IsTerminating() {
+			app.Shutdown(fmt.Errorf("term summaries cache server failed: %w", err))
+		}
+	}()
+
+	return app, nil
+}
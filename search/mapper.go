@@ -79,6 +79,7 @@ func buildBleveIndexMapper() *mapping.IndexMappingImpl {
 
 	// add other sub-sections here
 	rootDocMapping.AddSubDocumentMapping("data", search.DynamicNestedDocMapping)
+	rootDocMapping.AddSubDocumentMapping("return", search.DynamicNestedDocMapping)
 	rootDocMapping.AddSubDocumentMapping("db", dbDocMapping)
 	rootDocMapping.AddSubDocumentMapping("ram", ramDocMapping)
 	rootDocMapping.AddSubDocumentMapping("event", search.DynamicNestedDocMapping)
@@ -19,19 +19,25 @@ func RegisterDefaultHandlers() {
 }
 
 func RegisterHandlers(terms *IndexedTerms) {
+	search.GetMatchCollector = collector
+	search.GetSearchMatchFactory = func() search.SearchMatch { return &SearchMatch{} }
+	search.GetBleveQueryFactory = NewBleveQueryFactory(terms)
+	livenessQuery, _ := search.NewParsedQuery(context.Background(), "receiver:999")
+	searchArchive.LivenessQuery = livenessQuery
+}
+
+// NewBleveQueryFactory returns the factory registered by `RegisterHandlers`,
+// queries are validated against the given indexed terms.
+func NewBleveQueryFactory(terms *IndexedTerms) search.BleveQueryFactory {
 	validator := &BleveQueryValidator{
 		indexedTerms: terms,
 	}
 
-	search.GetMatchCollector = collector
-	search.GetSearchMatchFactory = func() search.SearchMatch { return &SearchMatch{} }
-	search.GetBleveQueryFactory = func(rawQuery string) *search.BleveQuery {
+	return func(rawQuery string) *search.BleveQuery {
 		return &search.BleveQuery{
 			Raw:              rawQuery,
 			FieldTransformer: sqe.NoOpFieldTransformer,
 			Validator:        validator,
 		}
 	}
-	livenessQuery, _ := search.NewParsedQuery(context.Background(), "receiver:999")
-	searchArchive.LivenessQuery = livenessQuery
 }
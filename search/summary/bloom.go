@@ -0,0 +1,103 @@
+package summary
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+)
+
+// bloomFilter is a classic bloom filter using double hashing over a single
+// 64 bits FNV-1a hash to derive its `k` probe positions.
+type bloomFilter struct {
+	k    uint32
+	m    uint64
+	bits []uint64
+}
+
+func newBloomFilter(expectedItems int, falsePositiveRate float64) *bloomFilter {
+	if expectedItems < 1 {
+		expectedItems = 1
+	}
+
+	n := float64(expectedItems)
+	m := uint64(math.Ceil(-n * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	if m < 64 {
+		m = 64
+	}
+
+	k := uint32(math.Round(float64(m) / n * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+
+	return &bloomFilter{
+		k:    k,
+		m:    m,
+		bits: make([]uint64, (m+63)/64),
+	}
+}
+
+func (f *bloomFilter) add(item []byte) {
+	h1, h2 := hashes(item)
+	for i := uint32(0); i < f.k; i++ {
+		pos := (h1 + uint64(i)*h2) % f.m
+		f.bits[pos/64] |= 1 << (pos % 64)
+	}
+}
+
+func (f *bloomFilter) mayContain(item []byte) bool {
+	h1, h2 := hashes(item)
+	for i := uint32(0); i < f.k; i++ {
+		pos := (h1 + uint64(i)*h2) % f.m
+		if f.bits[pos/64]&(1<<(pos%64)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hashes(item []byte) (uint64, uint64) {
+	hasher := fnv.New64a()
+	hasher.Write(item)
+	sum := hasher.Sum64()
+
+	// The second hash must be odd so that probes cycle through all positions
+	return sum, (sum>>33 | sum<<31) | 1
+}
+
+func (f *bloomFilter) encodedSize() int {
+	return 4 + 8 + 8*len(f.bits)
+}
+
+func (f *bloomFilter) encodeTo(out []byte) {
+	binary.LittleEndian.PutUint32(out[0:4], f.k)
+	binary.LittleEndian.PutUint64(out[4:12], f.m)
+	for i, word := range f.bits {
+		binary.LittleEndian.PutUint64(out[12+i*8:], word)
+	}
+}
+
+func decodeBloomFilter(in []byte) (*bloomFilter, error) {
+	if len(in) < 12 {
+		return nil, fmt.Errorf("bloom filter header too short, got %d bytes", len(in))
+	}
+
+	f := &bloomFilter{
+		k: binary.LittleEndian.Uint32(in[0:4]),
+		m: binary.LittleEndian.Uint64(in[4:12]),
+	}
+
+	wordCount := (f.m + 63) / 64
+	if f.k == 0 || f.m == 0 || uint64(len(in)-12) != wordCount*8 {
+		return nil, fmt.Errorf("invalid bloom filter, k=%d m=%d with %d bytes of bits", f.k, f.m, len(in)-12)
+	}
+
+	f.bits = make([]uint64, wordCount)
+	for i := range f.bits {
+		f.bits[i] = binary.LittleEndian.Uint64(in[12+i*8:])
+	}
+
+	return f, nil
+}
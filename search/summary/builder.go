@@ -0,0 +1,140 @@
+package summary
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/blevesearch/bleve/document"
+	"github.com/blevesearch/bleve/index"
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/search"
+	"go.uber.org/zap"
+)
+
+// FromIndexReader builds a shard summary out of all the terms found in the
+// term dictionaries of an existing bleve index.
+func FromIndexReader(reader index.IndexReader) (*Builder, error) {
+	fields, err := reader.Fields()
+	if err != nil {
+		return nil, fmt.Errorf("list fields: %w", err)
+	}
+
+	builder := NewBuilder()
+	for _, field := range fields {
+		dict, err := reader.FieldDict(field)
+		if err != nil {
+			return nil, fmt.Errorf("field %q dictionary: %w", field, err)
+		}
+
+		for {
+			entry, err := dict.Next()
+			if err != nil {
+				dict.Close()
+				return nil, fmt.Errorf("field %q dictionary next: %w", field, err)
+			}
+
+			if entry == nil {
+				break
+			}
+
+			builder.Add(field, entry.Term)
+		}
+
+		if err := dict.Close(); err != nil {
+			return nil, fmt.Errorf("field %q dictionary close: %w", field, err)
+		}
+	}
+
+	return builder, nil
+}
+
+// AddDocument adds all the terms a bleve index would produce when indexing
+// `doc`. Composite fields (like `_all`) are skipped since SQE never queries
+// them.
+func (b *Builder) AddDocument(doc *document.Document) {
+	for _, field := range doc.Fields {
+		_, frequencies := field.Analyze()
+		for term := range frequencies {
+			b.Add(field.Name(), term)
+		}
+	}
+}
+
+// SummarizingMapper wraps the indexer's `search.BlockMapper` and produces the
+// summary of each shard as a side effect of mapping blocks to documents. Blocks
+// are mapped concurrently and possibly out of order, so a shard is considered
+// complete only once every one of its blocks has been mapped. Shards that are
+// never completed (the first shard of a chain for example) simply get no
+// summary, which is always safe since they will be queried.
+type SummarizingMapper struct {
+	search.BlockMapper
+
+	shardSize         uint64
+	falsePositiveRate float64
+	onComplete        func(baseBlockNum uint64, summary *Summary)
+
+	lock   sync.Mutex
+	shards map[uint64]*pendingShard
+}
+
+type pendingShard struct {
+	builder *Builder
+	blocks  map[uint64]bool
+}
+
+func NewSummarizingMapper(mapper search.BlockMapper, shardSize uint64, onComplete func(baseBlockNum uint64, summary *Summary)) *SummarizingMapper {
+	return &SummarizingMapper{
+		BlockMapper:       mapper,
+		shardSize:         shardSize,
+		falsePositiveRate: DefaultFalsePositiveRate,
+		onComplete:        onComplete,
+		shards:            map[uint64]*pendingShard{},
+	}
+}
+
+func (m *SummarizingMapper) Map(block *bstream.Block) ([]*document.Document, error) {
+	docs, err := m.BlockMapper.Map(block)
+	if err != nil {
+		return nil, err
+	}
+
+	blockNum := block.Num()
+	baseBlockNum := blockNum - (blockNum % m.shardSize)
+
+	m.lock.Lock()
+	shard, found := m.shards[baseBlockNum]
+	if !found {
+		shard = &pendingShard{builder: NewBuilder(), blocks: map[uint64]bool{}}
+		m.shards[baseBlockNum] = shard
+	}
+
+	for _, doc := range docs {
+		shard.builder.AddDocument(doc)
+	}
+	shard.blocks[blockNum] = true
+
+	var completed *Builder
+	if uint64(len(shard.blocks)) == m.shardSize {
+		completed = shard.builder
+		delete(m.shards, baseBlockNum)
+		m.forgetStaleShards(baseBlockNum)
+	}
+	m.lock.Unlock()
+
+	if completed != nil {
+		zlog.Debug("shard summary completed", zap.Uint64("base_block_num", baseBlockNum), zap.Int("term_count", completed.Len()))
+		m.onComplete(baseBlockNum, completed.Build(m.falsePositiveRate))
+	}
+
+	return docs, nil
+}
+
+// forgetStaleShards drops pending shards far behind the last completed one,
+// they will never complete (partially indexed range on start).
+func (m *SummarizingMapper) forgetStaleShards(completedBaseBlockNum uint64) {
+	for baseBlockNum := range m.shards {
+		if baseBlockNum+2*m.shardSize <= completedBaseBlockNum {
+			delete(m.shards, baseBlockNum)
+		}
+	}
+}
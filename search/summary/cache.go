@@ -0,0 +1,184 @@
+package summary
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/search"
+	"github.com/streamingfast/search/archive/roarcache"
+	"github.com/streamingfast/search/sqe"
+	"go.uber.org/zap"
+)
+
+const maxRecordedQueries = 4096
+
+// Cache is a `roarcache.Cache` that marks as empty, for a given query, every
+// shard whose summary proves it cannot match. The archive backend consults
+// this bitmap before querying bleve, so those shards are never opened.
+//
+// The archive only hands the query hash to the cache, so queries are recorded
+// when they are validated (see `WrapValidator`) to be able to map a hash back
+// to its expression.
+//
+// When a `next` cache is configured (memcache for example), it's consulted
+// first and receives every bitmap published by the archive.
+type Cache struct {
+	store     dstore.Store
+	shardSize uint64
+	next      roarcache.Cache
+
+	summariesLock sync.RWMutex
+	summaries     map[uint32]*Summary
+
+	queriesLock sync.Mutex
+	queries     map[string]sqe.Expression
+}
+
+func NewCache(store dstore.Store, shardSize uint64, next roarcache.Cache) *Cache {
+	return &Cache{
+		store:     store,
+		shardSize: shardSize,
+		next:      next,
+		summaries: map[uint32]*Summary{},
+		queries:   map[string]sqe.Expression{},
+	}
+}
+
+func (c *Cache) Get(key string, roar *roaring.Bitmap) error {
+	if c.next != nil {
+		if err := c.next.Get(key, roar); err != nil {
+			zlog.Debug("next empty results cache get failed", zap.String("key", key), zap.Error(err))
+		}
+	}
+
+	c.queriesLock.Lock()
+	expr := c.queries[key]
+	c.queriesLock.Unlock()
+
+	if expr == nil {
+		return nil
+	}
+
+	c.summariesLock.RLock()
+	defer c.summariesLock.RUnlock()
+
+	skipped := 0
+	for absoluteShardNum, summary := range c.summaries {
+		if !summary.MayMatch(expr) {
+			roar.Add(absoluteShardNum)
+			skipped++
+		}
+	}
+
+	zlog.Debug("summaries consulted", zap.String("key", key), zap.Int("summary_count", len(c.summaries)), zap.Int("skipped_shards", skipped))
+	return nil
+}
+
+func (c *Cache) Put(key string, roar *roaring.Bitmap) error {
+	if c.next == nil {
+		return nil
+	}
+
+	return c.next.Put(key, roar)
+}
+
+// Load reads all summaries available in the store that are not yet known.
+func (c *Cache) Load(ctx context.Context) error {
+	baseBlockNums, err := List(ctx, c.store, c.shardSize)
+	if err != nil {
+		return err
+	}
+
+	loaded := 0
+	for _, baseBlockNum := range baseBlockNums {
+		absoluteShardNum := uint32(baseBlockNum / c.shardSize)
+
+		c.summariesLock.RLock()
+		_, known := c.summaries[absoluteShardNum]
+		c.summariesLock.RUnlock()
+		if known {
+			continue
+		}
+
+		summary, err := Read(ctx, c.store, c.shardSize, baseBlockNum)
+		if err != nil {
+			zlog.Warn("unable to read shard summary, shard will always be queried", zap.Uint64("base_block_num", baseBlockNum), zap.Error(err))
+			continue
+		}
+
+		c.summariesLock.Lock()
+		c.summaries[absoluteShardNum] = summary
+		c.summariesLock.Unlock()
+		loaded++
+	}
+
+	zlog.Info("loaded shard summaries", zap.Int("new_summary_count", loaded), zap.Int("available_summary_count", len(baseBlockNums)))
+	return nil
+}
+
+// PollEvery loads new summaries periodically until the context is done.
+func (c *Cache) PollEvery(ctx context.Context, interval time.Duration) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+
+		if err := c.Load(ctx); err != nil {
+			zlog.Warn("unable to poll shard summaries", zap.Error(err))
+		}
+	}
+}
+
+// WrapValidator returns a `search.BleveQueryValidator` recording each query it
+// sees after `inner` (which can be nil) accepted it.
+func (c *Cache) WrapValidator(inner search.BleveQueryValidator) search.BleveQueryValidator {
+	return &recordingValidator{inner: inner, cache: c}
+}
+
+func (c *Cache) record(q *search.BleveQuery) {
+	hash, err := q.Hash()
+	if err != nil {
+		return
+	}
+
+	// The parsed AST of the query is private, we parse it again
+	expr, err := sqe.Parse(context.Background(), q.Raw)
+	if err != nil {
+		return
+	}
+
+	if q.FieldTransformer != nil {
+		if err := sqe.TransformExpression(expr, q.FieldTransformer); err != nil {
+			return
+		}
+	}
+
+	c.queriesLock.Lock()
+	defer c.queriesLock.Unlock()
+
+	if _, found := c.queries[hash]; !found && len(c.queries) >= maxRecordedQueries {
+		c.queries = map[string]sqe.Expression{}
+	}
+	c.queries[hash] = expr
+}
+
+type recordingValidator struct {
+	inner search.BleveQueryValidator
+	cache *Cache
+}
+
+func (v *recordingValidator) Validate(q *search.BleveQuery) error {
+	if v.inner != nil {
+		if err := v.inner.Validate(q); err != nil {
+			return err
+		}
+	}
+
+	v.cache.record(q)
+	return nil
+}
@@ -0,0 +1,194 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package summary
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/streamingfast/search/archive/roarcache"
+	"go.uber.org/zap"
+)
+
+// MemcacheServer serves a `roarcache.Cache` over the subset of the memcache
+// text protocol used by `roarcache.Memcache` (`get`, `gets` and `set`).
+//
+// The archive backend only accepts its empty results cache as a memcache
+// server address, serving the summaries cache on a local address is how it
+// gets consulted without changes to the archive.
+type MemcacheServer struct {
+	cache     roarcache.Cache
+	keyPrefix string
+	listener  net.Listener
+}
+
+// NewMemcacheServer listens on `addr` (`127.0.0.1:0` picks a free port), keys
+// are expected in the `rc:<shardSize>:<key>` format of `roarcache.Memcache`.
+func NewMemcacheServer(addr string, shardSize uint64, cache roarcache.Cache) (*MemcacheServer, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("listening on %q: %w", addr, err)
+	}
+
+	return &MemcacheServer{
+		cache:     cache,
+		keyPrefix: fmt.Sprintf("rc:%d:", shardSize),
+		listener:  listener,
+	}, nil
+}
+
+func (s *MemcacheServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Serve accepts connections until `Close` is called.
+func (s *MemcacheServer) Serve() error {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return err
+		}
+
+		go s.serveConn(conn)
+	}
+}
+
+func (s *MemcacheServer) Close() error {
+	return s.listener.Close()
+}
+
+func (s *MemcacheServer) serveConn(conn net.Conn) {
+	defer conn.Close()
+
+	rw := bufio.NewReadWriter(bufio.NewReader(conn), bufio.NewWriter(conn))
+	for {
+		line, err := rw.ReadString('\n')
+		if err != nil {
+			if err != io.EOF {
+				zlog.Debug("memcache connection read failed", zap.Error(err))
+			}
+			return
+		}
+
+		if err := s.handle(rw, strings.Fields(line)); err != nil {
+			zlog.Debug("memcache command failed", zap.String("command", strings.TrimSpace(line)), zap.Error(err))
+			return
+		}
+
+		if err := rw.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func (s *MemcacheServer) handle(rw *bufio.ReadWriter, fields []string) error {
+	if len(fields) == 0 {
+		_, err := rw.WriteString("ERROR\r\n")
+		return err
+	}
+
+	switch fields[0] {
+	case "get", "gets":
+		for _, key := range fields[1:] {
+			if err := s.get(rw, key); err != nil {
+				return err
+			}
+		}
+		_, err := rw.WriteString("END\r\n")
+		return err
+
+	case "set":
+		// set <key> <flags> <exptime> <bytes> [noreply]
+		if len(fields) < 5 {
+			_, err := rw.WriteString("CLIENT_ERROR bad command line format\r\n")
+			return err
+		}
+
+		size, err := strconv.Atoi(fields[4])
+		if err != nil || size < 0 {
+			_, err := rw.WriteString("CLIENT_ERROR bad data chunk\r\n")
+			return err
+		}
+
+		data := make([]byte, size+2)
+		if _, err := io.ReadFull(rw, data); err != nil {
+			return err
+		}
+
+		reply := "STORED\r\n"
+		if err := s.set(fields[1], data[:size]); err != nil {
+			zlog.Debug("storing empty results bitmap failed", zap.String("key", fields[1]), zap.Error(err))
+			reply = "NOT_STORED\r\n"
+		}
+
+		if len(fields) > 5 && fields[5] == "noreply" {
+			return nil
+		}
+		_, err = rw.WriteString(reply)
+		return err
+
+	default:
+		_, err := rw.WriteString("ERROR\r\n")
+		return err
+	}
+}
+
+func (s *MemcacheServer) get(w io.Writer, key string) error {
+	if !strings.HasPrefix(key, s.keyPrefix) {
+		return nil
+	}
+
+	roar := roaring.New()
+	if err := s.cache.Get(strings.TrimPrefix(key, s.keyPrefix), roar); err != nil {
+		zlog.Debug("getting empty results bitmap failed", zap.String("key", key), zap.Error(err))
+		return nil
+	}
+
+	if roar.IsEmpty() {
+		return nil
+	}
+
+	content, err := roar.ToBytes()
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, "VALUE %s 0 %d 0\r\n", key, len(content)); err != nil {
+		return err
+	}
+	if _, err := w.Write(content); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\r\n")
+	return err
+}
+
+func (s *MemcacheServer) set(key string, content []byte) error {
+	if !strings.HasPrefix(key, s.keyPrefix) {
+		return fmt.Errorf("unexpected key format")
+	}
+
+	roar := roaring.New()
+	if _, err := roar.FromBuffer(content); err != nil {
+		return err
+	}
+
+	return s.cache.Put(strings.TrimPrefix(key, s.keyPrefix), roar)
+}
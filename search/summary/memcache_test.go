@@ -0,0 +1,50 @@
+package summary
+
+import (
+	"testing"
+	"time"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/bradfitz/gomemcache/memcache"
+	"github.com/streamingfast/search/archive/roarcache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRoarCache map[string]*roaring.Bitmap
+
+func (c testRoarCache) Get(key string, roar *roaring.Bitmap) error {
+	if stored, found := c[key]; found {
+		roar.Or(stored)
+	}
+	return nil
+}
+
+func (c testRoarCache) Put(key string, roar *roaring.Bitmap) error {
+	c[key] = roar
+	return nil
+}
+
+func TestMemcacheServer(t *testing.T) {
+	cache := testRoarCache{}
+	server, err := NewMemcacheServer("127.0.0.1:0", 200, cache)
+	require.NoError(t, err)
+	defer server.Close()
+	go server.Serve()
+
+	client := roarcache.NewMemcache(server.Addr(), time.Hour, 200)
+
+	err = client.Get("hash1", roaring.New())
+	assert.Equal(t, memcache.ErrCacheMiss.Error(), err.Error())
+
+	require.NoError(t, client.Put("hash1", roaring.BitmapOf(1, 5, 9)))
+	assert.Equal(t, []uint32{1, 5, 9}, cache["hash1"].ToArray())
+
+	roar := roaring.New()
+	require.NoError(t, client.Get("hash1", roar))
+	assert.Equal(t, []uint32{1, 5, 9}, roar.ToArray())
+
+	otherShardSize := roarcache.NewMemcache(server.Addr(), time.Hour, 100)
+	err = otherShardSize.Get("hash1", roaring.New())
+	assert.Equal(t, memcache.ErrCacheMiss.Error(), err.Error())
+}
@@ -0,0 +1,78 @@
+package summary
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+
+	"github.com/streamingfast/dstore"
+)
+
+var summaryFilenameRegex = regexp.MustCompile(`(\d{10})$`)
+
+// NewStore returns the store holding shard summaries. Summaries live right
+// next to the index shards they describe, as `shards-<size>/<base>.summary`.
+func NewStore(indicesStoreURL string) (dstore.Store, error) {
+	return dstore.NewStore(indicesStoreURL, "summary", "zstd", true)
+}
+
+func summaryFilename(shardSize, baseBlockNum uint64) string {
+	return fmt.Sprintf("shards-%d/%010d", shardSize, baseBlockNum)
+}
+
+func Write(ctx context.Context, store dstore.Store, shardSize, baseBlockNum uint64, summary *Summary) error {
+	content, err := summary.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("encode summary: %w", err)
+	}
+
+	if err := store.WriteObject(ctx, summaryFilename(shardSize, baseBlockNum), bytes.NewReader(content)); err != nil {
+		return fmt.Errorf("write summary of shard %d: %w", baseBlockNum, err)
+	}
+
+	return nil
+}
+
+func Read(ctx context.Context, store dstore.Store, shardSize, baseBlockNum uint64) (*Summary, error) {
+	reader, err := store.OpenObject(ctx, summaryFilename(shardSize, baseBlockNum))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("read summary of shard %d: %w", baseBlockNum, err)
+	}
+
+	summary := &Summary{}
+	if err := summary.UnmarshalBinary(content); err != nil {
+		return nil, fmt.Errorf("decode summary of shard %d: %w", baseBlockNum, err)
+	}
+
+	return summary, nil
+}
+
+// List returns the base block num of every summary available in the store
+// for the given shard size.
+func List(ctx context.Context, store dstore.Store, shardSize uint64) (out []uint64, err error) {
+	err = store.Walk(ctx, fmt.Sprintf("shards-%d/", shardSize), ".tmp", func(filename string) error {
+		match := summaryFilenameRegex.FindStringSubmatch(filename)
+		if match == nil {
+			return nil
+		}
+
+		baseBlockNum, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			return nil
+		}
+
+		out = append(out, baseBlockNum)
+		return nil
+	})
+
+	return
+}
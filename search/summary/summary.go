@@ -0,0 +1,152 @@
+package summary
+
+import (
+	"fmt"
+
+	"github.com/streamingfast/search/sqe"
+)
+
+const (
+	summaryVersion = 1
+
+	// DefaultFalsePositiveRate is the bloom filter false positive rate used
+	// when building summaries. A false positive only costs a useless shard
+	// query, it never hides results.
+	DefaultFalsePositiveRate = 0.01
+)
+
+// Summary records, in a compact probabilistic form, the set of `field:term`
+// pairs indexed in a single search shard. It can answer with certainty that a
+// query cannot match anything in the shard, in which case the shard can be
+// skipped altogether.
+type Summary struct {
+	filter *bloomFilter
+}
+
+// Builder accumulates the exact set of indexed terms of a shard, it's turned
+// into a `Summary` once the shard is complete.
+type Builder struct {
+	terms map[string]struct{}
+}
+
+func NewBuilder() *Builder {
+	return &Builder{terms: map[string]struct{}{}}
+}
+
+func (b *Builder) Add(field, term string) {
+	b.terms[termKey(field, term)] = struct{}{}
+}
+
+func (b *Builder) Len() int {
+	return len(b.terms)
+}
+
+func (b *Builder) Build(falsePositiveRate float64) *Summary {
+	filter := newBloomFilter(len(b.terms), falsePositiveRate)
+	for key := range b.terms {
+		filter.add([]byte(key))
+	}
+
+	return &Summary{filter: filter}
+}
+
+func termKey(field, term string) string {
+	return field + "\x00" + term
+}
+
+func (s *Summary) MayContain(field, term string) bool {
+	return s.filter.mayContain([]byte(termKey(field, term)))
+}
+
+// MayMatch returns `false` only when the expression is guaranteed to match no
+// document of the shard. Negations can match documents that do not contain a
+// given term, so they are always considered as potentially matching.
+func (s *Summary) MayMatch(expr sqe.Expression) bool {
+	switch v := expr.(type) {
+	case *sqe.SearchTerm:
+		return s.termMayMatch(v)
+
+	case *sqe.AndExpression:
+		for _, child := range v.Children {
+			if !s.MayMatch(child) {
+				return false
+			}
+		}
+		return true
+
+	case *sqe.OrExpression:
+		for _, child := range v.Children {
+			if s.MayMatch(child) {
+				return true
+			}
+		}
+		return false
+
+	case *sqe.ParenthesisExpression:
+		return s.MayMatch(v.Child)
+	}
+
+	return true
+}
+
+func (s *Summary) termMayMatch(term *sqe.SearchTerm) bool {
+	switch v := term.Value.(type) {
+	case *sqe.StringLiteral:
+		return s.literalMayMatch(term.Field, v)
+
+	case *sqe.StringsList:
+		for _, literal := range v.Values {
+			if s.literalMayMatch(term.Field, literal) {
+				return true
+			}
+		}
+
+		// An empty list matches nothing, see `sqe.ExpressionToBleve`
+		return false
+	}
+
+	return true
+}
+
+func (s *Summary) literalMayMatch(field string, literal *sqe.StringLiteral) bool {
+	value := literal.Literal()
+
+	// Unquoted `true` and `false` are turned into boolean queries, which bleve
+	// indexes as the `T` and `F` terms.
+	if literal.QuotingChar == "" {
+		switch value {
+		case "true":
+			return s.MayContain(field, "T")
+		case "false":
+			return s.MayContain(field, "F")
+		}
+	}
+
+	return s.MayContain(field, value)
+}
+
+func (s *Summary) MarshalBinary() ([]byte, error) {
+	out := make([]byte, 1+s.filter.encodedSize())
+	out[0] = summaryVersion
+	s.filter.encodeTo(out[1:])
+
+	return out, nil
+}
+
+func (s *Summary) UnmarshalBinary(in []byte) error {
+	if len(in) < 1 {
+		return fmt.Errorf("empty summary")
+	}
+
+	if in[0] != summaryVersion {
+		return fmt.Errorf("unsupported summary version %d, expecting %d", in[0], summaryVersion)
+	}
+
+	filter, err := decodeBloomFilter(in[1:])
+	if err != nil {
+		return err
+	}
+
+	s.filter = filter
+	return nil
+}
@@ -0,0 +1,149 @@
+package summary
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/blevesearch/bleve/document"
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/search"
+	"github.com/streamingfast/search/sqe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummary_MarshalRoundTrip(t *testing.T) {
+	builder := NewBuilder()
+	for i := 0; i < 1000; i++ {
+		builder.Add("receiver", fmt.Sprintf("account%d", i))
+	}
+
+	content, err := builder.Build(DefaultFalsePositiveRate).MarshalBinary()
+	require.NoError(t, err)
+
+	summary := &Summary{}
+	require.NoError(t, summary.UnmarshalBinary(content))
+
+	for i := 0; i < 1000; i++ {
+		assert.True(t, summary.MayContain("receiver", fmt.Sprintf("account%d", i)))
+	}
+
+	falsePositives := 0
+	for i := 0; i < 1000; i++ {
+		if summary.MayContain("receiver", fmt.Sprintf("other%d", i)) {
+			falsePositives++
+		}
+	}
+	assert.Less(t, falsePositives, 50)
+
+	assert.Error(t, summary.UnmarshalBinary(nil))
+	assert.Error(t, summary.UnmarshalBinary([]byte{99}))
+}
+
+func TestSummary_MayMatch(t *testing.T) {
+	builder := NewBuilder()
+	builder.Add("receiver", "eosio.token")
+	builder.Add("action", "transfer")
+	builder.Add("data.to", "bob")
+	builder.Add("input", "T")
+	summary := builder.Build(DefaultFalsePositiveRate)
+
+	tests := []struct {
+		query    string
+		expected bool
+	}{
+		{"receiver:eosio.token", true},
+		{"receiver:eosio", false},
+		{"receiver:eosio.token action:transfer", true},
+		{"receiver:eosio.token action:issue", false},
+		{"action:issue || action:transfer", true},
+		{"action:issue || action:buyram", false},
+		{"(action:issue || data.to:bob) receiver:eosio.token", true},
+		{"(action:issue || data.to:alice) receiver:eosio.token", false},
+		{"action:[issue, transfer]", true},
+		{"action:[issue, buyram]", false},
+		{"-action:issue", true},
+		{"receiver:unknown -action:issue", false},
+		{"input:true", true},
+		{"input:false", false},
+		{`input:"true"`, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			expr, err := sqe.Parse(context.Background(), test.query)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, summary.MayMatch(expr))
+		})
+	}
+}
+
+func TestSummarizingMapper_CompletesOutOfOrder(t *testing.T) {
+	completed := map[uint64]*Summary{}
+	mapper := NewSummarizingMapper(testMapper{}, 3, func(baseBlockNum uint64, summary *Summary) {
+		completed[baseBlockNum] = summary
+	})
+
+	for _, blockNum := range []uint64{4, 2, 3, 1, 5} {
+		_, err := mapper.Map(&bstream.Block{Number: blockNum})
+		require.NoError(t, err)
+	}
+
+	// Shard 0 only saw blocks 1 and 2, shard 3 saw all of 3, 4 and 5
+	require.Len(t, completed, 1)
+	require.Contains(t, completed, uint64(3))
+
+	summary := completed[3]
+	for _, blockNum := range []uint64{3, 4, 5} {
+		assert.True(t, summary.MayContain("block_num", fmt.Sprintf("%d", blockNum)))
+	}
+}
+
+func TestCache_SkipsUnmatchableShards(t *testing.T) {
+	ctx := context.Background()
+	store, err := dstore.NewStore("file://"+t.TempDir(), "summary", "zstd", true)
+	require.NoError(t, err)
+
+	tokenShard := NewBuilder()
+	tokenShard.Add("receiver", "eosio.token")
+	require.NoError(t, Write(ctx, store, 10, 20, tokenShard.Build(DefaultFalsePositiveRate)))
+
+	otherShard := NewBuilder()
+	otherShard.Add("receiver", "eosio")
+	require.NoError(t, Write(ctx, store, 10, 30, otherShard.Build(DefaultFalsePositiveRate)))
+
+	cache := NewCache(store, 10, nil)
+	require.NoError(t, cache.Load(ctx))
+
+	query := &search.BleveQuery{Raw: "receiver:eosio.token", Validator: cache.WrapValidator(nil)}
+	require.NoError(t, query.Parse(ctx))
+	require.NoError(t, query.Validate())
+
+	hash, err := query.Hash()
+	require.NoError(t, err)
+
+	roar := roaring.New()
+	require.NoError(t, cache.Get(hash, roar))
+	assert.Equal(t, []uint32{3}, roar.ToArray())
+
+	unknown := roaring.New()
+	require.NoError(t, cache.Get("unknown", unknown))
+	assert.True(t, unknown.IsEmpty())
+}
+
+type testMapper struct{}
+
+func (testMapper) Map(block *bstream.Block) ([]*document.Document, error) {
+	doc := document.NewDocument(block.ID())
+	doc.AddField(document.NewTextField("block_num", nil, []byte(fmt.Sprintf("%d", block.Num()))))
+
+	return []*document.Document{doc}, nil
+}
+
+func (testMapper) Validate() error {
+	return nil
+}
@@ -35,8 +35,9 @@ type IndexedTerms struct {
 	DBTable     bool
 	DBKey       bool
 
-	Base map[string]bool
-	Data map[string]bool
+	Base   map[string]bool
+	Data   map[string]bool
+	Return map[string]bool
 }
 
 type fieldCategory int
@@ -44,6 +45,7 @@ type fieldCategory int
 const (
 	fieldCategoryBase fieldCategory = iota
 	fieldCategoryData
+	fieldCategoryReturn
 )
 
 var splitTermRegexp = regexp.MustCompile("(,|\\s+)")
@@ -57,8 +59,9 @@ func NewIndexedTerms(specs string) (out *IndexedTerms, err error) {
 	}
 
 	out = &IndexedTerms{
-		Base: map[string]bool{},
-		Data: map[string]bool{},
+		Base:   map[string]bool{},
+		Data:   map[string]bool{},
+		Return: map[string]bool{},
 	}
 
 	terms := splitTermRegexp.Split(specs, -1)
@@ -101,6 +104,9 @@ func NewIndexedTerms(specs string) (out *IndexedTerms, err error) {
 			if strings.HasPrefix(term, "data.") {
 				category = fieldCategoryData
 				out.Data[out.NormalizeDataField(term)] = true
+			} else if strings.HasPrefix(term, "return.") {
+				category = fieldCategoryReturn
+				out.Return[out.NormalizeDataField(term)] = true
 			} else {
 				return nil, fmt.Errorf("invalid indexed term specs %q: unknown field %q", specs, term)
 			}
@@ -123,6 +129,10 @@ func (t *IndexedTerms) IsIndexed(fieldName string) bool {
 		return t.Data[t.NormalizeDataField(fieldName)]
 	}
 
+	if strings.HasPrefix(fieldName, "return.") {
+		return t.Return[t.NormalizeDataField(fieldName)]
+	}
+
 	return strings.HasPrefix(fieldName, "event.")
 }
 
@@ -14,10 +14,11 @@ func TestNewIndexedTerms(t *testing.T) {
 		expected    *IndexedTerms
 		expectedErr error
 	}{
-		{"single", "receiver", &IndexedTerms{Receiver: true, Base: map[string]bool{"receiver": true}, Data: map[string]bool{}}, nil},
-		{"multiple spaces", "receiver account", &IndexedTerms{Receiver: true, Account: true, Base: map[string]bool{"receiver": true, "account": true}, Data: map[string]bool{}}, nil},
-		{"multiple comma", "receiver, account", &IndexedTerms{Receiver: true, Account: true, Base: map[string]bool{"receiver": true, "account": true}, Data: map[string]bool{}}, nil},
-		{"data fields", "data.to", &IndexedTerms{Base: map[string]bool{}, Data: map[string]bool{"to": true}}, nil},
+		{"single", "receiver", &IndexedTerms{Receiver: true, Base: map[string]bool{"receiver": true}, Data: map[string]bool{}, Return: map[string]bool{}}, nil},
+		{"multiple spaces", "receiver account", &IndexedTerms{Receiver: true, Account: true, Base: map[string]bool{"receiver": true, "account": true}, Data: map[string]bool{}, Return: map[string]bool{}}, nil},
+		{"multiple comma", "receiver, account", &IndexedTerms{Receiver: true, Account: true, Base: map[string]bool{"receiver": true, "account": true}, Data: map[string]bool{}, Return: map[string]bool{}}, nil},
+		{"return fields", "return.to return.value", &IndexedTerms{Base: map[string]bool{}, Data: map[string]bool{}, Return: map[string]bool{"to": true, "value": true}}, nil},
+		{"data fields", "data.to", &IndexedTerms{Base: map[string]bool{}, Data: map[string]bool{"to": true}, Return: map[string]bool{}}, nil},
 	}
 
 	for _, test := range tests {
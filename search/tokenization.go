@@ -60,6 +60,13 @@ func (t *tokenizer) tokenize(actTrace *pbcodec.ActionTrace) (out map[string]inte
 		}
 	}
 
+	if len(t.indexedTerms.Return) > 0 {
+		tokens := t.tokenizeReturn(actTrace.JsonReturnValue)
+		if len(tokens) > 0 {
+			out["return"] = tokens
+		}
+	}
+
 	return out
 }
 
@@ -103,6 +110,33 @@ func (t *tokenizer) tokenizeData(data string) map[string]interface{} {
 	return out
 }
 
+// tokenizeReturn indexes the fields of an action's decoded return value, a return value that
+// is not a JSON object is indexed as the `return.value` field.
+func (t *tokenizer) tokenizeReturn(returnValue string) map[string]interface{} {
+	if returnValue == "" {
+		return nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal([]byte(returnValue), &value); err != nil {
+		return nil
+	}
+
+	fields, isObject := value.(map[string]interface{})
+	if !isObject {
+		fields = map[string]interface{}{"value": value}
+	}
+
+	out := make(map[string]interface{})
+	for fieldName, fieldValue := range fields {
+		if t.indexedTerms.IsIndexed("return." + fieldName) {
+			out[t.indexedTerms.NormalizeDataField(fieldName)] = fieldValue
+		}
+	}
+
+	return out
+}
+
 func (t *tokenizer) tokenizeEvent(config eventsConfig, authKey string, data string) url.Values {
 	out, err := url.ParseQuery(data)
 	if err != nil {
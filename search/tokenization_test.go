@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTokenizeEvent(t *testing.T) {
@@ -95,3 +96,33 @@ func TestTokenizeEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenizeReturn(t *testing.T) {
+	tests := []struct {
+		name        string
+		returnValue string
+		expect      map[string]interface{}
+	}{
+		{"empty", "", nil},
+		{"invalid json", "{", nil},
+		{"object indexed fields only", `{"to":"bob","memo":"hello"}`, map[string]interface{}{"to": "bob"}},
+		{"scalar as value", `"eosio"`, map[string]interface{}{"value": "eosio"}},
+		{"number as value", `12`, map[string]interface{}{"value": float64(12)}},
+	}
+
+	indexedTerms, err := NewIndexedTerms("return.to, return.value")
+	require.NoError(t, err)
+
+	tokenizer := tokenizer{indexedTerms: indexedTerms}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := tokenizer.tokenizeReturn(test.returnValue)
+			if test.expect == nil {
+				assert.Empty(t, res)
+			} else {
+				assert.Equal(t, test.expect, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package searchhook
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/streamingfast/dauth/authenticator"
+	"github.com/streamingfast/dgrpc"
+	"github.com/streamingfast/shutter"
+	searchclient "github.com/zhongshuwen/histnew/search-client"
+	"github.com/zhongshuwen/histnew/searchhook"
+	"github.com/zhongshuwen/histnew/trxdb"
+	"go.uber.org/zap"
+)
+
+type Config struct {
+	HTTPListenAddr      string        // Address to listen for incoming REST requests
+	AuthPlugin          string        // dauth plugin URI authenticating the REST requests
+	SearchAddr          string        // gRPC address of the search router
+	KvdbDSN             string        // trxdb DSN used to hydrate matched transactions
+	HooksFile           string        // Path of the JSON file storing hook definitions and cursors
+	DeliveryTimeout     time.Duration // Timeout of a single webhook POST
+	DeliveryMaxAttempts int           // Number of attempts to deliver a match before reconnecting the stream
+	RetryBackoff        time.Duration // Initial backoff between delivery attempts and stream reconnections
+	MaxRetryBackoff     time.Duration // Upper bound of the exponential backoff
+}
+
+type App struct {
+	*shutter.Shutter
+	config *Config
+	server *searchhook.Server
+}
+
+func New(config *Config) *App {
+	return &App{
+		Shutter: shutter.New(),
+		config:  config,
+	}
+}
+
+func (a *App) Run() error {
+	zlog.Info("running searchhook", zap.Reflect("config", a.config))
+
+	store, err := searchhook.NewFileStore(a.config.HooksFile)
+	if err != nil {
+		return fmt.Errorf("unable to init hooks store: %w", err)
+	}
+
+	dbReader, err := trxdb.New(a.config.KvdbDSN, trxdb.WithLogger(zlog))
+	if err != nil {
+		return fmt.Errorf("unable to init KVDB connection: %w", err)
+	}
+
+	searchConn, err := dgrpc.NewInternalClient(a.config.SearchAddr)
+	if err != nil {
+		return fmt.Errorf("unable to init gRPC search connection: %w", err)
+	}
+
+	sender := searchhook.NewSender(
+		&http.Client{Timeout: a.config.DeliveryTimeout},
+		a.config.DeliveryMaxAttempts,
+		a.config.RetryBackoff,
+		a.config.MaxRetryBackoff,
+	)
+
+	manager := searchhook.NewManager(store, searchclient.NewEOSClient(searchConn, dbReader), sender, a.config.RetryBackoff, a.config.MaxRetryBackoff)
+	manager.OnTerminated(a.Shutdown)
+	a.OnTerminating(manager.Shutdown)
+
+	auth, err := authenticator.New(a.config.AuthPlugin)
+	if err != nil {
+		return fmt.Errorf("unable to initialize dauth: %w", err)
+	}
+
+	a.server = searchhook.NewServer(manager, a.config.HTTPListenAddr, auth)
+	a.server.OnTerminated(a.Shutdown)
+	a.OnTerminating(a.server.Shutdown)
+
+	if err := manager.Launch(); err != nil {
+		return fmt.Errorf("unable to launch hooks: %w", err)
+	}
+
+	go a.server.Serve()
+	return nil
+}
+
+func (a *App) IsReady() bool {
+	return a.server != nil && !a.server.IsTerminating()
+}
@@ -0,0 +1,12 @@
+package searchhook
+
+import (
+	"github.com/streamingfast/logging"
+	"go.uber.org/zap"
+)
+
+var zlog = zap.NewNop()
+
+func init() {
+	logging.Register("github.com/zhongshuwen/histnew/searchhook/app/searchhook", &zlog)
+}
@@ -0,0 +1,73 @@
+package searchhook
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+
+	searchclient "github.com/zhongshuwen/histnew/search-client"
+)
+
+var hookNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_.-]{1,64}$`)
+
+// Hook is a named SQE query whose matches are POSTed to a webhook. The query
+// runs as a forward stream, the cursor of the last successfully delivered
+// match is persisted so the stream resumes where it stopped on restart.
+//
+// When a projection is set, the delivered traces only contain the matched
+// actions pruned according to it instead of the full transaction trace.
+//
+// A webhook rejecting a delivery with a permanent error (`4xx` other than
+// `408` and `429`) stops the hook, the error is kept in `Failure` until the
+// hook is updated, which restarts it from its last saved cursor.
+type Hook struct {
+	Name           string                   `json:"name"`
+	Query          string                   `json:"query"`
+	WebhookURL     string                   `json:"webhook_url"`
+	Secret         string                   `json:"secret,omitempty"`
+	StartBlock     int64                    `json:"start_block"`
+	WithReversible bool                     `json:"with_reversible"`
+	Projection     *searchclient.Projection `json:"projection,omitempty"`
+	Cursor         string                   `json:"cursor,omitempty"`
+	Failure        string                   `json:"failure,omitempty"`
+}
+
+// Validate checks that the hook definition is complete, it returns the list
+// of problems keyed by field name, in the format expected by
+// `derr.RequestValidationError`.
+func (h *Hook) Validate() url.Values {
+	errors := url.Values{}
+	if !hookNameRegex.MatchString(h.Name) {
+		errors.Add("name", "The name field must be 1 to 64 characters long and contain only letters, digits, '_', '.' or '-'.")
+	}
+
+	if h.Query == "" {
+		errors.Add("query", "The query field is required.")
+	}
+
+	if h.WebhookURL == "" {
+		errors.Add("webhook_url", "The webhook_url field is required.")
+	} else if u, err := url.Parse(h.WebhookURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errors.Add("webhook_url", "The webhook_url field must be an absolute http or https URL.")
+	}
+
+	return errors
+}
+
+// redactedSecret replaces secrets in REST API responses.
+const redactedSecret = "********"
+
+// Redacted returns a copy of the hook with its secret masked, suitable for
+// being returned by the REST API.
+func (h *Hook) Redacted() *Hook {
+	out := *h
+	if out.Secret != "" {
+		out.Secret = redactedSecret
+	}
+
+	return &out
+}
+
+func (h *Hook) String() string {
+	return fmt.Sprintf("%s (%q -> %s)", h.Name, h.Query, h.WebhookURL)
+}
@@ -0,0 +1,232 @@
+package searchhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/streamingfast/shutter"
+	"go.uber.org/zap"
+)
+
+var ErrHookExists = errors.New("hook already exists")
+
+// errRunnerReplaced is returned to a runner whose hook was updated or deleted
+// since it started, it must not touch the hook anymore.
+var errRunnerReplaced = errors.New("hook runner replaced")
+
+// Manager owns the set of saved hooks, it keeps the store and the running
+// streams in sync: creating a hook starts its stream, updating it restarts the
+// stream and deleting it stops the stream.
+type Manager struct {
+	*shutter.Shutter
+
+	store    Store
+	streamer MatchStreamer
+	sender   *Sender
+
+	reconnectBackoff    time.Duration
+	maxReconnectBackoff time.Duration
+
+	ctx        context.Context
+	lock       sync.Mutex
+	running    map[string]*runningHook
+	generation uint64
+}
+
+// runningHook identifies the runner currently owning a hook, `generation`
+// being unique to each started runner.
+type runningHook struct {
+	generation uint64
+	cancel     context.CancelFunc
+}
+
+func NewManager(store Store, streamer MatchStreamer, sender *Sender, reconnectBackoff, maxReconnectBackoff time.Duration) *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	m := &Manager{
+		Shutter:             shutter.New(),
+		store:               store,
+		streamer:            streamer,
+		sender:              sender,
+		reconnectBackoff:    reconnectBackoff,
+		maxReconnectBackoff: maxReconnectBackoff,
+		ctx:                 ctx,
+		running:             map[string]*runningHook{},
+	}
+
+	m.OnTerminating(func(_ error) {
+		zlog.Info("stopping all hook streams")
+		cancel()
+	})
+
+	return m
+}
+
+// Launch starts a stream for every hook found in the store.
+func (m *Manager) Launch() error {
+	hooks, err := m.store.List()
+	if err != nil {
+		return fmt.Errorf("list hooks: %w", err)
+	}
+
+	zlog.Info("launching saved hooks", zap.Int("hook_count", len(hooks)))
+	for _, hook := range hooks {
+		if hook.Failure != "" {
+			zlog.Warn("not launching failed hook, update it to restart it", zap.String("hook", hook.Name), zap.String("failure", hook.Failure))
+			continue
+		}
+
+		m.start(hook)
+	}
+
+	return nil
+}
+
+func (m *Manager) List() ([]*Hook, error) {
+	return m.store.List()
+}
+
+func (m *Manager) Get(name string) (*Hook, error) {
+	return m.store.Get(name)
+}
+
+func (m *Manager) Create(hook *Hook) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, err := m.store.Get(hook.Name); err == nil {
+		return ErrHookExists
+	}
+
+	hook.Failure = ""
+	if err := m.store.Put(hook); err != nil {
+		return err
+	}
+
+	m.startLocked(hook)
+	return nil
+}
+
+// Update replaces the definition of an existing hook. When the query changes,
+// the persisted cursor is reset since it's tied to the previous query. The
+// secret being write-only, an empty or masked one keeps the existing secret.
+// A failed hook is restarted.
+func (m *Manager) Update(hook *Hook) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	existing, err := m.store.Get(hook.Name)
+	if err != nil {
+		return err
+	}
+
+	if hook.Query == existing.Query && hook.Cursor == "" {
+		hook.Cursor = existing.Cursor
+	}
+
+	if hook.Secret == "" || hook.Secret == redactedSecret {
+		hook.Secret = existing.Secret
+	}
+	hook.Failure = ""
+
+	m.stopLocked(hook.Name)
+	if err := m.store.Put(hook); err != nil {
+		return err
+	}
+
+	m.startLocked(hook)
+	return nil
+}
+
+func (m *Manager) Delete(name string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.stopLocked(name)
+	return m.store.Delete(name)
+}
+
+func (m *Manager) start(hook *Hook) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.startLocked(hook)
+}
+
+func (m *Manager) startLocked(hook *Hook) {
+	ctx, cancel := context.WithCancel(m.ctx)
+
+	m.generation++
+	generation := m.generation
+	m.running[hook.Name] = &runningHook{generation: generation, cancel: cancel}
+
+	runnerHook := *hook
+	r := &runner{
+		hook:     &runnerHook,
+		streamer: m.streamer,
+		sender:   m.sender,
+		saveCursor: func(cursor string) error {
+			return m.saveCursor(hook.Name, generation, cursor)
+		},
+		fail: func(failure error) {
+			m.fail(hook.Name, generation, failure)
+		},
+		reconnectBackoff:    m.reconnectBackoff,
+		maxReconnectBackoff: m.maxReconnectBackoff,
+	}
+
+	go r.run(ctx)
+}
+
+func (m *Manager) stopLocked(name string) {
+	if running, found := m.running[name]; found {
+		running.cancel()
+		delete(m.running, name)
+	}
+}
+
+func (m *Manager) ownsLocked(name string, generation uint64) bool {
+	running, found := m.running[name]
+	return found && running.generation == generation
+}
+
+// saveCursor persists the cursor of the runner started as `generation`, the
+// manager's lock ensures it cannot overwrite the cursor of an updated hook.
+func (m *Manager) saveCursor(name string, generation uint64, cursor string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if !m.ownsLocked(name, generation) {
+		return errRunnerReplaced
+	}
+
+	return m.store.SaveCursor(name, cursor)
+}
+
+// fail stops the hook run by the runner started as `generation` and records
+// the failure in the store.
+func (m *Manager) fail(name string, generation uint64, failure error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if !m.ownsLocked(name, generation) {
+		return
+	}
+
+	zlog.Warn("stopping failed hook", zap.String("hook", name), zap.Error(failure))
+	m.stopLocked(name)
+
+	hook, err := m.store.Get(name)
+	if err != nil {
+		zlog.Warn("unable to retrieve failed hook", zap.String("hook", name), zap.Error(err))
+		return
+	}
+
+	hook.Failure = failure.Error()
+	if err := m.store.Put(hook); err != nil {
+		zlog.Warn("unable to save hook failure", zap.String("hook", name), zap.Error(err))
+	}
+}
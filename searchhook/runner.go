@@ -0,0 +1,164 @@
+package searchhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	pbsearch "github.com/streamingfast/pbgo/dfuse/search/v1"
+	searchclient "github.com/zhongshuwen/histnew/search-client"
+	"go.uber.org/zap"
+)
+
+// MatchStreamer is the subset of `searchclient.EOSClient` used to run hooks.
+type MatchStreamer interface {
+	StreamMatchesWithProjection(ctx context.Context, req *pbsearch.RouterRequest, projection *searchclient.Projection) (searchclient.EOSStreamMatchesClient, error)
+}
+
+type matchPayload struct {
+	Hook             string            `json:"hook"`
+	Cursor           string            `json:"cursor"`
+	Undo             bool              `json:"undo"`
+	BlockNum         uint64            `json:"block_num"`
+	BlockID          string            `json:"block_id"`
+	IrrBlockNum      uint64            `json:"irr_block_num,omitempty"`
+	TransactionTrace json.RawMessage   `json:"trace"`
+	MatchingActions  []json.RawMessage `json:"matching_actions"`
+}
+
+// runner streams the matches of a single hook and delivers them to its
+// webhook. The cursor is saved only after a successful delivery, which gives
+// at-least-once delivery semantics across restarts.
+type runner struct {
+	hook     *Hook
+	streamer MatchStreamer
+	sender   *Sender
+
+	// saveCursor and fail are bound to this runner by the `Manager`, they
+	// are no-ops once the hook is updated or deleted.
+	saveCursor func(cursor string) error
+	fail       func(failure error)
+
+	reconnectBackoff    time.Duration
+	maxReconnectBackoff time.Duration
+}
+
+func (r *runner) run(ctx context.Context) {
+	backoff := r.reconnectBackoff
+	for {
+		zlog.Info("starting hook stream", zap.String("hook", r.hook.Name), zap.String("cursor", r.hook.Cursor))
+		delivered, err := r.stream(ctx)
+		if ctx.Err() != nil {
+			zlog.Info("hook stream terminated", zap.String("hook", r.hook.Name))
+			return
+		}
+
+		if isPermanentDeliveryError(err) {
+			r.fail(err)
+			return
+		}
+
+		if delivered {
+			backoff = r.reconnectBackoff
+		}
+
+		zlog.Warn("hook stream interrupted, reconnecting", zap.String("hook", r.hook.Name), zap.Duration("backoff", backoff), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > r.maxReconnectBackoff {
+			backoff = r.maxReconnectBackoff
+		}
+	}
+}
+
+func (r *runner) stream(ctx context.Context) (delivered bool, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := r.streamer.StreamMatchesWithProjection(ctx, &pbsearch.RouterRequest{
+		Query:              r.hook.Query,
+		LowBlockNum:        r.hook.StartBlock,
+		HighBlockUnbounded: true,
+		WithReversible:     r.hook.WithReversible,
+		Cursor:             r.hook.Cursor,
+		Mode:               pbsearch.RouterRequest_STREAMING,
+	}, r.hook.Projection)
+	if err != nil {
+		return false, fmt.Errorf("connecting to search service: %w", err)
+	}
+
+	for {
+		match, err := stream.Recv()
+		if err != nil {
+			return delivered, fmt.Errorf("receiving match: %w", err)
+		}
+
+		// Live markers carry no transaction, there is nothing to deliver
+		// but we still advance the cursor so restarts do not replay them.
+		if match.TransactionTrace != nil {
+			body, err := encodeMatch(r.hook.Name, match)
+			if err != nil {
+				return delivered, fmt.Errorf("encoding match: %w", err)
+			}
+
+			if err := r.sender.Send(ctx, r.hook, body); err != nil {
+				return delivered, err
+			}
+			delivered = true
+		}
+
+		// The hook might have been updated or deleted while we were delivering,
+		// in which case the cursor belongs to a stale definition.
+		if ctx.Err() != nil {
+			return delivered, ctx.Err()
+		}
+
+		if err := r.saveCursor(match.Cursor); err != nil {
+			if errors.Is(err, ErrHookNotFound) || errors.Is(err, errRunnerReplaced) {
+				return delivered, err
+			}
+
+			zlog.Warn("unable to save hook cursor", zap.String("hook", r.hook.Name), zap.Error(err))
+		}
+		r.hook.Cursor = match.Cursor
+	}
+}
+
+func encodeMatch(hookName string, match *searchclient.EOSSearchMatch) ([]byte, error) {
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+
+	trace, err := marshaler.MarshalToString(match.TransactionTrace)
+	if err != nil {
+		return nil, fmt.Errorf("transaction trace: %w", err)
+	}
+
+	payload := &matchPayload{
+		Hook:             hookName,
+		Cursor:           match.Cursor,
+		Undo:             match.Undo,
+		BlockNum:         match.BlockNum,
+		BlockID:          match.BlockID,
+		IrrBlockNum:      match.IrrBlockNum,
+		TransactionTrace: json.RawMessage(trace),
+		MatchingActions:  make([]json.RawMessage, len(match.MatchingActions)),
+	}
+
+	for i, action := range match.MatchingActions {
+		actionTrace, err := marshaler.MarshalToString(action)
+		if err != nil {
+			return nil, fmt.Errorf("action trace: %w", err)
+		}
+
+		payload.MatchingActions[i] = json.RawMessage(actionTrace)
+	}
+
+	return json.Marshal(payload)
+}
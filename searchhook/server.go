@@ -0,0 +1,227 @@
+package searchhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/streamingfast/dauth/authenticator"
+	dauthMiddleware "github.com/streamingfast/dauth/authenticator/middleware"
+	"github.com/streamingfast/derr"
+	"github.com/streamingfast/shutter"
+	"go.uber.org/zap"
+)
+
+// Server exposes CRUD operations over the saved hooks:
+//
+//	GET    /v1/hooks         list all hooks
+//	POST   /v1/hooks         create a hook
+//	GET    /v1/hooks/{name}  retrieve a hook
+//	PUT    /v1/hooks/{name}  replace a hook
+//	DELETE /v1/hooks/{name}  delete a hook
+//
+// Secrets are write-only, they are always masked in responses. The hooks
+// routes are authenticated with the dauth authenticator given to `NewServer`,
+// when not nil.
+type Server struct {
+	*shutter.Shutter
+
+	manager    *Manager
+	addr       string
+	router     *mux.Router
+	httpServer *http.Server
+}
+
+func NewServer(manager *Manager, addr string, auth authenticator.Authenticator) *Server {
+	srv := &Server{
+		Shutter: shutter.New(),
+		manager: manager,
+		addr:    addr,
+		router:  mux.NewRouter(),
+	}
+
+	srv.router.HandleFunc("/healthz", srv.healthzHandler)
+
+	hooksRouter := srv.router.PathPrefix("/v1/hooks").Subrouter()
+	if auth != nil {
+		hooksRouter.Use(dauthMiddleware.NewAuthMiddleware(auth, authErrorHandler).Handler)
+	}
+
+	hooksRouter.Methods("GET").Path("").HandlerFunc(srv.listHandler)
+	hooksRouter.Methods("POST").Path("").HandlerFunc(srv.createHandler)
+	hooksRouter.Methods("GET").Path("/{name}").HandlerFunc(srv.getHandler)
+	hooksRouter.Methods("PUT").Path("/{name}").HandlerFunc(srv.updateHandler)
+	hooksRouter.Methods("DELETE").Path("/{name}").HandlerFunc(srv.deleteHandler)
+
+	srv.OnTerminating(func(_ error) {
+		if srv.httpServer != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			srv.httpServer.Shutdown(ctx)
+		}
+	})
+
+	return srv
+}
+
+func (srv *Server) Handler() http.Handler {
+	return srv.router
+}
+
+func (srv *Server) Serve() {
+	zlog.Info("listening & serving HTTP content", zap.String("http_listen_addr", srv.addr))
+	srv.httpServer = &http.Server{
+		Addr:    srv.addr,
+		Handler: srv.router,
+	}
+
+	err := srv.httpServer.ListenAndServe()
+	if err != nil && err != http.ErrServerClosed {
+		srv.Shutdown(fmt.Errorf("failed listening http %q: %w", srv.addr, err))
+	}
+}
+
+func (srv *Server) healthzHandler(w http.ResponseWriter, r *http.Request) {
+	if srv.IsTerminating() || derr.IsShuttingDown() {
+		http.Error(w, "not ready\n", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Write([]byte("ready\n"))
+}
+
+func (srv *Server) listHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	hooks, err := srv.manager.List()
+	if err != nil {
+		writeError(ctx, w, fmt.Errorf("list hooks: %w", err))
+		return
+	}
+
+	out := make([]*Hook, len(hooks))
+	for i, hook := range hooks {
+		out[i] = hook.Redacted()
+	}
+
+	writeResponse(ctx, w, http.StatusOK, out)
+}
+
+func (srv *Server) getHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	name := mux.Vars(r)["name"]
+
+	hook, err := srv.manager.Get(name)
+	if err != nil {
+		writeError(ctx, w, toHTTPError(ctx, name, err))
+		return
+	}
+
+	writeResponse(ctx, w, http.StatusOK, hook.Redacted())
+}
+
+func (srv *Server) createHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	hook, err := readHook(ctx, r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	if err := srv.manager.Create(hook); err != nil {
+		writeError(ctx, w, toHTTPError(ctx, hook.Name, err))
+		return
+	}
+
+	writeResponse(ctx, w, http.StatusCreated, hook.Redacted())
+}
+
+func (srv *Server) updateHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	hook, err := readHook(ctx, r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	name := mux.Vars(r)["name"]
+	if hook.Name != name {
+		writeError(ctx, w, derr.HTTPBadRequestError(ctx, nil, derr.C("hook_name_mismatch_error"), "The hook name in the body does not match the one in the path.",
+			"path_name", name,
+			"body_name", hook.Name,
+		))
+		return
+	}
+
+	if err := srv.manager.Update(hook); err != nil {
+		writeError(ctx, w, toHTTPError(ctx, name, err))
+		return
+	}
+
+	writeResponse(ctx, w, http.StatusOK, hook.Redacted())
+}
+
+func (srv *Server) deleteHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	name := mux.Vars(r)["name"]
+
+	if err := srv.manager.Delete(name); err != nil {
+		writeError(ctx, w, toHTTPError(ctx, name, err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func readHook(ctx context.Context, r *http.Request) (*Hook, error) {
+	if r.Body == nil {
+		return nil, derr.MissingBodyError(ctx)
+	}
+
+	hook := &Hook{}
+	if err := json.NewDecoder(r.Body).Decode(hook); err != nil {
+		return nil, derr.InvalidJSONError(ctx, err)
+	}
+
+	if errors := hook.Validate(); len(errors) > 0 {
+		return nil, derr.RequestValidationError(ctx, errors)
+	}
+
+	return hook, nil
+}
+
+func toHTTPError(ctx context.Context, name string, err error) error {
+	switch {
+	case errors.Is(err, ErrHookNotFound):
+		return derr.HTTPNotFoundError(ctx, nil, derr.C("hook_not_found_error"), "The requested hook does not exist.", "name", name)
+	case errors.Is(err, ErrHookExists):
+		return derr.HTTPConflictError(ctx, nil, derr.C("hook_exists_error"), "A hook with this name already exists.", "name", name)
+	}
+
+	return err
+}
+
+func authErrorHandler(w http.ResponseWriter, ctx context.Context, err error) {
+	derr.WriteError(ctx, w, "unable to authorize request", derr.HTTPUnauthorizedError(ctx, err, derr.C("auth_invalid_token_error"), "The request is not authorized.",
+		"reason", err.Error(),
+	))
+}
+
+func writeError(ctx context.Context, w http.ResponseWriter, err error) {
+	derr.WriteError(ctx, w, "unable to fullfil request", err)
+}
+
+func writeResponse(ctx context.Context, w http.ResponseWriter, status int, response interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		zlog.Debug("an error occurred while writing response", zap.Error(err))
+	}
+}
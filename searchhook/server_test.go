@@ -0,0 +1,308 @@
+package searchhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/streamingfast/dauth/authenticator"
+	pbsearch "github.com/streamingfast/pbgo/dfuse/search/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+	searchclient "github.com/zhongshuwen/histnew/search-client"
+)
+
+func TestServer_CRUD(t *testing.T) {
+	manager, store := newTestManager(t, &testStreamer{})
+	srv := httptest.NewServer(NewServer(manager, "", nil).Handler())
+	defer srv.Close()
+
+	resp := doRequest(t, "POST", srv.URL+"/v1/hooks", `{"name":"transfers","query":"action:transfer","webhook_url":"http://localhost:1/hook","secret":"s3cr3t"}`)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp = doRequest(t, "POST", srv.URL+"/v1/hooks", `{"name":"transfers","query":"action:transfer","webhook_url":"http://localhost:1/hook"}`)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	resp = doRequest(t, "POST", srv.URL+"/v1/hooks", `{"name":"bad name","query":"","webhook_url":"ftp://x"}`)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = doRequest(t, "GET", srv.URL+"/v1/hooks/transfers", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	hook := decodeHook(t, resp.Body)
+	assert.Equal(t, "action:transfer", hook.Query)
+	assert.Equal(t, "********", hook.Secret)
+
+	resp = doRequest(t, "PUT", srv.URL+"/v1/hooks/transfers", `{"name":"transfers","query":"action:issue","webhook_url":"http://localhost:1/hook"}`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	stored, err := store.Get("transfers")
+	require.NoError(t, err)
+	assert.Equal(t, "action:issue", stored.Query)
+	assert.Equal(t, "s3cr3t", stored.Secret)
+
+	resp = doRequest(t, "PUT", srv.URL+"/v1/hooks/transfers", `{"name":"transfers","query":"action:issue","webhook_url":"http://localhost:1/hook","secret":"********"}`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	stored, err = store.Get("transfers")
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", stored.Secret)
+
+	resp = doRequest(t, "PUT", srv.URL+"/v1/hooks/transfers", `{"name":"transfers","query":"action:issue","webhook_url":"http://localhost:1/hook","secret":"n3w"}`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	stored, err = store.Get("transfers")
+	require.NoError(t, err)
+	assert.Equal(t, "n3w", stored.Secret)
+
+	resp = doRequest(t, "PUT", srv.URL+"/v1/hooks/transfers", `{"name":"other","query":"action:issue","webhook_url":"http://localhost:1/hook"}`)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = doRequest(t, "GET", srv.URL+"/v1/hooks", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var hooks []*Hook
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&hooks))
+	assert.Len(t, hooks, 1)
+
+	resp = doRequest(t, "DELETE", srv.URL+"/v1/hooks/transfers", "")
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp = doRequest(t, "GET", srv.URL+"/v1/hooks/transfers", "")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestServer_Authenticated(t *testing.T) {
+	manager, _ := newTestManager(t, &testStreamer{})
+	srv := httptest.NewServer(NewServer(manager, "", testAuthenticator("s3cr3t")).Handler())
+	defer srv.Close()
+
+	resp := doRequest(t, "GET", srv.URL+"/v1/hooks", "")
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp = doRequest(t, "GET", srv.URL+"/v1/hooks/transfers?token=wrong", "")
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp = doRequest(t, "GET", srv.URL+"/v1/hooks?token=s3cr3t", "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = doRequest(t, "GET", srv.URL+"/healthz", "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestManager_DeliversMatchesAndPersistsCursor(t *testing.T) {
+	payloads := make(chan *matchPayload, 2)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload := &matchPayload{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(payload))
+		payloads <- payload
+	}))
+	defer webhook.Close()
+
+	streamer := &testStreamer{matches: []*searchclient.EOSSearchMatch{
+		{
+			SearchMatch:      &pbsearch.SearchMatch{BlockNum: 10, Cursor: "c1"},
+			BlockID:          "0000000aa",
+			TransactionTrace: &pbcodec.TransactionTrace{Id: "trx1"},
+			MatchingActions:  []*pbcodec.ActionTrace{{Receiver: "zswhq.token"}},
+		},
+		{SearchMatch: &pbsearch.SearchMatch{BlockNum: 11, Cursor: "c2"}, BlockID: "0000000bb"},
+	}}
+
+	manager, store := newTestManager(t, streamer)
+	require.NoError(t, manager.Create(&Hook{Name: "transfers", Query: "action:transfer", WebhookURL: webhook.URL}))
+
+	select {
+	case payload := <-payloads:
+		assert.Equal(t, "transfers", payload.Hook)
+		assert.Equal(t, "c1", payload.Cursor)
+		assert.Equal(t, uint64(10), payload.BlockNum)
+		assert.JSONEq(t, `{"id":"trx1"}`, string(payload.TransactionTrace))
+		assert.Len(t, payload.MatchingActions, 1)
+	case <-time.After(2 * time.Second):
+		t.Fatal("webhook never received the match")
+	}
+
+	require.Eventually(t, func() bool {
+		hook, err := store.Get("transfers")
+		return err == nil && hook.Cursor == "c2"
+	}, 2*time.Second, 10*time.Millisecond)
+
+	// Live markers are never delivered to the webhook
+	assert.Len(t, payloads, 0)
+}
+
+func TestManager_StreamsWithHookProjection(t *testing.T) {
+	streamer := &testStreamer{projections: make(chan *searchclient.Projection, 1)}
+	manager, _ := newTestManager(t, streamer)
+	srv := httptest.NewServer(NewServer(manager, "", nil).Handler())
+	defer srv.Close()
+
+	resp := doRequest(t, "POST", srv.URL+"/v1/hooks", `{"name":"transfers","query":"action:transfer","webhook_url":"http://localhost:1/hook","projection":{"action_data":true,"db_ops":true}}`)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	select {
+	case projection := <-streamer.projections:
+		assert.Equal(t, &searchclient.Projection{ActionData: true, DBOps: true}, projection)
+	case <-time.After(2 * time.Second):
+		t.Fatal("hook stream never started")
+	}
+
+	resp = doRequest(t, "GET", srv.URL+"/v1/hooks/transfers", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, &searchclient.Projection{ActionData: true, DBOps: true}, decodeHook(t, resp.Body).Projection)
+}
+
+func TestManager_StopsHookOnPermanentDeliveryFailure(t *testing.T) {
+	var attempts int32
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusGone)
+	}))
+	defer webhook.Close()
+
+	streamer := &testStreamer{matches: []*searchclient.EOSSearchMatch{
+		{SearchMatch: &pbsearch.SearchMatch{BlockNum: 10, Cursor: "c1"}, TransactionTrace: &pbcodec.TransactionTrace{Id: "trx1"}},
+	}}
+
+	manager, store := newTestManager(t, streamer)
+	require.NoError(t, manager.Create(&Hook{Name: "transfers", Query: "action:transfer", WebhookURL: webhook.URL}))
+
+	require.Eventually(t, func() bool {
+		hook, err := store.Get("transfers")
+		return err == nil && hook.Failure != ""
+	}, 2*time.Second, 10*time.Millisecond)
+
+	hook, err := store.Get("transfers")
+	require.NoError(t, err)
+	assert.Contains(t, hook.Failure, "status 410")
+	assert.Equal(t, "", hook.Cursor)
+
+	// The stream is not restarted, the match is never redelivered
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+
+	hook.WebhookURL = "http://localhost:1/hook"
+	require.NoError(t, manager.Update(hook))
+
+	hook, err = store.Get("transfers")
+	require.NoError(t, err)
+	assert.Equal(t, "", hook.Failure)
+}
+
+func TestManager_ReplacedRunnerCannotSaveCursor(t *testing.T) {
+	manager, store := newTestManager(t, &testStreamer{})
+	require.NoError(t, manager.Create(&Hook{Name: "transfers", Query: "action:transfer", WebhookURL: "http://localhost:1/hook"}))
+
+	manager.lock.Lock()
+	generation := manager.running["transfers"].generation
+	manager.lock.Unlock()
+
+	require.NoError(t, manager.Update(&Hook{Name: "transfers", Query: "action:issue", WebhookURL: "http://localhost:1/hook"}))
+
+	assert.Equal(t, errRunnerReplaced, manager.saveCursor("transfers", generation, "stale"))
+	manager.fail("transfers", generation, errors.New("stale failure"))
+
+	hook, err := store.Get("transfers")
+	require.NoError(t, err)
+	assert.Equal(t, "", hook.Cursor)
+	assert.Equal(t, "", hook.Failure)
+}
+
+func newTestManager(t *testing.T, streamer MatchStreamer) (*Manager, *FileStore) {
+	store, err := NewFileStore(filepath.Join(t.TempDir(), "hooks.json"))
+	require.NoError(t, err)
+
+	manager := NewManager(store, streamer, NewSender(http.DefaultClient, 1, time.Millisecond, time.Millisecond), time.Millisecond, time.Millisecond)
+	t.Cleanup(func() { manager.Shutdown(nil) })
+
+	return manager, store
+}
+
+func doRequest(t *testing.T, method, url, body string) *http.Response {
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+
+	req, err := http.NewRequest(method, url, reader)
+	require.NoError(t, err)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+
+	return resp
+}
+
+func decodeHook(t *testing.T, body io.Reader) *Hook {
+	content, err := ioutil.ReadAll(body)
+	require.NoError(t, err)
+
+	hook := &Hook{}
+	require.NoError(t, json.Unmarshal(content, hook))
+	return hook
+}
+
+type testAuthenticator string
+
+func (a testAuthenticator) GetAuthTokenRequirement() authenticator.AuthTokenRequirement {
+	return authenticator.AuthTokenRequired
+}
+
+func (a testAuthenticator) Check(ctx context.Context, token, ipAddress string) (context.Context, error) {
+	if token != string(a) {
+		return ctx, errors.New("unknown token")
+	}
+
+	return ctx, nil
+}
+
+type testStreamer struct {
+	matches     []*searchclient.EOSSearchMatch
+	projections chan *searchclient.Projection
+}
+
+func (s *testStreamer) StreamMatchesWithProjection(ctx context.Context, req *pbsearch.RouterRequest, projection *searchclient.Projection) (searchclient.EOSStreamMatchesClient, error) {
+	if s.projections != nil {
+		s.projections <- projection
+	}
+
+	var matches []*searchclient.EOSSearchMatch
+	for i, match := range s.matches {
+		if match.Cursor == req.Cursor {
+			matches = s.matches[i+1:]
+			break
+		}
+	}
+
+	if req.Cursor == "" {
+		matches = s.matches
+	}
+
+	return &testStream{ctx: ctx, matches: matches}, nil
+}
+
+type testStream struct {
+	ctx     context.Context
+	matches []*searchclient.EOSSearchMatch
+}
+
+func (s *testStream) Recv() (*searchclient.EOSSearchMatch, error) {
+	if len(s.matches) == 0 {
+		<-s.ctx.Done()
+		return nil, s.ctx.Err()
+	}
+
+	match := s.matches[0]
+	s.matches = s.matches[1:]
+	return match, nil
+}
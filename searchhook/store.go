@@ -0,0 +1,148 @@
+package searchhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+var ErrHookNotFound = errors.New("hook not found")
+
+// Store persists hook definitions along with their stream cursor.
+type Store interface {
+	List() ([]*Hook, error)
+	Get(name string) (*Hook, error)
+	Put(hook *Hook) error
+	Delete(name string) error
+	SaveCursor(name string, cursor string) error
+}
+
+// FileStore is a `Store` keeping all hooks in a single JSON file on disk. Every
+// mutation rewrites the file atomically (write to a temporary file, then
+// rename), so a crash never leaves a partially written file behind.
+type FileStore struct {
+	filename string
+
+	lock  sync.RWMutex
+	hooks map[string]*Hook
+}
+
+func NewFileStore(filename string) (*FileStore, error) {
+	s := &FileStore{
+		filename: filename,
+		hooks:    map[string]*Hook{},
+	}
+
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+
+		return nil, fmt.Errorf("read hooks file %q: %w", filename, err)
+	}
+
+	var hooks []*Hook
+	if err := json.Unmarshal(content, &hooks); err != nil {
+		return nil, fmt.Errorf("decode hooks file %q: %w", filename, err)
+	}
+
+	for _, hook := range hooks {
+		s.hooks[hook.Name] = hook
+	}
+
+	return s, nil
+}
+
+func (s *FileStore) List() ([]*Hook, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.sortedHooks(), nil
+}
+
+func (s *FileStore) Get(name string) (*Hook, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	hook, found := s.hooks[name]
+	if !found {
+		return nil, ErrHookNotFound
+	}
+
+	out := *hook
+	return &out, nil
+}
+
+func (s *FileStore) Put(hook *Hook) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stored := *hook
+	s.hooks[hook.Name] = &stored
+
+	return s.flush()
+}
+
+func (s *FileStore) Delete(name string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, found := s.hooks[name]; !found {
+		return ErrHookNotFound
+	}
+
+	delete(s.hooks, name)
+	return s.flush()
+}
+
+func (s *FileStore) SaveCursor(name string, cursor string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	hook, found := s.hooks[name]
+	if !found {
+		return ErrHookNotFound
+	}
+
+	hook.Cursor = cursor
+	return s.flush()
+}
+
+func (s *FileStore) sortedHooks() (out []*Hook) {
+	out = make([]*Hook, 0, len(s.hooks))
+	for _, hook := range s.hooks {
+		copied := *hook
+		out = append(out, &copied)
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+func (s *FileStore) flush() error {
+	content, err := json.MarshalIndent(s.sortedHooks(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode hooks: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.filename), 0755); err != nil {
+		return fmt.Errorf("create hooks file directory: %w", err)
+	}
+
+	tmpFilename := s.filename + ".tmp"
+	if err := ioutil.WriteFile(tmpFilename, content, 0600); err != nil {
+		return fmt.Errorf("write hooks file %q: %w", tmpFilename, err)
+	}
+
+	if err := os.Rename(tmpFilename, s.filename); err != nil {
+		return fmt.Errorf("rename hooks file %q: %w", tmpFilename, err)
+	}
+
+	return nil
+}
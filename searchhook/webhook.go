@@ -0,0 +1,157 @@
+package searchhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	SignatureHeader = "X-Dfuse-Signature"
+	TimestampHeader = "X-Dfuse-Timestamp"
+	HookNameHeader  = "X-Dfuse-Hook"
+)
+
+// Sign computes the signature sent in the `X-Dfuse-Signature` header. It's the
+// hex encoded HMAC-SHA256 of `<timestamp>.<body>` keyed with the hook's secret,
+// prefixed with `sha256=`. Receivers recompute it to authenticate payloads and
+// should reject stale timestamps to prevent replays.
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Sender POSTs payloads to webhooks, retrying failed deliveries with an
+// exponential backoff. Network errors, `408`, `429` and `5xx` responses are
+// retried, any other non-`2xx` response is considered permanent.
+type Sender struct {
+	client         *http.Client
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+func NewSender(client *http.Client, maxAttempts int, initialBackoff, maxBackoff time.Duration) *Sender {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	return &Sender{
+		client:         client,
+		maxAttempts:    maxAttempts,
+		initialBackoff: initialBackoff,
+		maxBackoff:     maxBackoff,
+	}
+}
+
+type deliveryError struct {
+	statusCode int
+	retryable  bool
+	err        error
+}
+
+func (e *deliveryError) Error() string {
+	if e.err != nil {
+		return e.err.Error()
+	}
+
+	return fmt.Sprintf("webhook responded with status %d", e.statusCode)
+}
+
+func (e *deliveryError) Unwrap() error {
+	return e.err
+}
+
+// isPermanentDeliveryError returns true when `err` comes from a webhook
+// rejecting the delivery, retrying it later would not help.
+func isPermanentDeliveryError(err error) bool {
+	var delivery *deliveryError
+	return errors.As(err, &delivery) && delivery.statusCode != 0 && !delivery.retryable
+}
+
+// Send delivers the payload to the hook's webhook, returning an error only
+// once all attempts are exhausted or when the failure is permanent.
+func (s *Sender) Send(ctx context.Context, hook *Hook, body []byte) error {
+	backoff := s.initialBackoff
+
+	var lastErr *deliveryError
+	for attempt := 1; attempt <= s.maxAttempts; attempt++ {
+		lastErr = s.send(ctx, hook, body)
+		if lastErr == nil {
+			return nil
+		}
+
+		if !lastErr.retryable || attempt == s.maxAttempts {
+			break
+		}
+
+		zlog.Debug("webhook delivery failed, retrying",
+			zap.String("hook", hook.Name),
+			zap.Int("attempt", attempt),
+			zap.Duration("backoff", backoff),
+			zap.Error(lastErr),
+		)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > s.maxBackoff {
+			backoff = s.maxBackoff
+		}
+	}
+
+	return fmt.Errorf("deliver to webhook %q: %w", hook.WebhookURL, lastErr)
+}
+
+func (s *Sender) send(ctx context.Context, hook *Hook, body []byte) *deliveryError {
+	req, err := http.NewRequestWithContext(ctx, "POST", hook.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return &deliveryError{err: fmt.Errorf("create request: %w", err)}
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HookNameHeader, hook.Name)
+	req.Header.Set(TimestampHeader, timestamp)
+	if hook.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(hook.Secret, timestamp, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return &deliveryError{retryable: ctx.Err() == nil, err: err}
+	}
+
+	// Drain the body so the underlying connection can be reused
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	return &deliveryError{
+		statusCode: resp.StatusCode,
+		retryable:  resp.StatusCode == http.StatusRequestTimeout || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500,
+	}
+}
@@ -0,0 +1,74 @@
+package searchhook
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSender_Send(t *testing.T) {
+	tests := []struct {
+		name             string
+		statuses         []int
+		expectedAttempts int32
+		expectError      bool
+	}{
+		{"success first attempt", []int{200}, 1, false},
+		{"retries server errors", []int{500, 503, 204}, 3, false},
+		{"retries too many requests", []int{429, 200}, 2, false},
+		{"retries request timeout", []int{408, 200}, 2, false},
+		{"gives up after max attempts", []int{500, 500, 500, 500}, 3, true},
+		{"client error is permanent", []int{400, 200}, 1, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var attempts int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempt := atomic.AddInt32(&attempts, 1)
+				w.WriteHeader(test.statuses[attempt-1])
+			}))
+			defer server.Close()
+
+			sender := NewSender(server.Client(), 3, time.Millisecond, 2*time.Millisecond)
+			err := sender.Send(context.Background(), &Hook{Name: "test", WebhookURL: server.URL}, []byte(`{}`))
+
+			if test.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedAttempts, atomic.LoadInt32(&attempts))
+		})
+	}
+}
+
+func TestSender_Signature(t *testing.T) {
+	received := make(chan *http.Request, 1)
+	receivedBody := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		received <- r
+		receivedBody <- body
+	}))
+	defer server.Close()
+
+	sender := NewSender(server.Client(), 1, time.Millisecond, time.Millisecond)
+	hook := &Hook{Name: "transfers", WebhookURL: server.URL, Secret: "s3cr3t"}
+	require.NoError(t, sender.Send(context.Background(), hook, []byte(`{"a":1}`)))
+
+	r := <-received
+	body := <-receivedBody
+
+	assert.Equal(t, `{"a":1}`, string(body))
+	assert.Equal(t, "transfers", r.Header.Get(HookNameHeader))
+	assert.Equal(t, Sign("s3cr3t", r.Header.Get(TimestampHeader), body), r.Header.Get(SignatureHeader))
+	assert.NotEqual(t, Sign("other", r.Header.Get(TimestampHeader), body), r.Header.Get(SignatureHeader))
+}
@@ -0,0 +1,187 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/streamingfast/dgrpc"
+	"github.com/streamingfast/dstore"
+	"github.com/tidwall/gjson"
+	"github.com/zhongshuwen/histnew/abicodec"
+	"github.com/zhongshuwen/histnew/abicodec/codegen"
+	pbstatedb "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/statedb/v1"
+	"github.com/zhongshuwen/zswchain-go"
+)
+
+var abiCodegenCmd = &cobra.Command{
+	Use:   "codegen {account}",
+	Short: "Generates Go, TypeScript and JSON Schema type definitions from the ABI of an account",
+	Long: Description(`
+		Retrieves the ABI of {account}, either from a JSON file (--abi-file), from an abicodec cache
+		file (--abi-cache-store-url) or from a running StateDB (--statedb-addr), and generates the types
+		of each of its actions and tables.
+
+		One file is written in --output-dir per requested language, named after {account}:
+		Go structs tagged for zswchain-go ('.go'), TypeScript interfaces ('.ts') and a JSON Schema
+		document ('.schema.json'). ABI type aliases, variants, optional fields and binary extensions
+		are all supported.
+	`),
+	Args: cobra.ExactArgs(1),
+	RunE: abiCodegenE,
+	Example: ExamplePrefixed("dfuseeos tools abi", `
+		codegen eosio.token --abi-file=./eosio.token.abi
+		codegen eosio.token --abi-cache-store-url=file://./dfuse-data/storage/abicache/abicodec_cache.bin --lang=go,ts
+		codegen eosio.token --statedb-addr=localhost:9000 --block-num=1000 --go-package=token --output-dir=./token
+	`),
+}
+
+func init() {
+	abicacheCmd.AddCommand(abiCodegenCmd)
+
+	abiCodegenCmd.Flags().String("abi-file", "", "Path of a JSON file holding the ABI, either as-is or under an 'abi' field like 'get_abi' responses")
+	abiCodegenCmd.Flags().String("abi-cache-store-url", "", "URL of an abicodec cache file to read the ABI from")
+	abiCodegenCmd.Flags().String("statedb-addr", "", "Address of a StateDB gRPC server to read the ABI from")
+	abiCodegenCmd.Flags().Uint32("block-num", 0, "Block at which the ABI is read from the abicodec cache or StateDB, 0 meaning the latest one")
+	abiCodegenCmd.Flags().StringSlice("lang", []string{"go", "ts", "jsonschema"}, "Languages to generate, any of 'go', 'ts' and 'jsonschema'")
+	abiCodegenCmd.Flags().String("output-dir", ".", "Directory where generated files are written")
+	abiCodegenCmd.Flags().String("go-package", "", "Package name of the generated Go file, defaults to {account} stripped of non letters")
+}
+
+func abiCodegenE(cmd *cobra.Command, args []string) error {
+	account := args[0]
+
+	var languages []codegen.Language
+	for _, lang := range viper.GetStringSlice("lang") {
+		languages = append(languages, codegen.Language(lang))
+	}
+
+	abi, err := fetchCodegenABI(cmd, account, viper.GetUint32("block-num"))
+	if err != nil {
+		return err
+	}
+
+	goPackage := viper.GetString("go-package")
+	if goPackage == "" {
+		goPackage = strings.Map(func(r rune) rune {
+			if r >= 'a' && r <= 'z' {
+				return r
+			}
+			return -1
+		}, strings.ToLower(account))
+	}
+
+	outputDir := viper.GetString("output-dir")
+	if err := os.MkdirAll(outputDir, os.ModePerm); err != nil {
+		return fmt.Errorf("unable to create output directory %q: %w", outputDir, err)
+	}
+
+	baseName := strings.ReplaceAll(account, ".", "_")
+	for _, lang := range languages {
+		out, err := codegen.Generate(lang, abi, goPackage)
+		if err != nil {
+			return fmt.Errorf("unable to generate %s code for %q: %w", lang, account, err)
+		}
+
+		filename := filepath.Join(outputDir, baseName+lang.FileExtension())
+		if err := ioutil.WriteFile(filename, out, 0644); err != nil {
+			return fmt.Errorf("unable to write %q: %w", filename, err)
+		}
+
+		fmt.Printf("Wrote %s types of %q to %q\n", lang, account, filename)
+	}
+
+	return nil
+}
+
+func fetchCodegenABI(cmd *cobra.Command, account string, blockNum uint32) (*zsw.ABI, error) {
+	abiFile := viper.GetString("abi-file")
+	cacheStoreURL := viper.GetString("abi-cache-store-url")
+	statedbAddr := viper.GetString("statedb-addr")
+
+	sources := 0
+	for _, source := range []string{abiFile, cacheStoreURL, statedbAddr} {
+		if source != "" {
+			sources++
+		}
+	}
+
+	if sources != 1 {
+		return nil, fmt.Errorf("exactly one of --abi-file, --abi-cache-store-url and --statedb-addr must be provided")
+	}
+
+	switch {
+	case abiFile != "":
+		content, err := ioutil.ReadFile(abiFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read ABI file %q: %w", abiFile, err)
+		}
+
+		if abiField := gjson.GetBytes(content, "abi"); abiField.IsObject() {
+			content = []byte(abiField.Raw)
+		}
+
+		abi := new(zsw.ABI)
+		if err := json.Unmarshal(content, abi); err != nil {
+			return nil, fmt.Errorf("unable to decode ABI file %q: %w", abiFile, err)
+		}
+
+		return abi, nil
+
+	case cacheStoreURL != "":
+		u, err := url.Parse(cacheStoreURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid abicodec cache store URL %q: %w", cacheStoreURL, err)
+		}
+
+		cacheName := path.Base(u.Path)
+		u.Path = path.Dir(u.Path)
+
+		store, err := dstore.NewSimpleStore(u.String())
+		if err != nil {
+			return nil, fmt.Errorf("unable to create abicodec cache store: %w", err)
+		}
+
+		cache, err := abicodec.NewABICache(store, cacheName)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load abicodec cache: %w", err)
+		}
+
+		if blockNum == 0 {
+			blockNum = math.MaxUint32
+		}
+
+		item := cache.ABIAtBlockNum(account, blockNum)
+		if item == nil {
+			return nil, fmt.Errorf("no ABI found in abicodec cache for %q at block %d", account, blockNum)
+		}
+
+		return item.ABI, nil
+	}
+
+	conn, err := dgrpc.NewInternalClient(statedbAddr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create StateDB gRPC client to %q: %w", statedbAddr, err)
+	}
+	defer conn.Close()
+
+	response, err := pbstatedb.NewStateClient(conn).GetABI(cmd.Context(), &pbstatedb.GetABIRequest{BlockNum: uint64(blockNum), Contract: account})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get ABI for %q: %w", account, err)
+	}
+
+	abi := new(zsw.ABI)
+	if err = zsw.UnmarshalBinary(response.RawAbi, abi); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal ABI for %q: %w", account, err)
+	}
+
+	return abi, nil
+}
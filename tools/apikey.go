@@ -0,0 +1,209 @@
+package tools
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zhongshuwen/histnew/apikey"
+)
+
+var apikeyCmd = &cobra.Command{Use: "apikey", Short: "Manage the signed keys file of the built-in 'apikey://' auth plugin"}
+
+var apikeyCreateCmd = &cobra.Command{
+	Use:   "create {id}",
+	Short: "Generates a new API key, adding it to the keys file (created if missing) and printing it",
+	Args:  cobra.ExactArgs(1),
+	RunE:  apikeyCreateE,
+	Example: ExamplePrefixed("dfuseeos tools apikey", `
+		create team-a --secret=signing-secret --documents=1000000 --rest-calls=100000 --concurrent-streams=5
+	`),
+}
+
+var apikeyUpdateCmd = &cobra.Command{
+	Use:   "update {id}",
+	Short: "Updates the quotas of an API key, only the quota flags that are explicitly set are changed",
+	Args:  cobra.ExactArgs(1),
+	RunE:  apikeyUpdateE,
+}
+
+var apikeyDisableCmd = &cobra.Command{
+	Use:   "disable {id}",
+	Short: "Disables an API key, requests made with it being rejected",
+	Args:  cobra.ExactArgs(1),
+	RunE:  apikeySetDisabledE(true),
+}
+
+var apikeyEnableCmd = &cobra.Command{
+	Use:   "enable {id}",
+	Short: "Re-enables a previously disabled API key",
+	Args:  cobra.ExactArgs(1),
+	RunE:  apikeySetDisabledE(false),
+}
+
+var apikeyListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists the API keys of the keys file with their quotas",
+	Args:  cobra.NoArgs,
+	RunE:  apikeyListE,
+}
+
+func init() {
+	Cmd.AddCommand(apikeyCmd)
+	apikeyCmd.AddCommand(apikeyCreateCmd)
+	apikeyCmd.AddCommand(apikeyUpdateCmd)
+	apikeyCmd.AddCommand(apikeyDisableCmd)
+	apikeyCmd.AddCommand(apikeyEnableCmd)
+	apikeyCmd.AddCommand(apikeyListCmd)
+
+	apikeyCmd.PersistentFlags().String("keys-file", "./keys.json", "Path of the signed keys file")
+	apikeyCmd.PersistentFlags().String("secret", "", "Secret signing the keys file, must match the 'secret' of the 'apikey://' plugin URI")
+
+	for _, cmd := range []*cobra.Command{apikeyCreateCmd, apikeyUpdateCmd} {
+		cmd.Flags().Uint64("documents", 0, "Number of documents that can be streamed per period, 0 means unlimited")
+		cmd.Flags().Uint64("rest-calls", 0, "Number of REST and GraphQL query calls per period, 0 means unlimited")
+		cmd.Flags().Uint64("concurrent-streams", 0, "Number of streams that can be open at the same time, 0 means unlimited")
+	}
+}
+
+func apikeySecret() ([]byte, error) {
+	secret := viper.GetString("secret")
+	if secret == "" {
+		return nil, errors.New("the --secret flag is required")
+	}
+
+	return []byte(secret), nil
+}
+
+func loadAPIKeys(allowMissing bool) ([]*apikey.Key, []byte, error) {
+	secret, err := apikeySecret()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path := viper.GetString("keys-file")
+	if _, err := os.Stat(path); os.IsNotExist(err) && allowMissing {
+		return nil, secret, nil
+	}
+
+	keys, err := apikey.LoadKeys(path, secret)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to load keys file %q: %w", path, err)
+	}
+
+	return keys, secret, nil
+}
+
+// apikeyQuotas reads the quota flags from the command itself, they are defined on more
+// than one command so their bound viper value cannot be relied on.
+func apikeyQuotas(cmd *cobra.Command) apikey.Quotas {
+	documents, _ := cmd.Flags().GetUint64("documents")
+	restCalls, _ := cmd.Flags().GetUint64("rest-calls")
+	concurrentStreams, _ := cmd.Flags().GetUint64("concurrent-streams")
+
+	return apikey.Quotas{
+		Documents:         documents,
+		RESTCalls:         restCalls,
+		ConcurrentStreams: concurrentStreams,
+	}
+}
+
+func findAPIKey(keys []*apikey.Key, id string) *apikey.Key {
+	for _, key := range keys {
+		if key.ID == id {
+			return key
+		}
+	}
+	return nil
+}
+
+func apikeyCreateE(cmd *cobra.Command, args []string) error {
+	keys, secret, err := loadAPIKeys(true)
+	if err != nil {
+		return err
+	}
+
+	if findAPIKey(keys, args[0]) != nil {
+		return fmt.Errorf("key %q already exists", args[0])
+	}
+
+	token, keySHA256, err := apikey.NewKey()
+	if err != nil {
+		return err
+	}
+
+	keys = append(keys, &apikey.Key{
+		ID:        args[0],
+		KeySHA256: keySHA256,
+		Quotas:    apikeyQuotas(cmd),
+	})
+
+	if err := apikey.WriteKeys(viper.GetString("keys-file"), keys, secret); err != nil {
+		return err
+	}
+
+	fmt.Printf("Created key %q, it is displayed only once:\n%s\n", args[0], token)
+	return nil
+}
+
+func apikeyUpdateE(cmd *cobra.Command, args []string) error {
+	keys, secret, err := loadAPIKeys(false)
+	if err != nil {
+		return err
+	}
+
+	key := findAPIKey(keys, args[0])
+	if key == nil {
+		return fmt.Errorf("key %q not found", args[0])
+	}
+
+	quotas := apikeyQuotas(cmd)
+	if cmd.Flags().Changed("documents") {
+		key.Quotas.Documents = quotas.Documents
+	}
+	if cmd.Flags().Changed("rest-calls") {
+		key.Quotas.RESTCalls = quotas.RESTCalls
+	}
+	if cmd.Flags().Changed("concurrent-streams") {
+		key.Quotas.ConcurrentStreams = quotas.ConcurrentStreams
+	}
+
+	return apikey.WriteKeys(viper.GetString("keys-file"), keys, secret)
+}
+
+func apikeySetDisabledE(disabled bool) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		keys, secret, err := loadAPIKeys(false)
+		if err != nil {
+			return err
+		}
+
+		key := findAPIKey(keys, args[0])
+		if key == nil {
+			return fmt.Errorf("key %q not found", args[0])
+		}
+
+		key.Disabled = disabled
+		return apikey.WriteKeys(viper.GetString("keys-file"), keys, secret)
+	}
+}
+
+func apikeyListE(cmd *cobra.Command, args []string) error {
+	keys, _, err := loadAPIKeys(false)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		status := "enabled"
+		if key.Disabled {
+			status = "disabled"
+		}
+
+		fmt.Printf("%s (%s) documents=%d rest_calls=%d concurrent_streams=%d\n", key.ID, status, key.Quotas.Documents, key.Quotas.RESTCalls, key.Quotas.ConcurrentStreams)
+	}
+
+	return nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/zhongshuwen/histnew/accounthist"
 	"github.com/zhongshuwen/histnew/accounthist/injector"
 	"github.com/zhongshuwen/histnew/accounthist/keyer"
+	"github.com/zhongshuwen/histnew/codec"
 	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
 	"github.com/zhongshuwen/histnew/statedb"
 	"github.com/zhongshuwen/histnew/trxdb/kv"
@@ -49,6 +50,12 @@ var checkMergedBlocksCmd = &cobra.Command{
 	Args:  cobra.ExactArgs(1),
 	RunE:  checkMergedBlocksE,
 }
+var checkBlocksSemanticCmd = &cobra.Command{
+	Use:   "blocks-semantic <store-url>",
+	Short: "Checks the semantic invariants of the blocks of merged blocks files (RAM deltas matching RAM ops, ops referencing existing actions, creation trees, counts)",
+	Args:  cobra.ExactArgs(1),
+	RunE:  checkBlocksSemanticE,
+}
 var checkTrxdbBlocksCmd = &cobra.Command{
 	Use:   "trxdb-blocks <store-dsn>",
 	Short: "Checks for any holes in the trxdb database",
@@ -77,6 +84,7 @@ var checkStateDBReprocInjectorCmd = &cobra.Command{
 func init() {
 	Cmd.AddCommand(checkCmd)
 	checkCmd.AddCommand(checkMergedBlocksCmd)
+	checkCmd.AddCommand(checkBlocksSemanticCmd)
 	checkCmd.AddCommand(checkTrxdbBlocksCmd)
 	checkCmd.AddCommand(checkStateDBConsistencyCmd)
 	checkCmd.AddCommand(checkStateDBReprocSharderCmd)
@@ -87,6 +95,8 @@ func init() {
 
 	checkMergedBlocksCmd.Flags().BoolP("print-stats", "s", false, "Natively decode each block in the segment and print statistics about it, ensuring it contains the required blocks")
 	checkMergedBlocksCmd.Flags().BoolP("print-full", "f", false, "Natively decode each block and print the full JSON representation of the block, should be used with a small range only if you don't want to be overwhelmed")
+
+	checkBlocksSemanticCmd.Flags().String("checks", "all", "Comma separated list of the checks to run, 'all' runs every check, valid checks are 'trace-counts', 'op-action-indexes', 'ram-deltas' and 'creation-tree', same format as '--mindreader-block-validation' (policies are ignored, any violation fails the command)")
 }
 
 func checkStateDBReprocInjectorE(cmd *cobra.Command, args []string) error {
@@ -474,6 +484,102 @@ func checkMergedBlocksE(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func checkBlocksSemanticE(cmd *cobra.Command, args []string) error {
+	storeURL := args[0]
+	fileBlockSize := uint32(100)
+
+	validator, err := codec.ParseBlockValidator(viper.GetString("checks"))
+	if err != nil {
+		return err
+	}
+	checks := validator.Checks()
+
+	blockRange, err := getBlockRangeFromFlag()
+	if err != nil {
+		return err
+	}
+
+	blocksStore, err := dstore.NewDBinStore(storeURL)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Checking blocks semantic on %s\n", storeURL)
+
+	ctx := cmd.Context()
+	number := regexp.MustCompile(`(\d{10})`)
+	violationCounts := map[codec.BlockCheck]int{}
+	blockCount := 0
+
+	err = blocksStore.Walk(ctx, walkBlockPrefix(blockRange, fileBlockSize), ".tmp", func(filename string) error {
+		match := number.FindStringSubmatch(filename)
+		if match == nil {
+			return nil
+		}
+
+		baseNum, _ := strconv.ParseUint(match[1], 10, 32)
+		if baseNum+uint64(fileBlockSize)-1 < blockRange.Start {
+			return nil
+		}
+
+		if !blockRange.Unbounded() && baseNum >= blockRange.Stop {
+			return errStopWalk
+		}
+
+		reader, err := blocksStore.OpenObject(ctx, filename)
+		if err != nil {
+			return fmt.Errorf("unable to read blocks segment %s: %w", filename, err)
+		}
+		defer reader.Close()
+
+		blockReader, err := bstream.GetBlockReaderFactory.New(reader)
+		if err != nil {
+			return fmt.Errorf("unable to read blocks segment %s: %w", filename, err)
+		}
+
+		for {
+			block, err := blockReader.Read()
+			if block != nil && (blockRange.Unbounded() || (block.Number >= blockRange.Start && block.Number < blockRange.Stop)) {
+				blockCount++
+				for _, violation := range codec.CheckBlock(block.ToNative().(*pbcodec.Block), checks...) {
+					violationCounts[violation.Check]++
+					fmt.Printf("❌ %s\n", violation)
+				}
+			}
+
+			if err == io.EOF {
+				return nil
+			}
+
+			if err != nil {
+				return fmt.Errorf("unable to read blocks segment %s: %w", filename, err)
+			}
+		}
+	})
+	if err != nil && err != errStopWalk {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Printf("Checked %d blocks\n", blockCount)
+
+	violationCount := 0
+	for _, check := range checks {
+		if violationCounts[check] > 0 {
+			fmt.Printf("🆘 %s: %d violations\n", check, violationCounts[check])
+		} else {
+			fmt.Printf("🆗 %s: no violation\n", check)
+		}
+		violationCount += violationCounts[check]
+	}
+
+	if violationCount > 0 {
+		return fmt.Errorf("found %d semantic violations in %d blocks", violationCount, blockCount)
+	}
+
+	return nil
+}
+
 func walkBlockPrefix(blockRange BlockRange, fileBlockSize uint32) string {
 	if blockRange.Unbounded() {
 		return ""
@@ -0,0 +1,168 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/streamingfast/dgrpc"
+	"github.com/streamingfast/dstore"
+	"github.com/zhongshuwen/histnew/codec"
+	pbabicodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/abicodec/v1"
+)
+
+var convertBlocksCmd = &cobra.Command{
+	Use:   "convert-blocks {source-store-url} {destination-store-url} {start_block} {stop_block}",
+	Short: "Converts merged blocks files between the standard and the compact block encodings",
+	Long: Description(`
+		Reads the merged blocks files covering {start_block} to {stop_block} from {source-store-url},
+		whatever their encoding, and writes them to {destination-store-url} using the encoding picked
+		by --encoding. Whole merged blocks files are converted, the range being extended to 100 blocks
+		boundaries.
+
+		The 'compact' encoding stores transaction traces deduplicated and without the JSON data of
+		actions other than 'zswhq' ones, the 'standard' encoding stores blocks as-is. Blocks of both
+		encodings are readable by all components, the action JSON data of compact blocks being
+		decoded again with the ABIs of the abicodec service at --abicodec-addr, required when writing
+		the 'standard' encoding from compact blocks. The size of each merged blocks file, before compression, is printed along the
+		way.
+	`),
+	Args: cobra.ExactArgs(4),
+	RunE: convertBlocksE,
+	Example: ExamplePrefixed("dfuseeos tools", `
+		convert-blocks file://./dfuse-data/storage/merged-blocks file://./compact-merged-blocks 0 99999
+		convert-blocks --encoding=standard file://./compact-merged-blocks file://./merged-blocks 0 99999
+	`),
+}
+
+func init() {
+	Cmd.AddCommand(convertBlocksCmd)
+
+	convertBlocksCmd.Flags().String("encoding", "compact", "Encoding of the written merged blocks files, either 'compact' or 'standard'")
+	convertBlocksCmd.Flags().String("abicodec-addr", "", "Address of the abicodec gRPC service used to decode the action JSON data of compact blocks, required to convert compact blocks to the 'standard' encoding")
+}
+
+func convertBlocksE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	var writerOptions []codec.BlockWriterOption
+	switch encoding := viper.GetString("encoding"); encoding {
+	case "compact":
+		writerOptions = append(writerOptions, codec.CompactEncoding())
+	case "standard":
+	default:
+		return fmt.Errorf("unknown encoding %q, valid encodings are compact and standard", encoding)
+	}
+
+	if abicodecAddr := viper.GetString("abicodec-addr"); abicodecAddr != "" {
+		conn, err := dgrpc.NewInternalClient(abicodecAddr)
+		if err != nil {
+			return fmt.Errorf("unable to create abicodec gRPC client to %q: %w", abicodecAddr, err)
+		}
+		defer conn.Close()
+
+		codec.GetActionABI = codec.NewABICodecActionABIGetter(pbabicodec.NewDecoderClient(conn))
+	}
+
+	sourceStore, err := dstore.NewDBinStore(args[0])
+	if err != nil {
+		return fmt.Errorf("unable to create source store: %w", err)
+	}
+
+	destinationStore, err := dstore.NewDBinStore(args[1])
+	if err != nil {
+		return fmt.Errorf("unable to create destination store: %w", err)
+	}
+
+	startBlock, err := strconv.ParseUint(args[2], 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to parse start block %q: %w", args[2], err)
+	}
+
+	stopBlock, err := strconv.ParseUint(args[3], 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to parse stop block %q: %w", args[3], err)
+	}
+
+	if stopBlock < startBlock {
+		return fmt.Errorf("stop block %d is lower than start block %d", stopBlock, startBlock)
+	}
+
+	var sourceTotal, destinationTotal int
+	for baseBlock := startBlock - startBlock%100; baseBlock <= stopBlock; baseBlock += 100 {
+		bundle := fmt.Sprintf("%010d", baseBlock)
+
+		sourceSize, destinationSize, err := convertBlocksBundle(ctx, sourceStore, destinationStore, bundle, writerOptions)
+		if err != nil {
+			return fmt.Errorf("unable to convert merged blocks file %s: %w", bundle, err)
+		}
+
+		sourceTotal += sourceSize
+		destinationTotal += destinationSize
+		fmt.Printf("Converted merged blocks file %s (%d bytes -> %d bytes)\n", bundle, sourceSize, destinationSize)
+	}
+
+	fmt.Printf("Converted %d bytes into %d bytes (%s)\n", sourceTotal, destinationTotal, sizeRatio(sourceTotal, destinationTotal))
+	return nil
+}
+
+func convertBlocksBundle(ctx context.Context, sourceStore, destinationStore dstore.Store, bundle string, writerOptions []codec.BlockWriterOption) (sourceSize int, destinationSize int, err error) {
+	reader, err := sourceStore.OpenObject(ctx, bundle)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to open merged blocks file: %w", err)
+	}
+	defer reader.Close()
+
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to read merged blocks file: %w", err)
+	}
+
+	blockReader, err := codec.NewBlockReader(bytes.NewReader(content))
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to read merged blocks file: %w", err)
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	blockWriter, err := codec.NewBlockWriter(buffer, writerOptions...)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for {
+		block, err := blockReader.Read()
+		if block != nil {
+			if err := blockWriter.Write(block); err != nil {
+				return 0, 0, fmt.Errorf("unable to write block %s: %w", block, err)
+			}
+		}
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return 0, 0, fmt.Errorf("unable to read merged blocks file: %w", err)
+		}
+	}
+
+	destinationSize = buffer.Len()
+	if err := destinationStore.WriteObject(ctx, bundle, buffer); err != nil {
+		return 0, 0, fmt.Errorf("unable to write merged blocks file: %w", err)
+	}
+
+	return len(content), destinationSize, nil
+}
+
+func sizeRatio(before, after int) string {
+	if before == 0 {
+		return "n/a"
+	}
+
+	return fmt.Sprintf("%.1f%%", float64(after)*100/float64(before))
+}
@@ -7,10 +7,12 @@ import (
 
 	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
 	trxdb "github.com/zhongshuwen/histnew/trxdb"
+	"github.com/zhongshuwen/histnew/trxdb/flat"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/streamingfast/cli"
 	"github.com/streamingfast/kvdb"
+	"github.com/streamingfast/kvdb/store"
 	_ "github.com/streamingfast/kvdb/store/badger"
 	_ "github.com/streamingfast/kvdb/store/bigkv"
 	_ "github.com/streamingfast/kvdb/store/tikv"
@@ -57,6 +59,27 @@ var dbTrxEventsCmd = &cobra.Command{
 	`),
 }
 
+var dbCompactCmd = &cobra.Command{
+	Use:   "compact {bundles-store-url}",
+	Short: "Moves the rows of old irreversible blocks out of the database into flat bundles",
+	Long: Description(`
+		Moves the blocks and transactions of irreversible blocks older than --keep-blocks out of
+		the database into immutable bundles of --bundle-size blocks written to {bundles-store-url}.
+
+		The bundles are served by the 'flat' driver, combine it with the database using a tiered
+		DSN like 'tiered://?hot=badger:///data/trxdb&cold=flat:///data/trxdb-cold' so readers
+		see the full history. Secondary indexes, timeline and accounts rows are left in the database.
+
+		The database must hold both blocks and transactions, run the command periodically to
+		keep the database size bounded.
+	`),
+	RunE: dbCompactE,
+	Args: cobra.ExactArgs(1),
+	Example: ExamplePrefixed("dfuseeos tools db", `
+		compact --dsn="badger://./dfuse-data/storage/trxdb-v1" --keep-blocks=1000000 ./dfuse-data/storage/trxdb-cold
+	`),
+}
+
 var chainDiscriminator = func(blockID string) bool {
 	return true
 }
@@ -66,6 +89,11 @@ func init() {
 	dbCmd.AddCommand(dbBlkCmd)
 	dbCmd.AddCommand(dbTrxCmd)
 	dbCmd.AddCommand(dbTrxEventsCmd)
+	dbCmd.AddCommand(dbCompactCmd)
+
+	dbCompactCmd.Flags().Uint32("keep-blocks", 1000000, "Number of blocks below the last irreversible block kept in the database")
+	dbCompactCmd.Flags().Uint32("bundle-size", 100000, "Number of blocks per bundle")
+	dbCompactCmd.Flags().Bool("keep-hot-rows", false, "Write the bundles without deleting the bundled rows from the database")
 
 	dbCmd.PersistentFlags().String("dsn", "badger:///dfuse-data/kvdb/kvdb_badger.db", "kvStore DSN")
 }
@@ -144,6 +172,48 @@ func dbReadTrxEventsE(cmd *cobra.Command, args []string) (err error) {
 	return nil
 }
 
+func dbCompactE(cmd *cobra.Command, args []string) (err error) {
+	dsn, err := store.RemoveDSNOptions(viper.GetString("dsn"), "read", "write", "blk_marker")
+	if err != nil {
+		return fmt.Errorf("invalid dsn: %w", err)
+	}
+
+	hot, err := store.New(dsn)
+	if err != nil {
+		return fmt.Errorf("unable to create store: %w", err)
+	}
+	defer hot.Close()
+
+	if tiered, ok := hot.(*flat.TieredStore); ok {
+		hot = tiered.Hot()
+	}
+
+	bundles, err := flat.NewBundlesStore(args[0])
+	if err != nil {
+		return fmt.Errorf("unable to create bundles store: %w", err)
+	}
+
+	bundleSize := viper.GetUint32("bundle-size")
+	if bundleSize == 0 {
+		return fmt.Errorf("the bundle size must be greater than 0")
+	}
+
+	compactor := flat.NewCompactor(hot, bundles, bundleSize)
+	compactor.KeepHotRows = viper.GetBool("keep-hot-rows")
+
+	written, err := compactor.Compact(cmd.Context(), viper.GetUint32("keep-blocks"))
+	for _, bundle := range written {
+		fmt.Printf("Wrote bundle %s (%d rows)\n", bundle, bundle.Len())
+	}
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Compaction completed, %d bundles written\n", len(written))
+	return nil
+}
+
 func printEntity(obj interface{}) (err error) {
 	cnt, err := json.Marshal(obj)
 	if err != nil {
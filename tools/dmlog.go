@@ -0,0 +1,689 @@
+package tools
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/dstore"
+	"github.com/zhongshuwen/histnew/codec"
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+	"go.uber.org/zap"
+)
+
+var dmlogCmd = &cobra.Command{
+	Use:   "dmlog",
+	Short: "Record, replay and debug raw deep mind output without a running nodeos",
+	Long: Description(`
+		Deep mind recordings are made of compressed segment files named '<first_block>-<last_block>'
+		holding the raw 'DMLOG' lines of a range of blocks, each one along with a
+		'<first_block>-<last_block>.header' file holding the 'DEEP_MIND_VERSION' and 'ABIDUMP' lines
+		in force at the start of the segment, those lines being also kept in place in the segments.
+		Replaying a range of blocks feeds the header of the first segment followed by the segments
+		covering the range to the same console reader as mindreader.
+	`),
+}
+
+var dmlogRecordCmd = &cobra.Command{
+	Use:   "record {dmlog-store-url}",
+	Short: "Records the deep mind output of nodeos, read from standard input (or --input), into segment files",
+	Args:  cobra.ExactArgs(1),
+	RunE:  dmlogRecordE,
+	Example: ExamplePrefixed("dfuseeos tools dmlog", `
+		record file://./dmlog < nodeos.log
+		record file://./dmlog --input=./nodeos.log --segment-size=1000
+	`),
+}
+
+var dmlogReplayCmd = &cobra.Command{
+	Use:   "replay {dmlog-store-url} {merged-blocks-store-url} {start_block} [stop_block]",
+	Short: "Replays recorded deep mind output through the console reader, writing the blocks as merged blocks files",
+	Long: Description(`
+		Replays the recorded deep mind output of the blocks between {start_block} and {stop_block}
+		(inclusive, defaults to the last recorded block) and writes them as merged blocks files of
+		100 blocks. A merged blocks file not fully covered by the range is still written, with the
+		blocks available, so the range should be aligned on 100 blocks boundaries when replacing
+		existing files. A merged blocks file is written once the last irreversible block moved past
+		it and never rewritten, blocks of an already written file are skipped with a warning.
+	`),
+	Args: cobra.RangeArgs(3, 4),
+	RunE: dmlogReplayE,
+	Example: ExamplePrefixed("dfuseeos tools dmlog", `
+		replay file://./dmlog file://./dfuse-data/storage/merged-blocks 1000 1999
+	`),
+}
+
+var dmlogBisectCmd = &cobra.Command{
+	Use:   "bisect {dmlog-store-url} {start_block} [stop_block]",
+	Short: "Finds the first recorded deep mind line the console reader fails on, printing it with the lines around it",
+	Args:  cobra.RangeArgs(2, 3),
+	RunE:  dmlogBisectE,
+	Example: ExamplePrefixed("dfuseeos tools dmlog", `
+		bisect file://./dmlog 1000 1999 --context-lines=10
+	`),
+}
+
+func init() {
+	Cmd.AddCommand(dmlogCmd)
+	dmlogCmd.AddCommand(dmlogRecordCmd)
+	dmlogCmd.AddCommand(dmlogReplayCmd)
+	dmlogCmd.AddCommand(dmlogBisectCmd)
+
+	dmlogRecordCmd.Flags().String("input", "-", "File to read the nodeos output from, '-' reads it from standard input")
+	dmlogRecordCmd.Flags().Uint64("segment-size", 100, "Number of blocks per segment file, segments are aligned on multiples of it")
+
+	dmlogBisectCmd.Flags().Int("context-lines", 5, "Number of lines to print before and after the failing line")
+}
+
+// dmlogLegacyHeaderObject is the single header of recordings made before each segment had its
+// own, holding the latest version and ABI dump lines recorded.
+const dmlogLegacyHeaderObject = "header"
+
+func dmlogSegmentHeaderObject(segmentName string) string {
+	return segmentName + ".header"
+}
+
+var dmlogSegmentRegex = regexp.MustCompile(`^(\d{10})-(\d{10})$`)
+
+func newDmlogStore(storeURL string) (dstore.Store, error) {
+	store, err := dstore.NewStore(storeURL, "dmlog", "zstd", true)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create dmlog store: %w", err)
+	}
+
+	return store, nil
+}
+
+func dmlogRecordE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	store, err := newDmlogStore(args[0])
+	if err != nil {
+		return err
+	}
+
+	segmentSize := viper.GetUint64("segment-size")
+	if segmentSize == 0 {
+		return fmt.Errorf("the --segment-size flag must be greater than 0")
+	}
+
+	input := io.Reader(os.Stdin)
+	if inputFile := viper.GetString("input"); inputFile != "-" {
+		file, err := os.Open(inputFile)
+		if err != nil {
+			return fmt.Errorf("unable to open input: %w", err)
+		}
+		defer file.Close()
+
+		input = file
+	}
+
+	recorder := &dmlogRecorder{store: store, segmentSize: segmentSize}
+
+	scanner := bufio.NewScanner(input)
+	scanner.Buffer(make([]byte, 50*1024*1024), 50*1024*1024)
+	for scanner.Scan() {
+		if err := recorder.processLine(ctx, scanner.Text()); err != nil {
+			return err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("unable to read input: %w", err)
+	}
+
+	return recorder.close(ctx)
+}
+
+type dmlogRecorder struct {
+	store       dstore.Store
+	segmentSize uint64
+
+	versionLine  string
+	abiDumpLines []string
+
+	segment       bytes.Buffer
+	segmentHeader []byte // version and ABI dump lines seen before the start of the segment
+	segmentEnd    int    // length of the segment up to its last accepted block
+	firstBlock    uint64
+	lastBlock     uint64
+}
+
+func (r *dmlogRecorder) processLine(ctx context.Context, line string) error {
+	if !strings.HasPrefix(line, "DMLOG ") {
+		return nil
+	}
+
+	// Version and ABI dump lines are kept in place so they apply from the same point on when
+	// replaying, and tracked to build the header of the next segments
+	content := line[6:]
+	switch {
+	case strings.HasPrefix(content, "DEEP_MIND_VERSION"):
+		r.versionLine = line
+	case strings.HasPrefix(content, "ABIDUMP START"):
+		r.abiDumpLines = []string{line}
+	case strings.HasPrefix(content, "ABIDUMP"):
+		r.abiDumpLines = append(r.abiDumpLines, line)
+	}
+
+	r.segment.WriteString(line)
+	r.segment.WriteByte('\n')
+
+	if !strings.HasPrefix(content, "ACCEPTED_BLOCK") {
+		return nil
+	}
+
+	chunks := strings.SplitN(content, " ", 3)
+	if len(chunks) != 3 {
+		return fmt.Errorf("expected 3 fields on ACCEPTED_BLOCK line, got %d", len(chunks))
+	}
+
+	blockNum, err := strconv.ParseUint(chunks[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("block_num is not a valid number, got: %q", chunks[1])
+	}
+
+	if r.firstBlock == 0 {
+		r.firstBlock = blockNum
+	}
+	r.lastBlock = blockNum
+	r.segmentEnd = r.segment.Len()
+
+	if (blockNum+1)%r.segmentSize == 0 {
+		return r.flush(ctx)
+	}
+
+	return nil
+}
+
+func (r *dmlogRecorder) headerContent() []byte {
+	header := &bytes.Buffer{}
+	for _, line := range append([]string{r.versionLine}, r.abiDumpLines...) {
+		if line != "" {
+			header.WriteString(line + "\n")
+		}
+	}
+
+	return header.Bytes()
+}
+
+func (r *dmlogRecorder) flush(ctx context.Context) error {
+	name := fmt.Sprintf("%010d-%010d", r.firstBlock, r.lastBlock)
+
+	// Written first so a segment never exists without its header
+	if len(r.segmentHeader) > 0 {
+		if err := r.store.WriteObject(ctx, dmlogSegmentHeaderObject(name), bytes.NewReader(r.segmentHeader)); err != nil {
+			return fmt.Errorf("unable to write header of segment %s: %w", name, err)
+		}
+	}
+
+	if err := r.store.WriteObject(ctx, name, bytes.NewReader(r.segment.Bytes())); err != nil {
+		return fmt.Errorf("unable to write segment %s: %w", name, err)
+	}
+
+	fmt.Printf("Recorded segment %s (%d bytes)\n", name, r.segment.Len())
+	r.segment.Reset()
+	r.segmentHeader = r.headerContent()
+	r.segmentEnd = 0
+	r.firstBlock = 0
+	r.lastBlock = 0
+
+	return nil
+}
+
+func (r *dmlogRecorder) close(ctx context.Context) error {
+	// Lines after the last accepted block belong to a block nodeos did not complete
+	if dropped := r.segment.Len() - r.segmentEnd; dropped > 0 {
+		fmt.Printf("Dropping %d bytes of deep mind output not followed by an accepted block\n", dropped)
+		r.segment.Truncate(r.segmentEnd)
+	}
+
+	if r.lastBlock == 0 {
+		return nil
+	}
+
+	return r.flush(ctx)
+}
+
+type dmlogSegment struct {
+	name       string
+	firstBlock uint64
+	lastBlock  uint64
+}
+
+// listDmlogSegments returns the segments of the store holding blocks between `startBlock` and
+// `stopBlock` (inclusive), sorted by block range.
+func listDmlogSegments(ctx context.Context, store dstore.Store, startBlock, stopBlock uint64) (out []*dmlogSegment, err error) {
+	err = store.Walk(ctx, "", ".tmp", func(filename string) error {
+		match := dmlogSegmentRegex.FindStringSubmatch(filename)
+		if match == nil {
+			return nil
+		}
+
+		firstBlock, _ := strconv.ParseUint(match[1], 10, 64)
+		lastBlock, _ := strconv.ParseUint(match[2], 10, 64)
+		if lastBlock < startBlock || firstBlock > stopBlock {
+			return nil
+		}
+
+		out = append(out, &dmlogSegment{name: filename, firstBlock: firstBlock, lastBlock: lastBlock})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list segments: %w", err)
+	}
+
+	if len(out) == 0 {
+		return nil, fmt.Errorf("no recorded segment holds blocks between %d and %d", startBlock, stopBlock)
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].firstBlock < out[j].firstBlock })
+	for i := 1; i < len(out); i++ {
+		if out[i].firstBlock != out[i-1].lastBlock+1 {
+			zlog.Warn("recorded segments are not contiguous", zap.String("previous", out[i-1].name), zap.String("next", out[i].name))
+		}
+	}
+
+	return out, nil
+}
+
+func parseDmlogRange(args []string) (startBlock, stopBlock uint64, err error) {
+	startBlock, err = strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to parse start block %q: %w", args[0], err)
+	}
+
+	stopBlock = ^uint64(0)
+	if len(args) > 1 {
+		stopBlock, err = strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("unable to parse stop block %q: %w", args[1], err)
+		}
+	}
+
+	if stopBlock < startBlock {
+		return 0, 0, fmt.Errorf("stop block %d is lower than start block %d", stopBlock, startBlock)
+	}
+
+	return startBlock, stopBlock, nil
+}
+
+// openDmlogRange returns the header followed by the content of the segments, opened one
+// at a time as they are read.
+func openDmlogRange(ctx context.Context, store dstore.Store, segments []*dmlogSegment) io.ReadCloser {
+	reader, writer := io.Pipe()
+
+	go func() {
+		objects, err := dmlogObjects(ctx, store, segments)
+		if err != nil {
+			writer.CloseWithError(err)
+			return
+		}
+
+		for _, object := range objects {
+			if err := copyObject(ctx, store, object, writer); err != nil {
+				writer.CloseWithError(err)
+				return
+			}
+		}
+
+		writer.Close()
+	}()
+
+	return reader
+}
+
+// dmlogObjects returns the objects to read in order to replay the segments. The header in force
+// at the start of a segment is read before the first segment and before a segment following a
+// gap, later version and ABI dump lines being recorded in place in the segments. Recordings made before segments had
+// their own header fall back to the single legacy header, read before the first segment.
+func dmlogObjects(ctx context.Context, store dstore.Store, segments []*dmlogSegment) (out []string, err error) {
+	for i, segment := range segments {
+		if i == 0 || segment.firstBlock != segments[i-1].lastBlock+1 {
+			header, err := dmlogSegmentHeader(ctx, store, segment, i == 0)
+			if err != nil {
+				return nil, err
+			}
+
+			if header != "" {
+				out = append(out, header)
+			}
+		}
+
+		out = append(out, segment.name)
+	}
+
+	return out, nil
+}
+
+// dmlogSegmentHeader returns the header object of the segment, empty when the recorded output
+// had no version nor ABI dump lines before it.
+func dmlogSegmentHeader(ctx context.Context, store dstore.Store, segment *dmlogSegment, withLegacy bool) (string, error) {
+	candidates := []string{dmlogSegmentHeaderObject(segment.name)}
+	if withLegacy {
+		candidates = append(candidates, dmlogLegacyHeaderObject)
+	}
+
+	for _, candidate := range candidates {
+		exists, err := store.FileExists(ctx, candidate)
+		if err != nil {
+			return "", fmt.Errorf("unable to check header %s: %w", candidate, err)
+		}
+
+		if exists {
+			return candidate, nil
+		}
+	}
+
+	return "", nil
+}
+
+func copyObject(ctx context.Context, store dstore.Store, name string, writer io.Writer) error {
+	object, err := store.OpenObject(ctx, name)
+	if err != nil {
+		return fmt.Errorf("unable to open %s: %w", name, err)
+	}
+	defer object.Close()
+
+	if _, err := io.Copy(writer, object); err != nil {
+		return fmt.Errorf("unable to read %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func dmlogReplayE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	store, err := newDmlogStore(args[0])
+	if err != nil {
+		return err
+	}
+
+	blocksStore, err := dstore.NewDBinStore(args[1])
+	if err != nil {
+		return fmt.Errorf("unable to create merged blocks store: %w", err)
+	}
+
+	startBlock, stopBlock, err := parseDmlogRange(args[2:])
+	if err != nil {
+		return err
+	}
+
+	segments, err := listDmlogSegments(ctx, store, startBlock, stopBlock)
+	if err != nil {
+		return err
+	}
+
+	input := openDmlogRange(ctx, store, segments)
+	defer input.Close()
+
+	consoleReader, err := codec.NewConsoleReader(input)
+	if err != nil {
+		return err
+	}
+	defer consoleReader.Close()
+
+	bundler := &mergedBlocksBundler{store: blocksStore}
+	for {
+		out, err := consoleReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("unable to read blocks, use 'dfuseeos tools dmlog bisect' to locate the failing line: %w", err)
+		}
+
+		block := out.(*pbcodec.Block)
+		if uint64(block.Number) < startBlock {
+			continue
+		}
+		if uint64(block.Number) > stopBlock {
+			break
+		}
+
+		bstreamBlock, err := codec.BlockFromProto(block)
+		if err != nil {
+			return fmt.Errorf("unable to convert block %s: %w", block.AsRef(), err)
+		}
+
+		if err := bundler.add(ctx, bstreamBlock); err != nil {
+			return err
+		}
+	}
+
+	return bundler.flush(ctx)
+}
+
+// mergedBlocksBundlerMaxPending is the number of merged blocks files kept pending when the
+// last irreversible block does not move forward, the lowest one being written when exceeded.
+const mergedBlocksBundlerMaxPending = 10
+
+// mergedBlocksBundler groups the replayed blocks into merged blocks files of 100 blocks. A file
+// is written once the last irreversible block moved past its range, so forked blocks replayed
+// later still land in it, and files are only ever written forward: a block belonging to an
+// already written file is skipped instead of rewriting the file.
+type mergedBlocksBundler struct {
+	store dstore.Store
+
+	pending         map[uint64][]*bstream.Block
+	written         bool
+	lastWrittenBase uint64
+}
+
+func (b *mergedBlocksBundler) add(ctx context.Context, block *bstream.Block) error {
+	baseBlock := block.Num() - block.Num()%100
+	if b.written && baseBlock <= b.lastWrittenBase {
+		fmt.Printf("Skipping block %s, its merged blocks file %010d was already written\n", block, baseBlock)
+		return nil
+	}
+
+	if b.pending == nil {
+		b.pending = map[uint64][]*bstream.Block{}
+	}
+	b.pending[baseBlock] = append(b.pending[baseBlock], block)
+
+	for _, base := range b.pendingBases() {
+		if base+100 > block.LibNum && len(b.pending) <= mergedBlocksBundlerMaxPending {
+			break
+		}
+
+		if err := b.write(ctx, base); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// flush writes all the pending merged blocks files, in increasing order.
+func (b *mergedBlocksBundler) flush(ctx context.Context) error {
+	for _, base := range b.pendingBases() {
+		if err := b.write(ctx, base); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *mergedBlocksBundler) pendingBases() (out []uint64) {
+	for base := range b.pending {
+		out = append(out, base)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+
+	return out
+}
+
+func (b *mergedBlocksBundler) write(ctx context.Context, baseBlock uint64) error {
+	blocks := b.pending[baseBlock]
+	delete(b.pending, baseBlock)
+
+	buffer := &bytes.Buffer{}
+	writer, err := codec.NewBlockWriter(buffer)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range blocks {
+		if err := writer.Write(block); err != nil {
+			return fmt.Errorf("unable to write block %s: %w", block, err)
+		}
+	}
+
+	name := fmt.Sprintf("%010d", baseBlock)
+	if err := b.store.WriteObject(ctx, name, buffer); err != nil {
+		return fmt.Errorf("unable to write merged blocks file %s: %w", name, err)
+	}
+
+	b.written = true
+	b.lastWrittenBase = baseBlock
+
+	if len(blocks) < 100 {
+		fmt.Printf("Wrote partial merged blocks file %s (%d blocks)\n", name, len(blocks))
+	} else {
+		fmt.Printf("Wrote merged blocks file %s\n", name)
+	}
+
+	return nil
+}
+
+// dmlogBisectLine is a line read from a recorded object, kept to print the context of the
+// failing line.
+type dmlogBisectLine struct {
+	object string
+	number int
+	line   string
+}
+
+func (l *dmlogBisectLine) print(marker string) {
+	fmt.Printf("%s %s:%d %s\n", marker, l.object, l.number, truncateDmlogLine(l.line))
+}
+
+func dmlogBisectE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	contextLines := viper.GetInt("context-lines")
+	if contextLines < 0 {
+		contextLines = 0
+	}
+
+	store, err := newDmlogStore(args[0])
+	if err != nil {
+		return err
+	}
+
+	startBlock, stopBlock, err := parseDmlogRange(args[1:])
+	if err != nil {
+		return err
+	}
+
+	segments, err := listDmlogSegments(ctx, store, startBlock, stopBlock)
+	if err != nil {
+		return err
+	}
+
+	objects, err := dmlogObjects(ctx, store, segments)
+	if err != nil {
+		return err
+	}
+
+	// The lines are fed one at a time to the same parsing state as the console reader, the
+	// first one failing is found in a single pass, only the lines before it are kept around.
+	lineReader := codec.NewLineReader()
+
+	var before []*dmlogBisectLine
+	var failing *dmlogBisectLine
+	var failure error
+	after := 0
+	lineCount := 0
+
+	for _, object := range objects {
+		done, err := scanObject(ctx, store, object, func(number int, line string) bool {
+			current := &dmlogBisectLine{object: object, number: number, line: line}
+			lineCount++
+
+			if failing != nil {
+				current.print(" ")
+				after++
+				return after < contextLines
+			}
+
+			if _, err := lineReader.ReadLine(line); err != nil {
+				failing = current
+				failure = err
+
+				fmt.Printf("First failing line is line %d of %s:\n", number, object)
+				fmt.Printf("  %s\n\n", err)
+				for _, previous := range before {
+					previous.print(" ")
+				}
+				failing.print(">")
+				return contextLines > 0
+			}
+
+			if contextLines > 0 {
+				if len(before) == contextLines {
+					before = before[1:]
+				}
+				before = append(before, current)
+			}
+			return true
+		})
+		if err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+	}
+
+	if failure == nil {
+		fmt.Printf("No failure reading the %d lines of blocks %d to %d\n", lineCount, segments[0].firstBlock, segments[len(segments)-1].lastBlock)
+	}
+
+	return nil
+}
+
+// scanObject calls `onLine` with each line of the object along with its number, stopping as
+// soon as `onLine` returns false, in which case `done` is true.
+func scanObject(ctx context.Context, store dstore.Store, name string, onLine func(number int, line string) bool) (done bool, err error) {
+	object, err := store.OpenObject(ctx, name)
+	if err != nil {
+		return false, fmt.Errorf("unable to open %s: %w", name, err)
+	}
+	defer object.Close()
+
+	scanner := bufio.NewScanner(object)
+	scanner.Buffer(make([]byte, 50*1024*1024), 50*1024*1024)
+	for number := 1; scanner.Scan(); number++ {
+		if !onLine(number, scanner.Text()) {
+			return true, nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return false, fmt.Errorf("unable to read %s: %w", name, err)
+	}
+
+	return false, nil
+}
+
+func truncateDmlogLine(line string) string {
+	if len(line) <= 256 {
+		return line
+	}
+
+	return fmt.Sprintf("%s... (%d bytes)", line[:256], len(line))
+}
@@ -0,0 +1,555 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/streamingfast/dstore"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/source"
+	"github.com/xitongsys/parquet-go/writer"
+	"github.com/zhongshuwen/histnew/codec"
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+	"go.uber.org/zap"
+)
+
+var exportParquetCmd = &cobra.Command{
+	Use:   "export-parquet {merged-blocks-store-url} {output-store-url} {start_block} {stop_block}",
+	Short: "Exports blocks, transactions, action traces and database, RAM and permission operations as Parquet files",
+	Long: Description(`
+		Reads the merged blocks files covering {start_block} to {stop_block} and writes, for each of them,
+		one Parquet file per table under {output-store-url}, named '<table>/<base_block_num>.parquet'. The
+		tables are 'blocks', 'transactions', 'action_traces', 'db_ops', 'ram_ops' and 'perm_ops'. Whole
+		merged blocks files are exported, the range being extended to 100 blocks boundaries.
+
+		Merged blocks files hold forked blocks too, every row has an 'irreversible' column telling
+		if its block is the irreversible one of its height, filter on it to only keep the canonical
+		chain. The irreversible blocks are known from the last irreversible block of the highest
+		block of the merged blocks file and the next one, the export stops at the first merged
+		blocks file not fully irreversible yet, to be exported once later blocks are merged.
+
+		Merged blocks files already exported are skipped, so an interrupted export can be resumed by
+		running the same command again. Use --overwrite to export them again.
+	`),
+	Args: cobra.ExactArgs(4),
+	RunE: exportParquetE,
+	Example: ExamplePrefixed("dfuseeos tools", `
+		export-parquet file://./dfuse-data/storage/merged-blocks file://./parquet 0 99999
+	`),
+}
+
+func init() {
+	Cmd.AddCommand(exportParquetCmd)
+
+	exportParquetCmd.Flags().Bool("overwrite", false, "Export again the merged blocks files that were already exported")
+}
+
+// parquetTables are written in this order, the last one being present meaning the merged
+// blocks file was fully exported.
+var parquetTables = []string{"blocks", "transactions", "action_traces", "db_ops", "ram_ops", "perm_ops"}
+
+type parquetBlock struct {
+	BlockNum                 int64  `parquet:"name=block_num, type=INT64"`
+	Irreversible             bool   `parquet:"name=irreversible, type=BOOLEAN"`
+	BlockID                  string `parquet:"name=block_id, type=UTF8"`
+	PreviousID               string `parquet:"name=previous_id, type=UTF8"`
+	Timestamp                int64  `parquet:"name=timestamp, type=TIMESTAMP_MILLIS"`
+	Producer                 string `parquet:"name=producer, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	DposIrreversibleBlockNum int64  `parquet:"name=dpos_irreversible_block_num, type=INT64"`
+	TransactionCount         int32  `parquet:"name=transaction_count, type=INT32"`
+	TransactionTraceCount    int32  `parquet:"name=transaction_trace_count, type=INT32"`
+	ActionTraceCount         int32  `parquet:"name=action_trace_count, type=INT32"`
+}
+
+type parquetTransaction struct {
+	BlockNum             int64  `parquet:"name=block_num, type=INT64"`
+	BlockID              string `parquet:"name=block_id, type=UTF8"`
+	Irreversible         bool   `parquet:"name=irreversible, type=BOOLEAN"`
+	Timestamp            int64  `parquet:"name=timestamp, type=TIMESTAMP_MILLIS"`
+	TransactionID        string `parquet:"name=transaction_id, type=UTF8"`
+	Index                int64  `parquet:"name=index, type=INT64"`
+	Status               string `parquet:"name=status, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	CPUUsageMicroSeconds int64  `parquet:"name=cpu_usage_micro_seconds, type=INT64"`
+	NetUsage             int64  `parquet:"name=net_usage, type=INT64"`
+	Elapsed              int64  `parquet:"name=elapsed, type=INT64"`
+	Scheduled            bool   `parquet:"name=scheduled, type=BOOLEAN"`
+	ActionTraceCount     int32  `parquet:"name=action_trace_count, type=INT32"`
+	ErrorCode            int64  `parquet:"name=error_code, type=INT64"`
+}
+
+type parquetActionTrace struct {
+	BlockNum                               int64  `parquet:"name=block_num, type=INT64"`
+	BlockID                                string `parquet:"name=block_id, type=UTF8"`
+	Irreversible                           bool   `parquet:"name=irreversible, type=BOOLEAN"`
+	Timestamp                              int64  `parquet:"name=timestamp, type=TIMESTAMP_MILLIS"`
+	TransactionID                          string `parquet:"name=transaction_id, type=UTF8"`
+	ExecutionIndex                         int32  `parquet:"name=execution_index, type=INT32"`
+	ActionOrdinal                          int32  `parquet:"name=action_ordinal, type=INT32"`
+	CreatorActionOrdinal                   int32  `parquet:"name=creator_action_ordinal, type=INT32"`
+	ClosestUnnotifiedAncestorActionOrdinal int32  `parquet:"name=closest_unnotified_ancestor_action_ordinal, type=INT32"`
+	GlobalSequence                         int64  `parquet:"name=global_sequence, type=INT64"`
+	Receiver                               string `parquet:"name=receiver, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Account                                string `parquet:"name=account, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Name                                   string `parquet:"name=name, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Authorizations                         string `parquet:"name=authorizations, type=UTF8"`
+	Data                                   string `parquet:"name=data, type=UTF8"`
+	JSONData                               string `parquet:"name=json_data, type=UTF8"`
+	Console                                string `parquet:"name=console, type=UTF8"`
+	ContextFree                            bool   `parquet:"name=context_free, type=BOOLEAN"`
+	Elapsed                                int64  `parquet:"name=elapsed, type=INT64"`
+	Failed                                 bool   `parquet:"name=failed, type=BOOLEAN"`
+}
+
+type parquetDBOp struct {
+	BlockNum      int64  `parquet:"name=block_num, type=INT64"`
+	BlockID       string `parquet:"name=block_id, type=UTF8"`
+	Irreversible  bool   `parquet:"name=irreversible, type=BOOLEAN"`
+	TransactionID string `parquet:"name=transaction_id, type=UTF8"`
+	ActionIndex   int32  `parquet:"name=action_index, type=INT32"`
+	Operation     string `parquet:"name=operation, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Code          string `parquet:"name=code, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Scope         string `parquet:"name=scope, type=UTF8"`
+	TableName     string `parquet:"name=table_name, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	PrimaryKey    string `parquet:"name=primary_key, type=UTF8"`
+	OldPayer      string `parquet:"name=old_payer, type=UTF8"`
+	NewPayer      string `parquet:"name=new_payer, type=UTF8"`
+	OldData       string `parquet:"name=old_data, type=UTF8"`
+	NewData       string `parquet:"name=new_data, type=UTF8"`
+}
+
+type parquetRAMOp struct {
+	BlockNum      int64  `parquet:"name=block_num, type=INT64"`
+	BlockID       string `parquet:"name=block_id, type=UTF8"`
+	Irreversible  bool   `parquet:"name=irreversible, type=BOOLEAN"`
+	TransactionID string `parquet:"name=transaction_id, type=UTF8"`
+	ActionIndex   int32  `parquet:"name=action_index, type=INT32"`
+	Payer         string `parquet:"name=payer, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Delta         int64  `parquet:"name=delta, type=INT64"`
+	Usage         int64  `parquet:"name=usage, type=INT64"`
+	Namespace     string `parquet:"name=namespace, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Action        string `parquet:"name=action, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	UniqueKey     string `parquet:"name=unique_key, type=UTF8"`
+}
+
+type parquetPermOp struct {
+	BlockNum      int64  `parquet:"name=block_num, type=INT64"`
+	BlockID       string `parquet:"name=block_id, type=UTF8"`
+	Irreversible  bool   `parquet:"name=irreversible, type=BOOLEAN"`
+	TransactionID string `parquet:"name=transaction_id, type=UTF8"`
+	ActionIndex   int32  `parquet:"name=action_index, type=INT32"`
+	Operation     string `parquet:"name=operation, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Owner         string `parquet:"name=owner, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Name          string `parquet:"name=name, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	OldAuthority  string `parquet:"name=old_authority, type=UTF8"`
+	NewAuthority  string `parquet:"name=new_authority, type=UTF8"`
+}
+
+func exportParquetE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	overwrite := viper.GetBool("overwrite")
+
+	blocksStore, err := dstore.NewDBinStore(args[0])
+	if err != nil {
+		return fmt.Errorf("unable to create merged blocks store: %w", err)
+	}
+
+	outputStore, err := dstore.NewStore(args[1], "parquet", "", true)
+	if err != nil {
+		return fmt.Errorf("unable to create output store: %w", err)
+	}
+
+	startBlock, err := strconv.ParseUint(args[2], 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to parse start block %q: %w", args[2], err)
+	}
+
+	stopBlock, err := strconv.ParseUint(args[3], 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to parse stop block %q: %w", args[3], err)
+	}
+
+	if stopBlock < startBlock {
+		return fmt.Errorf("stop block %d is lower than start block %d", stopBlock, startBlock)
+	}
+
+	var next []*pbcodec.Block
+	nextBaseBlock := uint64(0)
+	for baseBlock := startBlock - startBlock%100; baseBlock <= stopBlock; baseBlock += 100 {
+		bundle := fmt.Sprintf("%010d", baseBlock)
+
+		if !overwrite {
+			exported, err := outputStore.FileExists(ctx, parquetTables[len(parquetTables)-1]+"/"+bundle)
+			if err != nil {
+				return fmt.Errorf("unable to check merged blocks file %s export: %w", bundle, err)
+			}
+
+			if exported {
+				zlog.Debug("merged blocks file already exported, skipping", zap.String("bundle", bundle))
+				continue
+			}
+		}
+
+		blocks := next
+		if next == nil || nextBaseBlock != baseBlock {
+			if blocks, err = readMergedBlocks(ctx, blocksStore, bundle); err != nil {
+				return err
+			}
+		}
+
+		// The next merged blocks file, when already merged, holds the blocks making the last
+		// ones of this file irreversible
+		nextBaseBlock = baseBlock + 100
+		next = nil
+		nextBundle := fmt.Sprintf("%010d", nextBaseBlock)
+		if exists, err := blocksStore.FileExists(ctx, nextBundle); err != nil {
+			return fmt.Errorf("unable to check merged blocks file %s: %w", nextBundle, err)
+		} else if exists {
+			if next, err = readMergedBlocks(ctx, blocksStore, nextBundle); err != nil {
+				return err
+			}
+		}
+
+		irreversibleIDs, complete := irreversibleBlockIDs(blocks, next)
+		if !complete {
+			fmt.Printf("Merged blocks file %s is not fully irreversible yet, stopping, run the export again once later blocks are merged\n", bundle)
+			return nil
+		}
+
+		if err := exportParquetBundle(ctx, outputStore, bundle, blocks, irreversibleIDs); err != nil {
+			return fmt.Errorf("unable to export merged blocks file %s: %w", bundle, err)
+		}
+
+		fmt.Printf("Exported merged blocks file %s (%d blocks)\n", bundle, len(blocks))
+	}
+
+	return nil
+}
+
+func readMergedBlocks(ctx context.Context, store dstore.Store, bundle string) (out []*pbcodec.Block, err error) {
+	reader, err := store.OpenObject(ctx, bundle)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open merged blocks file %s: %w", bundle, err)
+	}
+	defer reader.Close()
+
+	blockReader, err := codec.BlockReaderFactory(reader)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read merged blocks file %s: %w", bundle, err)
+	}
+
+	for {
+		block, err := blockReader.Read()
+		if block != nil {
+			out = append(out, block.ToNative().(*pbcodec.Block))
+		}
+
+		if err == io.EOF {
+			return out, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("unable to read merged blocks file %s: %w", bundle, err)
+		}
+	}
+}
+
+// irreversibleBlockIDs returns the IDs of the irreversible blocks among `blocks`, walking down
+// the chain from the highest block of `blocks` and `next` (the blocks of the following merged
+// blocks file, if any) and keeping the blocks at or below its last irreversible block. The
+// other blocks are forked or still reversible, `complete` is false when a height of `blocks`
+// has no irreversible block yet.
+func irreversibleBlockIDs(blocks, next []*pbcodec.Block) (ids map[string]bool, complete bool) {
+	byID := map[string]*pbcodec.Block{}
+	var head *pbcodec.Block
+	for _, candidates := range [][]*pbcodec.Block{blocks, next} {
+		for _, block := range candidates {
+			byID[block.ID()] = block
+			if head == nil || block.Num() > head.Num() {
+				head = block
+			}
+		}
+	}
+
+	ids = map[string]bool{}
+	if head == nil {
+		return ids, true
+	}
+
+	irreversibleHeights := map[uint64]bool{}
+	for block := head; block != nil; block = byID[block.PreviousID()] {
+		if block.Num() <= uint64(head.DposIrreversibleBlocknum) {
+			ids[block.ID()] = true
+			irreversibleHeights[block.Num()] = true
+		}
+	}
+
+	for _, block := range blocks {
+		if !irreversibleHeights[block.Num()] {
+			return ids, false
+		}
+	}
+
+	return ids, true
+}
+
+func exportParquetBundle(ctx context.Context, store dstore.Store, bundle string, blocks []*pbcodec.Block, irreversibleIDs map[string]bool) error {
+	rows := map[string][]interface{}{}
+	for _, block := range blocks {
+		appendParquetRows(rows, block, irreversibleIDs[block.ID()])
+	}
+
+	schemas := map[string]interface{}{
+		"blocks":        new(parquetBlock),
+		"transactions":  new(parquetTransaction),
+		"action_traces": new(parquetActionTrace),
+		"db_ops":        new(parquetDBOp),
+		"ram_ops":       new(parquetRAMOp),
+		"perm_ops":      new(parquetPermOp),
+	}
+
+	for _, table := range parquetTables {
+		content, err := writeParquet(schemas[table], rows[table])
+		if err != nil {
+			return fmt.Errorf("unable to write %s: %w", table, err)
+		}
+
+		if err := store.WriteObject(ctx, table+"/"+bundle, bytes.NewReader(content)); err != nil {
+			return fmt.Errorf("unable to upload %s: %w", table, err)
+		}
+	}
+
+	return nil
+}
+
+// parquetBlockRef holds the block columns repeated in the rows of every table
+type parquetBlockRef struct {
+	num          int64
+	id           string
+	time         int64
+	irreversible bool
+}
+
+func appendParquetRows(rows map[string][]interface{}, block *pbcodec.Block, irreversible bool) {
+	ref := &parquetBlockRef{
+		num:          int64(block.Num()),
+		id:           block.ID(),
+		time:         parquetTimestamp(block.GetHeader().GetTimestamp()),
+		irreversible: irreversible,
+	}
+
+	traces := block.TransactionTraces()
+	blockRow := &parquetBlock{
+		BlockNum:                 ref.num,
+		Irreversible:             ref.irreversible,
+		BlockID:                  ref.id,
+		PreviousID:               block.PreviousID(),
+		Timestamp:                ref.time,
+		Producer:                 block.GetHeader().GetProducer(),
+		DposIrreversibleBlockNum: int64(block.DposIrreversibleBlocknum),
+		TransactionCount:         int32(len(block.Transactions())),
+		TransactionTraceCount:    int32(len(traces)),
+	}
+	rows["blocks"] = append(rows["blocks"], blockRow)
+
+	for _, trace := range traces {
+		blockRow.ActionTraceCount += int32(len(trace.ActionTraces))
+
+		trxRow := &parquetTransaction{
+			BlockNum:         ref.num,
+			BlockID:          ref.id,
+			Irreversible:     ref.irreversible,
+			Timestamp:        ref.time,
+			TransactionID:    trace.Id,
+			Index:            int64(trace.Index),
+			Elapsed:          trace.Elapsed,
+			NetUsage:         int64(trace.NetUsage),
+			Scheduled:        trace.Scheduled,
+			ActionTraceCount: int32(len(trace.ActionTraces)),
+			ErrorCode:        int64(trace.ErrorCode),
+		}
+		if trace.Receipt != nil {
+			trxRow.Status = trace.Receipt.Status.String()
+			trxRow.CPUUsageMicroSeconds = int64(trace.Receipt.CpuUsageMicroSeconds)
+		}
+		rows["transactions"] = append(rows["transactions"], trxRow)
+
+		for _, actionTrace := range trace.ActionTraces {
+			rows["action_traces"] = append(rows["action_traces"], newParquetActionTrace(ref, trace.Id, actionTrace))
+		}
+
+		for _, op := range trace.DbOps {
+			rows["db_ops"] = append(rows["db_ops"], &parquetDBOp{
+				BlockNum:      ref.num,
+				BlockID:       ref.id,
+				Irreversible:  ref.irreversible,
+				TransactionID: trace.Id,
+				ActionIndex:   int32(op.ActionIndex),
+				Operation:     op.Operation.String(),
+				Code:          op.Code,
+				Scope:         op.Scope,
+				TableName:     op.TableName,
+				PrimaryKey:    op.PrimaryKey,
+				OldPayer:      op.OldPayer,
+				NewPayer:      op.NewPayer,
+				OldData:       hex.EncodeToString(op.OldData),
+				NewData:       hex.EncodeToString(op.NewData),
+			})
+		}
+
+		for _, op := range trace.RamOps {
+			rows["ram_ops"] = append(rows["ram_ops"], &parquetRAMOp{
+				BlockNum:      ref.num,
+				BlockID:       ref.id,
+				Irreversible:  ref.irreversible,
+				TransactionID: trace.Id,
+				ActionIndex:   int32(op.ActionIndex),
+				Payer:         op.Payer,
+				Delta:         op.Delta,
+				Usage:         int64(op.Usage),
+				Namespace:     op.Namespace.String(),
+				Action:        op.Action.String(),
+				UniqueKey:     op.UniqueKey,
+			})
+		}
+
+		for _, op := range trace.PermOps {
+			rows["perm_ops"] = append(rows["perm_ops"], newParquetPermOp(ref, trace.Id, op))
+		}
+	}
+}
+
+func newParquetActionTrace(ref *parquetBlockRef, trxID string, actionTrace *pbcodec.ActionTrace) *parquetActionTrace {
+	row := &parquetActionTrace{
+		BlockNum:                               ref.num,
+		BlockID:                                ref.id,
+		Irreversible:                           ref.irreversible,
+		Timestamp:                              ref.time,
+		TransactionID:                          trxID,
+		ExecutionIndex:                         int32(actionTrace.ExecutionIndex),
+		ActionOrdinal:                          int32(actionTrace.ActionOrdinal),
+		CreatorActionOrdinal:                   int32(actionTrace.CreatorActionOrdinal),
+		ClosestUnnotifiedAncestorActionOrdinal: int32(actionTrace.ClosestUnnotifiedAncestorActionOrdinal),
+		Receiver:                               actionTrace.Receiver,
+		Console:                                actionTrace.Console,
+		ContextFree:                            actionTrace.ContextFree,
+		Elapsed:                                actionTrace.Elapsed,
+		Failed:                                 actionTrace.Exception != nil,
+	}
+
+	if actionTrace.Receipt != nil {
+		row.GlobalSequence = int64(actionTrace.Receipt.GlobalSequence)
+	}
+
+	if action := actionTrace.Action; action != nil {
+		row.Account = action.Account
+		row.Name = action.Name
+		row.Data = hex.EncodeToString(action.RawData)
+		row.JSONData = action.JsonData
+
+		authorizations := make([]string, len(action.Authorization))
+		for i, authorization := range action.Authorization {
+			authorizations[i] = authorization.Actor + "@" + authorization.Permission
+		}
+		row.Authorizations = mustParquetJSON(authorizations)
+	}
+
+	return row
+}
+
+func newParquetPermOp(ref *parquetBlockRef, trxID string, op *pbcodec.PermOp) *parquetPermOp {
+	row := &parquetPermOp{
+		BlockNum:      ref.num,
+		BlockID:       ref.id,
+		Irreversible:  ref.irreversible,
+		TransactionID: trxID,
+		ActionIndex:   int32(op.ActionIndex),
+		Operation:     op.Operation.String(),
+	}
+
+	if op.OldPerm != nil {
+		row.Owner, row.Name = op.OldPerm.Owner, op.OldPerm.Name
+		row.OldAuthority = mustParquetJSON(op.OldPerm.Authority)
+	}
+
+	if op.NewPerm != nil {
+		row.Owner, row.Name = op.NewPerm.Owner, op.NewPerm.Name
+		row.NewAuthority = mustParquetJSON(op.NewPerm.Authority)
+	}
+
+	return row
+}
+
+func parquetTimestamp(in *timestamp.Timestamp) int64 {
+	if in == nil {
+		return 0
+	}
+
+	out, err := ptypes.Timestamp(in)
+	if err != nil {
+		return 0
+	}
+
+	return out.UnixNano() / 1e6
+}
+
+func mustParquetJSON(in interface{}) string {
+	out, err := json.Marshal(in)
+	if err != nil {
+		panic(fmt.Errorf("unable to marshal %T: %w", in, err))
+	}
+
+	return string(out)
+}
+
+// writeParquet writes the rows with a single writer routine, so the same rows always
+// produce the same file.
+func writeParquet(schema interface{}, rows []interface{}) ([]byte, error) {
+	file := &parquetBuffer{}
+	parquetWriter, err := writer.NewParquetWriter(file, schema, 1)
+	if err != nil {
+		return nil, err
+	}
+	parquetWriter.CompressionType = parquet.CompressionCodec_SNAPPY
+
+	for _, row := range rows {
+		if err := parquetWriter.Write(row); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := parquetWriter.WriteStop(); err != nil {
+		return nil, err
+	}
+
+	return file.Bytes(), nil
+}
+
+// parquetBuffer is an in-memory `source.ParquetFile`, the Parquet writer only appends to it
+type parquetBuffer struct {
+	bytes.Buffer
+}
+
+func (b *parquetBuffer) Seek(offset int64, whence int) (int64, error) {
+	return 0, fmt.Errorf("seek is not supported")
+}
+
+func (b *parquetBuffer) Close() error {
+	return nil
+}
+
+func (b *parquetBuffer) Open(name string) (source.ParquetFile, error) {
+	return nil, fmt.Errorf("open is not supported")
+}
+
+func (b *parquetBuffer) Create(name string) (source.ParquetFile, error) {
+	return nil, fmt.Errorf("create is not supported")
+}
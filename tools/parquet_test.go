@@ -0,0 +1,102 @@
+package tools
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/source"
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+)
+
+func TestIrreversibleBlockIDs(t *testing.T) {
+	blocks := []*pbcodec.Block{
+		parquetTestBlock("00000001aa", "", 0),
+		parquetTestBlock("00000002aa", "00000001aa", 1),
+		parquetTestBlock("00000002bb", "00000001aa", 1),
+		parquetTestBlock("00000003aa", "00000002aa", 2),
+	}
+
+	_, complete := irreversibleBlockIDs(blocks, nil)
+	assert.False(t, complete, "the last blocks are reversible without the next merged blocks file")
+
+	next := []*pbcodec.Block{
+		parquetTestBlock("00000004aa", "00000003aa", 2),
+		parquetTestBlock("00000005aa", "00000004aa", 3),
+	}
+
+	ids, complete := irreversibleBlockIDs(blocks, next)
+	assert.True(t, complete)
+	assert.Equal(t, map[string]bool{"00000001aa": true, "00000002aa": true, "00000003aa": true}, ids)
+}
+
+func TestExportParquetBundle_IrreversibleColumn(t *testing.T) {
+	blocks := []*pbcodec.Block{
+		parquetTestBlock("00000002aa", "00000001aa", 1),
+		parquetTestBlock("00000002bb", "00000001aa", 1),
+	}
+	blocks[0].UnfilteredTransactionTraces = []*pbcodec.TransactionTrace{{Id: "trx.1"}}
+	blocks[1].UnfilteredTransactionTraces = []*pbcodec.TransactionTrace{{Id: "trx.1"}}
+
+	rows := map[string][]interface{}{}
+	for _, block := range blocks {
+		appendParquetRows(rows, block, block.Id == "00000002aa")
+	}
+
+	content, err := writeParquet(new(parquetTransaction), rows["transactions"])
+	require.NoError(t, err)
+
+	parquetReader, err := reader.NewParquetReader(&parquetTestFile{Reader: bytes.NewReader(content)}, new(parquetTransaction), 1)
+	require.NoError(t, err)
+	defer parquetReader.ReadStop()
+
+	transactions := make([]parquetTransaction, parquetReader.GetNumRows())
+	require.NoError(t, parquetReader.Read(&transactions))
+
+	require.Len(t, transactions, 2)
+	assert.Equal(t, "00000002aa", transactions[0].BlockID)
+	assert.True(t, transactions[0].Irreversible)
+	assert.Equal(t, "00000002bb", transactions[1].BlockID)
+	assert.False(t, transactions[1].Irreversible)
+}
+
+func parquetTestBlock(id, previousID string, libNum uint32) *pbcodec.Block {
+	var number uint32
+	fmt.Sscanf(id[:8], "%08x", &number)
+
+	return &pbcodec.Block{
+		Id:                       id,
+		Number:                   number,
+		DposIrreversibleBlocknum: libNum,
+		Header:                   &pbcodec.BlockHeader{Previous: previousID},
+	}
+}
+
+// parquetTestFile is a read-only in-memory `source.ParquetFile`
+type parquetTestFile struct {
+	*bytes.Reader
+}
+
+func (f *parquetTestFile) Write(p []byte) (int, error) {
+	return 0, fmt.Errorf("write is not supported")
+}
+
+func (f *parquetTestFile) Close() error {
+	return nil
+}
+
+func (f *parquetTestFile) Open(name string) (source.ParquetFile, error) {
+	content := make([]byte, f.Size())
+	if _, err := f.ReadAt(content, 0); err != nil {
+		return nil, err
+	}
+
+	return &parquetTestFile{Reader: bytes.NewReader(content)}, nil
+}
+
+func (f *parquetTestFile) Create(name string) (source.ParquetFile, error) {
+	return nil, fmt.Errorf("create is not supported")
+}
@@ -0,0 +1,99 @@
+package tools
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/blevesearch/bleve/index/scorch"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zhongshuwen/histnew/search/summary"
+	"go.uber.org/zap"
+)
+
+var searchCmd = &cobra.Command{Use: "search", Short: "Search indexes helper functions"}
+var buildSummariesCmd = &cobra.Command{
+	Use:   "build-summaries {indexes-path} {indices-store-url}",
+	Short: "Builds the term summary of every local index shard and writes it to the indices store",
+	Long: Description(`
+		Builds the term summary of every '<base>.bleve' index shard found in {indexes-path}
+		(as downloaded by search-archive) and writes it next to the shard in {indices-store-url}.
+		search-archive uses those summaries to skip shards that cannot match a query when
+		started with --search-archive-enable-term-summaries.
+	`),
+	Args: cobra.ExactArgs(2),
+	RunE: buildSummariesE,
+	Example: ExamplePrefixed("dfuseeos tools search", `
+		build-summaries ./dfuse-data/search/archiver file://./dfuse-data/storage/indexes
+	`),
+}
+
+func init() {
+	Cmd.AddCommand(searchCmd)
+
+	searchCmd.AddCommand(buildSummariesCmd)
+	buildSummariesCmd.Flags().Uint64("shard-size", 200, "Shard size of the indexes found in {indexes-path}")
+}
+
+func buildSummariesE(cmd *cobra.Command, args []string) (err error) {
+	ctx := cmd.Context()
+	shardSize := viper.GetUint64("shard-size")
+
+	store, err := summary.NewStore(args[1])
+	if err != nil {
+		return fmt.Errorf("unable to create summaries store: %w", err)
+	}
+
+	paths, err := filepath.Glob(filepath.Join(args[0], "??????????.bleve"))
+	if err != nil {
+		return fmt.Errorf("unable to list indexes: %w", err)
+	}
+
+	for _, path := range paths {
+		baseBlockNum, err := strconv.ParseUint(strings.TrimSuffix(filepath.Base(path), ".bleve"), 10, 64)
+		if err != nil {
+			zlog.Info("skipping unrecognized index", zap.String("path", path))
+			continue
+		}
+
+		builder, err := summaryBuilderFromIndex(path)
+		if err != nil {
+			return fmt.Errorf("index %q: %w", path, err)
+		}
+
+		if err := summary.Write(ctx, store, shardSize, baseBlockNum, builder.Build(summary.DefaultFalsePositiveRate)); err != nil {
+			return err
+		}
+
+		fmt.Printf("Wrote summary of shard %d (%d terms)\n", baseBlockNum, builder.Len())
+	}
+
+	return nil
+}
+
+func summaryBuilderFromIndex(path string) (*summary.Builder, error) {
+	idx, err := scorch.NewScorch("data", map[string]interface{}{
+		"forceSegmentType":    "zap",
+		"forceSegmentVersion": 14,
+		"read_only":           true,
+		"path":                path,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("new scorch: %w", err)
+	}
+
+	if err := idx.Open(); err != nil {
+		return nil, fmt.Errorf("open index: %w", err)
+	}
+	defer idx.Close()
+
+	reader, err := idx.Reader()
+	if err != nil {
+		return nil, fmt.Errorf("getting reader: %w", err)
+	}
+	defer reader.Close()
+
+	return summary.FromIndexReader(reader)
+}
@@ -0,0 +1,393 @@
+package tools
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/bstream/forkable"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/kvdb"
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+	"github.com/zhongshuwen/histnew/trxdb"
+	trxdbloader "github.com/zhongshuwen/histnew/trxdb-loader"
+	"github.com/zhongshuwen/histnew/trxdb/kv"
+	"go.uber.org/zap"
+)
+
+var trxdbCmd = &cobra.Command{Use: "trxdb", Short: "Verification and maintenance of the EOS Database (trxdb)"}
+var trxdbVerifyCmd = &cobra.Command{
+	Use:   "verify <store-dsn>",
+	Short: "Cross-checks the blocks, transactions, deferred transactions and irreversible markers of trxdb",
+	Long: Description(`
+		Cross-checks every block (forked ones included) of --range in trxdb: transactions,
+		implicit transactions and traces referenced by each block must exist, the deferred
+		transaction rows created, cancelled or failed by the traces must be consistent and the
+		irreversible markers must form a chain of existing blocks.
+
+		With --merged-blocks-store-url, the blocks of the merged blocks files are also compared
+		to trxdb, each of them must exist in trxdb and the irreversible markers must match one
+		of the merged blocks of their height.
+
+		With --repair, the blocks of every height having an issue are re-injected from the merged
+		blocks files through the trxdb-loader patch pipeline and marked irreversible again. Extra
+		irreversible markers are reported but never deleted. The repair stops once no merged block
+		was read for --repair-idle-timeout, i.e. when the merged blocks ran out before making the
+		highest repaired height irreversible, and the heights that could not be repaired are listed.
+	`),
+	Args: cobra.ExactArgs(1),
+	RunE: trxdbVerifyE,
+	Example: ExamplePrefixed("dfuseeos tools trxdb", `
+		verify badger://./dfuse-data/storage/trxdb-v1 --range=1000:2000
+		verify badger://./dfuse-data/storage/trxdb-v1 --range=1000:2000 --merged-blocks-store-url=file://./dfuse-data/storage/merged-blocks --repair --chain-id=<hex>
+	`),
+}
+
+func init() {
+	Cmd.AddCommand(trxdbCmd)
+	trxdbCmd.AddCommand(trxdbVerifyCmd)
+
+	trxdbVerifyCmd.Flags().StringP("range", "r", "", "Block range to verify, format is of the form '<start>:<stop>' with <stop> exclusive (i.e. '-r 1000:2000')")
+	trxdbVerifyCmd.Flags().String("merged-blocks-store-url", "", "Merged blocks store to compare trxdb with, required by --repair")
+	trxdbVerifyCmd.Flags().Bool("repair", false, "Re-inject the blocks having issues from the merged blocks files")
+	trxdbVerifyCmd.Flags().String("chain-id", "", "Chain ID in hex, required by --repair to compute the signing keys of re-injected transactions")
+	trxdbVerifyCmd.Flags().Uint64("num-blocks-before-start", 300, "Number of blocks read before the first repaired block, so that the first repaired blocks are linked")
+	trxdbVerifyCmd.Flags().Int("parallel-file-download-count", 2, "Maximum number of merged blocks files to download in parallel when repairing")
+	trxdbVerifyCmd.Flags().Duration("repair-idle-timeout", time.Minute, "Stop repairing when no merged block was read for this long, the merged blocks having run out")
+}
+
+func trxdbVerifyE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	blockRange, err := getBlockRangeFromFlag()
+	if err != nil {
+		return err
+	}
+	if blockRange.Unbounded() || blockRange.Stop <= blockRange.Start {
+		return fmt.Errorf("a valid --range is required")
+	}
+
+	repair := viper.GetBool("repair")
+	mergedBlocksStoreURL := viper.GetString("merged-blocks-store-url")
+	if repair && mergedBlocksStoreURL == "" {
+		return fmt.Errorf("--repair requires --merged-blocks-store-url")
+	}
+
+	var chainID []byte
+	if repair {
+		chainID, err = hex.DecodeString(viper.GetString("chain-id"))
+		if err != nil || len(chainID) == 0 {
+			return fmt.Errorf("--repair requires a valid hex --chain-id")
+		}
+	}
+
+	db, err := kv.New([]string{args[0]})
+	if err != nil {
+		return fmt.Errorf("unable to create trxdb: %w", err)
+	}
+	defer db.Close()
+
+	lowBlockNum, highBlockNum := uint32(blockRange.Start), uint32(blockRange.Stop-1)
+	issueHeights := map[uint32]bool{}
+
+	fmt.Printf("Verifying trxdb blocks %s\n", blockRange)
+	stats, err := db.VerifyBlocks(ctx, lowBlockNum, highBlockNum, func(issue *kv.VerifyIssue) {
+		issueHeights[issue.BlockNum] = true
+		fmt.Printf("❌ %s\n", issue)
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Verified %d blocks (%d irreversible), %d transactions, %d traces and %d deferred transaction operations\n", stats.Blocks, stats.IrreversibleBlocks, stats.Transactions, stats.Traces, stats.DtrxOps)
+
+	var blocksStore dstore.Store
+	if mergedBlocksStoreURL != "" {
+		blocksStore, err = dstore.NewDBinStore(mergedBlocksStoreURL)
+		if err != nil {
+			return fmt.Errorf("unable to create merged blocks store: %w", err)
+		}
+
+		fmt.Printf("Comparing trxdb with merged blocks of %s\n", mergedBlocksStoreURL)
+		err = compareMergedBlocks(ctx, db, blocksStore, lowBlockNum, highBlockNum, func(blockNum uint32, blockID string, message string) {
+			issueHeights[blockNum] = true
+			if blockID == "" {
+				fmt.Printf("❌ %s at block #%d\n", message, blockNum)
+				return
+			}
+			fmt.Printf("❌ %s at block #%d (%s)\n", message, blockNum, blockID)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Println()
+	if len(issueHeights) == 0 {
+		fmt.Printf("🆗 All good, no problem detected\n")
+		return nil
+	}
+
+	fmt.Printf("🆘 Problem(s) detected at %d block heights!\n", len(issueHeights))
+	if !repair {
+		return nil
+	}
+
+	db.SetWriterChainID(chainID)
+	return repairTrxdb(db, blocksStore, issueHeights)
+}
+
+var mergedBlocksFilenameRegex = regexp.MustCompile(`^(\d{10})$`)
+
+// compareMergedBlocks ensures every merged block exists in trxdb and that the irreversible
+// blocks of trxdb are among the merged blocks, heights at or below the last irreversible block
+// of trxdb must have an irreversible block.
+func compareMergedBlocks(ctx context.Context, db *kv.DB, blocksStore dstore.Store, lowBlockNum, highBlockNum uint32, onIssue func(blockNum uint32, blockID string, message string)) error {
+	libNum := uint32(0)
+	libRef, err := db.GetLastWrittenIrreversibleBlockRef(ctx)
+	if err != nil && err != kvdb.ErrNotFound {
+		return fmt.Errorf("get last irreversible block: %w", err)
+	}
+	if libRef != nil {
+		libNum = uint32(libRef.Num())
+	}
+
+	fileBlockSize := uint32(100)
+	walkPrefix := walkBlockPrefix(BlockRange{uint64(lowBlockNum), uint64(highBlockNum) + 1}, fileBlockSize)
+
+	err = blocksStore.Walk(ctx, walkPrefix, ".tmp", func(filename string) error {
+		match := mergedBlocksFilenameRegex.FindStringSubmatch(filename)
+		if match == nil {
+			return nil
+		}
+
+		baseNum, _ := strconv.ParseUint(match[1], 10, 32)
+		fileLow, fileHigh := uint32(baseNum), uint32(baseNum)+fileBlockSize-1
+		if fileHigh < lowBlockNum {
+			return nil
+		}
+		if fileLow > highBlockNum {
+			return errStopWalk
+		}
+
+		if fileLow < lowBlockNum {
+			fileLow = lowBlockNum
+		}
+		if fileHigh > highBlockNum {
+			fileHigh = highBlockNum
+		}
+
+		mergedIDs, err := readMergedBlockIDs(ctx, blocksStore, filename, fileLow, fileHigh)
+		if err != nil {
+			return err
+		}
+
+		blocks, err := db.ListBlocksInRange(ctx, fileLow, fileHigh, trxdb.BlockRangeAllForks)
+		if err != nil {
+			return fmt.Errorf("list trxdb blocks: %w", err)
+		}
+
+		known := map[string]bool{}
+		irreversibleHeights := map[uint32]bool{}
+		for _, blk := range blocks {
+			known[blk.Id] = true
+			if !blk.Irreversible {
+				continue
+			}
+
+			irreversibleHeights[blk.Block.Number] = true
+			if ids, found := mergedIDs[blk.Block.Number]; found && !ids[blk.Id] {
+				onIssue(blk.Block.Number, blk.Id, "irreversible block not found in merged blocks")
+			}
+		}
+
+		heights := make([]uint32, 0, len(mergedIDs))
+		for num := range mergedIDs {
+			heights = append(heights, num)
+		}
+		sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+
+		for _, num := range heights {
+			for id := range mergedIDs[num] {
+				if !known[id] {
+					onIssue(num, id, "merged block missing from trxdb")
+				}
+			}
+
+			if num <= libNum && !irreversibleHeights[num] {
+				onIssue(num, "", "no irreversible block in trxdb at height below last irreversible block")
+			}
+		}
+
+		return nil
+	})
+	if err != nil && err != errStopWalk {
+		return err
+	}
+
+	return nil
+}
+
+func readMergedBlockIDs(ctx context.Context, blocksStore dstore.Store, filename string, lowBlockNum, highBlockNum uint32) (map[uint32]map[string]bool, error) {
+	reader, err := blocksStore.OpenObject(ctx, filename)
+	if err != nil {
+		return nil, fmt.Errorf("open merged blocks %s: %w", filename, err)
+	}
+	defer reader.Close()
+
+	blockReader, err := bstream.GetBlockReaderFactory.New(reader)
+	if err != nil {
+		return nil, fmt.Errorf("read merged blocks %s: %w", filename, err)
+	}
+
+	out := map[uint32]map[string]bool{}
+	for {
+		block, err := blockReader.Read()
+		if block != nil {
+			num := uint32(block.Number)
+			if num >= lowBlockNum && num <= highBlockNum {
+				if out[num] == nil {
+					out[num] = map[string]bool{}
+				}
+				out[num][block.Id] = true
+			}
+		}
+
+		if err == io.EOF {
+			return out, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read merged blocks %s: %w", filename, err)
+		}
+	}
+}
+
+// repairTrxdb re-injects, through the trxdb-loader patch pipeline, every block of the given
+// heights and marks them irreversible again once the merged blocks make them so. The loader
+// only stops once a block past the highest height is irreversible, it's stopped when no
+// merged block was read for `--repair-idle-timeout` instead of waiting forever for merged
+// blocks files that will never come. The heights not repaired are reported in the error.
+func repairTrxdb(db *kv.DB, blocksStore dstore.Store, heights map[uint32]bool) error {
+	lowBlockNum, highBlockNum := uint32(0), uint32(0)
+	for num := range heights {
+		if lowBlockNum == 0 || num < lowBlockNum {
+			lowBlockNum = num
+		}
+		if num > highBlockNum {
+			highBlockNum = num
+		}
+	}
+
+	fmt.Printf("Repairing %d block heights between #%d and #%d from merged blocks\n", len(heights), lowBlockNum, highBlockNum)
+
+	var lock sync.Mutex
+	lastRead := time.Now()
+	injected := map[uint32]bool{}
+	markedIrreversible := map[uint32]bool{}
+
+	loader := trxdbloader.NewTrxDBLoader("", blocksStore, 1000, db, viper.GetInt("parallel-file-download-count"), nil, 0, nil)
+	loader.SetPatchFunc(func(ctx context.Context, writer trxdb.DBWriter, blk *pbcodec.Block, step forkable.StepType) error {
+		lock.Lock()
+		defer lock.Unlock()
+
+		lastRead = time.Now()
+		if !heights[blk.Number] {
+			return nil
+		}
+
+		switch step {
+		case forkable.StepNew:
+			injected[blk.Number] = true
+			zlog.Debug("re-injecting block", zap.Stringer("block", blk.AsRef()))
+			return writer.PutBlock(ctx, blk)
+		case forkable.StepIrreversible:
+			markedIrreversible[blk.Number] = true
+			zlog.Debug("marking block irreversible", zap.Stringer("block", blk.AsRef()))
+			return writer.UpdateNowIrreversibleBlock(ctx, blk)
+		}
+
+		return nil
+	})
+
+	loader.StopBeforeBlock(uint64(highBlockNum) + 1)
+	loader.BuildPipelinePatch(uint64(lowBlockNum), viper.GetUint64("num-blocks-before-start"))
+
+	idleTimeout := viper.GetDuration("repair-idle-timeout")
+	if idleTimeout <= 0 {
+		return fmt.Errorf("--repair-idle-timeout must be greater than 0")
+	}
+
+	idleCheck := time.NewTicker(idleTimeout / 10)
+	defer idleCheck.Stop()
+
+	go loader.Launch()
+
+	stoppedIdle := false
+wait:
+	for {
+		select {
+		case <-loader.Terminated():
+			break wait
+		case <-idleCheck.C:
+			lock.Lock()
+			idle := time.Since(lastRead)
+			lock.Unlock()
+
+			if idle >= idleTimeout {
+				fmt.Printf("No merged block read for %s, the merged blocks ran out, stopping\n", idleTimeout)
+				stoppedIdle = true
+				loader.Shutdown(nil)
+				<-loader.Terminated()
+				break wait
+			}
+		}
+	}
+
+	if err := loader.Err(); err != nil {
+		return fmt.Errorf("repair: %w", err)
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	if stoppedIdle {
+		// The loader only flushes when reaching its end block
+		if err := db.Flush(context.Background()); err != nil {
+			return fmt.Errorf("repair: flush: %w", err)
+		}
+	}
+
+	var notInjected, notIrreversible []uint32
+	for num := range heights {
+		if !injected[num] {
+			notInjected = append(notInjected, num)
+		} else if !markedIrreversible[num] {
+			notIrreversible = append(notIrreversible, num)
+		}
+	}
+	sort.Slice(notInjected, func(i, j int) bool { return notInjected[i] < notInjected[j] })
+	sort.Slice(notIrreversible, func(i, j int) bool { return notIrreversible[i] < notIrreversible[j] })
+
+	fmt.Printf("✅ Re-injected the blocks of %d heights, run the verification again to confirm\n", len(injected))
+	for _, num := range notInjected {
+		fmt.Printf("❌ Block height #%d not repaired, no block of this height read from the merged blocks\n", num)
+	}
+	for _, num := range notIrreversible {
+		fmt.Printf("❌ Block height #%d re-injected but not marked irreversible, the merged blocks did not make it irreversible\n", num)
+	}
+
+	if unrepaired := len(notInjected) + len(notIrreversible); unrepaired > 0 {
+		return fmt.Errorf("unable to fully repair %d of %d block heights", unrepaired, len(heights))
+	}
+
+	return nil
+}
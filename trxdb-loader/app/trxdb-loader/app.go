@@ -17,6 +17,7 @@ package trxdb_loader
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
@@ -44,7 +45,7 @@ type Config struct {
 	NumBlocksBeforeStart      uint64 // [BATCH] Number of blocks to fetch before start block
 	ParallelFileDownloadCount int    // Number of threads of parallel file download
 	AllowLiveOnEmptyTable     bool   // [LIVE] force pipeline creation if live request and table is empty
-	HTTPListenAddr            string //  http listen address for /healthz endpoint
+	HTTPListenAddr            string //  http listen address for /healthz and /v1/purge_report endpoints
 	EnableTruncationMarker    bool   // Enables the storage of truncation markers
 	TruncationWindow          uint64 // Truncate date within this duration
 	PurgerInterval            uint64 // Purger at every X block
@@ -100,6 +101,10 @@ func (a *App) Run() error {
 		return fmt.Errorf("unable to create trxdb: %w", err)
 	}
 
+	if holder, ok := db.(trxdb.TTLPolicyHolder); ok && holder.HasTTLPolicies() && !a.config.EnableTruncationMarker {
+		return fmt.Errorf("the ttl option of the trxdb dsn requires truncation to be enabled, the ttl policies would be ignored otherwise")
+	}
+
 	db.SetWriterChainID(chainID)
 
 	loader := trxdbloader.NewTrxDBLoader(
@@ -127,9 +132,26 @@ func (a *App) Run() error {
 		return fmt.Errorf("unable to create error logger: %w", err)
 	}
 
+	purgeReportHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reporter, ok := db.(trxdb.PurgeReporter)
+		if !ok || reporter.PurgeReport() == nil {
+			http.Error(w, "purging is not enabled", http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(reporter.PurgeReport()); err != nil {
+			zlog.Warn("unable to write purge report", zap.Error(err))
+		}
+	})
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/purge_report", purgeReportHandler)
+	mux.Handle("/", healthzHandler)
+
 	httpSrv := &http.Server{
 		Addr:     a.config.HTTPListenAddr,
-		Handler:  healthzHandler,
+		Handler:  mux,
 		ErrorLog: errorLogger,
 	}
 	zlog.Info("starting webserver", zap.String("http_addr", a.config.HTTPListenAddr))
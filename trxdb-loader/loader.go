@@ -36,6 +36,10 @@ import (
 
 type Job = func(blockNum uint64, blk *pbcodec.Block, fObj *forkable.ForkableObject) (err error)
 
+// PatchFunc is called by `PatchJob` with every new block and every block becoming
+// irreversible, the step being respectively `forkable.StepNew` and `forkable.StepIrreversible`.
+type PatchFunc = func(ctx context.Context, db trxdb.DBWriter, blk *pbcodec.Block, step forkable.StepType) error
+
 type TrxDBLoader struct {
 	*shutter.Shutter
 	processingJob             Job
@@ -54,6 +58,7 @@ type TrxDBLoader struct {
 	healthy                   bool
 	truncationWindow          uint64
 	blockmeta                 pbblockmeta.BlockIDClient
+	patchFunc                 PatchFunc
 }
 
 func NewTrxDBLoader(
@@ -203,6 +208,12 @@ func (l *TrxDBLoader) BuildPipelinePatch(startBlockNum uint64, numBlocksBeforeSt
 	l.BuildPipelineJob(startBlockNum, numBlocksBeforeStart, l.PatchJob)
 }
 
+// SetPatchFunc defines the patch applied by the patch pipeline, when not set the
+// patch pipeline only walks the blocks.
+func (l *TrxDBLoader) SetPatchFunc(f PatchFunc) {
+	l.patchFunc = f
+}
+
 func (l *TrxDBLoader) BuildPipelineJob(startBlockNum uint64, numBlocksBeforeStart uint64, job Job) {
 	l.processingJob = job
 
@@ -445,10 +456,19 @@ func (l *TrxDBLoader) UpdateIrreversibleData(nowIrreversibleBlocks []*bstream.Pr
 // `patch-<tag>-<date>` where the tag is giving an overview of the patch and the date
 // is the effective date (`<year>-<month>-<day>`): `patch-add-trx-meta-written-2019-06-30`.
 // The branch is then deleted and the tag is pushed to the remote repository.
+//
+// Reusable patches, like the rows re-injection of `dfuseeos tools trxdb verify --repair`,
+// are instead provided through `SetPatchFunc`.
 func (l *TrxDBLoader) PatchJob(blockNum uint64, blk *pbcodec.Block, fObj *forkable.ForkableObject) (err error) {
 	switch fObj.Step {
 	case forkable.StepNew:
 		l.ShowProgress(blockNum)
+		if l.patchFunc != nil {
+			if err := l.patchFunc(context.Background(), l.db, blk, fObj.Step); err != nil {
+				return fmt.Errorf("patch block %s: %w", blk.AsRef(), err)
+			}
+		}
+
 		return l.FlushIfNeeded(blockNum, blk.MustTime())
 
 	case forkable.StepIrreversible:
@@ -461,6 +481,12 @@ func (l *TrxDBLoader) PatchJob(blockNum uint64, blk *pbcodec.Block, fObj *forkab
 			l.Shutdown(nil)
 			return nil
 		}
+
+		if l.patchFunc != nil {
+			if err := l.patchFunc(context.Background(), l.db, blk, fObj.Step); err != nil {
+				return fmt.Errorf("patch irreversible block %s: %w", blk.AsRef(), err)
+			}
+		}
 	}
 
 	return nil
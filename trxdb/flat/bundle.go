@@ -0,0 +1,348 @@
+package flat
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/kvdb/store"
+)
+
+const (
+	bundleMagic   = "TRXDBKV"
+	bundleVersion = 1
+
+	indexMagic   = "TRXDBIX"
+	indexVersion = 1
+)
+
+var bundleFilenameRegex = regexp.MustCompile(`^(\d{10})-(\d{10})\.kvb$`)
+
+// Bundle holds, sorted by key, the trxdb rows of every block (forked ones
+// included) in the inclusive range [LowBlockNum, HighBlockNum]. Bundles are
+// immutable once written.
+type Bundle struct {
+	LowBlockNum  uint32
+	HighBlockNum uint32
+
+	kvs []store.KV
+}
+
+func NewBundle(lowBlockNum, highBlockNum uint32, kvs []store.KV) *Bundle {
+	sorted := make([]store.KV, len(kvs))
+	copy(sorted, kvs)
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i].Key, sorted[j].Key) < 0 })
+
+	return &Bundle{LowBlockNum: lowBlockNum, HighBlockNum: highBlockNum, kvs: sorted}
+}
+
+func (b *Bundle) Len() int {
+	return len(b.kvs)
+}
+
+func (b *Bundle) String() string {
+	return bundleName(b.LowBlockNum, b.HighBlockNum)
+}
+
+func (b *Bundle) get(key []byte) ([]byte, bool) {
+	i := b.seek(key)
+	if i < len(b.kvs) && bytes.Equal(b.kvs[i].Key, key) {
+		return b.kvs[i].Value, true
+	}
+	return nil, false
+}
+
+// seek returns the index of the first row whose key is greater or equal to `key`
+func (b *Bundle) seek(key []byte) int {
+	return sort.Search(len(b.kvs), func(i int) bool { return bytes.Compare(b.kvs[i].Key, key) >= 0 })
+}
+
+// scan returns at most `limit` rows (unbounded when `limit` is <= 0) in [start, exclusiveEnd)
+func (b *Bundle) scan(start, exclusiveEnd []byte, limit int) (out []store.KV) {
+	for i := b.seek(start); i < len(b.kvs); i++ {
+		if len(exclusiveEnd) > 0 && bytes.Compare(b.kvs[i].Key, exclusiveEnd) >= 0 {
+			break
+		}
+		if store.Limit(limit).Reached(uint64(len(out))) {
+			break
+		}
+		out = append(out, b.kvs[i])
+	}
+	return
+}
+
+// trxPrefixes returns the sorted and unique first 4 bytes of the transaction ID of every
+// transaction keyed row of the bundle.
+func (b *Bundle) trxPrefixes() (out []uint32) {
+	seen := map[uint32]bool{}
+	for _, kv := range b.kvs {
+		if !isTrxTable(kv.Key[0]) || len(kv.Key) < 5 {
+			continue
+		}
+
+		prefix := binary.BigEndian.Uint32(kv.Key[1:5])
+		if !seen[prefix] {
+			seen[prefix] = true
+			out = append(out, prefix)
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return
+}
+
+func (b *Bundle) MarshalBinary() ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	buf.WriteString(bundleMagic)
+	buf.WriteByte(bundleVersion)
+
+	var scratch [binary.MaxVarintLen64]byte
+	writeUvarint := func(v uint64) {
+		buf.Write(scratch[:binary.PutUvarint(scratch[:], v)])
+	}
+
+	writeUvarint(uint64(b.LowBlockNum))
+	writeUvarint(uint64(b.HighBlockNum))
+	writeUvarint(uint64(len(b.kvs)))
+	for _, kv := range b.kvs {
+		writeUvarint(uint64(len(kv.Key)))
+		buf.Write(kv.Key)
+		writeUvarint(uint64(len(kv.Value)))
+		buf.Write(kv.Value)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (b *Bundle) UnmarshalBinary(data []byte) error {
+	if len(data) < len(bundleMagic)+1 || string(data[:len(bundleMagic)]) != bundleMagic {
+		return fmt.Errorf("invalid bundle, magic header not found")
+	}
+	if version := data[len(bundleMagic)]; version != bundleVersion {
+		return fmt.Errorf("unsupported bundle version %d, expected %d", version, bundleVersion)
+	}
+
+	reader := bufio.NewReader(bytes.NewReader(data[len(bundleMagic)+1:]))
+	low, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return fmt.Errorf("read low block num: %w", err)
+	}
+	high, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return fmt.Errorf("read high block num: %w", err)
+	}
+	count, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return fmt.Errorf("read row count: %w", err)
+	}
+
+	readBytes := func() ([]byte, error) {
+		length, err := binary.ReadUvarint(reader)
+		if err != nil {
+			return nil, err
+		}
+		if length > uint64(len(data)) {
+			return nil, fmt.Errorf("length %d larger than bundle", length)
+		}
+
+		out := make([]byte, length)
+		_, err = io.ReadFull(reader, out)
+		return out, err
+	}
+
+	b.LowBlockNum = uint32(low)
+	b.HighBlockNum = uint32(high)
+	b.kvs = make([]store.KV, 0, count)
+	for i := uint64(0); i < count; i++ {
+		key, err := readBytes()
+		if err != nil {
+			return fmt.Errorf("read key of row %d: %w", i, err)
+		}
+		value, err := readBytes()
+		if err != nil {
+			return fmt.Errorf("read value of row %d: %w", i, err)
+		}
+
+		b.kvs = append(b.kvs, store.KV{Key: key, Value: value})
+	}
+
+	return nil
+}
+
+// trxIndexPrefixLen is the length of the transaction ID prefixes listed by a `trxIndex`
+const trxIndexPrefixLen = 4
+
+// trxIndex is the companion of a bundle listing the 4 bytes prefixes of the transaction
+// IDs it contains. It's small enough to be consulted before downloading the bundle itself.
+type trxIndex struct {
+	prefixes []uint32
+}
+
+func (i *trxIndex) mayContain(trxIDPrefix []byte) bool {
+	value := binary.BigEndian.Uint32(trxIDPrefix[0:trxIndexPrefixLen])
+	pos := sort.Search(len(i.prefixes), func(j int) bool { return i.prefixes[j] >= value })
+	return pos < len(i.prefixes) && i.prefixes[pos] == value
+}
+
+func (i *trxIndex) MarshalBinary() ([]byte, error) {
+	out := make([]byte, len(indexMagic)+1+4*len(i.prefixes))
+	copy(out, indexMagic)
+	out[len(indexMagic)] = indexVersion
+	for j, prefix := range i.prefixes {
+		binary.BigEndian.PutUint32(out[len(indexMagic)+1+4*j:], prefix)
+	}
+	return out, nil
+}
+
+func (i *trxIndex) UnmarshalBinary(data []byte) error {
+	headerLen := len(indexMagic) + 1
+	if len(data) < headerLen || string(data[:len(indexMagic)]) != indexMagic {
+		return fmt.Errorf("invalid bundle index, magic header not found")
+	}
+	if version := data[len(indexMagic)]; version != indexVersion {
+		return fmt.Errorf("unsupported bundle index version %d, expected %d", version, indexVersion)
+	}
+	if (len(data)-headerLen)%4 != 0 {
+		return fmt.Errorf("invalid bundle index, content length %d is not a multiple of 4", len(data)-headerLen)
+	}
+
+	i.prefixes = make([]uint32, (len(data)-headerLen)/4)
+	for j := range i.prefixes {
+		i.prefixes[j] = binary.BigEndian.Uint32(data[headerLen+4*j:])
+	}
+	return nil
+}
+
+func bundleName(lowBlockNum, highBlockNum uint32) string {
+	return fmt.Sprintf("%010d-%010d", lowBlockNum, highBlockNum)
+}
+
+func bundleFilename(lowBlockNum, highBlockNum uint32) string {
+	return bundleName(lowBlockNum, highBlockNum) + ".kvb"
+}
+
+func indexFilename(lowBlockNum, highBlockNum uint32) string {
+	return bundleName(lowBlockNum, highBlockNum) + ".idx"
+}
+
+// NewBundlesStore returns the dstore holding the bundles and their indexes
+func NewBundlesStore(bundlesStoreURL string) (dstore.Store, error) {
+	return dstore.NewStore(bundlesStoreURL, "", "zstd", false)
+}
+
+// WriteBundle writes the bundle and its index to the store, the index is written
+// last so that a bundle is only considered once completely written.
+func WriteBundle(ctx context.Context, bundles dstore.Store, bundle *Bundle) error {
+	content, err := bundle.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("encode bundle %s: %w", bundle, err)
+	}
+
+	if err := bundles.WriteObject(ctx, bundleFilename(bundle.LowBlockNum, bundle.HighBlockNum), bytes.NewReader(content)); err != nil {
+		return fmt.Errorf("write bundle %s: %w", bundle, err)
+	}
+
+	index, err := (&trxIndex{prefixes: bundle.trxPrefixes()}).MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("encode bundle %s index: %w", bundle, err)
+	}
+
+	if err := bundles.WriteObject(ctx, indexFilename(bundle.LowBlockNum, bundle.HighBlockNum), bytes.NewReader(index)); err != nil {
+		return fmt.Errorf("write bundle %s index: %w", bundle, err)
+	}
+
+	return nil
+}
+
+func readBundle(ctx context.Context, bundles dstore.Store, ref bundleRef) (*Bundle, error) {
+	content, err := readObject(ctx, bundles, bundleFilename(ref.low, ref.high))
+	if err != nil {
+		return nil, fmt.Errorf("read bundle %s: %w", ref, err)
+	}
+
+	bundle := &Bundle{}
+	if err := bundle.UnmarshalBinary(content); err != nil {
+		return nil, fmt.Errorf("decode bundle %s: %w", ref, err)
+	}
+
+	return bundle, nil
+}
+
+func readTrxIndex(ctx context.Context, bundles dstore.Store, ref bundleRef) (*trxIndex, error) {
+	content, err := readObject(ctx, bundles, indexFilename(ref.low, ref.high))
+	if err != nil {
+		return nil, fmt.Errorf("read bundle %s index: %w", ref, err)
+	}
+
+	index := &trxIndex{}
+	if err := index.UnmarshalBinary(content); err != nil {
+		return nil, fmt.Errorf("decode bundle %s index: %w", ref, err)
+	}
+
+	return index, nil
+}
+
+func readObject(ctx context.Context, bundles dstore.Store, filename string) ([]byte, error) {
+	reader, err := bundles.OpenObject(ctx, filename)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return ioutil.ReadAll(reader)
+}
+
+type bundleRef struct {
+	low, high uint32
+}
+
+func (r bundleRef) String() string {
+	return bundleName(r.low, r.high)
+}
+
+func (r bundleRef) intersects(low, high uint32) bool {
+	return r.low <= high && r.high >= low
+}
+
+// listBundles returns the complete bundles (those with an index) found in the store, sorted by block range.
+func listBundles(ctx context.Context, bundles dstore.Store) (out []bundleRef, err error) {
+	indexed := map[string]bool{}
+	var candidates []bundleRef
+
+	err = bundles.Walk(ctx, "", ".tmp", func(filename string) error {
+		if len(filename) > 4 && filename[len(filename)-4:] == ".idx" {
+			indexed[filename[:len(filename)-4]] = true
+			return nil
+		}
+
+		match := bundleFilenameRegex.FindStringSubmatch(filename)
+		if match == nil {
+			return nil
+		}
+
+		low, _ := strconv.ParseUint(match[1], 10, 32)
+		high, _ := strconv.ParseUint(match[2], 10, 32)
+		candidates = append(candidates, bundleRef{low: uint32(low), high: uint32(high)})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list bundles: %w", err)
+	}
+
+	for _, candidate := range candidates {
+		if indexed[candidate.String()] {
+			out = append(out, candidate)
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].low < out[j].low })
+	return out, nil
+}
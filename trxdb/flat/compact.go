@@ -0,0 +1,258 @@
+package flat
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/kvdb/store"
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+	pbtrxdb "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/trxdb/v1"
+	"github.com/zhongshuwen/histnew/trxdb"
+	"github.com/zhongshuwen/histnew/trxdb/kv"
+	zsw "github.com/zhongshuwen/zswchain-go"
+	"go.uber.org/zap"
+)
+
+// Compactor moves the rows of old irreversible blocks out of a hot store into bundles.
+// Bundles are aligned on `bundleSize` and only written for complete ranges, a range
+// with no block in the hot store is skipped.
+//
+// The hot store must hold both the block and the transaction tables of the blocks.
+type Compactor struct {
+	hot        store.KVStore
+	bundles    dstore.Store
+	bundleSize uint32
+	dec        *trxdb.ProtoDecoder
+
+	// KeepHotRows leaves the bundled rows in the hot store, useful to validate
+	// bundles before freeing the hot store.
+	KeepHotRows bool
+}
+
+func NewCompactor(hot store.KVStore, bundles dstore.Store, bundleSize uint32) *Compactor {
+	return &Compactor{
+		hot:        hot,
+		bundles:    bundles,
+		bundleSize: bundleSize,
+		dec:        trxdb.NewProtoDecoder(),
+	}
+}
+
+// Compact bundles every complete range of blocks ending more than `keepBlocks` below the
+// last irreversible block of the hot store. It returns the bundles written.
+func (c *Compactor) Compact(ctx context.Context, keepBlocks uint32) (out []*Bundle, err error) {
+	libNum, found, err := c.lastIrreversibleBlockNum(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !found || libNum < keepBlocks {
+		zlog.Info("nothing to compact", zap.Bool("has_irreversible_block", found), zap.Uint32("lib_num", libNum))
+		return nil, nil
+	}
+	cutoff := libNum - keepBlocks
+
+	lowestNum, found, err := c.lowestBlockNum(ctx, libNum)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, nil
+	}
+
+	refs, err := listBundles(ctx, c.bundles)
+	if err != nil {
+		return nil, err
+	}
+
+	low := lowestNum - lowestNum%c.bundleSize
+	if len(refs) > 0 && refs[len(refs)-1].high >= low {
+		low = refs[len(refs)-1].high + 1
+	}
+
+	for ; low+c.bundleSize-1 < cutoff; low += c.bundleSize {
+		high := low + c.bundleSize - 1
+
+		bundle, err := c.compactRange(ctx, low, high)
+		if err != nil {
+			return out, fmt.Errorf("compact blocks [%d, %d]: %w", low, high, err)
+		}
+		if bundle != nil {
+			out = append(out, bundle)
+		}
+	}
+
+	return out, nil
+}
+
+func (c *Compactor) compactRange(ctx context.Context, low, high uint32) (*Bundle, error) {
+	kvs, err := c.rangeRows(ctx, low, high)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(kvs) == 0 {
+		zlog.Debug("no rows in range, skipping", zap.Uint32("low_block_num", low), zap.Uint32("high_block_num", high))
+		return nil, nil
+	}
+
+	bundle := NewBundle(low, high, kvs)
+	if err := WriteBundle(ctx, c.bundles, bundle); err != nil {
+		return nil, err
+	}
+
+	zlog.Info("bundle written", zap.Stringer("bundle", bundle), zap.Int("row_count", bundle.Len()))
+	if c.KeepHotRows {
+		return bundle, nil
+	}
+
+	keys := make([][]byte, len(kvs))
+	for i, row := range kvs {
+		keys[i] = row.Key
+	}
+
+	if err := c.hot.BatchDelete(ctx, keys); err != nil {
+		return nil, fmt.Errorf("delete bundled rows from hot store: %w", err)
+	}
+
+	return bundle, nil
+}
+
+// rangeRows returns every row related to the blocks in [low, high], forked blocks included
+func (c *Compactor) rangeRows(ctx context.Context, low, high uint32) (out []store.KV, err error) {
+	blockRows, err := collect(c.hot.Scan(ctx, kv.Keys.PackBlockNumPrefix(high), blockNumRangeEnd(kv.Keys.PackBlockNumPrefix, kv.Keys.EndOfBlocksTable(), low), store.Unlimited))
+	if err != nil {
+		return nil, fmt.Errorf("scan blocks: %w", err)
+	}
+
+	irrRows, err := collect(c.hot.Scan(ctx, kv.Keys.PackIrrBlockNumPrefix(high), blockNumRangeEnd(kv.Keys.PackIrrBlockNumPrefix, kv.Keys.EndOfIrrBlockTable(), low), store.Unlimited))
+	if err != nil {
+		return nil, fmt.Errorf("scan irreversible blocks: %w", err)
+	}
+
+	out = append(out, blockRows...)
+	out = append(out, irrRows...)
+
+	var trxKeys, traceKeys [][]byte
+	for _, row := range blockRows {
+		blockRow := &pbtrxdb.BlockRow{}
+		if err := c.dec.Into(row.Value, blockRow); err != nil {
+			return nil, fmt.Errorf("decode block row: %w", err)
+		}
+
+		blockID := kv.Keys.UnpackBlocksKey(row.Key)
+		for _, hash := range refHashes(blockRow.TrxRefs) {
+			trxKeys = append(trxKeys, kv.Keys.PackTrxsKey(hex.EncodeToString(hash), blockID))
+		}
+		for _, hash := range refHashes(blockRow.ImplicitTrxRefs) {
+			trxKeys = append(trxKeys, kv.Keys.PackImplicitTrxsKey(hex.EncodeToString(hash), blockID))
+		}
+		for _, hash := range refHashes(blockRow.TraceRefs) {
+			traceKeys = append(traceKeys, kv.Keys.PackTrxTracesKey(hex.EncodeToString(hash), blockID))
+		}
+	}
+
+	// Keys are full length, a prefix lookup returns only the existing ones where a batch get would stop at the first missing row
+	trxRows, err := c.existingRows(ctx, trxKeys)
+	if err != nil {
+		return nil, fmt.Errorf("fetch transactions: %w", err)
+	}
+
+	traceRows, err := c.existingRows(ctx, traceKeys)
+	if err != nil {
+		return nil, fmt.Errorf("fetch transaction traces: %w", err)
+	}
+
+	out = append(out, trxRows...)
+	out = append(out, traceRows...)
+
+	var dtrxPrefixes [][]byte
+	for _, row := range traceRows {
+		traceRow := &pbtrxdb.TrxTraceRow{}
+		if err := c.dec.Into(row.Value, traceRow); err != nil {
+			return nil, fmt.Errorf("decode transaction trace row: %w", err)
+		}
+
+		_, blockID := kv.Keys.UnpackTrxTracesKey(row.Key)
+		for _, dtrxOp := range traceRow.TrxTrace.GetDtrxOps() {
+			dtrxPrefixes = append(dtrxPrefixes, kv.Keys.PackDtrxsPrefix(dtrxOp.TransactionId+blockID))
+		}
+	}
+
+	dtrxRows, err := c.existingRows(ctx, dtrxPrefixes)
+	if err != nil {
+		return nil, fmt.Errorf("fetch deferred transactions: %w", err)
+	}
+
+	return append(out, dtrxRows...), nil
+}
+
+func (c *Compactor) existingRows(ctx context.Context, prefixes [][]byte) ([]store.KV, error) {
+	if len(prefixes) == 0 {
+		return nil, nil
+	}
+
+	return collect(c.hot.BatchPrefix(ctx, prefixes, store.Unlimited))
+}
+
+func (c *Compactor) lastIrreversibleBlockNum(ctx context.Context) (uint32, bool, error) {
+	rows, err := collect(c.hot.Scan(ctx, kv.Keys.StartOfIrrBlockTable(), kv.Keys.EndOfIrrBlockTable(), 1, store.KeyOnly()))
+	if err != nil || len(rows) == 0 {
+		return 0, false, err
+	}
+
+	return zsw.BlockNum(kv.Keys.UnpackIrrBlocksKey(rows[0].Key)), true, nil
+}
+
+// lowestBlockNum binary searches the lowest block num of the hot store, stores cannot scan
+// backward and the blocks table is sorted from the highest block num.
+func (c *Compactor) lowestBlockNum(ctx context.Context, upperBound uint32) (uint32, bool, error) {
+	hasBlockAtOrBelow := func(num uint32) (bool, error) {
+		rows, err := collect(c.hot.Scan(ctx, kv.Keys.PackBlockNumPrefix(num), kv.Keys.EndOfBlocksTable(), 1, store.KeyOnly()))
+		return len(rows) > 0, err
+	}
+
+	found, err := hasBlockAtOrBelow(upperBound)
+	if err != nil || !found {
+		return 0, false, err
+	}
+
+	low, high := uint32(0), upperBound
+	for low < high {
+		mid := low + (high-low)/2
+		found, err := hasBlockAtOrBelow(mid)
+		if err != nil {
+			return 0, false, err
+		}
+
+		if found {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+
+	return low, true, nil
+}
+
+func blockNumRangeEnd(packPrefix func(uint32) []byte, endOfTable []byte, low uint32) []byte {
+	if low == 0 {
+		return endOfTable
+	}
+	return packPrefix(low - 1)
+}
+
+func refHashes(refs *pbcodec.TransactionRefs) [][]byte {
+	if refs == nil {
+		return nil
+	}
+	return refs.Hashes
+}
+
+func collect(it *store.Iterator) (out []store.KV, err error) {
+	for it.Next() {
+		out = append(out, it.Item())
+	}
+	return out, it.Err()
+}
@@ -0,0 +1,240 @@
+package flat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/streamingfast/kvdb/store"
+	_ "github.com/streamingfast/kvdb/store/badger"
+	"github.com/streamingfast/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ct "github.com/zhongshuwen/histnew/codec/testing"
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+	"github.com/zhongshuwen/histnew/trxdb"
+	"github.com/zhongshuwen/histnew/trxdb/kv"
+	"github.com/zhongshuwen/histnew/trxdb/trxdbtest"
+	"go.uber.org/zap"
+)
+
+func init() {
+	if os.Getenv("DEBUG") != "" || os.Getenv("TRACE") == "true" {
+		logger, _ := zap.NewDevelopment()
+		logging.Override(logger)
+	}
+}
+
+func TestAll_Tiered(t *testing.T) {
+	trxdbtest.TestAll(t, "tiered", func() (trxdb.DB, trxdbtest.DriverCleanupFunc) {
+		db, err := kv.New([]string{tieredDSN(t.TempDir(), t.TempDir())})
+		require.NoError(t, err)
+
+		return db, func() { db.Close() }
+	})
+}
+
+func TestBundle_MarshalRoundTrip(t *testing.T) {
+	bundle := NewBundle(10, 19, []store.KV{
+		{Key: []byte{kv.TblPrefixTrxs, 0xbb, 0x01, 0x02, 0x03, 0x04}, Value: []byte("b")},
+		{Key: []byte{kv.TblPrefixTrxs, 0xaa, 0x01, 0x02, 0x03}, Value: []byte("a")},
+		{Key: []byte{kv.TblPrefixBlocks, 0x01}, Value: nil},
+	})
+
+	content, err := bundle.MarshalBinary()
+	require.NoError(t, err)
+
+	decoded := &Bundle{}
+	require.NoError(t, decoded.UnmarshalBinary(content))
+	assert.Equal(t, uint32(10), decoded.LowBlockNum)
+	assert.Equal(t, uint32(19), decoded.HighBlockNum)
+	require.Equal(t, 3, decoded.Len())
+
+	value, found := decoded.get([]byte{kv.TblPrefixTrxs, 0xaa, 0x01, 0x02, 0x03})
+	assert.True(t, found)
+	assert.Equal(t, []byte("a"), value)
+
+	rows := decoded.scan([]byte{kv.TblPrefixTrxs}, []byte{kv.TblPrefixTrxs + 1}, 0)
+	require.Len(t, rows, 2)
+	assert.Equal(t, []byte("a"), rows[0].Value)
+	assert.Equal(t, []byte("b"), rows[1].Value)
+
+	index := &trxIndex{prefixes: decoded.trxPrefixes()}
+	assert.True(t, index.mayContain([]byte{0xbb, 0x01, 0x02, 0x03}))
+	assert.False(t, index.mayContain([]byte{0xaa, 0x01, 0x02, 0x04}))
+
+	assert.Error(t, decoded.UnmarshalBinary([]byte("garbage")))
+}
+
+func TestCompactor_Compact(t *testing.T) {
+	ctx := context.Background()
+	hotDir, coldDir := t.TempDir(), t.TempDir()
+
+	trxIDs := map[uint32]string{}
+	db, err := kv.New([]string{"badger://" + hotDir})
+	require.NoError(t, err)
+	for num := uint32(1); num <= 8; num++ {
+		trxIDs[num] = fmt.Sprintf("%02xbc5790ef36d5779e2a0a849a11c09c999b5dc564afce6920e20b07af1f4b6a", num)
+		blk := ct.Block(t, fmt.Sprintf("%08xaa000000000000000000000000000000000000000000000000000000", num),
+			ct.TrxTrace(t, ct.TrxID(trxIDs[num]),
+				ct.DtrxOp(t, "create", trxIDs[num], ct.DtrxOpPayer("eoscanada1"), &pbcodec.SignedTransaction{Signatures: []string{"signature"}}),
+			),
+		)
+
+		require.NoError(t, db.PutBlock(ctx, blk))
+		require.NoError(t, db.UpdateNowIrreversibleBlock(ctx, blk))
+	}
+	require.NoError(t, db.Flush(ctx))
+	require.NoError(t, db.Close())
+
+	hot, err := store.New("badger://" + hotDir)
+	require.NoError(t, err)
+
+	bundles, err := NewBundlesStore(coldDir)
+	require.NoError(t, err)
+
+	// Last irreversible block is 8, keeping 2 blocks leaves [0, 3] and [4, 5] as complete
+	// ranges below the cutoff, the last one is not complete yet
+	compacted, err := NewCompactor(hot, bundles, 4).Compact(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, compacted, 1)
+	assert.Equal(t, "0000000000-0000000003", compacted[0].String())
+
+	_, err = hot.Get(ctx, kv.Keys.PackBlocksKey(fmt.Sprintf("%08xaa000000000000000000000000000000000000000000000000000000", 2)))
+	assert.Equal(t, store.ErrNotFound, err)
+
+	_, err = hot.Get(ctx, kv.Keys.PackBlocksKey(fmt.Sprintf("%08xaa000000000000000000000000000000000000000000000000000000", 4)))
+	assert.NoError(t, err)
+	require.NoError(t, hot.Close())
+
+	tiered, err := kv.New([]string{tieredDSN(hotDir, coldDir)})
+	require.NoError(t, err)
+	defer tiered.Close()
+
+	cold, err := kv.New([]string{"flat://" + coldDir + "?write=none"})
+	require.NoError(t, err)
+	defer cold.Close()
+
+	for num := uint32(1); num <= 8; num++ {
+		blocks, err := tiered.GetBlockByNum(ctx, num)
+		require.NoError(t, err, "block %d", num)
+		require.Len(t, blocks, 1)
+		assert.True(t, blocks[0].Irreversible)
+
+		events, err := tiered.GetTransactionEvents(ctx, trxIDs[num][0:8])
+		require.NoError(t, err, "transaction of block %d", num)
+		assert.Len(t, events, 2)
+	}
+
+	blocks, err := tiered.ListBlocks(ctx, 8, 8)
+	require.NoError(t, err)
+	assert.Len(t, blocks, 8)
+
+	coldBlocks, err := cold.ListBlocks(ctx, 8, 8)
+	require.NoError(t, err)
+	assert.Len(t, coldBlocks, 3)
+
+	events, err := cold.GetTransactionEvents(ctx, trxIDs[2])
+	require.NoError(t, err)
+	assert.Len(t, events, 2)
+
+	events, err = cold.GetTransactionEvents(ctx, trxIDs[5])
+	require.NoError(t, err)
+	assert.Len(t, events, 0)
+
+	// Prefixes shorter than the indexed ones are rejected by the cold store and answered
+	// by the hot store only when tiered
+	_, err = cold.GetTransactionEvents(ctx, trxIDs[2][0:6])
+	assert.True(t, errors.Is(err, ErrShortTrxPrefix), "got %v", err)
+
+	events, err = tiered.GetTransactionEvents(ctx, trxIDs[2][0:6])
+	require.NoError(t, err)
+	assert.Len(t, events, 0)
+
+	events, err = tiered.GetTransactionEvents(ctx, trxIDs[5][0:6])
+	require.NoError(t, err)
+	assert.Len(t, events, 2)
+}
+
+func TestStore_ScanStopsAtLimit(t *testing.T) {
+	ctx := context.Background()
+
+	bundles, err := NewBundlesStore(t.TempDir())
+	require.NoError(t, err)
+
+	blockKey := func(num uint32) []byte {
+		return kv.Keys.PackBlocksKey(fmt.Sprintf("%08xaa000000000000000000000000000000000000000000000000000000", num))
+	}
+
+	for low := uint32(0); low < 12; low += 4 {
+		var rows []store.KV
+		for num := low; num < low+4; num++ {
+			rows = append(rows, store.KV{Key: blockKey(num), Value: []byte{byte(num)}})
+		}
+		require.NoError(t, WriteBundle(ctx, bundles, NewBundle(low, low+3, rows)))
+	}
+
+	flat, err := newStore(bundles, 16, time.Minute)
+	require.NoError(t, err)
+
+	rows, err := collect(flat.Prefix(ctx, []byte{kv.TblPrefixBlocks}, 2))
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, []byte{11}, rows[0].Value)
+	assert.Equal(t, []byte{10}, rows[1].Value)
+	assert.Equal(t, 1, flat.bundleCache.Len(), "only the most recent bundle should be loaded")
+
+	rows, err = collect(flat.Prefix(ctx, []byte{kv.TblPrefixBlocks}, 6))
+	require.NoError(t, err)
+	require.Len(t, rows, 6)
+	assert.Equal(t, []byte{6}, rows[5].Value)
+	assert.Equal(t, 2, flat.bundleCache.Len())
+
+	rows, err = collect(flat.Prefix(ctx, []byte{kv.TblPrefixBlocks}, 0))
+	require.NoError(t, err)
+	assert.Len(t, rows, 12)
+}
+
+func TestTieredStore_MergesLazily(t *testing.T) {
+	ctx := context.Background()
+
+	hot, err := store.New("badger://" + t.TempDir())
+	require.NoError(t, err)
+	defer hot.Close()
+
+	cold, err := store.New("badger://" + t.TempDir())
+	require.NoError(t, err)
+	defer cold.Close()
+
+	for i := byte(0); i < 200; i++ {
+		if i%2 == 0 {
+			require.NoError(t, hot.Put(ctx, []byte{0x10, 0x01, i}, []byte("hot")))
+		}
+		require.NoError(t, cold.Put(ctx, []byte{0x10, 0x01, i}, []byte("cold")))
+	}
+	require.NoError(t, cold.Put(ctx, []byte{0x10, 0x02, 0x00}, []byte("cold")))
+	require.NoError(t, hot.FlushPuts(ctx))
+	require.NoError(t, cold.FlushPuts(ctx))
+
+	tiered := NewTiered(hot, cold)
+
+	rows, err := collect(tiered.Prefix(ctx, []byte{0x10, 0x01}, 3))
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.Equal(t, "hot", string(rows[0].Value))
+	assert.Equal(t, "cold", string(rows[1].Value))
+	assert.Equal(t, "hot", string(rows[2].Value))
+
+	rows, err = collect(tiered.BatchPrefix(ctx, [][]byte{{0x10, 0x02}, {0x10, 0x01}}, 0))
+	require.NoError(t, err)
+	require.Len(t, rows, 201)
+	assert.Equal(t, []byte{0x10, 0x02, 0x00}, rows[0].Key)
+	assert.Equal(t, []byte{0x10, 0x01, 199}, rows[200].Key)
+}
+
+func tieredDSN(hotDir, coldDir string) string {
+	return fmt.Sprintf("tiered://?hot=badger://%s&cold=flat://%s", hotDir, coldDir)
+}
@@ -0,0 +1,12 @@
+package flat
+
+import (
+	"github.com/streamingfast/logging"
+	"go.uber.org/zap"
+)
+
+var zlog *zap.Logger
+
+func init() {
+	logging.Register("github.com/zhongshuwen/histnew/trxdb/flat", &zlog)
+}
@@ -0,0 +1,504 @@
+package flat
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/streamingfast/dstore"
+	"github.com/streamingfast/kvdb/store"
+	"github.com/zhongshuwen/histnew/trxdb/kv"
+	"go.uber.org/zap"
+)
+
+var ErrReadOnly = errors.New("flat store is read-only, rows are added to it through compaction")
+
+// ErrShortTrxPrefix is returned when transaction rows are requested without the 4 bytes
+// transaction ID prefix needed to rule out bundles using their index, which would require
+// downloading every bundle.
+var ErrShortTrxPrefix = fmt.Errorf("flat store transaction lookups require a transaction ID prefix of at least %d bytes", trxIndexPrefixLen)
+
+func init() {
+	store.Register(&store.Registration{
+		Name:        "flat",
+		Title:       "Immutable bundle files in a dstore",
+		FactoryFunc: NewStore,
+	})
+}
+
+// Store is a read-only `store.KVStore` serving trxdb rows out of the immutable bundles
+// written by the `Compactor`. Only the block and transaction tables are ever bundled,
+// every other table is reported as empty.
+//
+// Bundles and their transaction indexes are kept in in-memory LRU caches, the listing
+// of available bundles is refreshed every `refresh` interval to pick up new bundles.
+type Store struct {
+	bundles         dstore.Store
+	refreshInterval time.Duration
+
+	refsLock    sync.Mutex
+	refs        []bundleRef
+	refreshedAt time.Time
+
+	bundleCache *lru.Cache
+	indexCache  *lru.Cache
+}
+
+// NewStore creates a flat store from a DSN of the form `flat://<bundles store url>?cache=16&refresh=1m`
+// where the bundles store URL is any URL supported by `dstore`, like `flat:///data/trxdb-cold` or
+// `flat://gs://bucket/trxdb-cold`.
+func NewStore(dsn string) (store.KVStore, error) {
+	bundlesURL, options, err := parseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheSize, _, err := options.IntOption("cache", 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cache option: %w", err)
+	}
+
+	refreshInterval, _, err := options.DurationOption("refresh", time.Minute)
+	if err != nil {
+		return nil, fmt.Errorf("invalid refresh option: %w", err)
+	}
+
+	bundles, err := NewBundlesStore(bundlesURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create bundles store: %w", err)
+	}
+
+	return newStore(bundles, cacheSize, refreshInterval)
+}
+
+func newStore(bundles dstore.Store, cacheSize int, refreshInterval time.Duration) (*Store, error) {
+	bundleCache, err := lru.New(cacheSize)
+	if err != nil {
+		return nil, fmt.Errorf("bundle cache: %w", err)
+	}
+
+	// Indexes are a tiny fraction of the size of a bundle
+	indexCache, err := lru.New(cacheSize * 64)
+	if err != nil {
+		return nil, fmt.Errorf("index cache: %w", err)
+	}
+
+	return &Store{
+		bundles:         bundles,
+		refreshInterval: refreshInterval,
+		bundleCache:     bundleCache,
+		indexCache:      indexCache,
+	}, nil
+}
+
+func parseDSN(dsn string) (bundlesURL string, options store.DSNQuery, err error) {
+	if !strings.HasPrefix(dsn, "flat://") {
+		return "", nil, fmt.Errorf("invalid flat dsn %q, expected scheme flat://", dsn)
+	}
+
+	bundlesURL = strings.TrimPrefix(dsn, "flat://")
+	if bundlesURL == "" {
+		return "", nil, fmt.Errorf("invalid flat dsn %q, the bundles store url is required", dsn)
+	}
+
+	parsed, err := url.Parse(bundlesURL)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid bundles store url: %w", err)
+	}
+
+	options = store.DSNQuery(parsed.Query())
+	return store.RemoveDSNOptionsFromURL(parsed, "cache", "refresh").String(), options, nil
+}
+
+func (s *Store) Put(ctx context.Context, key, value []byte) error     { return ErrReadOnly }
+func (s *Store) FlushPuts(ctx context.Context) error                  { return nil }
+func (s *Store) BatchDelete(ctx context.Context, keys [][]byte) error { return ErrReadOnly }
+
+func (s *Store) Close() error {
+	s.bundleCache.Purge()
+	s.indexCache.Purge()
+	return nil
+}
+
+func (s *Store) Get(ctx context.Context, key []byte) ([]byte, error) {
+	refs, err := s.candidates(ctx, key, store.Key(key).Next(), key)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ref := range refs {
+		bundle, err := s.loadBundle(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+
+		if value, found := bundle.get(key); found {
+			return value, nil
+		}
+	}
+
+	return nil, store.ErrNotFound
+}
+
+func (s *Store) BatchGet(ctx context.Context, keys [][]byte) *store.Iterator {
+	return s.iterate(ctx, nil, func(push func(store.KV) bool) error {
+		for _, key := range keys {
+			value, err := s.Get(ctx, key)
+			if err != nil {
+				return err
+			}
+
+			if !push(store.KV{Key: key, Value: value}) {
+				return nil
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Store) Scan(ctx context.Context, start, exclusiveEnd []byte, limit int, options ...store.ReadOption) *store.Iterator {
+	return s.iterate(ctx, options, func(push func(store.KV) bool) error {
+		kvs, err := s.scan(ctx, start, exclusiveEnd, commonPrefix(start, exclusiveEnd), limit)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range kvs {
+			if !push(row) {
+				return nil
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Store) Prefix(ctx context.Context, prefix []byte, limit int, options ...store.ReadOption) *store.Iterator {
+	return s.BatchPrefix(ctx, [][]byte{prefix}, limit, options...)
+}
+
+func (s *Store) BatchPrefix(ctx context.Context, prefixes [][]byte, limit int, options ...store.ReadOption) *store.Iterator {
+	return s.iterate(ctx, options, func(push func(store.KV) bool) error {
+		count := 0
+		for _, prefix := range prefixes {
+			kvs, err := s.scan(ctx, prefix, store.Key(prefix).PrefixNext(), prefix, limit-count)
+			if err != nil {
+				return err
+			}
+
+			for _, row := range kvs {
+				if !push(row) {
+					return nil
+				}
+
+				count++
+				if store.Limit(limit).Reached(uint64(count)) {
+					return nil
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Store) iterate(ctx context.Context, options []store.ReadOption, fetch func(push func(store.KV) bool) error) *store.Iterator {
+	readOptions := &store.ReadOptions{}
+	for _, opt := range options {
+		opt.Apply(readOptions)
+	}
+
+	it := store.NewIterator(ctx)
+	go func() {
+		err := fetch(func(row store.KV) bool {
+			if readOptions.KeyOnly {
+				row.Value = nil
+			}
+			return it.PushItem(row)
+		})
+		if err != nil {
+			it.PushError(err)
+			return
+		}
+		it.PushFinished()
+	}()
+
+	return it
+}
+
+// scan returns the rows of [start, exclusiveEnd) across all bundles, sorted by key. The
+// `probe` is a prefix shared by all requested keys, used to rule out bundles using their index.
+//
+// Bundles are walked from the most recent one, block table keys starting with the reversed
+// block num. With a bounded limit, the walk stops as soon as the older bundles cannot hold
+// keys lower than the last of the `limit` rows gathered so far, so they are never downloaded.
+func (s *Store) scan(ctx context.Context, start, exclusiveEnd, probe []byte, limit int) ([]store.KV, error) {
+	refs, err := s.candidates(ctx, start, exclusiveEnd, probe)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []store.KV
+	for i := len(refs) - 1; i >= 0; i-- {
+		bundle, err := s.loadBundle(ctx, refs[i])
+		if err != nil {
+			return nil, err
+		}
+
+		out = mergeRows(out, bundle.scan(start, exclusiveEnd, limit), limit)
+
+		if i > 0 && store.Limit(limit).Bounded() && len(out) >= limit {
+			if bytes.Compare(out[limit-1].Key, lowestKey(refs[i-1], start, exclusiveEnd)) < 0 {
+				break
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// mergeRows merges two lists of rows sorted by key, dropping the rows of `b` having a
+// key already in `a`, keeping at most `limit` rows (unbounded when `limit` is <= 0).
+func mergeRows(a, b []store.KV, limit int) []store.KV {
+	if len(a) == 0 {
+		return b
+	}
+
+	out := make([]store.KV, 0, len(a)+len(b))
+	i, j := 0, 0
+	for (i < len(a) || j < len(b)) && !store.Limit(limit).Reached(uint64(len(out))) {
+		switch {
+		case j >= len(b):
+			out = append(out, a[i])
+			i++
+		case i >= len(a):
+			out = append(out, b[j])
+			j++
+		default:
+			cmp := bytes.Compare(a[i].Key, b[j].Key)
+			if cmp > 0 {
+				out = append(out, b[j])
+				j++
+				continue
+			}
+
+			out = append(out, a[i])
+			i++
+			if cmp == 0 {
+				j++
+			}
+		}
+	}
+
+	return out
+}
+
+// lowestKey returns the lowest key of [start, exclusiveEnd) the bundle can hold. Only block
+// table keys tell apart bundles, the lowest key is `start` when a transaction table comes first.
+func lowestKey(ref bundleRef, start, exclusiveEnd []byte) []byte {
+	for _, table := range bundledTables {
+		if !tableIntersects(table, start, exclusiveEnd) {
+			continue
+		}
+
+		if !isBlockTable(table) {
+			return start
+		}
+
+		low, high := blockNumRange(table, start, exclusiveEnd)
+		if !ref.intersects(low, high) {
+			continue
+		}
+
+		key := make([]byte, 5)
+		key[0] = table
+		binary.BigEndian.PutUint32(key[1:], math.MaxUint32-ref.high)
+		if bytes.Compare(key, start) < 0 {
+			return start
+		}
+		return key
+	}
+
+	// The bundle holds no key in the range
+	return exclusiveEnd
+}
+
+// candidates returns the refs of the bundles that may hold keys in [start, exclusiveEnd), sorted
+// by block range. The bundles themselves are only loaded by the callers as they need them.
+func (s *Store) candidates(ctx context.Context, start, exclusiveEnd, probe []byte) (out []bundleRef, err error) {
+	if shortTrxProbe(start, exclusiveEnd, probe) {
+		return nil, ErrShortTrxPrefix
+	}
+
+	refs, err := s.listBundles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ref := range refs {
+		mayHold, err := s.mayHold(ctx, ref, start, exclusiveEnd, probe)
+		if err != nil {
+			return nil, err
+		}
+		if mayHold {
+			out = append(out, ref)
+		}
+	}
+
+	return out, nil
+}
+
+func (s *Store) mayHold(ctx context.Context, ref bundleRef, start, exclusiveEnd, probe []byte) (bool, error) {
+	for _, table := range bundledTables {
+		if !tableIntersects(table, start, exclusiveEnd) {
+			continue
+		}
+
+		if isBlockTable(table) {
+			low, high := blockNumRange(table, start, exclusiveEnd)
+			if ref.intersects(low, high) {
+				return true, nil
+			}
+			continue
+		}
+
+		index, err := s.loadIndex(ctx, ref)
+		if err != nil {
+			return false, err
+		}
+		if index.mayContain(probe[1 : 1+trxIndexPrefixLen]) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (s *Store) listBundles(ctx context.Context) ([]bundleRef, error) {
+	s.refsLock.Lock()
+	defer s.refsLock.Unlock()
+
+	if s.refs != nil && time.Since(s.refreshedAt) < s.refreshInterval {
+		return s.refs, nil
+	}
+
+	refs, err := listBundles(ctx, s.bundles)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(refs) != len(s.refs) {
+		zlog.Info("bundles listing refreshed", zap.Int("bundle_count", len(refs)))
+	}
+
+	if refs == nil {
+		refs = []bundleRef{}
+	}
+
+	s.refs = refs
+	s.refreshedAt = time.Now()
+	return refs, nil
+}
+
+func (s *Store) loadBundle(ctx context.Context, ref bundleRef) (*Bundle, error) {
+	if cached, found := s.bundleCache.Get(ref); found {
+		return cached.(*Bundle), nil
+	}
+
+	zlog.Debug("loading bundle", zap.Stringer("bundle", ref))
+	bundle, err := readBundle(ctx, s.bundles, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	s.bundleCache.Add(ref, bundle)
+	return bundle, nil
+}
+
+func (s *Store) loadIndex(ctx context.Context, ref bundleRef) (*trxIndex, error) {
+	if cached, found := s.indexCache.Get(ref); found {
+		return cached.(*trxIndex), nil
+	}
+
+	index, err := readTrxIndex(ctx, s.bundles, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	s.indexCache.Add(ref, index)
+	return index, nil
+}
+
+// bundledTables are the trxdb tables moved out of the hot store by the compaction, the
+// secondary indexes, timeline and accounts tables stay in the hot store. They are listed
+// in key order.
+var bundledTables = []byte{
+	kv.TblPrefixTrxs,
+	kv.TblPrefixBlocks,
+	kv.TblPrefixIrrBlks,
+	kv.TblPrefixImplTrxs,
+	kv.TblPrefixDtrxs,
+	kv.TblPrefixTrxTraces,
+}
+
+func isBlockTable(table byte) bool {
+	return table == kv.TblPrefixBlocks || table == kv.TblPrefixIrrBlks
+}
+
+func isTrxTable(table byte) bool {
+	return table == kv.TblPrefixTrxs || table == kv.TblPrefixImplTrxs || table == kv.TblPrefixDtrxs || table == kv.TblPrefixTrxTraces
+}
+
+// shortTrxProbe tells if [start, exclusiveEnd) reaches a transaction table without `probe`
+// holding the table and the transaction ID prefix indexed by bundles.
+func shortTrxProbe(start, exclusiveEnd, probe []byte) bool {
+	for _, table := range bundledTables {
+		if !isTrxTable(table) || !tableIntersects(table, start, exclusiveEnd) {
+			continue
+		}
+
+		if len(probe) < 1+trxIndexPrefixLen || probe[0] != table {
+			return true
+		}
+	}
+
+	return false
+}
+
+func tableIntersects(table byte, start, exclusiveEnd []byte) bool {
+	if bytes.Compare([]byte{table + 1}, start) <= 0 {
+		return false
+	}
+
+	return len(exclusiveEnd) == 0 || bytes.Compare(exclusiveEnd, []byte{table}) > 0
+}
+
+// blockNumRange returns the inclusive block num range covered by [start, exclusiveEnd) within
+// a block table. Block table keys start with the reversed block num, so the start key holds the
+// highest block num.
+func blockNumRange(table byte, start, exclusiveEnd []byte) (low, high uint32) {
+	high = math.MaxUint32
+	if len(start) >= 5 && start[0] == table {
+		high = math.MaxUint32 - binary.BigEndian.Uint32(start[1:5])
+	}
+
+	if len(exclusiveEnd) >= 5 && exclusiveEnd[0] == table {
+		low = math.MaxUint32 - binary.BigEndian.Uint32(exclusiveEnd[1:5])
+	}
+
+	return low, high
+}
+
+func commonPrefix(a, b []byte) []byte {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return a[:i]
+}
@@ -0,0 +1,266 @@
+package flat
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/streamingfast/kvdb/store"
+)
+
+func init() {
+	store.Register(&store.Registration{
+		Name:        "tiered",
+		Title:       "Hot KV store backed by a cold store",
+		FactoryFunc: NewTieredStore,
+	})
+}
+
+// TieredStore combines a hot store, receiving all the writes, with a cold read-only store
+// holding older rows, usually a flat store. Reads are served from both, the hot store
+// winning when a key exists in both.
+//
+// Transaction rows requested with an ID prefix shorter than the one indexed by flat bundles
+// (4 bytes, 8 hexadecimal characters) are served from the hot store only, finding them in
+// the cold store would require downloading every bundle.
+type TieredStore struct {
+	hot  store.KVStore
+	cold store.KVStore
+}
+
+// NewTieredStore creates a tiered store from a DSN of the form `tiered://?hot=<dsn>&cold=<dsn>`,
+// for example `tiered://?hot=badger:///data/trxdb&cold=flat:///data/trxdb-cold`. Nested DSNs
+// having query options of their own must be URL encoded.
+func NewTieredStore(dsn string) (store.KVStore, error) {
+	dsnURL, err := url.Parse(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tiered dsn: %w", err)
+	}
+
+	query := dsnURL.Query()
+	hotDSN, coldDSN := query.Get("hot"), query.Get("cold")
+	if hotDSN == "" || coldDSN == "" {
+		return nil, fmt.Errorf("invalid tiered dsn %q, both hot and cold options are required", dsn)
+	}
+
+	hot, err := store.New(hotDSN)
+	if err != nil {
+		return nil, fmt.Errorf("hot store: %w", err)
+	}
+
+	cold, err := store.New(coldDSN)
+	if err != nil {
+		return nil, fmt.Errorf("cold store: %w", err)
+	}
+
+	return NewTiered(hot, cold), nil
+}
+
+func NewTiered(hot, cold store.KVStore) *TieredStore {
+	return &TieredStore{hot: hot, cold: cold}
+}
+
+func (s *TieredStore) Hot() store.KVStore  { return s.hot }
+func (s *TieredStore) Cold() store.KVStore { return s.cold }
+
+func (s *TieredStore) Put(ctx context.Context, key, value []byte) error {
+	return s.hot.Put(ctx, key, value)
+}
+
+func (s *TieredStore) FlushPuts(ctx context.Context) error {
+	return s.hot.FlushPuts(ctx)
+}
+
+func (s *TieredStore) BatchDelete(ctx context.Context, keys [][]byte) error {
+	return s.hot.BatchDelete(ctx, keys)
+}
+
+func (s *TieredStore) Close() error {
+	hotErr := s.hot.Close()
+	if err := s.cold.Close(); err != nil {
+		return err
+	}
+	return hotErr
+}
+
+func (s *TieredStore) Get(ctx context.Context, key []byte) ([]byte, error) {
+	value, err := s.hot.Get(ctx, key)
+	if err != store.ErrNotFound || shortTrxProbe(key, store.Key(key).Next(), key) {
+		return value, err
+	}
+
+	return s.cold.Get(ctx, key)
+}
+
+func (s *TieredStore) BatchGet(ctx context.Context, keys [][]byte) *store.Iterator {
+	it := store.NewIterator(ctx)
+	go func() {
+		for _, key := range keys {
+			value, err := s.Get(ctx, key)
+			if err != nil {
+				it.PushError(err)
+				return
+			}
+
+			if !it.PushItem(store.KV{Key: key, Value: value}) {
+				return
+			}
+		}
+		it.PushFinished()
+	}()
+
+	return it
+}
+
+func (s *TieredStore) Scan(ctx context.Context, start, exclusiveEnd []byte, limit int, options ...store.ReadOption) *store.Iterator {
+	if shortTrxProbe(start, exclusiveEnd, commonPrefix(start, exclusiveEnd)) {
+		return s.hot.Scan(ctx, start, exclusiveEnd, limit, options...)
+	}
+
+	return s.merge(ctx, limit, nil, func(ctx context.Context) (hot, cold *store.Iterator) {
+		return s.hot.Scan(ctx, start, exclusiveEnd, limit, options...), s.cold.Scan(ctx, start, exclusiveEnd, limit, options...)
+	})
+}
+
+func (s *TieredStore) Prefix(ctx context.Context, prefix []byte, limit int, options ...store.ReadOption) *store.Iterator {
+	if shortTrxProbe(prefix, store.Key(prefix).PrefixNext(), prefix) {
+		return s.hot.Prefix(ctx, prefix, limit, options...)
+	}
+
+	return s.merge(ctx, limit, nil, func(ctx context.Context) (hot, cold *store.Iterator) {
+		return s.hot.Prefix(ctx, prefix, limit, options...), s.cold.Prefix(ctx, prefix, limit, options...)
+	})
+}
+
+// BatchPrefix merges prefix by prefix, the order of the prefixes is kept in the
+// results like the underlying stores do.
+func (s *TieredStore) BatchPrefix(ctx context.Context, prefixes [][]byte, limit int, options ...store.ReadOption) *store.Iterator {
+	var coldPrefixes [][]byte
+	for _, prefix := range prefixes {
+		if !shortTrxProbe(prefix, store.Key(prefix).PrefixNext(), prefix) {
+			coldPrefixes = append(coldPrefixes, prefix)
+		}
+	}
+
+	return s.merge(ctx, limit, prefixes, func(ctx context.Context) (hot, cold *store.Iterator) {
+		return s.hot.BatchPrefix(ctx, prefixes, limit, options...), s.cold.BatchPrefix(ctx, coldPrefixes, limit, options...)
+	})
+}
+
+// merge combines the sorted iterators returned by `open` into a single sorted one, rows of
+// the hot store winning over the rows of the cold store having the same key. Rows are read
+// from the iterators as they are merged, both are canceled once the limit is reached or the
+// consumer stops early.
+//
+// When `prefixes` is set, the iterators hold groups of rows sorted by key, one group per
+// prefix in the order of `prefixes`, and the groups are merged one after the other.
+func (s *TieredStore) merge(ctx context.Context, limit int, prefixes [][]byte, open func(ctx context.Context) (hot, cold *store.Iterator)) *store.Iterator {
+	it := store.NewIterator(ctx)
+
+	readCtx, cancelRead := context.WithCancel(ctx)
+	hot, cold := open(readCtx)
+
+	go func() {
+		defer cancelRead()
+
+		merger := &sortedMerger{hot: &peekingIterator{it: hot}, cold: &peekingIterator{it: cold}}
+		if prefixes == nil {
+			prefixes = [][]byte{nil}
+		}
+
+		count := 0
+		for _, prefix := range prefixes {
+			for !store.Limit(limit).Reached(uint64(count)) {
+				row, found, err := merger.next(prefix)
+				if err != nil {
+					it.PushError(err)
+					return
+				}
+				if !found {
+					break
+				}
+
+				if !it.PushItem(row) {
+					return
+				}
+				count++
+			}
+		}
+		it.PushFinished()
+	}()
+
+	return it
+}
+
+// sortedMerger merges two iterators sorted by key, rows of `hot` winning over the rows of
+// `cold` having the same key.
+type sortedMerger struct {
+	hot  *peekingIterator
+	cold *peekingIterator
+}
+
+// next returns the next merged row having `prefix`, `found` being false once both iterators
+// are past the rows having it.
+func (m *sortedMerger) next(prefix []byte) (row store.KV, found bool, err error) {
+	hotRow, hotFound := m.hot.peek(prefix)
+	coldRow, coldFound := m.cold.peek(prefix)
+	if err := m.hot.it.Err(); err != nil {
+		return row, false, err
+	}
+	if err := m.cold.it.Err(); err != nil {
+		return row, false, err
+	}
+
+	switch {
+	case !hotFound && !coldFound:
+		return row, false, nil
+	case !coldFound:
+		m.hot.consume()
+		return hotRow, true, nil
+	case !hotFound:
+		m.cold.consume()
+		return coldRow, true, nil
+	}
+
+	cmp := bytes.Compare(hotRow.Key, coldRow.Key)
+	if cmp > 0 {
+		m.cold.consume()
+		return coldRow, true, nil
+	}
+
+	m.hot.consume()
+	if cmp == 0 {
+		m.cold.consume()
+	}
+	return hotRow, true, nil
+}
+
+// peekingIterator reads one row ahead of its consumer
+type peekingIterator struct {
+	it      *store.Iterator
+	row     store.KV
+	read    bool
+	hasMore bool
+}
+
+// peek returns the next row without consuming it, `found` being false when the iterator
+// completed or the next row does not have `prefix`.
+func (p *peekingIterator) peek(prefix []byte) (row store.KV, found bool) {
+	if !p.read {
+		p.read = true
+		p.hasMore = p.it.Next()
+		if p.hasMore {
+			p.row = p.it.Item()
+		}
+	}
+
+	if !p.hasMore || !bytes.HasPrefix(p.row.Key, prefix) {
+		return row, false
+	}
+	return p.row, true
+}
+
+func (p *peekingIterator) consume() {
+	p.read = false
+}
@@ -47,6 +47,14 @@ type BlocksTransactionsReader interface {
 type AccountsReader interface {
 	GetAccount(ctx context.Context, accountName string) (*pbcodec.AccountCreationRef, error)
 	ListAccountNames(ctx context.Context) ([]string, error)
+
+	// ListAccountsCreatedBy returns the accounts created by `creator`, ordered by name. When `afterAccount`
+	// is not empty, the listing resumes right after this account. It returns at most `limit` accounts.
+	ListAccountsCreatedBy(ctx context.Context, creator string, afterAccount string, limit int) ([]*pbcodec.AccountCreationRef, error)
+	// GetAccountLineage returns the creation of `accountName` followed by the creation of its creator and so on,
+	// walking up the creator chain until reaching an account with no known creation, like the system account
+	// and other accounts created at genesis. It returns `kvdb.ErrNotFound` when `accountName` itself is not found.
+	GetAccountLineage(ctx context.Context, accountName string) ([]*pbcodec.AccountCreationRef, error)
 }
 
 type TransactionsReader interface {
@@ -72,6 +80,33 @@ type TransactionsReader interface {
 	// If some ids are not found, the corresponding index will have a nil list of TransactionEvent.
 	// It will return an error if one of the the idPrefixes matches multiple transactions (e.g. is too short)
 	GetTransactionEventsBatch(ctx context.Context, idPrefixes []string) ([][]*pbcodec.TransactionEvent, error)
+
+	// ListTransactionRefsBySignerKey returns references to the transactions signed by `publicKey`, most recent
+	// first. The public key can be in any of its supported textual formats (`EOS...`, `PUB_K1_...`, etc).
+	// When `after` is not nil, the listing resumes right after this reference. It returns at most `limit` references.
+	ListTransactionRefsBySignerKey(ctx context.Context, publicKey string, after *TransactionRef, limit int) ([]*TransactionRef, error)
+	// ListTransactionRefsByActionDataHash returns references to the transactions containing an action whose raw
+	// data has the hex encoded sha256 `dataHash`, most recent first. Pagination works like `ListTransactionRefsBySignerKey`.
+	ListTransactionRefsByActionDataHash(ctx context.Context, dataHash string, after *TransactionRef, limit int) ([]*TransactionRef, error)
+
+	// ListDeferredTransactionRefsBySender returns references to the creations of the deferred transactions
+	// scheduled by the account `sender`, most recent first. Pagination works like `ListTransactionRefsBySignerKey`.
+	ListDeferredTransactionRefsBySender(ctx context.Context, sender string, after *TransactionRef, limit int) ([]*TransactionRef, error)
+	// ListPendingDeferredTransactionRefs returns references to the creations of the deferred transactions still
+	// waiting to execute at `atBlockNum`, i.e. created at or before this block and neither executed, canceled
+	// nor failed yet. They are ordered by expiration, soonest first. Pagination works like `ListTransactionRefsBySignerKey`.
+	ListPendingDeferredTransactionRefs(ctx context.Context, atBlockNum uint32, after *TransactionRef, limit int) ([]*TransactionRef, error)
+}
+
+// ProjectedTracesReader is implemented by drivers able to prune the execution traces while
+// reading them, so only the projected parts of a transaction are retained in memory.
+type ProjectedTracesReader interface {
+	// GetProjectedTransactionTracesBatch works like `GetTransactionTracesBatch` but each execution trace
+	// is replaced by `projection.Apply(trace, actionIndexes[i])`, `i` being the index of the id prefix it
+	// matched. The transaction receipt header is always kept, `pbcodec.MergeTransactionEvents` needs its
+	// status, and traces not holding all the action indexes (e.g. the delayed push of a deferred transaction)
+	// are left untouched.
+	GetProjectedTransactionTracesBatch(ctx context.Context, idPrefixes []string, actionIndexes [][]uint32, projection *TraceProjection) ([][]*pbcodec.TransactionEvent, error)
 }
 
 type TimelineExplorer interface {
@@ -93,11 +128,15 @@ type BlocksReader interface {
 	// example, if you pass `highBlockNum = math.MaxUint32` with
 	// `limit = 1`, it will retrieve the last written block.
 	//
-	// FIXME: this one should be `lowBlockNum` and `highBlockNum`, the
-	// thing is you might not have the expected block range if there
-	// are forked blocks provided.
+	// Forked blocks count in the `limit`, use `ListBlocksInRange` to
+	// retrieve an exact block range.
 	ListBlocks(ctx context.Context, highBlockNum uint32, limit int) ([]*pbcodec.BlockWithRefs, error)
 	ListSiblingBlocks(ctx context.Context, blockNum uint32, spread uint32) ([]*pbcodec.BlockWithRefs, error)
+
+	// ListBlocksInRange retrieves the blocks from `highBlockNum` down to
+	// `lowBlockNum`, both inclusive, `mode` telling which blocks of each
+	// height are returned. The irreversible blocks are flagged as such.
+	ListBlocksInRange(ctx context.Context, lowBlockNum, highBlockNum uint32, mode BlockRangeMode) ([]*pbcodec.BlockWithRefs, error)
 }
 
 type DBWriter interface {
@@ -114,3 +153,15 @@ type DBWriter interface {
 type Debugeable interface {
 	Dump()
 }
+
+// PurgeReporter is implemented by drivers purging old rows, the report
+// is nil when purging is not enabled.
+type PurgeReporter interface {
+	PurgeReport() *PurgeReport
+}
+
+// TTLPolicyHolder is implemented by drivers accepting per table retention policies, those
+// are only enforced when purging is enabled with `WithPurgeableStoreOption`.
+type TTLPolicyHolder interface {
+	HasTTLPolicies() bool
+}
@@ -49,6 +49,7 @@ type DB struct {
 	dec *trxdb.ProtoDecoder
 
 	purgeInterval uint64
+	ttlPolicies   map[string]uint64
 	logger        *zap.Logger
 }
 
@@ -62,11 +63,14 @@ func init() {
 	trxdb.Register("bigkv", testFactory)
 	trxdb.Register("netkv", testFactory)
 	trxdb.Register("cznickv", testFactory)
+	trxdb.Register("flat", testFactory)
+	trxdb.Register("tiered", testFactory)
 }
 
 type dsnOptions struct {
 	reads  []string
 	writes []string
+	ttls   map[string]uint64
 }
 
 func New(dsns []string) (*DB, error) {
@@ -98,6 +102,13 @@ func New(dsns []string) (*DB, error) {
 		}
 
 		db.setupReadWriteOpts(driver, dsnOptions.reads, dsnOptions.writes)
+
+		if len(dsnOptions.ttls) > 0 {
+			if !isWriter {
+				return nil, fmt.Errorf("the ttl option is only valid on the writer dsn")
+			}
+			db.ttlPolicies = dsnOptions.ttls
+		}
 	}
 	return db, nil
 
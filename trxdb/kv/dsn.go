@@ -45,7 +45,18 @@ func parseAndCleanDSN(dsn string) (cleanDsn string, opt *dsnOptions, err error)
 		dsnOptions.writes = append(dsnOptions.writes, "all")
 	}
 
-	cleanDsn, err = store.RemoveDSNOptions(dsn, "read", "write", "blk_marker")
+	for _, ttlValues := range query["ttl"] {
+		if dsnOptions.ttls == nil {
+			dsnOptions.ttls = map[string]uint64{}
+		}
+
+		if err = parseTTLPolicies(ttlValues, dsnOptions.ttls); err != nil {
+			err = fmt.Errorf("invalid ttl option: %w", err)
+			return
+		}
+	}
+
+	cleanDsn, err = store.RemoveDSNOptions(dsn, "read", "write", "blk_marker", "ttl")
 	if err != nil {
 		err = fmt.Errorf("Unable to clean dsn: %w", err)
 	}
@@ -116,6 +116,26 @@ func Test_parseDSN(t *testing.T) {
 				writes: []string{"all"},
 			},
 		},
+		{
+			name:           "test with ttl policies",
+			dsn:            "bigkv://dev.dev/test-trxdb?createTable=true&ttl=traces:720h,blocks:forever,trxs:1000",
+			expectCleanDSN: "bigkv://dev.dev/test-trxdb?createTable=true",
+			expectOpt: &dsnOptions{
+				reads:  []string{"all"},
+				writes: []string{"all"},
+				ttls:   map[string]uint64{"traces": 5184000, "blocks": 0, "trxs": 1000},
+			},
+		},
+		{
+			name:        "test with ttl policy on unknown table",
+			dsn:         "bigkv://dev.dev/test-trxdb?ttl=unknown:1000",
+			expectError: true,
+		},
+		{
+			name:        "test with invalid ttl policy",
+			dsn:         "bigkv://dev.dev/test-trxdb?ttl=traces:soon",
+			expectError: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -19,9 +19,15 @@ const (
 	TblPrefixTrxTraces = 0x05
 	TblPrefixAccts     = 0x06
 	TblTTL             = 0x10
+	TblTableTTL        = 0x11
 
-	idxPrefixTimelineFwd = 0x80
-	idxPrefixTimelineBck = 0x81
+	idxPrefixTimelineFwd    = 0x80
+	idxPrefixTimelineBck    = 0x81
+	idxPrefixSignerKeyTrxs  = 0x82
+	idxPrefixActionDataTrxs = 0x83
+	idxPrefixCreatorAccts   = 0x84
+	idxPrefixDtrxSender     = 0x85
+	idxPrefixDtrxExpiration = 0x86
 
 	dtrxSuffixCreated   = 0x90
 	dtrxSuffixCancelled = 0x91
@@ -191,6 +197,95 @@ func (Keyer) UnpackAccountKey(key []byte) string {
 func (Keyer) StartOfAccountTable() []byte { return []byte{TblPrefixAccts} }
 func (Keyer) EndOfAccountTable() []byte   { return []byte{TblPrefixAccts + 1} }
 
+// Creator index, accounts keyed by creator then by name. Names are packed big
+// endian so accounts of a given creator are sorted by their uint64 value.
+
+func (Keyer) PackCreatorAccountKey(creator, accountName string) []byte {
+	creatorName, err := zsw.StringToName(creator)
+	if err != nil {
+		panic(fmt.Errorf("invalid creator name %q: %w", creator, err))
+	}
+	name, err := zsw.StringToName(accountName)
+	if err != nil {
+		panic(fmt.Errorf("invalid account name %q: %w", accountName, err))
+	}
+	b := make([]byte, 17)
+	b[0] = idxPrefixCreatorAccts
+	binary.BigEndian.PutUint64(b[1:], creatorName)
+	binary.BigEndian.PutUint64(b[9:], name)
+	return b
+}
+
+func (Keyer) UnpackCreatorAccountKey(key []byte) (creator, accountName string) {
+	return zsw.NameToString(binary.BigEndian.Uint64(key[1:9])), zsw.NameToString(binary.BigEndian.Uint64(key[9:17]))
+}
+
+func (Keyer) PackCreatorAccountPrefix(creator string) []byte {
+	creatorName, err := zsw.StringToName(creator)
+	if err != nil {
+		panic(fmt.Errorf("invalid creator name %q: %w", creator, err))
+	}
+	b := make([]byte, 9)
+	b[0] = idxPrefixCreatorAccts
+	binary.BigEndian.PutUint64(b[1:], creatorName)
+	return b
+}
+
+// Deferred transactions indexes, both pointing to the created dtrx row. The
+// sender index is keyed by sender then by reversed block ID, so the most
+// recent creations come first. The expiration index is keyed by expiration
+// time in seconds, the pending deferred transactions at a given time all
+// being found from that time onward.
+
+func (k Keyer) PackDtrxSenderKey(sender, blockID, trxID string) []byte {
+	id, err := hex.DecodeString(kvdb.ReversedBlockID(blockID) + trxID)
+	if err != nil {
+		panic(fmt.Errorf("invalid block ID %q or trx ID %q: %w", blockID, trxID, err))
+	}
+	return append(k.PackDtrxSenderPrefix(sender), id...)
+}
+
+func (Keyer) UnpackDtrxSenderKey(key []byte) (blockID, trxID string) {
+	if len(key) != 73 {
+		panic(fmt.Errorf("invalid key %q length, expected length 73 got %d", string(key), len(key)))
+	}
+	return kvdb.ReversedBlockID(hex.EncodeToString(key[9:41])), hex.EncodeToString(key[41:73])
+}
+
+func (Keyer) PackDtrxSenderPrefix(sender string) []byte {
+	senderName, err := zsw.StringToName(sender)
+	if err != nil {
+		panic(fmt.Errorf("invalid sender name %q: %w", sender, err))
+	}
+	b := make([]byte, 9)
+	b[0] = idxPrefixDtrxSender
+	binary.BigEndian.PutUint64(b[1:], senderName)
+	return b
+}
+
+func (k Keyer) PackDtrxExpirationKey(expiration time.Time, trxID, blockID string) []byte {
+	id, err := hex.DecodeString(trxID + blockID)
+	if err != nil {
+		panic(fmt.Errorf("invalid trx ID %q or block ID %q: %w", trxID, blockID, err))
+	}
+	return append(k.PackDtrxExpirationPrefix(expiration), id...)
+}
+
+func (Keyer) UnpackDtrxExpirationKey(key []byte) (expiration time.Time, trxID, blockID string) {
+	if len(key) != 73 {
+		panic(fmt.Errorf("invalid key %q length, expected length 73 got %d", string(key), len(key)))
+	}
+	expiration = time.Unix(int64(binary.BigEndian.Uint64(key[1:9])), 0).UTC()
+	return expiration, hex.EncodeToString(key[9:41]), hex.EncodeToString(key[41:73])
+}
+
+func (Keyer) PackDtrxExpirationPrefix(expiration time.Time) []byte {
+	b := make([]byte, 9)
+	b[0] = idxPrefixDtrxExpiration
+	binary.BigEndian.PutUint64(b[1:], uint64(expiration.Unix()))
+	return b
+}
+
 // Timeline indexes
 
 func (Keyer) PackTimelineKey(fwd bool, blockTime time.Time, blockID string) []byte {
@@ -244,6 +339,56 @@ func (Keyer) EndOfTimelineIndex(fwd bool) []byte {
 	return []byte{idxPrefixTimelineBck + 1}
 }
 
+// Signer key and action data indexes, both keyed by a 32 bytes digest (of the
+// public key and of the action data respectively) followed by the reversed
+// block ID, so the most recent transactions come first, and the trx ID.
+
+func (k Keyer) PackSignerKeyTrxsKey(keyDigest []byte, blockID, trxID string) []byte {
+	return k.packDigestIndexKey(idxPrefixSignerKeyTrxs, keyDigest, blockID, trxID)
+}
+
+func (k Keyer) UnpackSignerKeyTrxsKey(key []byte) (blockID, trxID string) {
+	return k.unpackDigestIndexKey(key)
+}
+
+func (k Keyer) PackSignerKeyTrxsPrefix(keyDigest []byte) []byte {
+	return k.packDigestIndexPrefix(idxPrefixSignerKeyTrxs, keyDigest)
+}
+
+func (k Keyer) PackActionDataTrxsKey(dataDigest []byte, blockID, trxID string) []byte {
+	return k.packDigestIndexKey(idxPrefixActionDataTrxs, dataDigest, blockID, trxID)
+}
+
+func (k Keyer) UnpackActionDataTrxsKey(key []byte) (blockID, trxID string) {
+	return k.unpackDigestIndexKey(key)
+}
+
+func (k Keyer) PackActionDataTrxsPrefix(dataDigest []byte) []byte {
+	return k.packDigestIndexPrefix(idxPrefixActionDataTrxs, dataDigest)
+}
+
+func (Keyer) packDigestIndexPrefix(prefix byte, digest []byte) []byte {
+	if len(digest) != 32 {
+		panic(fmt.Errorf("invalid digest %x length, expected length 32 got %d", digest, len(digest)))
+	}
+	return append([]byte{prefix}, digest...)
+}
+
+func (k Keyer) packDigestIndexKey(prefix byte, digest []byte, blockID, trxID string) []byte {
+	id, err := hex.DecodeString(kvdb.ReversedBlockID(blockID) + trxID)
+	if err != nil {
+		panic(fmt.Errorf("invalid block ID %q or trx ID %q: %w", blockID, trxID, err))
+	}
+	return append(k.packDigestIndexPrefix(prefix, digest), id...)
+}
+
+func (Keyer) unpackDigestIndexKey(key []byte) (blockID, trxID string) {
+	if len(key) != 97 {
+		panic(fmt.Errorf("invalid key %q length, expected length 97 got %d", string(key), len(key)))
+	}
+	return kvdb.ReversedBlockID(hex.EncodeToString(key[33:65])), hex.EncodeToString(key[65:97])
+}
+
 func (Keyer) packTrxBlockIDKey(prefix byte, trxID, blockID string) []byte {
 	id, err := hex.DecodeString(trxID + blockID)
 	if err != nil {
@@ -1,6 +1,7 @@
 package kv
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -65,3 +66,50 @@ func TestKeyer_PackDtrxsKeyFailed(t *testing.T) {
 	require.Equal(t, expectedTrxID, trxID)
 
 }
+
+func TestKeyer_PackSignerKeyTrxsKey(t *testing.T) {
+	expectedBlockID := "0000001aafcedbf5e651b27bee47c8a28de01635b5029ac2ce32896a1bcb1615"
+	expectedTrxID := "f2c8602f6d2b8241894383b22614a82740338d3f5c34961c0c82b382ac9e11ae"
+	digest := bytes.Repeat([]byte{0xab}, 32)
+
+	packed := Keys.PackSignerKeyTrxsKey(digest, expectedBlockID, expectedTrxID)
+	blockID, trxID := Keys.UnpackSignerKeyTrxsKey(packed)
+	require.True(t, bytes.HasPrefix(packed, Keys.PackSignerKeyTrxsPrefix(digest)))
+	require.Equal(t, expectedBlockID, blockID)
+	require.Equal(t, expectedTrxID, trxID)
+}
+
+func TestKeyer_PackActionDataTrxsKey_MostRecentFirst(t *testing.T) {
+	trxID := "f2c8602f6d2b8241894383b22614a82740338d3f5c34961c0c82b382ac9e11ae"
+	digest := bytes.Repeat([]byte{0xab}, 32)
+
+	older := Keys.PackActionDataTrxsKey(digest, "0000001aafcedbf5e651b27bee47c8a28de01635b5029ac2ce32896a1bcb1615", trxID)
+	newer := Keys.PackActionDataTrxsKey(digest, "0000001bafcedbf5e651b27bee47c8a28de01635b5029ac2ce32896a1bcb1615", trxID)
+	require.Equal(t, -1, bytes.Compare(newer, older))
+}
+
+func TestKeyer_PackDtrxSenderKey(t *testing.T) {
+	expectedBlockID := "0000001aafcedbf5e651b27bee47c8a28de01635b5029ac2ce32896a1bcb1615"
+	expectedTrxID := "f2c8602f6d2b8241894383b22614a82740338d3f5c34961c0c82b382ac9e11ae"
+
+	packed := Keys.PackDtrxSenderKey("eoscanadacom", expectedBlockID, expectedTrxID)
+	blockID, trxID := Keys.UnpackDtrxSenderKey(packed)
+	require.True(t, bytes.HasPrefix(packed, Keys.PackDtrxSenderPrefix("eoscanadacom")))
+	require.Equal(t, expectedBlockID, blockID)
+	require.Equal(t, expectedTrxID, trxID)
+}
+
+func TestKeyer_PackDtrxExpirationKey(t *testing.T) {
+	expectedBlockID := "0000001aafcedbf5e651b27bee47c8a28de01635b5029ac2ce32896a1bcb1615"
+	expectedTrxID := "f2c8602f6d2b8241894383b22614a82740338d3f5c34961c0c82b382ac9e11ae"
+	expectedExpiration := time.Date(2020, 5, 12, 10, 30, 0, 0, time.UTC)
+
+	packed := Keys.PackDtrxExpirationKey(expectedExpiration, expectedTrxID, expectedBlockID)
+	expiration, trxID, blockID := Keys.UnpackDtrxExpirationKey(packed)
+	require.Equal(t, expectedExpiration, expiration)
+	require.Equal(t, expectedBlockID, blockID)
+	require.Equal(t, expectedTrxID, trxID)
+
+	later := Keys.PackDtrxExpirationKey(expectedExpiration.Add(time.Second), expectedTrxID, expectedBlockID)
+	require.Equal(t, -1, bytes.Compare(packed, later))
+}
@@ -1,7 +1,7 @@
 package kv
 
 import (
-	kvdbstore "github.com/streamingfast/kvdb/store"
+	"github.com/zhongshuwen/histnew/trxdb"
 	"go.uber.org/zap"
 )
 
@@ -14,10 +14,24 @@ func (db *DB) SetLogger(logger *zap.Logger) error {
 func (db *DB) SetPurgeableStore(ttl, purgeInterval uint64) error {
 	zlog.Info("applying purgeable option")
 
+	// The `ttl` option of the writer DSN overrides `ttl` for the tables it lists
 	if db.writeStore != nil {
-		db.writeStore = kvdbstore.NewPurgeableStore([]byte{TblTTL}, db.writeStore, ttl)
+		db.writeStore = newTablePurgeableStore(db.writeStore, ttl, db.ttlPolicies)
 	}
 
 	db.purgeInterval = purgeInterval
 	return nil
 }
+
+// HasTTLPolicies tells if the writer DSN has a `ttl` option, which is only enforced once
+// `SetPurgeableStore` is called.
+func (db *DB) HasTTLPolicies() bool {
+	return len(db.ttlPolicies) > 0
+}
+
+func (db *DB) PurgeReport() *trxdb.PurgeReport {
+	if s, ok := db.writeStore.(*tablePurgeableStore); ok {
+		return s.report()
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/streamingfast/kvdb/store"
+	"github.com/zhongshuwen/histnew/trxdb"
+	"go.uber.org/zap"
+)
+
+// ttlBlockInterval converts the duration TTLs of the `ttl` DSN option to blocks
+const ttlBlockInterval = 500 * time.Millisecond
+
+const legacyTTLTable = "legacy"
+
+// ttlTables are the tables having their own retention through the `ttl` DSN option,
+// the rows of tables without any policy are kept for the default TTL of the store.
+var ttlTables = map[string][]byte{
+	"trxs":          {TblPrefixTrxs},
+	"blocks":        {TblPrefixBlocks, TblPrefixIrrBlks},
+	"implicit_trxs": {TblPrefixImplTrxs},
+	"dtrxs":         {TblPrefixDtrxs, idxPrefixDtrxSender, idxPrefixDtrxExpiration},
+	"traces":        {TblPrefixTrxTraces},
+	"accounts":      {TblPrefixAccts, idxPrefixCreatorAccts},
+	"timeline":      {idxPrefixTimelineFwd, idxPrefixTimelineBck},
+	"indexes":       {idxPrefixSignerKeyTrxs, idxPrefixActionDataTrxs},
+}
+
+// parseTTLPolicies parses `<table>:<ttl>` comma separated pairs, the TTL being a number of
+// blocks, a duration (`720h`) or `forever`. A TTL of 0 (or `forever`) keeps the rows forever.
+func parseTTLPolicies(in string, out map[string]uint64) error {
+	for _, policy := range strings.Split(in, ",") {
+		if policy == "" {
+			continue
+		}
+
+		parts := strings.SplitN(policy, ":", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid policy %q, expecting <table>:<ttl>", policy)
+		}
+
+		table, rawTTL := parts[0], parts[1]
+		if _, found := ttlTables[table]; !found {
+			return fmt.Errorf("unknown table %q in policy %q, valid tables are %s", table, policy, strings.Join(ttlTableNames(), ", "))
+		}
+
+		ttl, err := parseTTL(rawTTL)
+		if err != nil {
+			return fmt.Errorf("invalid ttl of policy %q: %w", policy, err)
+		}
+
+		out[table] = ttl
+	}
+
+	return nil
+}
+
+func parseTTL(in string) (uint64, error) {
+	if in == "forever" {
+		return 0, nil
+	}
+
+	if blocks, err := strconv.ParseUint(in, 10, 64); err == nil {
+		return blocks, nil
+	}
+
+	duration, err := time.ParseDuration(in)
+	if err != nil {
+		return 0, fmt.Errorf("%q is neither a block count, a duration nor 'forever'", in)
+	}
+	if duration < ttlBlockInterval {
+		return 0, fmt.Errorf("duration %s is shorter than a block", duration)
+	}
+
+	return uint64(duration / ttlBlockInterval), nil
+}
+
+func ttlTableNames() (out []string) {
+	for table := range ttlTables {
+		out = append(out, table)
+	}
+	sort.Strings(out)
+	return
+}
+
+// tablePurgeableStore is like `store.PurgeableKVStore` but with a retention per table. Each
+// row written gets a deletion row `TblTableTTL | table prefix | height | key`, except for the
+// tables kept forever. Deletion rows of the legacy `TblTTL | height | key` format are purged
+// using the default TTL.
+type tablePurgeableStore struct {
+	store.KVStore
+
+	defaultTTL uint64
+	ttls       map[string]uint64
+
+	height    uint64
+	heightSet bool
+
+	reportLock sync.Mutex
+	reports    map[string]*trxdb.TablePurgeReport
+}
+
+func newTablePurgeableStore(kvStore store.KVStore, defaultTTL uint64, ttls map[string]uint64) *tablePurgeableStore {
+	s := &tablePurgeableStore{
+		KVStore:    kvStore,
+		defaultTTL: defaultTTL,
+		ttls:       ttls,
+		reports:    map[string]*trxdb.TablePurgeReport{},
+	}
+
+	for _, table := range append(ttlTableNames(), legacyTTLTable) {
+		ttl, keepForever := s.tableTTL(table)
+		s.reports[table] = &trxdb.TablePurgeReport{Table: table, TTLInBlocks: ttl, KeepForever: keepForever}
+	}
+
+	return s
+}
+
+func (s *tablePurgeableStore) tableTTL(table string) (ttl uint64, keepForever bool) {
+	if ttl, found := s.ttls[table]; found {
+		return ttl, ttl == 0
+	}
+	return s.defaultTTL, false
+}
+
+func (s *tablePurgeableStore) prefixTTL(prefix byte) (ttl uint64, keepForever bool) {
+	for table, prefixes := range ttlTables {
+		for _, tablePrefix := range prefixes {
+			if tablePrefix == prefix {
+				return s.tableTTL(table)
+			}
+		}
+	}
+	return s.defaultTTL, false
+}
+
+func (s *tablePurgeableStore) Put(ctx context.Context, key, value []byte) error {
+	if !s.heightSet {
+		return fmt.Errorf("purgeable kv store height not set")
+	}
+
+	if err := s.KVStore.Put(ctx, key, value); err != nil {
+		return err
+	}
+
+	if _, keepForever := s.prefixTTL(key[0]); keepForever {
+		return nil
+	}
+
+	return s.KVStore.Put(ctx, tableDeletionKey(key[0], s.height, key), []byte{0x00})
+}
+
+func (s *tablePurgeableStore) MarkCurrentHeight(height uint64) {
+	// Guarded as the height is also read by the report
+	s.reportLock.Lock()
+	defer s.reportLock.Unlock()
+
+	s.height = height
+	s.heightSet = true
+}
+
+func (s *tablePurgeableStore) PurgeKeys(ctx context.Context) error {
+	for _, table := range ttlTableNames() {
+		ttl, keepForever := s.tableTTL(table)
+		if keepForever || s.height < ttl {
+			continue
+		}
+
+		belowBlockNum := s.height - ttl
+		var purged uint64
+		for _, prefix := range ttlTables[table] {
+			count, err := s.purgeRange(ctx, tableDeletionKey(prefix, 0, nil), tableDeletionKey(prefix, belowBlockNum, nil), 2+8)
+			if err != nil {
+				return fmt.Errorf("purge table %s: %w", table, err)
+			}
+			purged += count
+		}
+
+		s.recordPurge(table, belowBlockNum, purged)
+	}
+
+	if s.height >= s.defaultTTL {
+		belowBlockNum := s.height - s.defaultTTL
+		purged, err := s.purgeRange(ctx, legacyDeletionKey(0), legacyDeletionKey(belowBlockNum), 1+8)
+		if err != nil {
+			return fmt.Errorf("purge legacy ttl rows: %w", err)
+		}
+
+		s.recordPurge(legacyTTLTable, belowBlockNum, purged)
+	}
+
+	return nil
+}
+
+// purgeRange deletes the deletion rows in [start, end) and the rows they point to, the
+// original key starting at `keyOffset` in the deletion row.
+func (s *tablePurgeableStore) purgeRange(ctx context.Context, start, end []byte, keyOffset int) (purged uint64, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var keys [][]byte
+	it := s.KVStore.Scan(ctx, start, end, store.Unlimited, store.KeyOnly())
+	for it.Next() {
+		if len(keys) >= store.PurgeableMaxBatchSize {
+			if err := s.KVStore.BatchDelete(ctx, keys); err != nil {
+				return purged, fmt.Errorf("unable to delete batch: %w", err)
+			}
+			keys = nil
+		}
+
+		deletionKey := it.Item().Key
+		keys = append(keys, deletionKey, deletionKey[keyOffset:])
+		purged++
+	}
+	if err := it.Err(); err != nil {
+		return purged, fmt.Errorf("scan deletion rows: %w", err)
+	}
+
+	if len(keys) > 0 {
+		if err := s.KVStore.BatchDelete(ctx, keys); err != nil {
+			return purged, fmt.Errorf("unable to delete batch: %w", err)
+		}
+	}
+
+	return purged, nil
+}
+
+func (s *tablePurgeableStore) recordPurge(table string, belowBlockNum, purged uint64) {
+	zlog.Debug("purged table", zap.String("table", table), zap.Uint64("below_block_num", belowBlockNum), zap.Uint64("purged_rows", purged))
+
+	s.reportLock.Lock()
+	defer s.reportLock.Unlock()
+
+	report := s.reports[table]
+	report.PurgedBelowBlockNum = belowBlockNum
+	report.LastPurgedRows = purged
+	report.TotalPurgedRows += purged
+	report.LastPurgeTime = time.Now()
+}
+
+func (s *tablePurgeableStore) report() *trxdb.PurgeReport {
+	s.reportLock.Lock()
+	defer s.reportLock.Unlock()
+
+	out := &trxdb.PurgeReport{Height: s.height, DefaultTTL: s.defaultTTL}
+	for _, table := range append(ttlTableNames(), legacyTTLTable) {
+		report := *s.reports[table]
+		out.Tables = append(out.Tables, &report)
+	}
+	return out
+}
+
+func tableDeletionKey(tablePrefix byte, height uint64, key []byte) []byte {
+	out := make([]byte, 2+8+len(key))
+	out[0] = TblTableTTL
+	out[1] = tablePrefix
+	binary.BigEndian.PutUint64(out[2:], height)
+	copy(out[10:], key)
+	return out
+}
+
+func legacyDeletionKey(height uint64) []byte {
+	out := make([]byte, 1+8)
+	out[0] = TblTTL
+	binary.BigEndian.PutUint64(out[1:], height)
+	return out
+}
@@ -0,0 +1,77 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/streamingfast/kvdb/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTablePurgeableStore_PurgeKeys(t *testing.T) {
+	ctx := context.Background()
+	db, err := New([]string{"badger://" + t.TempDir() + "?ttl=traces:10,blocks:forever"})
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, db.SetPurgeableStore(100, 1))
+	s := db.writeStore.(*tablePurgeableStore)
+
+	traceKey := []byte{TblPrefixTrxTraces, 0x01}
+	blockKey := []byte{TblPrefixBlocks, 0x01}
+	trxKey := []byte{TblPrefixTrxs, 0x01}
+
+	s.MarkCurrentHeight(1)
+	for _, key := range [][]byte{traceKey, blockKey, trxKey} {
+		require.NoError(t, s.Put(ctx, key, []byte{0x01}))
+	}
+	require.NoError(t, s.FlushPuts(ctx))
+
+	exists := func(key []byte) bool {
+		_, err := s.Get(ctx, key)
+		if err == store.ErrNotFound {
+			return false
+		}
+		require.NoError(t, err)
+		return true
+	}
+
+	s.MarkCurrentHeight(50)
+	require.NoError(t, s.PurgeKeys(ctx))
+	assert.False(t, exists(traceKey))
+	assert.True(t, exists(blockKey))
+	assert.True(t, exists(trxKey))
+
+	s.MarkCurrentHeight(200)
+	require.NoError(t, s.PurgeKeys(ctx))
+	assert.True(t, exists(blockKey))
+	assert.False(t, exists(trxKey))
+
+	report := db.PurgeReport()
+	require.NotNil(t, report)
+	assert.Equal(t, uint64(200), report.Height)
+	for _, table := range report.Tables {
+		switch table.Table {
+		case "traces":
+			assert.Equal(t, uint64(1), table.TotalPurgedRows)
+		case "blocks":
+			assert.True(t, table.KeepForever)
+		case "trxs":
+			assert.Equal(t, uint64(1), table.TotalPurgedRows)
+			assert.Equal(t, uint64(100), table.PurgedBelowBlockNum)
+		}
+	}
+}
+
+func TestDB_HasTTLPolicies(t *testing.T) {
+	db, err := New([]string{"badger://" + t.TempDir() + "?ttl=traces:10"})
+	require.NoError(t, err)
+	defer db.Close()
+	assert.True(t, db.HasTTLPolicies())
+
+	other, err := New([]string{"badger://" + t.TempDir()})
+	require.NoError(t, err)
+	defer other.Close()
+	assert.False(t, other.HasTTLPolicies())
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/streamingfast/bstream"
 	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
 	pbtrxdb "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/trxdb/v1"
+	"github.com/zhongshuwen/histnew/trxdb"
 	"github.com/zhongshuwen/zswchain-go"
 	"github.com/streamingfast/kvdb"
 	"github.com/streamingfast/kvdb/store"
@@ -269,6 +270,176 @@ func (db *DB) ListSiblingBlocks(ctx context.Context, blockNum uint32, spread uin
 	return
 }
 
+func (db *DB) ListBlocksInRange(ctx context.Context, lowBlockNum, highBlockNum uint32, mode trxdb.BlockRangeMode) (out []*pbcodec.BlockWithRefs, err error) {
+	if lowBlockNum > highBlockNum {
+		return nil, fmt.Errorf("invalid block range, low block num %d is greater than high block num %d", lowBlockNum, highBlockNum)
+	}
+
+	db.logger.Debug("list blocks in range", zap.Uint32("low_block_num", lowBlockNum), zap.Uint32("high_block_num", highBlockNum), zap.Int("mode", int(mode)))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	irrEnd := Keys.EndOfIrrBlockTable()
+	if lowBlockNum > 0 {
+		irrEnd = Keys.PackIrrBlockNumPrefix(lowBlockNum - 1)
+	}
+
+	var irrKeys [][]byte
+	irrBlockIDs := map[string]bool{}
+	it := db.blkReadStore.Scan(ctx, Keys.PackIrrBlockNumPrefix(highBlockNum), irrEnd, 0, store.KeyOnly())
+	for it.Next() {
+		blockID := Keys.UnpackIrrBlocksKey(it.Item().Key)
+		irrBlockIDs[blockID] = true
+		irrKeys = append(irrKeys, Keys.PackBlocksKey(blockID))
+	}
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("scan irreversible blocks: %w", err)
+	}
+
+	toBlock := func(blockRow *pbtrxdb.BlockRow) *pbcodec.BlockWithRefs {
+		return &pbcodec.BlockWithRefs{
+			Id:                      blockRow.Block.Id,
+			Block:                   blockRow.Block,
+			ImplicitTransactionRefs: blockRow.ImplicitTrxRefs,
+			TransactionRefs:         blockRow.TrxRefs,
+			TransactionTraceRefs:    blockRow.TrxRefs,
+			Irreversible:            irrBlockIDs[blockRow.Block.Id],
+		}
+	}
+
+	if mode == trxdb.BlockRangeIrreversibleOnly {
+		if len(irrKeys) == 0 {
+			return nil, nil
+		}
+
+		// Irreversible block keys are sorted like the blocks table, from the highest block num
+		it := db.blkReadStore.BatchGet(ctx, irrKeys)
+		for it.Next() {
+			out = append(out, toBlock(db.decodeBlockRow(it.Item().Value)))
+		}
+		if err := it.Err(); err != nil {
+			if err == store.ErrNotFound {
+				return nil, fmt.Errorf("irreversible block row missing: %w", kvdb.ErrNotFound)
+			}
+			return nil, fmt.Errorf("get irreversible blocks: %w", err)
+		}
+
+		return out, nil
+	}
+
+	blocksEnd := Keys.EndOfBlocksTable()
+	if lowBlockNum > 0 {
+		blocksEnd = Keys.PackBlockNumPrefix(lowBlockNum - 1)
+	}
+
+	var rows []*pbtrxdb.BlockRow
+	it = db.blkReadStore.Scan(ctx, Keys.PackBlockNumPrefix(highBlockNum), blocksEnd, 0)
+	for it.Next() {
+		rows = append(rows, db.decodeBlockRow(it.Item().Value))
+	}
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("scan blocks: %w", err)
+	}
+
+	if mode == trxdb.BlockRangeCanonical {
+		if rows, err = db.canonicalBlockRows(ctx, rows, highBlockNum, irrBlockIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, row := range rows {
+		out = append(out, toBlock(row))
+	}
+
+	return out, nil
+}
+
+// canonicalBlockRows keeps one of the `rows` per height, sorted from the highest
+// height. Irreversible blocks are always kept, on reversible heights the kept
+// block is the one on the chain linking the head block down to them. The head
+// being above the range when the top of the range is reversible, the blocks
+// above `highBlockNum` are then read too, those are all reversible. Without a
+// last irreversible block, nothing bounds them and the highest block of the
+// range is taken as the head instead.
+func (db *DB) canonicalBlockRows(ctx context.Context, rows []*pbtrxdb.BlockRow, highBlockNum uint32, irrBlockIDs map[string]bool) (out []*pbtrxdb.BlockRow, err error) {
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	byID := map[string]*pbtrxdb.BlockRow{}
+	for _, row := range rows {
+		byID[row.Block.Id] = row
+	}
+
+	head := rows[0]
+	if !irrBlockIDs[head.Block.Id] || head.Block.Number != highBlockNum {
+		libRef, err := db.GetLastWrittenIrreversibleBlockRef(ctx)
+		if err != nil && err != kvdb.ErrNotFound {
+			return nil, fmt.Errorf("get last irreversible block: %w", err)
+		}
+
+		if libRef != nil && uint64(highBlockNum) > libRef.Num() {
+			it := db.blkReadStore.Scan(ctx, Keys.StartOfBlocksTable(), Keys.PackBlockNumPrefix(highBlockNum), 0)
+			for first := true; it.Next(); first = false {
+				row := db.decodeBlockRow(it.Item().Value)
+				if first {
+					head = row
+				}
+				byID[row.Block.Id] = row
+			}
+			if err := it.Err(); err != nil {
+				return nil, fmt.Errorf("scan blocks above range: %w", err)
+			}
+		}
+	}
+
+	expectedID := head.Block.Id
+	for i := 0; i < len(rows); {
+		num := rows[i].Block.Number
+
+		j := i
+		for j < len(rows) && rows[j].Block.Number == num {
+			j++
+		}
+		siblings := rows[i:j]
+		i = j
+
+		// Walk the chain down to the current height, heights might be missing
+		for expectedID != "" && zsw.BlockNum(expectedID) > num {
+			previous, found := byID[expectedID]
+			if !found {
+				expectedID = ""
+				break
+			}
+			expectedID = previous.Block.Header.Previous
+		}
+
+		kept := siblings[0]
+		for _, sibling := range siblings {
+			if irrBlockIDs[sibling.Block.Id] {
+				kept = sibling
+				break
+			}
+
+			if sibling.Block.Id == expectedID {
+				kept = sibling
+			}
+		}
+
+		out = append(out, kept)
+		expectedID = kept.Block.Header.Previous
+	}
+
+	return out, nil
+}
+
+func (db *DB) decodeBlockRow(value []byte) *pbtrxdb.BlockRow {
+	blockRow := &pbtrxdb.BlockRow{}
+	db.dec.MustInto(value, blockRow)
+	return blockRow
+}
+
 func (db *DB) GetAccount(ctx context.Context, accountName string) (*pbcodec.AccountCreationRef, error) {
 	value, err := db.blkReadStore.Get(ctx, Keys.PackAccountKey(accountName))
 
@@ -282,6 +453,10 @@ func (db *DB) GetAccount(ctx context.Context, accountName string) (*pbcodec.Acco
 
 	acctRow := &pbtrxdb.AccountRow{}
 	db.dec.MustInto(value, acctRow)
+	return accountRowToCreationRef(acctRow), nil
+}
+
+func accountRowToCreationRef(acctRow *pbtrxdb.AccountRow) *pbcodec.AccountCreationRef {
 	return &pbcodec.AccountCreationRef{
 		Account:       acctRow.Name,
 		Creator:       acctRow.Creator,
@@ -289,7 +464,64 @@ func (db *DB) GetAccount(ctx context.Context, accountName string) (*pbcodec.Acco
 		BlockId:       acctRow.BlockId,
 		BlockTime:     acctRow.BlockTime,
 		TransactionId: acctRow.TrxId,
-	}, nil
+	}
+}
+
+func (db *DB) ListAccountsCreatedBy(ctx context.Context, creator string, afterAccount string, limit int) (out []*pbcodec.AccountCreationRef, err error) {
+	if _, err := zsw.StringToName(creator); err != nil {
+		return nil, fmt.Errorf("invalid creator name %q: %w", creator, err)
+	}
+
+	prefix := Keys.PackCreatorAccountPrefix(creator)
+	start := prefix
+	if afterAccount != "" {
+		if _, err := zsw.StringToName(afterAccount); err != nil {
+			return nil, fmt.Errorf("invalid account name %q: %w", afterAccount, err)
+		}
+		start = append(Keys.PackCreatorAccountKey(creator, afterAccount), 0x00)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	it := db.blkReadStore.Scan(ctx, start, prefixEnd(prefix), limit)
+	for it.Next() {
+		acctRow := &pbtrxdb.AccountRow{}
+		db.dec.MustInto(it.Item().Value, acctRow)
+		out = append(out, accountRowToCreationRef(acctRow))
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// maxAccountLineageDepth guards against creator cycles, which cannot happen on a
+// sane chain but would otherwise loop forever on corrupted data.
+const maxAccountLineageDepth = 256
+
+func (db *DB) GetAccountLineage(ctx context.Context, accountName string) (out []*pbcodec.AccountCreationRef, err error) {
+	seen := map[string]bool{}
+
+	name := accountName
+	for len(out) < maxAccountLineageDepth && !seen[name] {
+		seen[name] = true
+
+		ref, err := db.GetAccount(ctx, name)
+		if err == kvdb.ErrNotFound && len(out) > 0 {
+			// Reached an account created at genesis
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, ref)
+		name = ref.Creator
+	}
+
+	return out, nil
 }
 
 func (db *DB) ListAccountNames(ctx context.Context) (out []string, err error) {
@@ -0,0 +1,231 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/streamingfast/kvdb"
+	"github.com/streamingfast/kvdb/store"
+	pbtrxdb "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/trxdb/v1"
+	"github.com/zhongshuwen/histnew/trxdb"
+	zsw "github.com/zhongshuwen/zswchain-go"
+	"go.uber.org/zap"
+)
+
+// pendingDtrxsBatchSize is the number of candidates of the expiration index
+// resolved at once when listing pending deferred transactions
+const pendingDtrxsBatchSize = 100
+
+// maxDtrxExpirationWindow bounds how far after its creation a deferred transaction
+// can expire, that is the chain's default `max_transaction_delay` (45 days) plus its
+// default `deferred_trx_expiration_window` (10 minutes)
+const maxDtrxExpirationWindow = 45*24*time.Hour + 10*time.Minute
+
+func (db *DB) ListDeferredTransactionRefsBySender(ctx context.Context, sender string, after *trxdb.TransactionRef, limit int) ([]*trxdb.TransactionRef, error) {
+	if _, err := zsw.StringToName(sender); err != nil {
+		return nil, fmt.Errorf("invalid sender %q: %w", sender, err)
+	}
+
+	var startKey []byte
+	if after != nil {
+		startKey = Keys.PackDtrxSenderKey(sender, after.BlockID, after.ID)
+	}
+
+	return db.listDigestIndex(ctx, Keys.PackDtrxSenderPrefix(sender), startKey, limit, Keys.UnpackDtrxSenderKey)
+}
+
+func (db *DB) ListPendingDeferredTransactionRefs(ctx context.Context, atBlockNum uint32, after *trxdb.TransactionRef, limit int) (out []*trxdb.TransactionRef, err error) {
+	db.logger.Debug("list pending deferred transactions", zap.Uint32("at_block_num", atBlockNum), zap.Int("limit", limit))
+	if limit < 1 {
+		return nil, nil
+	}
+
+	blocks, err := db.GetBlockByNum(ctx, atBlockNum)
+	if err != nil {
+		return nil, fmt.Errorf("get block #%d: %w", atBlockNum, err)
+	}
+	atTime := blocks[0].Block.MustTime()
+
+	libNum := uint32(0)
+	libRef, err := db.GetLastWrittenIrreversibleBlockRef(ctx)
+	if err != nil && err != kvdb.ErrNotFound {
+		return nil, fmt.Errorf("get last irreversible block: %w", err)
+	}
+	if libRef != nil {
+		libNum = uint32(libRef.Num())
+	}
+
+	start := Keys.PackDtrxExpirationPrefix(atTime)
+	end := Keys.PackDtrxExpirationPrefix(atTime.Add(maxDtrxExpirationWindow + time.Second))
+	if after != nil {
+		expiration, err := db.dtrxExpiration(ctx, after)
+		if err != nil {
+			return nil, err
+		}
+
+		afterKey := Keys.PackDtrxExpirationKey(expiration, after.ID, after.BlockID)
+		if bytes.Compare(afterKey, start) >= 0 {
+			start = append(afterKey, 0x00)
+		}
+	}
+
+	resolver := &pendingDtrxsResolver{db: db, atBlockNum: atBlockNum, libNum: libNum, seen: map[string]bool{}}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Deferred transactions expiring before `atTime` can't be pending anymore, every
+	// deferred transaction expiring later is a candidate if created at or before `atBlockNum`,
+	// which also means it expires at the latest `maxDtrxExpirationWindow` after `atTime`
+	var candidates []*trxdb.TransactionRef
+	it := db.trxReadStore.Scan(ctx, start, end, store.Unlimited, store.KeyOnly())
+	for it.Next() {
+		_, trxID, blockID := Keys.UnpackDtrxExpirationKey(it.Item().Key)
+		blockNum := zsw.BlockNum(blockID)
+		if blockNum > atBlockNum {
+			continue
+		}
+
+		candidates = append(candidates, &trxdb.TransactionRef{ID: trxID, BlockID: blockID, BlockNum: blockNum})
+		if len(candidates) < pendingDtrxsBatchSize {
+			continue
+		}
+
+		if out, err = resolver.appendPending(ctx, out, candidates, limit); err != nil {
+			return nil, err
+		}
+		if len(out) >= limit {
+			return out, nil
+		}
+		candidates = nil
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return resolver.appendPending(ctx, out, candidates, limit)
+}
+
+func (db *DB) dtrxExpiration(ctx context.Context, ref *trxdb.TransactionRef) (time.Time, error) {
+	value, err := db.trxReadStore.Get(ctx, Keys.PackDtrxsKeyCreated(ref.ID, ref.BlockID))
+	if err == store.ErrNotFound {
+		return time.Time{}, fmt.Errorf("deferred transaction %s created in block %s not found", ref.ID, ref.BlockID)
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	row := &pbtrxdb.DtrxRow{}
+	db.dec.MustInto(value, row)
+
+	expiration, err := zsw.ParseJSONTime(row.CreatedBy.GetDtrxOp().GetExpirationAt())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expiration of deferred transaction %s: %w", ref.ID, err)
+	}
+
+	return expiration.Time, nil
+}
+
+// pendingDtrxsResolver filters out the deferred transactions executed, canceled or
+// failed at or before `atBlockNum`. Only the blocks that are irreversible or above the
+// last irreversible block are considered, forked out blocks are ignored.
+type pendingDtrxsResolver struct {
+	db         *DB
+	atBlockNum uint32
+	libNum     uint32
+	seen       map[string]bool
+}
+
+func (r *pendingDtrxsResolver) appendPending(ctx context.Context, out []*trxdb.TransactionRef, candidates []*trxdb.TransactionRef, limit int) ([]*trxdb.TransactionRef, error) {
+	if len(candidates) == 0 {
+		return out, nil
+	}
+
+	var prefixes [][]byte
+	blockIDs := map[string]bool{}
+	for _, candidate := range candidates {
+		prefixes = append(prefixes, Keys.PackDtrxsPrefix(candidate.ID), Keys.PackTrxTracesPrefix(candidate.ID))
+		blockIDs[candidate.BlockID] = true
+	}
+
+	resolutionBlockIDs := map[string][]string{}
+	it := r.db.trxReadStore.BatchPrefix(ctx, prefixes, store.Unlimited, store.KeyOnly())
+	for it.Next() {
+		key := it.Item().Key
+
+		var trxID, blockID string
+		if key[0] == TblPrefixDtrxs {
+			if key[len(key)-1] == dtrxSuffixCreated {
+				continue
+			}
+			trxID, blockID = Keys.UnpackDtrxsKey(key)
+		} else {
+			trxID, blockID = Keys.UnpackTrxTracesKey(key)
+		}
+
+		if zsw.BlockNum(blockID) > r.atBlockNum {
+			continue
+		}
+
+		resolutionBlockIDs[trxID] = append(resolutionBlockIDs[trxID], blockID)
+		blockIDs[blockID] = true
+	}
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("get deferred transactions events: %w", err)
+	}
+
+	irreversible, err := r.db.irreversibleBlockIDs(ctx, blockIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	inChain := func(blockID string) bool {
+		return irreversible[blockID] || zsw.BlockNum(blockID) > r.libNum
+	}
+
+	for _, candidate := range candidates {
+		if r.seen[candidate.ID] || !inChain(candidate.BlockID) {
+			continue
+		}
+
+		resolved := false
+		for _, blockID := range resolutionBlockIDs[candidate.ID] {
+			if inChain(blockID) {
+				resolved = true
+				break
+			}
+		}
+		if resolved {
+			continue
+		}
+
+		r.seen[candidate.ID] = true
+		out = append(out, candidate)
+		if len(out) >= limit {
+			break
+		}
+	}
+
+	return out, nil
+}
+
+func (db *DB) irreversibleBlockIDs(ctx context.Context, blockIDs map[string]bool) (map[string]bool, error) {
+	var keys [][]byte
+	for blockID := range blockIDs {
+		keys = append(keys, Keys.PackIrrBlocksKey(blockID))
+	}
+
+	// A batch get stops at the first key not found, prefixes of whole keys are used instead
+	out := map[string]bool{}
+	it := db.blkReadStore.BatchPrefix(ctx, keys, store.Unlimited, store.KeyOnly())
+	for it.Next() {
+		out[Keys.UnpackIrrBlocksKey(it.Item().Key)] = true
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,101 @@
+package kv
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/streamingfast/kvdb/store"
+	"github.com/zhongshuwen/histnew/trxdb"
+	zsw "github.com/zhongshuwen/zswchain-go"
+	"github.com/zhongshuwen/zswchain-go/ecc"
+)
+
+func (db *DB) ListTransactionRefsBySignerKey(ctx context.Context, publicKey string, after *trxdb.TransactionRef, limit int) ([]*trxdb.TransactionRef, error) {
+	digest, err := signerKeyDigest(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var startKey []byte
+	if after != nil {
+		startKey = Keys.PackSignerKeyTrxsKey(digest, after.BlockID, after.ID)
+	}
+
+	return db.listDigestIndex(ctx, Keys.PackSignerKeyTrxsPrefix(digest), startKey, limit, Keys.UnpackSignerKeyTrxsKey)
+}
+
+func (db *DB) ListTransactionRefsByActionDataHash(ctx context.Context, dataHash string, after *trxdb.TransactionRef, limit int) ([]*trxdb.TransactionRef, error) {
+	digest, err := hex.DecodeString(dataHash)
+	if err != nil || len(digest) != sha256.Size {
+		return nil, fmt.Errorf("invalid action data hash %q, expecting an hex encoded sha256", dataHash)
+	}
+
+	var startKey []byte
+	if after != nil {
+		startKey = Keys.PackActionDataTrxsKey(digest, after.BlockID, after.ID)
+	}
+
+	return db.listDigestIndex(ctx, Keys.PackActionDataTrxsPrefix(digest), startKey, limit, Keys.UnpackActionDataTrxsKey)
+}
+
+// listDigestIndex lists the refs found under `prefix`, starting right after
+// `afterKey` when it's set.
+func (db *DB) listDigestIndex(ctx context.Context, prefix []byte, afterKey []byte, limit int, unpack func(key []byte) (blockID, trxID string)) (out []*trxdb.TransactionRef, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	start := prefix
+	if afterKey != nil {
+		start = append(afterKey, 0x00)
+	}
+
+	it := db.trxReadStore.Scan(ctx, start, prefixEnd(prefix), limit, store.KeyOnly())
+	for it.Next() {
+		blockID, trxID := unpack(it.Item().Key)
+		out = append(out, &trxdb.TransactionRef{
+			ID:       trxID,
+			BlockID:  blockID,
+			BlockNum: zsw.BlockNum(blockID),
+		})
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// signerKeyDigest identifies a public key regardless of the textual format
+// it was given in, both the legacy `EOS...` and the `PUB_K1_...` forms of the
+// same key give the same digest.
+func signerKeyDigest(publicKey string) ([]byte, error) {
+	key, err := ecc.NewPublicKey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key %q: %w", publicKey, err)
+	}
+
+	digest := sha256.Sum256(append([]byte{byte(key.Curve)}, key.Content...))
+	return digest[:], nil
+}
+
+func actionDataDigest(data []byte) []byte {
+	digest := sha256.Sum256(data)
+	return digest[:]
+}
+
+// prefixEnd returns the smallest key greater than every key starting with `prefix`
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/zhongshuwen/histnew/codec"
 	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
 	pbtrxdb "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/trxdb/v1"
+	"github.com/zhongshuwen/histnew/trxdb"
 	"github.com/zhongshuwen/zswchain-go"
 	"github.com/streamingfast/kvdb/store"
 )
@@ -79,6 +80,44 @@ func (db *DB) GetTransactionTracesBatch(ctx context.Context, idPrefixes []string
 	return
 }
 
+func (db *DB) GetProjectedTransactionTracesBatch(ctx context.Context, idPrefixes []string, actionIndexes [][]uint32, projection *trxdb.TraceProjection) (out [][]*pbcodec.TransactionEvent, err error) {
+	if len(actionIndexes) != len(idPrefixes) {
+		return nil, fmt.Errorf("expected action indexes for each of the %d id prefixes, got %d", len(idPrefixes), len(actionIndexes))
+	}
+
+	project := func(trace *pbcodec.TransactionTrace) *pbcodec.TransactionTrace {
+		for i, idPrefix := range idPrefixes {
+			if strings.HasPrefix(trace.Id, idPrefix) {
+				return projectTrace(trace, actionIndexes[i], projection)
+			}
+		}
+		return trace
+	}
+
+	flat, err := db.getProjectedTransactionEvents(ctx, idPrefixes, project, TrxExecutionEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = db.fillIrreversibilityData(ctx, flat)
+	if err != nil {
+		return nil, err
+	}
+	out = splitEventsPerTrx(idPrefixes, flat)
+	return
+}
+
+func projectTrace(trace *pbcodec.TransactionTrace, actionIndexes []uint32, projection *trxdb.TraceProjection) *pbcodec.TransactionTrace {
+	for _, actionIndex := range actionIndexes {
+		if int(actionIndex) >= len(trace.ActionTraces) {
+			return trace
+		}
+	}
+
+	out, _ := projection.Apply(trace, actionIndexes)
+	out.Receipt = trace.Receipt
+	return out
+}
+
 func (db *DB) fillIrreversibilityData(ctx context.Context, events []*pbcodec.TransactionEvent) error {
 	blockIDs := make(map[string]bool)
 	for _, ev := range events {
@@ -131,6 +170,12 @@ func (p2f trxPrefixToFull) check(full string) error {
 }
 
 func (db *DB) getTransactionEvents(ctx context.Context, idPrefixes []string, eventTypes ...TrxEventType) (out []*pbcodec.TransactionEvent, err error) {
+	return db.getProjectedTransactionEvents(ctx, idPrefixes, nil, eventTypes...)
+}
+
+// getProjectedTransactionEvents works like `getTransactionEvents`, passing each execution trace
+// through `project`, when not nil, right after decoding it.
+func (db *DB) getProjectedTransactionEvents(ctx context.Context, idPrefixes []string, project func(trace *pbcodec.TransactionTrace) *pbcodec.TransactionTrace, eventTypes ...TrxEventType) (out []*pbcodec.TransactionEvent, err error) {
 	var keys [][]byte
 	if len(eventTypes) == 0 { //default behavior is get all events
 		eventTypes = []TrxEventType{TrxAdditionEvent, TrxExecutionEvent, ImplicitTrxEvent, DtrxEvent}
@@ -230,9 +275,14 @@ func (db *DB) getTransactionEvents(ctx context.Context, idPrefixes []string, eve
 			}
 
 			codec.ReduplicateTransactionTrace(row.TrxTrace)
+			trace := row.TrxTrace
+			if project != nil {
+				trace = project(trace)
+			}
+
 			ev.Event = &pbcodec.TransactionEvent_Execution{
 				Execution: &pbcodec.TransactionEvent_Executed{
-					Trace:       row.TrxTrace,
+					Trace:       trace,
 					BlockHeader: row.BlockHeader,
 				},
 			}
@@ -0,0 +1,327 @@
+package kv
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/streamingfast/kvdb/store"
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+	pbtrxdb "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/trxdb/v1"
+	zsw "github.com/zhongshuwen/zswchain-go"
+	"go.uber.org/zap"
+)
+
+type VerifyIssueKind string
+
+const (
+	VerifyIssueMissingTransaction         VerifyIssueKind = "missing_transaction"
+	VerifyIssueMissingImplicitTransaction VerifyIssueKind = "missing_implicit_transaction"
+	VerifyIssueMissingTrace               VerifyIssueKind = "missing_trace"
+	VerifyIssueMissingDtrx                VerifyIssueKind = "missing_dtrx"
+	VerifyIssueInconsistentDtrx           VerifyIssueKind = "inconsistent_dtrx"
+	VerifyIssueMissingIrreversibleBlock   VerifyIssueKind = "missing_irreversible_block"
+	VerifyIssueConflictingIrreversible    VerifyIssueKind = "conflicting_irreversible"
+	VerifyIssueBrokenIrreversibleChain    VerifyIssueKind = "broken_irreversible_chain"
+)
+
+// VerifyIssue is an inconsistency found by `VerifyBlocks`, all of them can be fixed by
+// writing back the block at `BlockNum` (and marking it irreversible for the irreversible
+// related kinds) from merged blocks files.
+type VerifyIssue struct {
+	Kind     VerifyIssueKind
+	BlockNum uint32
+	BlockID  string
+	TrxID    string
+	Detail   string
+}
+
+func (i *VerifyIssue) String() string {
+	out := fmt.Sprintf("%s at block #%d (%s)", i.Kind, i.BlockNum, i.BlockID)
+	if i.TrxID != "" {
+		out += fmt.Sprintf(" for transaction %s", i.TrxID)
+	}
+	if i.Detail != "" {
+		out += ": " + i.Detail
+	}
+	return out
+}
+
+type VerifyStats struct {
+	Blocks             int
+	IrreversibleBlocks int
+	Transactions       int
+	Traces             int
+	DtrxOps            int
+	Issues             int
+}
+
+const verifyWindowSize = 1000
+
+// VerifyBlocks cross-checks the rows of every block, forked ones included, between
+// `lowBlockNum` and `highBlockNum` (both inclusive): the transactions, implicit
+// transactions and traces referenced by the block exist, the deferred transaction
+// rows created, cancelled or failed by the traces are consistent and the irreversible
+// markers form a chain of existing blocks. Each issue found is passed to `onIssue`.
+func (db *DB) VerifyBlocks(ctx context.Context, lowBlockNum, highBlockNum uint32, onIssue func(issue *VerifyIssue)) (*VerifyStats, error) {
+	if lowBlockNum > highBlockNum {
+		return nil, fmt.Errorf("invalid block range, low block num %d is greater than high block num %d", lowBlockNum, highBlockNum)
+	}
+	if db.blkReadStore == nil || db.trxReadStore == nil {
+		return nil, fmt.Errorf("verification requires reading both blocks and transactions, check the 'read' option of the dsn")
+	}
+
+	stats := &VerifyStats{}
+	report := func(issue *VerifyIssue) {
+		stats.Issues++
+		onIssue(issue)
+	}
+
+	// Windows are processed from the highest block num, like the tables are sorted
+	var expectedPreviousID string
+	var previousIrrNum uint32
+	for high := int64(highBlockNum); high >= int64(lowBlockNum); high -= verifyWindowSize {
+		low := high - verifyWindowSize + 1
+		if low < int64(lowBlockNum) {
+			low = int64(lowBlockNum)
+		}
+
+		db.logger.Debug("verifying blocks window", zap.Int64("low_block_num", low), zap.Int64("high_block_num", high))
+		blocks, err := db.blockRowsInRange(ctx, uint32(low), uint32(high))
+		if err != nil {
+			return stats, err
+		}
+
+		irrIDs, err := db.irreversibleIDsInRange(ctx, uint32(low), uint32(high))
+		if err != nil {
+			return stats, err
+		}
+
+		blocksByID := map[string]*pbtrxdb.BlockRow{}
+		for _, blk := range blocks {
+			stats.Blocks++
+			blocksByID[blk.Block.Id] = blk
+
+			if err := db.verifyBlockTransactions(ctx, blk, stats, report); err != nil {
+				return stats, fmt.Errorf("verify block %s: %w", blk.Block.Id, err)
+			}
+		}
+
+		for _, irrID := range irrIDs {
+			stats.IrreversibleBlocks++
+			num := zsw.BlockNum(irrID)
+
+			if stats.IrreversibleBlocks > 1 && num == previousIrrNum {
+				report(&VerifyIssue{Kind: VerifyIssueConflictingIrreversible, BlockNum: num, BlockID: irrID, Detail: "more than one block marked irreversible at this height"})
+			}
+			previousIrrNum = num
+
+			if expectedPreviousID != "" && zsw.BlockNum(expectedPreviousID) == num && expectedPreviousID != irrID {
+				report(&VerifyIssue{Kind: VerifyIssueBrokenIrreversibleChain, BlockNum: num, BlockID: irrID, Detail: fmt.Sprintf("irreversible block above links to %s", expectedPreviousID)})
+			}
+
+			blk, found := blocksByID[irrID]
+			if !found {
+				report(&VerifyIssue{Kind: VerifyIssueMissingIrreversibleBlock, BlockNum: num, BlockID: irrID, Detail: "marked irreversible but no block row"})
+				expectedPreviousID = ""
+				continue
+			}
+
+			expectedPreviousID = ""
+			if blk.Block.Header != nil {
+				expectedPreviousID = blk.Block.Header.Previous
+			}
+		}
+	}
+
+	return stats, nil
+}
+
+func (db *DB) blockRowsInRange(ctx context.Context, lowBlockNum, highBlockNum uint32) (out []*pbtrxdb.BlockRow, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	end := Keys.EndOfBlocksTable()
+	if lowBlockNum > 0 {
+		end = Keys.PackBlockNumPrefix(lowBlockNum - 1)
+	}
+
+	it := db.blkReadStore.Scan(ctx, Keys.PackBlockNumPrefix(highBlockNum), end, 0)
+	for it.Next() {
+		blockRow := &pbtrxdb.BlockRow{}
+		if err := db.dec.Into(it.Item().Value, blockRow); err != nil {
+			return nil, fmt.Errorf("decode block row %x: %w", it.Item().Key, err)
+		}
+		out = append(out, blockRow)
+	}
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("scan blocks: %w", err)
+	}
+
+	return out, nil
+}
+
+func (db *DB) irreversibleIDsInRange(ctx context.Context, lowBlockNum, highBlockNum uint32) (out []string, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	end := Keys.EndOfIrrBlockTable()
+	if lowBlockNum > 0 {
+		end = Keys.PackIrrBlockNumPrefix(lowBlockNum - 1)
+	}
+
+	it := db.blkReadStore.Scan(ctx, Keys.PackIrrBlockNumPrefix(highBlockNum), end, 0, store.KeyOnly())
+	for it.Next() {
+		out = append(out, Keys.UnpackIrrBlocksKey(it.Item().Key))
+	}
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("scan irreversible blocks: %w", err)
+	}
+
+	return out, nil
+}
+
+func (db *DB) verifyBlockTransactions(ctx context.Context, blk *pbtrxdb.BlockRow, stats *VerifyStats, report func(*VerifyIssue)) error {
+	blockID := blk.Block.Id
+	issue := func(kind VerifyIssueKind, trxID, detail string) {
+		report(&VerifyIssue{Kind: kind, BlockNum: blk.Block.Number, BlockID: blockID, TrxID: trxID, Detail: detail})
+	}
+
+	seenTraces := map[string]bool{}
+	checkTrace := func(trxID string) (*pbtrxdb.TrxTraceRow, error) {
+		seenTraces[trxID] = true
+		stats.Traces++
+
+		traceRow := &pbtrxdb.TrxTraceRow{}
+		found, err := db.getTrxRow(ctx, Keys.PackTrxTracesKey(trxID, blockID), traceRow)
+		if err != nil || !found {
+			return nil, err
+		}
+
+		if err := db.verifyDtrxOps(ctx, blockID, traceRow.TrxTrace, stats, issue); err != nil {
+			return nil, err
+		}
+		return traceRow, nil
+	}
+
+	for _, hash := range refHashes(blk.TrxRefs) {
+		trxID := hex.EncodeToString(hash)
+		stats.Transactions++
+
+		found, err := db.getTrxRow(ctx, Keys.PackTrxsKey(trxID, blockID), nil)
+		if err != nil {
+			return err
+		}
+
+		traceRow, err := checkTrace(trxID)
+		if err != nil {
+			return err
+		}
+
+		if traceRow == nil {
+			issue(VerifyIssueMissingTrace, trxID, "referenced transaction has no trace")
+		}
+
+		// Receipts of deferred transactions have no transaction row, it's held by the dtrx created row
+		if !found && (traceRow == nil || !traceRow.TrxTrace.GetScheduled()) {
+			issue(VerifyIssueMissingTransaction, trxID, "")
+		}
+	}
+
+	for _, hash := range refHashes(blk.TraceRefs) {
+		trxID := hex.EncodeToString(hash)
+		if seenTraces[trxID] {
+			continue
+		}
+
+		traceRow, err := checkTrace(trxID)
+		if err != nil {
+			return err
+		}
+
+		if traceRow == nil {
+			issue(VerifyIssueMissingTrace, trxID, "")
+		}
+	}
+
+	for _, hash := range refHashes(blk.ImplicitTrxRefs) {
+		trxID := hex.EncodeToString(hash)
+		stats.Transactions++
+
+		found, err := db.getTrxRow(ctx, Keys.PackImplicitTrxsKey(trxID, blockID), nil)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			issue(VerifyIssueMissingImplicitTransaction, trxID, "")
+		}
+	}
+
+	return nil
+}
+
+func (db *DB) verifyDtrxOps(ctx context.Context, blockID string, trace *pbcodec.TransactionTrace, stats *VerifyStats, issue func(kind VerifyIssueKind, trxID, detail string)) error {
+	for _, dtrxOp := range trace.GetDtrxOps() {
+		stats.DtrxOps++
+
+		var key []byte
+		switch {
+		case dtrxOp.IsCreateOperation():
+			key = Keys.PackDtrxsKeyCreated(dtrxOp.TransactionId, blockID)
+		case dtrxOp.IsCancelOperation():
+			key = Keys.PackDtrxsKeyCancelled(dtrxOp.TransactionId, blockID)
+		case dtrxOp.IsFailedOperation():
+			key = Keys.PackDtrxsKeyFailed(dtrxOp.TransactionId, blockID)
+		default:
+			issue(VerifyIssueInconsistentDtrx, dtrxOp.TransactionId, fmt.Sprintf("unknown operation %s in trace of %s", dtrxOp.Operation, trace.Id))
+			continue
+		}
+
+		dtrxRow := &pbtrxdb.DtrxRow{}
+		found, err := db.getTrxRow(ctx, key, dtrxRow)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			issue(VerifyIssueMissingDtrx, dtrxOp.TransactionId, fmt.Sprintf("no %s row for operation of %s", dtrxOp.Operation, trace.Id))
+			continue
+		}
+
+		switch {
+		case dtrxOp.IsCreateOperation() && (dtrxRow.CreatedBy == nil || dtrxRow.SignedTrx == nil):
+			issue(VerifyIssueInconsistentDtrx, dtrxOp.TransactionId, "created row without its creation operation or signed transaction")
+		case dtrxOp.IsCancelOperation() && dtrxRow.CanceledBy == nil:
+			issue(VerifyIssueInconsistentDtrx, dtrxOp.TransactionId, "cancelled row without its cancel operation")
+		}
+	}
+
+	return nil
+}
+
+// getTrxRow fetches a row of the transactions store, decoding it into `row` when not nil
+func (db *DB) getTrxRow(ctx context.Context, key []byte, row proto.Message) (found bool, err error) {
+	value, err := db.trxReadStore.Get(ctx, key)
+	if err == store.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("get row %x: %w", key, err)
+	}
+
+	if row != nil {
+		if err := db.dec.Into(value, row); err != nil {
+			return false, fmt.Errorf("decode row %x: %w", key, err)
+		}
+	}
+	return true, nil
+}
+
+func refHashes(refs *pbcodec.TransactionRefs) [][]byte {
+	if refs == nil {
+		return nil
+	}
+	return refs.Hashes
+}
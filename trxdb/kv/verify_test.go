@@ -0,0 +1,66 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ct "github.com/zhongshuwen/histnew/codec/testing"
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+)
+
+func TestDB_VerifyBlocks(t *testing.T) {
+	ctx := context.Background()
+	db, err := New([]string{"badger://" + t.TempDir()})
+	require.NoError(t, err)
+	defer db.Close()
+
+	blockID := func(num uint32, fork string) string {
+		return fmt.Sprintf("%08x%s000000000000000000000000000000000000000000000000000000", num, fork)
+	}
+	trxID := func(num uint32) string {
+		return fmt.Sprintf("%02xbc5790ef36d5779e2a0a849a11c09c999b5dc564afce6920e20b07af1f4b6a", num)
+	}
+
+	for num := uint32(1); num <= 5; num++ {
+		blk := ct.Block(t, blockID(num, "aa"),
+			ct.TrxTrace(t, ct.TrxID(trxID(num)),
+				ct.DtrxOp(t, "create", trxID(num), ct.DtrxOpPayer("eoscanada1"), &pbcodec.SignedTransaction{Signatures: []string{"signature"}}),
+			),
+		)
+
+		require.NoError(t, db.PutBlock(ctx, blk))
+		require.NoError(t, db.UpdateNowIrreversibleBlock(ctx, blk))
+	}
+	require.NoError(t, db.Flush(ctx))
+
+	verify := func() (issues []VerifyIssueKind) {
+		stats, err := db.VerifyBlocks(ctx, 1, 5, func(issue *VerifyIssue) {
+			issues = append(issues, issue.Kind)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 5, stats.Blocks)
+		assert.Equal(t, len(issues), stats.Issues)
+		return
+	}
+
+	assert.Len(t, verify(), 0)
+
+	require.NoError(t, db.writeStore.BatchDelete(ctx, [][]byte{
+		Keys.PackTrxTracesKey(trxID(2), blockID(2, "aa")),
+		Keys.PackDtrxsKeyCreated(trxID(3), blockID(3, "aa")),
+	}))
+
+	forked := ct.Block(t, blockID(4, "bb"))
+	require.NoError(t, db.UpdateNowIrreversibleBlock(ctx, forked))
+	require.NoError(t, db.Flush(ctx))
+
+	assert.ElementsMatch(t, []VerifyIssueKind{
+		VerifyIssueMissingTrace,
+		VerifyIssueMissingDtrx,
+		VerifyIssueConflictingIrreversible,
+		VerifyIssueMissingIrreversibleBlock,
+	}, verify())
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/zhongshuwen/histnew/trxdb"
 	"github.com/golang/protobuf/ptypes"
 	kvdbstore "github.com/streamingfast/kvdb/store"
+	zsw "github.com/zhongshuwen/zswchain-go"
 	"go.uber.org/zap"
 )
 
@@ -123,6 +124,68 @@ func (db *DB) putTransactions(ctx context.Context, blk *pbcodec.Block) error {
 		if err != nil {
 			return fmt.Errorf("put trx: write to db: %w", err)
 		}
+
+		if err := db.putSignerKeyIndexes(ctx, blk, trxReceipt.Id, pubKeyProto.PublicKeys); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (db *DB) putSignerKeyIndexes(ctx context.Context, blk *pbcodec.Block, trxID string, publicKeys []string) error {
+	for _, publicKey := range publicKeys {
+		digest, err := signerKeyDigest(publicKey)
+		if err != nil {
+			db.logger.Debug("skipping signer key index of unparsable public key", zap.String("trx_id", trxID), zap.String("public_key", publicKey), zap.Error(err))
+			continue
+		}
+
+		// NOTE: This function is guarded by the parent with db.enableTrxWrite
+		if err := db.writeStore.Put(ctx, Keys.PackSignerKeyTrxsKey(digest, blk.Id, trxID), oneByte); err != nil {
+			return fmt.Errorf("put signer key index: write to db: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (db *DB) putActionDataIndexes(ctx context.Context, blk *pbcodec.Block, trxTrace *pbcodec.TransactionTrace) error {
+	seen := map[string]bool{}
+	for _, actTrace := range trxTrace.ActionTraces {
+		if actTrace.Action == nil || len(actTrace.Action.RawData) == 0 {
+			continue
+		}
+
+		digest := actionDataDigest(actTrace.Action.RawData)
+		if seen[string(digest)] {
+			continue
+		}
+		seen[string(digest)] = true
+
+		// NOTE: This function is guarded by the parent with db.enableTrxWrite
+		if err := db.writeStore.Put(ctx, Keys.PackActionDataTrxsKey(digest, blk.Id, trxTrace.Id), oneByte); err != nil {
+			return fmt.Errorf("put action data index: write to db: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (db *DB) putDtrxIndexes(ctx context.Context, blk *pbcodec.Block, dtrxOp *pbcodec.DTrxOp) error {
+	// NOTE: This function is guarded by the parent with db.enableTrxWrite
+	if err := db.writeStore.Put(ctx, Keys.PackDtrxSenderKey(dtrxOp.Sender, blk.Id, dtrxOp.TransactionId), oneByte); err != nil {
+		return fmt.Errorf("put dtrx sender index: write to db: %w", err)
+	}
+
+	expiration, err := zsw.ParseJSONTime(dtrxOp.ExpirationAt)
+	if err != nil {
+		db.logger.Debug("skipping dtrx expiration index of unparsable expiration", zap.String("trx_id", dtrxOp.TransactionId), zap.String("expiration_at", dtrxOp.ExpirationAt), zap.Error(err))
+		return nil
+	}
+
+	if err := db.writeStore.Put(ctx, Keys.PackDtrxExpirationKey(expiration.Time, dtrxOp.TransactionId, blk.Id), oneByte); err != nil {
+		return fmt.Errorf("put dtrx expiration index: write to db: %w", err)
 	}
 
 	return nil
@@ -154,6 +217,17 @@ func (db *DB) putTransactionTraces(ctx context.Context, blk *pbcodec.Block) erro
 			if err := db.writeStore.Put(ctx, key, db.enc.MustProto(dtrxRow)); err != nil {
 				return fmt.Errorf("put dtrxRow: write to db: %w", err)
 			}
+
+			if dtrxOp.IsCreateOperation() {
+				if err := db.putDtrxIndexes(ctx, blk, dtrxOp); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Must be done before deduplication which strips repeated action data
+		if err := db.putActionDataIndexes(ctx, blk, trxTrace); err != nil {
+			return err
 		}
 
 		codec.DeduplicateTransactionTrace(trxTrace)
@@ -199,10 +273,16 @@ func (db *DB) putNewAccount(ctx context.Context, blk *pbcodec.Block, trace *pbco
 
 	// NOTE: This function is guarded by the parent with db.enableBlkWrite
 	key := Keys.PackAccountKey(acctRow.Name)
-	if err := db.writeStore.Put(ctx, key, db.enc.MustProto(acctRow)); err != nil {
+	value := db.enc.MustProto(acctRow)
+	if err := db.writeStore.Put(ctx, key, value); err != nil {
 		return fmt.Errorf("put acctRow: write to db: %w", err)
 	}
 
+	// The creator index holds a copy of the row, listing accounts of a creator requires no extra lookup
+	if err := db.writeStore.Put(ctx, Keys.PackCreatorAccountKey(acctRow.Creator, acctRow.Name), value); err != nil {
+		return fmt.Errorf("put creator index: write to db: %w", err)
+	}
+
 	return nil
 }
 
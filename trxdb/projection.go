@@ -0,0 +1,104 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trxdb
+
+import (
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+)
+
+// TraceProjection selects which parts of a search matched transaction are kept
+// when the trace is hydrated, see `ProjectedTracesReader` for drivers pruning
+// the traces while reading them. A `nil` projection keeps the full transaction
+// trace.
+//
+// When a projection is applied, the resulting `TransactionTrace` only contains
+// the matched action traces (in the same order as `MatchingActions`) along with
+// the transaction level identification fields (id, block num, block id, block
+// time, index). Everything else is dropped unless explicitly requested here,
+// in particular the RAM, table, deferred transaction and other action indexed
+// operations are never kept.
+type TraceProjection struct {
+	// ActionData keeps the `Action` payload (account, name, authorizations, raw
+	// and JSON data) of each matched action.
+	ActionData bool `json:"action_data"`
+
+	// Receipts keeps the action receipt of each matched action as well as the
+	// transaction receipt header.
+	Receipts bool `json:"receipts"`
+
+	// DBOps keeps the database operations performed by the matched actions,
+	// their `ActionIndex` pointing in the projected `ActionTraces`.
+	DBOps bool `json:"db_ops"`
+}
+
+// Apply returns a new trace containing only the matched actions of `trace`,
+// pruned according to the projection. The input trace is never modified, the
+// returned action traces are shallow copies of the original ones.
+func (p *TraceProjection) Apply(trace *pbcodec.TransactionTrace, actionIndexes []uint32) (out *pbcodec.TransactionTrace, matchingActions []*pbcodec.ActionTrace) {
+	out = &pbcodec.TransactionTrace{
+		Id:              trace.Id,
+		BlockNum:        trace.BlockNum,
+		Index:           trace.Index,
+		BlockTime:       trace.BlockTime,
+		ProducerBlockId: trace.ProducerBlockId,
+		Scheduled:       trace.Scheduled,
+	}
+
+	if p.Receipts {
+		out.Receipt = trace.Receipt
+	}
+
+	out.ActionTraces = make([]*pbcodec.ActionTrace, len(actionIndexes))
+	for i, actionIndex := range actionIndexes {
+		out.ActionTraces[i] = p.projectAction(trace.ActionTraces[actionIndex])
+
+		if p.DBOps {
+			for _, op := range trace.DBOpsForAction(actionIndex) {
+				projectedOp := *op
+				projectedOp.ActionIndex = uint32(i)
+				out.DbOps = append(out.DbOps, &projectedOp)
+			}
+		}
+	}
+
+	return out, out.ActionTraces
+}
+
+func (p *TraceProjection) projectAction(in *pbcodec.ActionTrace) *pbcodec.ActionTrace {
+	out := &pbcodec.ActionTrace{
+		Receiver:                               in.Receiver,
+		TransactionId:                          in.TransactionId,
+		BlockNum:                               in.BlockNum,
+		ProducerBlockId:                        in.ProducerBlockId,
+		BlockTime:                              in.BlockTime,
+		ActionOrdinal:                          in.ActionOrdinal,
+		CreatorActionOrdinal:                   in.CreatorActionOrdinal,
+		ClosestUnnotifiedAncestorActionOrdinal: in.ClosestUnnotifiedAncestorActionOrdinal,
+		ExecutionIndex:                         in.ExecutionIndex,
+	}
+
+	if p.ActionData {
+		out.Action = in.Action
+	} else if in.Action != nil {
+		// We always keep the action's identification, only the payload is dropped
+		out.Action = &pbcodec.Action{Account: in.Action.Account, Name: in.Action.Name}
+	}
+
+	if p.Receipts {
+		out.Receipt = in.Receipt
+	}
+
+	return out
+}
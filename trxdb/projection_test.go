@@ -0,0 +1,86 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trxdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+)
+
+func TestTraceProjection_Apply(t *testing.T) {
+	trace := &pbcodec.TransactionTrace{
+		Id:       "trx1",
+		BlockNum: 10,
+		Receipt:  &pbcodec.TransactionReceiptHeader{CpuUsageMicroSeconds: 100},
+		ActionTraces: []*pbcodec.ActionTrace{
+			{Receiver: "zswhq", Action: &pbcodec.Action{Account: "zswhq", Name: "newaccount", RawData: []byte{0x01}}, Receipt: &pbcodec.ActionReceipt{GlobalSequence: 1}, Console: "a"},
+			{Receiver: "zswhq.token", Action: &pbcodec.Action{Account: "zswhq.token", Name: "transfer", RawData: []byte{0x02}}, Receipt: &pbcodec.ActionReceipt{GlobalSequence: 2}, Console: "b"},
+		},
+		DbOps: []*pbcodec.DBOp{
+			{ActionIndex: 0, TableName: "accounts"},
+			{ActionIndex: 1, TableName: "stat"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		projection *TraceProjection
+		check      func(t *testing.T, out *pbcodec.TransactionTrace)
+	}{
+		{
+			name:       "nothing selected",
+			projection: &TraceProjection{},
+			check: func(t *testing.T, out *pbcodec.TransactionTrace) {
+				assert.Nil(t, out.Receipt)
+				assert.Nil(t, out.DbOps)
+				assert.Equal(t, &pbcodec.Action{Account: "zswhq.token", Name: "transfer"}, out.ActionTraces[0].Action)
+				assert.Nil(t, out.ActionTraces[0].Receipt)
+			},
+		},
+		{
+			name:       "everything selected",
+			projection: &TraceProjection{ActionData: true, Receipts: true, DBOps: true},
+			check: func(t *testing.T, out *pbcodec.TransactionTrace) {
+				assert.Equal(t, trace.Receipt, out.Receipt)
+				assert.Equal(t, []*pbcodec.DBOp{{ActionIndex: 0, TableName: "stat"}}, out.DbOps)
+				assert.Equal(t, trace.ActionTraces[1].Action, out.ActionTraces[0].Action)
+				assert.Equal(t, trace.ActionTraces[1].Receipt, out.ActionTraces[0].Receipt)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out, matchingActions := test.projection.Apply(trace, []uint32{1})
+
+			require.Len(t, out.ActionTraces, 1)
+			assert.Equal(t, out.ActionTraces, matchingActions)
+			assert.Equal(t, "trx1", out.Id)
+			assert.Equal(t, uint64(10), out.BlockNum)
+			assert.Equal(t, "zswhq.token", out.ActionTraces[0].Receiver)
+			assert.Empty(t, out.ActionTraces[0].Console)
+
+			test.check(t, out)
+		})
+	}
+
+	// Input trace must not be altered by the projection
+	assert.Len(t, trace.ActionTraces, 2)
+	assert.Equal(t, "a", trace.ActionTraces[0].Console)
+	assert.Equal(t, uint32(1), trace.DbOps[1].ActionIndex)
+}
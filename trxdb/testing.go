@@ -50,6 +50,22 @@ func (r *TestTransactionsReader) GetTransactionEventsBatch(ctx context.Context,
 	panic("not implemented")
 }
 
+func (r *TestTransactionsReader) ListTransactionRefsBySignerKey(ctx context.Context, publicKey string, after *TransactionRef, limit int) ([]*TransactionRef, error) {
+	panic("not implemented")
+}
+
+func (r *TestTransactionsReader) ListTransactionRefsByActionDataHash(ctx context.Context, dataHash string, after *TransactionRef, limit int) ([]*TransactionRef, error) {
+	panic("not implemented")
+}
+
+func (r *TestTransactionsReader) ListDeferredTransactionRefsBySender(ctx context.Context, sender string, after *TransactionRef, limit int) ([]*TransactionRef, error) {
+	panic("not implemented")
+}
+
+func (r *TestTransactionsReader) ListPendingDeferredTransactionRefs(ctx context.Context, atBlockNum uint32, after *TransactionRef, limit int) ([]*TransactionRef, error) {
+	panic("not implemented")
+}
+
 type testDriver struct {
 	dsn           string
 	options       []Option
@@ -79,6 +95,14 @@ func (db *testDriver) ListAccountNames(ctx context.Context, concurrentReadCount
 	panic("test driver, not callable")
 }
 
+func (db *testDriver) ListAccountsCreatedBy(ctx context.Context, creator string, afterAccount string, limit int) ([]*pbcodec.AccountCreationRef, error) {
+	panic("test driver, not callable")
+}
+
+func (db *testDriver) GetAccountLineage(ctx context.Context, accountName string) ([]*pbcodec.AccountCreationRef, error) {
+	panic("test driver, not callable")
+}
+
 func (db *testDriver) GetTransactionTraces(ctx context.Context, idPrefix string) ([]*pbcodec.TransactionEvent, error) {
 	panic("test driver, not callable")
 }
@@ -95,6 +119,22 @@ func (db *testDriver) GetTransactionEventsBatch(ctx context.Context, idPrefixes
 	panic("test driver, not callable")
 }
 
+func (db *testDriver) ListTransactionRefsBySignerKey(ctx context.Context, publicKey string, after *TransactionRef, limit int) ([]*TransactionRef, error) {
+	panic("test driver, not callable")
+}
+
+func (db *testDriver) ListTransactionRefsByActionDataHash(ctx context.Context, dataHash string, after *TransactionRef, limit int) ([]*TransactionRef, error) {
+	panic("test driver, not callable")
+}
+
+func (db *testDriver) ListDeferredTransactionRefsBySender(ctx context.Context, sender string, after *TransactionRef, limit int) ([]*TransactionRef, error) {
+	panic("test driver, not callable")
+}
+
+func (db *testDriver) ListPendingDeferredTransactionRefs(ctx context.Context, atBlockNum uint32, after *TransactionRef, limit int) ([]*TransactionRef, error) {
+	panic("test driver, not callable")
+}
+
 func (db *testDriver) BlockIDAt(ctx context.Context, start time.Time) (id string, err error) {
 	panic("test driver, not callable")
 }
@@ -135,6 +175,10 @@ func (db *testDriver) ListSiblingBlocks(ctx context.Context, blockNum uint32, sp
 	panic("test driver, not callable")
 }
 
+func (db *testDriver) ListBlocksInRange(ctx context.Context, lowBlockNum, highBlockNum uint32, mode BlockRangeMode) ([]*pbcodec.BlockWithRefs, error) {
+	panic("test driver, not callable")
+}
+
 func (db *testDriver) SetWriterChainID(chainID []byte) { panic("test driver, not callable") }
 
 func (db *testDriver) GetLastWrittenIrreversibleBlockRef(ctx context.Context) (ref bstream.BlockRef, err error) {
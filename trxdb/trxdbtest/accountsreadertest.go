@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	ct "github.com/zhongshuwen/histnew/codec/testing"
+	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
 	"github.com/zhongshuwen/histnew/trxdb"
 	"github.com/zhongshuwen/zswchain-go"
 	"github.com/zhongshuwen/zswchain-go/system"
@@ -32,6 +33,8 @@ import (
 var accountsReaderTest = []DriverTestFunc{
 	TestGetAccount,
 	TestListAccountNames,
+	TestListAccountsCreatedBy,
+	TestGetAccountLineage,
 }
 
 func TestGetAccount(t *testing.T, driverFactory DriverFactory) {
@@ -124,6 +127,63 @@ func TestListAccountNames(t *testing.T, driverFactory DriverFactory) {
 	}
 }
 
+func TestListAccountsCreatedBy(t *testing.T, driverFactory DriverFactory) {
+	db, clean := driverFactory()
+	defer clean()
+
+	putAccount(t, "factory", "farm.c", db)
+	putAccount(t, "factory", "farm.a", db)
+	putAccount(t, "factory", "farm.b", db)
+	putAccount(t, "other", "farm.d", db)
+
+	accountNames := func(refs []*pbcodec.AccountCreationRef) (out []string) {
+		for _, ref := range refs {
+			assert.Equal(t, "factory", ref.Creator)
+			out = append(out, ref.Account)
+		}
+		return
+	}
+
+	ctx := context.Background()
+	refs, err := db.ListAccountsCreatedBy(ctx, "factory", "", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"farm.a", "farm.b", "farm.c"}, accountNames(refs))
+
+	refs, err = db.ListAccountsCreatedBy(ctx, "factory", "", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"farm.a", "farm.b"}, accountNames(refs))
+
+	refs, err = db.ListAccountsCreatedBy(ctx, "factory", "farm.b", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"farm.c"}, accountNames(refs))
+
+	refs, err = db.ListAccountsCreatedBy(ctx, "nobody", "", 10)
+	require.NoError(t, err)
+	assert.Len(t, refs, 0)
+}
+
+func TestGetAccountLineage(t *testing.T, driverFactory DriverFactory) {
+	db, clean := driverFactory()
+	defer clean()
+
+	putAccount(t, "zswhq", "factory", db)
+	putAccount(t, "factory", "farm.a", db)
+	putAccount(t, "farm.a", "farm.aa", db)
+
+	ctx := context.Background()
+	refs, err := db.GetAccountLineage(ctx, "farm.aa")
+	require.NoError(t, err)
+
+	var lineage []string
+	for _, ref := range refs {
+		lineage = append(lineage, ref.Account+"<"+ref.Creator)
+	}
+	assert.Equal(t, []string{"farm.aa<farm.a", "farm.a<factory", "factory<zswhq"}, lineage)
+
+	_, err = db.GetAccountLineage(ctx, "unknown")
+	assert.Equal(t, kvdb.ErrNotFound, err)
+}
+
 func putAccount(t *testing.T, creator, account string, db trxdb.DB) {
 	blk := ct.Block(t, "00000002aa",
 		ct.TrxTrace(t, ct.TrxID("a1"),
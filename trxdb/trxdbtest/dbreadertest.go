@@ -20,6 +20,7 @@ import (
 
 	ct "github.com/zhongshuwen/histnew/codec/testing"
 	pbcodec "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/codec/v1"
+	"github.com/zhongshuwen/histnew/trxdb"
 	"github.com/streamingfast/kvdb"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -30,6 +31,7 @@ var dbReaderTests = []DriverTestFunc{
 	TestGetBlockByNum,
 	TestListBlocks,
 	TestListSiblingBlocks,
+	TestListBlocksInRange,
 	TestGetClosestIrreversibleIDAtBlockNum,
 	TestGetIrreversibleIDAtBlockID,
 	TestGetLastWrittenBlockID,
@@ -392,6 +394,101 @@ func TestListBlocks(t *testing.T, driverFactory DriverFactory) {
 	require.Equal(t, 0, len(resps))
 }
 
+func TestListBlocksInRange(t *testing.T, driverFactory DriverFactory) {
+	ctx := context.Background()
+	driver, cleanup := driverFactory()
+	defer cleanup()
+
+	putBlockAfter := func(id string, previousID string, irreversible bool) {
+		b := ct.Block(t, id)
+		if previousID != "" {
+			b.Header.Previous = previousID
+		}
+
+		require.NoError(t, driver.PutBlock(ctx, b))
+		if irreversible {
+			require.NoError(t, driver.UpdateNowIrreversibleBlock(ctx, b))
+		}
+	}
+
+	putBlock := func(id string, irreversible bool) {
+		putBlockAfter(id, "", irreversible)
+	}
+
+	putBlock("00000003aa", true)
+	putBlock("00000004aa", true)
+	putBlock("00000004bb", false)
+	putBlock("00000005aa", true)
+	putBlock("00000005bb", false)
+	putBlock("00000006aa", false)
+	require.NoError(t, driver.Flush(ctx))
+
+	ids := func(blocks []*pbcodec.BlockWithRefs) (out []string) {
+		for _, blk := range blocks {
+			out = append(out, blk.Id)
+		}
+		return
+	}
+
+	resps, err := driver.ListBlocksInRange(ctx, 4, 6, trxdb.BlockRangeIrreversibleOnly)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"00000005aa", "00000004aa"}, ids(resps))
+	for _, resp := range resps {
+		assert.True(t, resp.Irreversible)
+	}
+
+	resps, err = driver.ListBlocksInRange(ctx, 4, 6, trxdb.BlockRangeAllForks)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"00000006aa", "00000005aa", "00000005bb", "00000004aa", "00000004bb"}, ids(resps))
+	assert.False(t, resps[0].Irreversible)
+	assert.True(t, resps[1].Irreversible)
+	assert.False(t, resps[2].Irreversible)
+
+	resps, err = driver.ListBlocksInRange(ctx, 4, 6, trxdb.BlockRangeCanonical)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"00000006aa", "00000005aa", "00000004aa"}, ids(resps))
+
+	resps, err = driver.ListBlocksInRange(ctx, 0, 3, trxdb.BlockRangeIrreversibleOnly)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"00000003aa"}, ids(resps))
+
+	resps, err = driver.ListBlocksInRange(ctx, 7, 10, trxdb.BlockRangeAllForks)
+	require.NoError(t, err)
+	assert.Len(t, resps, 0)
+
+	_, err = driver.ListBlocksInRange(ctx, 5, 4, trxdb.BlockRangeAllForks)
+	assert.Error(t, err)
+
+	// A longer fork built on the last irreversible block becomes the canonical chain,
+	// even when its head is above the requested range
+	putBlockAfter("00000006cc", "00000005aa", false)
+	putBlock("00000007cc", false)
+	putBlock("00000008cc", false)
+	require.NoError(t, driver.Flush(ctx))
+
+	resps, err = driver.ListBlocksInRange(ctx, 4, 7, trxdb.BlockRangeCanonical)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"00000007cc", "00000006cc", "00000005aa", "00000004aa"}, ids(resps))
+
+	resps, err = driver.ListBlocksInRange(ctx, 6, 6, trxdb.BlockRangeCanonical)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"00000006cc"}, ids(resps))
+
+	// Without a last irreversible block, the blocks above the range are not read and
+	// the highest block of the range is the head
+	reversibleDriver, reversibleCleanup := driverFactory()
+	defer reversibleCleanup()
+
+	for _, id := range []string{"00000005aa", "00000006aa", "00000007aa"} {
+		require.NoError(t, reversibleDriver.PutBlock(ctx, ct.Block(t, id)))
+	}
+	require.NoError(t, reversibleDriver.Flush(ctx))
+
+	resps, err = reversibleDriver.ListBlocksInRange(ctx, 5, 6, trxdb.BlockRangeCanonical)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"00000006aa", "00000005aa"}, ids(resps))
+}
+
 func TestListSiblingBlocks(t *testing.T, driverFactory DriverFactory) {
 
 	ctx := context.Background()
@@ -16,6 +16,8 @@ package trxdbtest
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"testing"
 
@@ -29,9 +31,84 @@ import (
 var transactionReaderTests = []DriverTestFunc{
 	TestGetTransactionTraces,
 	TestGetTransactionTracesBatch,
+	TestGetProjectedTransactionTracesBatch,
 	TestGetTransactionEvents,
 	TestGetTransactionEventsBatch,
 	TestReadTransactions,
+	TestListTransactionRefsBySignerKey,
+	TestListTransactionRefsByActionDataHash,
+	TestListDeferredTransactionRefsBySender,
+	TestListPendingDeferredTransactionRefs,
+}
+
+func TestListTransactionRefsBySignerKey(t *testing.T, driverFactory DriverFactory) {
+	db, clean := driverFactory()
+	defer clean()
+
+	ctx := context.Background()
+	in := testBlock1()
+
+	require.NoError(t, db.PutBlock(ctx, in))
+	require.NoError(t, db.Flush(ctx))
+
+	expected := []*trxdb.TransactionRef{
+		{
+			ID:       "00112233aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			BlockID:  "00000002aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			BlockNum: 2,
+		},
+	}
+
+	// Both textual formats of the same key must resolve to the same index entries
+	refs, err := db.ListTransactionRefsBySignerKey(ctx, "EOS7T3GcBYpYf2D63HGDG7qB9TiD56XT4m1hAQfkHWuV9LhMoQ1ZY", nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, expected, refs)
+
+	refs, err = db.ListTransactionRefsBySignerKey(ctx, "PUB_K1_7T3GcBYpYf2D63HGDG7qB9TiD56XT4m1hAQfkHWuV9LhMoQ1ZY", nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, expected, refs)
+
+	refs, err = db.ListTransactionRefsBySignerKey(ctx, "PUB_K1_7T3GcBYpYf2D63HGDG7qB9TiD56XT4m1hAQfkHWuV9LhMoQ1ZY", expected[0], 10)
+	require.NoError(t, err)
+	assert.Len(t, refs, 0)
+
+	refs, err = db.ListTransactionRefsBySignerKey(ctx, "EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV", nil, 10)
+	require.NoError(t, err)
+	assert.Len(t, refs, 0)
+
+	_, err = db.ListTransactionRefsBySignerKey(ctx, "invalid", nil, 10)
+	assert.Error(t, err)
+}
+
+func TestListTransactionRefsByActionDataHash(t *testing.T, driverFactory DriverFactory) {
+	db, clean := driverFactory()
+	defer clean()
+
+	ctx := context.Background()
+	in := testBlock1()
+	in.UnfilteredTransactionTraces[0].ActionTraces[0].Action.RawData = []byte{0x01, 0x02, 0x03}
+
+	require.NoError(t, db.PutBlock(ctx, in))
+	require.NoError(t, db.Flush(ctx))
+
+	dataHash := sha256.Sum256([]byte{0x01, 0x02, 0x03})
+	refs, err := db.ListTransactionRefsByActionDataHash(ctx, hex.EncodeToString(dataHash[:]), nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []*trxdb.TransactionRef{
+		{
+			ID:       "00112233aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			BlockID:  "00000002aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			BlockNum: 2,
+		},
+	}, refs)
+
+	otherHash := sha256.Sum256([]byte{0x04})
+	refs, err = db.ListTransactionRefsByActionDataHash(ctx, hex.EncodeToString(otherHash[:]), nil, 10)
+	require.NoError(t, err)
+	assert.Len(t, refs, 0)
+
+	_, err = db.ListTransactionRefsByActionDataHash(ctx, "abcd", nil, 10)
+	assert.Error(t, err)
 }
 
 func TestReadTransactions(t *testing.T, driverFactory DriverFactory) {
@@ -183,6 +260,43 @@ func TestGetTransactionTracesBatch(t *testing.T, driverFactory DriverFactory) {
 	}
 }
 
+func TestGetProjectedTransactionTracesBatch(t *testing.T, driverFactory DriverFactory) {
+	var ctx = context.Background()
+	db, clean := driverFactory()
+	defer clean()
+
+	reader, ok := db.(trxdb.ProjectedTracesReader)
+	if !ok {
+		t.Skip("driver does not implement trxdb.ProjectedTracesReader")
+	}
+
+	trxID := "1aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	blk := ct.Block(t, "00000002aa000000000000000000000000000000000000000000000000000000",
+		ct.TrxTrace(t, ct.TrxID(trxID),
+			ct.ActionTrace(t, "zswhq:zswhq:newaccount"),
+			ct.ActionTrace(t, "zswhq.token:zswhq.token:transfer"),
+		),
+	)
+	require.NoError(t, db.PutBlock(ctx, blk))
+	require.NoError(t, db.UpdateNowIrreversibleBlock(ctx, blk))
+	require.NoError(t, db.Flush(ctx))
+
+	matches, err := reader.GetProjectedTransactionTracesBatch(ctx, []string{"1aaa"}, [][]uint32{{1}}, &trxdb.TraceProjection{})
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.Len(t, matches[0], 1)
+
+	trace := matches[0][0].GetExecution().Trace
+	require.Len(t, trace.ActionTraces, 1)
+	assert.Equal(t, "zswhq.token", trace.ActionTraces[0].Receiver)
+	assert.Nil(t, trace.ActionTraces[0].Receipt)
+	assert.Equal(t, pbcodec.TransactionStatus_TRANSACTIONSTATUS_EXECUTED, trace.Receipt.Status)
+	assert.True(t, matches[0][0].Irreversible)
+
+	_, err = reader.GetProjectedTransactionTracesBatch(ctx, []string{"1aaa"}, nil, &trxdb.TraceProjection{})
+	assert.Error(t, err)
+}
+
 func TestGetTransactionEvents(t *testing.T, driverFactory DriverFactory) {
 	tests := []struct {
 		name        string
@@ -303,3 +417,99 @@ func putTransaction(t *testing.T, db trxdb.DB, trxID string) {
 	require.NoError(t, db.UpdateNowIrreversibleBlock(ctx, blk))
 	require.NoError(t, db.Flush(ctx))
 }
+
+func putDeferredTestBlocks(t *testing.T, db trxdb.DB) (created []*trxdb.TransactionRef) {
+	ctx := context.Background()
+
+	dtrxOp := func(operation pbcodec.DTrxOp_Operation, trxID string, sender string, expirationAt string) *pbcodec.DTrxOp {
+		return &pbcodec.DTrxOp{Operation: operation, TransactionId: trxID, Sender: sender, ExpirationAt: expirationAt}
+	}
+
+	blk2 := ct.Block(t, "00000002aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		ct.TrxTrace(t, ct.TrxID("00000002bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")),
+	)
+	blk2.UnfilteredTransactionTraces[0].DtrxOps = []*pbcodec.DTrxOp{
+		dtrxOp(pbcodec.DTrxOp_OPERATION_CREATE, "d1000000dddddddddddddddddddddddddddddddddddddddddddddddddddddddd", "alice", "2006-01-02T16:00:00"),
+		dtrxOp(pbcodec.DTrxOp_OPERATION_CREATE, "d2000000dddddddddddddddddddddddddddddddddddddddddddddddddddddddd", "alice", "2006-01-02T16:30:00"),
+		// Already expired at creation, it's never pending
+		dtrxOp(pbcodec.DTrxOp_OPERATION_CREATE, "d3000000dddddddddddddddddddddddddddddddddddddddddddddddddddddddd", "bob", "2006-01-02T15:00:00"),
+	}
+
+	blk3 := ct.Block(t, "00000003aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		ct.TrxTrace(t, ct.TrxID("00000003bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+			ct.DtrxOp(t, "cancel", "d2000000dddddddddddddddddddddddddddddddddddddddddddddddddddddddd"),
+		),
+	)
+
+	blk4 := ct.Block(t, "00000004aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		ct.TrxTrace(t, ct.TrxID("d1000000dddddddddddddddddddddddddddddddddddddddddddddddddddddddd")),
+	)
+
+	for _, blk := range []*pbcodec.Block{blk2, blk3, blk4} {
+		require.NoError(t, db.PutBlock(ctx, blk))
+		require.NoError(t, db.UpdateNowIrreversibleBlock(ctx, blk))
+	}
+	require.NoError(t, db.Flush(ctx))
+
+	for _, op := range blk2.UnfilteredTransactionTraces[0].DtrxOps {
+		created = append(created, &trxdb.TransactionRef{ID: op.TransactionId, BlockID: blk2.Id, BlockNum: 2})
+	}
+	return
+}
+
+func TestListDeferredTransactionRefsBySender(t *testing.T, driverFactory DriverFactory) {
+	db, clean := driverFactory()
+	defer clean()
+
+	ctx := context.Background()
+	created := putDeferredTestBlocks(t, db)
+
+	refs, err := db.ListDeferredTransactionRefsBySender(ctx, "alice", nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, created[0:2], refs)
+
+	refs, err = db.ListDeferredTransactionRefsBySender(ctx, "alice", created[0], 10)
+	require.NoError(t, err)
+	assert.Equal(t, created[1:2], refs)
+
+	refs, err = db.ListDeferredTransactionRefsBySender(ctx, "bob", nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, created[2:3], refs)
+
+	refs, err = db.ListDeferredTransactionRefsBySender(ctx, "nobody", nil, 10)
+	require.NoError(t, err)
+	assert.Len(t, refs, 0)
+}
+
+func TestListPendingDeferredTransactionRefs(t *testing.T, driverFactory DriverFactory) {
+	db, clean := driverFactory()
+	defer clean()
+
+	ctx := context.Background()
+	created := putDeferredTestBlocks(t, db)
+
+	refs, err := db.ListPendingDeferredTransactionRefs(ctx, 2, nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, created[0:2], refs)
+
+	refs, err = db.ListPendingDeferredTransactionRefs(ctx, 2, nil, 1)
+	require.NoError(t, err)
+	assert.Equal(t, created[0:1], refs)
+
+	refs, err = db.ListPendingDeferredTransactionRefs(ctx, 2, created[0], 10)
+	require.NoError(t, err)
+	assert.Equal(t, created[1:2], refs)
+
+	// Canceled at block #3
+	refs, err = db.ListPendingDeferredTransactionRefs(ctx, 3, nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, created[0:1], refs)
+
+	// Executed at block #4
+	refs, err = db.ListPendingDeferredTransactionRefs(ctx, 4, nil, 10)
+	require.NoError(t, err)
+	assert.Len(t, refs, 0)
+
+	_, err = db.ListPendingDeferredTransactionRefs(ctx, 10, nil, 10)
+	assert.Error(t, err)
+}
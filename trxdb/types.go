@@ -18,10 +18,57 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	pbtrxdb "github.com/zhongshuwen/histnew/pb/dfuse/zswhq/trxdb/v1"
 )
 
+// TransactionRef points to a transaction included in a given block, as
+// returned by the secondary indexes lookups.
+type TransactionRef struct {
+	ID       string `json:"id"`
+	BlockID  string `json:"block_id"`
+	BlockNum uint32 `json:"block_num"`
+}
+
+// BlockRangeMode tells which blocks of each height are listed by
+// `ListBlocksInRange`.
+type BlockRangeMode int
+
+const (
+	// BlockRangeCanonical lists one block per height, the irreversible one
+	// when the height is irreversible, otherwise the one on the longest
+	// chain built on top of the irreversible blocks.
+	BlockRangeCanonical BlockRangeMode = iota
+
+	// BlockRangeIrreversibleOnly lists the irreversible block of each
+	// height, the heights not yet irreversible being left out.
+	BlockRangeIrreversibleOnly
+
+	// BlockRangeAllForks lists every block of each height, forked
+	// siblings included.
+	BlockRangeAllForks
+)
+
+// PurgeReport describes the retention of each table of a purgeable store
+// and what the purges removed so far.
+type PurgeReport struct {
+	Height     uint64              `json:"height"`
+	DefaultTTL uint64              `json:"default_ttl_in_blocks"`
+	Tables     []*TablePurgeReport `json:"tables"`
+}
+
+type TablePurgeReport struct {
+	Table       string `json:"table"`
+	KeepForever bool   `json:"keep_forever"`
+	TTLInBlocks uint64 `json:"ttl_in_blocks,omitempty"`
+
+	PurgedBelowBlockNum uint64    `json:"purged_below_block_num"`
+	LastPurgedRows      uint64    `json:"last_purged_rows"`
+	TotalPurgedRows     uint64    `json:"total_purged_rows"`
+	LastPurgeTime       time.Time `json:"last_purge_time"`
+}
+
 var NoIndexing IndexableCategories = nil
 var FullIndexing IndexableCategories
 